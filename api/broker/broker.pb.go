@@ -18,6 +18,7 @@
 		DeduplicatedDeviceActivationRequest
 		ActivationChallengeRequest
 		ActivationChallengeResponse
+		TxAcknowledgement
 		SubscribeRequest
 		StatusRequest
 		Status
@@ -195,14 +196,16 @@ func (m *UplinkMessage) GetTrace() *trace.Trace {
 
 // received from the Handler, sent to the Router, used as Template
 type DownlinkMessage struct {
-	Payload        []byte                                             `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Message        *protocol.Message                                  `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
-	DevEui         *github_com_TheThingsNetwork_ttn_core_types.DevEUI `protobuf:"bytes,11,opt,name=dev_eui,json=devEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.DevEUI" json:"dev_eui,omitempty"`
-	AppEui         *github_com_TheThingsNetwork_ttn_core_types.AppEUI `protobuf:"bytes,12,opt,name=app_eui,json=appEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.AppEUI" json:"app_eui,omitempty"`
-	AppId          string                                             `protobuf:"bytes,13,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	DevId          string                                             `protobuf:"bytes,14,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
-	DownlinkOption *DownlinkOption                                    `protobuf:"bytes,21,opt,name=downlink_option,json=downlinkOption" json:"downlink_option,omitempty"`
-	Trace          *trace.Trace                                       `protobuf:"bytes,31,opt,name=trace" json:"trace,omitempty"`
+	Payload []byte                                             `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Message *protocol.Message                                  `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	DevEui  *github_com_TheThingsNetwork_ttn_core_types.DevEUI `protobuf:"bytes,11,opt,name=dev_eui,json=devEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.DevEUI" json:"dev_eui,omitempty"`
+	AppEui  *github_com_TheThingsNetwork_ttn_core_types.AppEUI `protobuf:"bytes,12,opt,name=app_eui,json=appEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.AppEUI" json:"app_eui,omitempty"`
+	AppId   string                                             `protobuf:"bytes,13,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevId   string                                             `protobuf:"bytes,14,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// The MulticastGroupID is set instead of the DevEUI and DevID for downlink to a multicast group
+	MulticastGroupId string          `protobuf:"bytes,15,opt,name=multicast_group_id,json=multicastGroupId,proto3" json:"multicast_group_id,omitempty"`
+	DownlinkOption   *DownlinkOption `protobuf:"bytes,21,opt,name=downlink_option,json=downlinkOption" json:"downlink_option,omitempty"`
+	Trace            *trace.Trace    `protobuf:"bytes,31,opt,name=trace" json:"trace,omitempty"`
 }
 
 func (m *DownlinkMessage) Reset()                    { *m = DownlinkMessage{} }
@@ -237,6 +240,13 @@ func (m *DownlinkMessage) GetDevId() string {
 	return ""
 }
 
+func (m *DownlinkMessage) GetMulticastGroupId() string {
+	if m != nil {
+		return m.MulticastGroupId
+	}
+	return ""
+}
+
 func (m *DownlinkMessage) GetDownlinkOption() *DownlinkOption {
 	if m != nil {
 		return m.DownlinkOption
@@ -597,13 +607,73 @@ func (m *ActivationChallengeResponse) GetMessage() *protocol.Message {
 	return nil
 }
 
+// message TxAcknowledgement is sent by the Router to the Broker and by the Broker to the Handler
+// when a gateway handled a downlink message
+type TxAcknowledgement struct {
+	// The identifier of the DownlinkOption of the downlink message
+	DownlinkId string                                             `protobuf:"bytes,1,opt,name=downlink_id,json=downlinkId,proto3" json:"downlink_id,omitempty"`
+	Result     gateway.TxAcknowledgement_Result                   `protobuf:"varint,2,opt,name=result,proto3,enum=gateway.TxAcknowledgement.Result" json:"result,omitempty"`
+	GatewayId  string                                             `protobuf:"bytes,3,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	DevEui     *github_com_TheThingsNetwork_ttn_core_types.DevEUI `protobuf:"bytes,11,opt,name=dev_eui,json=devEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.DevEUI" json:"dev_eui,omitempty"`
+	AppEui     *github_com_TheThingsNetwork_ttn_core_types.AppEUI `protobuf:"bytes,12,opt,name=app_eui,json=appEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.AppEUI" json:"app_eui,omitempty"`
+	AppId      string                                             `protobuf:"bytes,13,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevId      string                                             `protobuf:"bytes,14,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	Trace      *trace.Trace                                       `protobuf:"bytes,21,opt,name=trace" json:"trace,omitempty"`
+}
+
+func (m *TxAcknowledgement) Reset()                    { *m = TxAcknowledgement{} }
+func (*TxAcknowledgement) ProtoMessage()               {}
+func (*TxAcknowledgement) Descriptor() ([]byte, []int) { return fileDescriptorBroker, []int{9} }
+
+func (m *TxAcknowledgement) GetDownlinkId() string {
+	if m != nil {
+		return m.DownlinkId
+	}
+	return ""
+}
+
+func (m *TxAcknowledgement) GetResult() gateway.TxAcknowledgement_Result {
+	if m != nil {
+		return m.Result
+	}
+	return gateway.TxAcknowledgement_SUCCESS
+}
+
+func (m *TxAcknowledgement) GetGatewayId() string {
+	if m != nil {
+		return m.GatewayId
+	}
+	return ""
+}
+
+func (m *TxAcknowledgement) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *TxAcknowledgement) GetDevId() string {
+	if m != nil {
+		return m.DevId
+	}
+	return ""
+}
+
+func (m *TxAcknowledgement) GetTrace() *trace.Trace {
+	if m != nil {
+		return m.Trace
+	}
+	return nil
+}
+
 // message SubscribeRequest is used by a Handler to subscribe to uplink messages
 type SubscribeRequest struct {
 }
 
 func (m *SubscribeRequest) Reset()                    { *m = SubscribeRequest{} }
 func (*SubscribeRequest) ProtoMessage()               {}
-func (*SubscribeRequest) Descriptor() ([]byte, []int) { return fileDescriptorBroker, []int{9} }
+func (*SubscribeRequest) Descriptor() ([]byte, []int) { return fileDescriptorBroker, []int{10} }
 
 // message StatusRequest is used to request the status of this Broker
 type StatusRequest struct {
@@ -611,7 +681,7 @@ type StatusRequest struct {
 
 func (m *StatusRequest) Reset()                    { *m = StatusRequest{} }
 func (*StatusRequest) ProtoMessage()               {}
-func (*StatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorBroker, []int{10} }
+func (*StatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorBroker, []int{11} }
 
 type Status struct {
 	System            *api.SystemStats    `protobuf:"bytes,1,opt,name=system" json:"system,omitempty"`
@@ -629,7 +699,7 @@ type Status struct {
 
 func (m *Status) Reset()                    { *m = Status{} }
 func (*Status) ProtoMessage()               {}
-func (*Status) Descriptor() ([]byte, []int) { return fileDescriptorBroker, []int{11} }
+func (*Status) Descriptor() ([]byte, []int) { return fileDescriptorBroker, []int{12} }
 
 func (m *Status) GetSystem() *api.SystemStats {
 	if m != nil {
@@ -709,7 +779,7 @@ type ApplicationHandlerRegistration struct {
 func (m *ApplicationHandlerRegistration) Reset()      { *m = ApplicationHandlerRegistration{} }
 func (*ApplicationHandlerRegistration) ProtoMessage() {}
 func (*ApplicationHandlerRegistration) Descriptor() ([]byte, []int) {
-	return fileDescriptorBroker, []int{12}
+	return fileDescriptorBroker, []int{13}
 }
 
 func (m *ApplicationHandlerRegistration) GetAppId() string {
@@ -736,6 +806,7 @@ func init() {
 	proto.RegisterType((*DeduplicatedDeviceActivationRequest)(nil), "broker.DeduplicatedDeviceActivationRequest")
 	proto.RegisterType((*ActivationChallengeRequest)(nil), "broker.ActivationChallengeRequest")
 	proto.RegisterType((*ActivationChallengeResponse)(nil), "broker.ActivationChallengeResponse")
+	proto.RegisterType((*TxAcknowledgement)(nil), "broker.TxAcknowledgement")
 	proto.RegisterType((*SubscribeRequest)(nil), "broker.SubscribeRequest")
 	proto.RegisterType((*StatusRequest)(nil), "broker.StatusRequest")
 	proto.RegisterType((*Status)(nil), "broker.Status")
@@ -1022,6 +1093,9 @@ func (this *DownlinkMessage) VerboseEqual(that interface{}) error {
 	if this.DevId != that1.DevId {
 		return fmt.Errorf("DevId this(%v) Not Equal that(%v)", this.DevId, that1.DevId)
 	}
+	if this.MulticastGroupId != that1.MulticastGroupId {
+		return fmt.Errorf("MulticastGroupId this(%v) Not Equal that(%v)", this.MulticastGroupId, that1.MulticastGroupId)
+	}
 	if !this.DownlinkOption.Equal(that1.DownlinkOption) {
 		return fmt.Errorf("DownlinkOption this(%v) Not Equal that(%v)", this.DownlinkOption, that1.DownlinkOption)
 	}
@@ -1081,6 +1155,9 @@ func (this *DownlinkMessage) Equal(that interface{}) bool {
 	if this.DevId != that1.DevId {
 		return false
 	}
+	if this.MulticastGroupId != that1.MulticastGroupId {
+		return false
+	}
 	if !this.DownlinkOption.Equal(that1.DownlinkOption) {
 		return false
 	}
@@ -1771,6 +1848,124 @@ func (this *ActivationChallengeResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TxAcknowledgement) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*TxAcknowledgement)
+	if !ok {
+		that2, ok := that.(TxAcknowledgement)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *TxAcknowledgement")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *TxAcknowledgement but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *TxAcknowledgement but is not nil && this == nil")
+	}
+	if this.DownlinkId != that1.DownlinkId {
+		return fmt.Errorf("DownlinkId this(%v) Not Equal that(%v)", this.DownlinkId, that1.DownlinkId)
+	}
+	if this.Result != that1.Result {
+		return fmt.Errorf("Result this(%v) Not Equal that(%v)", this.Result, that1.Result)
+	}
+	if this.GatewayId != that1.GatewayId {
+		return fmt.Errorf("GatewayId this(%v) Not Equal that(%v)", this.GatewayId, that1.GatewayId)
+	}
+	if that1.DevEui == nil {
+		if this.DevEui != nil {
+			return fmt.Errorf("this.DevEui != nil && that1.DevEui == nil")
+		}
+	} else if !this.DevEui.Equal(*that1.DevEui) {
+		return fmt.Errorf("DevEui this(%v) Not Equal that(%v)", this.DevEui, that1.DevEui)
+	}
+	if that1.AppEui == nil {
+		if this.AppEui != nil {
+			return fmt.Errorf("this.AppEui != nil && that1.AppEui == nil")
+		}
+	} else if !this.AppEui.Equal(*that1.AppEui) {
+		return fmt.Errorf("AppEui this(%v) Not Equal that(%v)", this.AppEui, that1.AppEui)
+	}
+	if this.AppId != that1.AppId {
+		return fmt.Errorf("AppId this(%v) Not Equal that(%v)", this.AppId, that1.AppId)
+	}
+	if this.DevId != that1.DevId {
+		return fmt.Errorf("DevId this(%v) Not Equal that(%v)", this.DevId, that1.DevId)
+	}
+	if !this.Trace.Equal(that1.Trace) {
+		return fmt.Errorf("Trace this(%v) Not Equal that(%v)", this.Trace, that1.Trace)
+	}
+	return nil
+}
+func (this *TxAcknowledgement) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*TxAcknowledgement)
+	if !ok {
+		that2, ok := that.(TxAcknowledgement)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.DownlinkId != that1.DownlinkId {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
+	if this.GatewayId != that1.GatewayId {
+		return false
+	}
+	if that1.DevEui == nil {
+		if this.DevEui != nil {
+			return false
+		}
+	} else if !this.DevEui.Equal(*that1.DevEui) {
+		return false
+	}
+	if that1.AppEui == nil {
+		if this.AppEui != nil {
+			return false
+		}
+	} else if !this.AppEui.Equal(*that1.AppEui) {
+		return false
+	}
+	if this.AppId != that1.AppId {
+		return false
+	}
+	if this.DevId != that1.DevId {
+		return false
+	}
+	if !this.Trace.Equal(that1.Trace) {
+		return false
+	}
+	return true
+}
 func (this *SubscribeRequest) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
@@ -2079,6 +2274,8 @@ type BrokerClient interface {
 	Publish(ctx context.Context, opts ...grpc.CallOption) (Broker_PublishClient, error)
 	// Router requests device activation
 	Activate(ctx context.Context, in *DeviceActivationRequest, opts ...grpc.CallOption) (*DeviceActivationResponse, error)
+	// Router forwards the acknowledgement of a gateway for a downlink message
+	TxAck(ctx context.Context, in *TxAcknowledgement, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 }
 
 type brokerClient struct {
@@ -2195,6 +2392,15 @@ func (c *brokerClient) Activate(ctx context.Context, in *DeviceActivationRequest
 	return out, nil
 }
 
+func (c *brokerClient) TxAck(ctx context.Context, in *TxAcknowledgement, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/broker.Broker/TxAck", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Broker service
 
 type BrokerServer interface {
@@ -2206,6 +2412,8 @@ type BrokerServer interface {
 	Publish(Broker_PublishServer) error
 	// Router requests device activation
 	Activate(context.Context, *DeviceActivationRequest) (*DeviceActivationResponse, error)
+	// Router forwards the acknowledgement of a gateway for a downlink message
+	TxAck(context.Context, *TxAcknowledgement) (*google_protobuf.Empty, error)
 }
 
 func RegisterBrokerServer(s *grpc.Server, srv BrokerServer) {
@@ -2303,6 +2511,24 @@ func _Broker_Activate_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Broker_TxAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxAcknowledgement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).TxAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/broker.Broker/TxAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).TxAck(ctx, req.(*TxAcknowledgement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Broker_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "broker.Broker",
 	HandlerType: (*BrokerServer)(nil),
@@ -2311,6 +2537,10 @@ var _Broker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Activate",
 			Handler:    _Broker_Activate_Handler,
 		},
+		{
+			MethodName: "TxAck",
+			Handler:    _Broker_TxAck_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2675,6 +2905,12 @@ func (m *DownlinkMessage) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintBroker(dAtA, i, uint64(len(m.DevId)))
 		i += copy(dAtA[i:], m.DevId)
 	}
+	if len(m.MulticastGroupId) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintBroker(dAtA, i, uint64(len(m.MulticastGroupId)))
+		i += copy(dAtA[i:], m.MulticastGroupId)
+	}
 	if m.DownlinkOption != nil {
 		dAtA[i] = 0xaa
 		i++
@@ -3232,6 +3468,85 @@ func (m *ActivationChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TxAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.DownlinkId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBroker(dAtA, i, uint64(len(m.DownlinkId)))
+		i += copy(dAtA[i:], m.DownlinkId)
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBroker(dAtA, i, uint64(m.Result))
+	}
+	if len(m.GatewayId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBroker(dAtA, i, uint64(len(m.GatewayId)))
+		i += copy(dAtA[i:], m.GatewayId)
+	}
+	if m.DevEui != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintBroker(dAtA, i, uint64(m.DevEui.Size()))
+		n41, err := m.DevEui.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	if m.AppEui != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintBroker(dAtA, i, uint64(m.AppEui.Size()))
+		n42, err := m.AppEui.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
+	}
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintBroker(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintBroker(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if m.Trace != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintBroker(dAtA, i, uint64(m.Trace.Size()))
+		n43, err := m.Trace.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n43
+	}
+	return i, nil
+}
+
 func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3287,71 +3602,71 @@ func (m *Status) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.System.Size()))
-		n41, err := m.System.MarshalTo(dAtA[i:])
+		n44, err := m.System.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n44
 	}
 	if m.Component != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Component.Size()))
-		n42, err := m.Component.MarshalTo(dAtA[i:])
+		n45, err := m.Component.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n45
 	}
 	if m.Uplink != nil {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Uplink.Size()))
-		n43, err := m.Uplink.MarshalTo(dAtA[i:])
+		n46, err := m.Uplink.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n46
 	}
 	if m.UplinkUnique != nil {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.UplinkUnique.Size()))
-		n44, err := m.UplinkUnique.MarshalTo(dAtA[i:])
+		n47, err := m.UplinkUnique.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n47
 	}
 	if m.Downlink != nil {
 		dAtA[i] = 0x6a
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Downlink.Size()))
-		n45, err := m.Downlink.MarshalTo(dAtA[i:])
+		n48, err := m.Downlink.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n48
 	}
 	if m.Activations != nil {
 		dAtA[i] = 0x72
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Activations.Size()))
-		n46, err := m.Activations.MarshalTo(dAtA[i:])
+		n49, err := m.Activations.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n49
 	}
 	if m.ActivationsUnique != nil {
 		dAtA[i] = 0x7a
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.ActivationsUnique.Size()))
-		n47, err := m.ActivationsUnique.MarshalTo(dAtA[i:])
+		n50, err := m.ActivationsUnique.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n50
 	}
 	if m.Deduplication != nil {
 		dAtA[i] = 0x82
@@ -3359,11 +3674,11 @@ func (m *Status) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Deduplication.Size()))
-		n48, err := m.Deduplication.MarshalTo(dAtA[i:])
+		n51, err := m.Deduplication.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n51
 	}
 	if m.ConnectedRouters != 0 {
 		dAtA[i] = 0xa8
@@ -3542,6 +3857,10 @@ func (m *DownlinkMessage) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBroker(uint64(l))
 	}
+	l = len(m.MulticastGroupId)
+	if l > 0 {
+		n += 1 + l + sovBroker(uint64(l))
+	}
 	if m.DownlinkOption != nil {
 		l = m.DownlinkOption.Size()
 		n += 2 + l + sovBroker(uint64(l))
@@ -3769,6 +4088,43 @@ func (m *ActivationChallengeResponse) Size() (n int) {
 	return n
 }
 
+func (m *TxAcknowledgement) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DownlinkId)
+	if l > 0 {
+		n += 1 + l + sovBroker(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovBroker(uint64(m.Result))
+	}
+	l = len(m.GatewayId)
+	if l > 0 {
+		n += 1 + l + sovBroker(uint64(l))
+	}
+	if m.DevEui != nil {
+		l = m.DevEui.Size()
+		n += 1 + l + sovBroker(uint64(l))
+	}
+	if m.AppEui != nil {
+		l = m.AppEui.Size()
+		n += 1 + l + sovBroker(uint64(l))
+	}
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovBroker(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovBroker(uint64(l))
+	}
+	if m.Trace != nil {
+		l = m.Trace.Size()
+		n += 2 + l + sovBroker(uint64(l))
+	}
+	return n
+}
+
 func (m *SubscribeRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -3897,6 +4253,7 @@ func (this *DownlinkMessage) String() string {
 		`AppEui:` + fmt.Sprintf("%v", this.AppEui) + `,`,
 		`AppId:` + fmt.Sprintf("%v", this.AppId) + `,`,
 		`DevId:` + fmt.Sprintf("%v", this.DevId) + `,`,
+		`MulticastGroupId:` + fmt.Sprintf("%v", this.MulticastGroupId) + `,`,
 		`DownlinkOption:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkOption), "DownlinkOption", "DownlinkOption", 1) + `,`,
 		`Trace:` + strings.Replace(fmt.Sprintf("%v", this.Trace), "Trace", "trace.Trace", 1) + `,`,
 		`}`,
@@ -4001,6 +4358,23 @@ func (this *ActivationChallengeResponse) String() string {
 	}, "")
 	return s
 }
+func (this *TxAcknowledgement) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxAcknowledgement{`,
+		`DownlinkId:` + fmt.Sprintf("%v", this.DownlinkId) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`GatewayId:` + fmt.Sprintf("%v", this.GatewayId) + `,`,
+		`DevEui:` + fmt.Sprintf("%v", this.DevEui) + `,`,
+		`AppEui:` + fmt.Sprintf("%v", this.AppEui) + `,`,
+		`AppId:` + fmt.Sprintf("%v", this.AppId) + `,`,
+		`DevId:` + fmt.Sprintf("%v", this.DevId) + `,`,
+		`Trace:` + strings.Replace(fmt.Sprintf("%v", this.Trace), "Trace", "trace.Trace", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SubscribeRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -4850,6 +5224,35 @@ func (m *DownlinkMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.DevId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MulticastGroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBroker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBroker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MulticastGroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 21:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkOption", wireType)
@@ -6611,6 +7014,288 @@ func (m *ActivationChallengeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TxAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBroker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBroker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBroker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DownlinkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBroker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= (gateway.TxAcknowledgement_Result(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBroker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBroker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBroker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBroker
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.DevEUI
+			m.DevEui = &v
+			if err := m.DevEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBroker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBroker
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.AppEUI
+			m.AppEui = &v
+			if err := m.AppEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBroker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBroker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBroker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBroker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBroker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBroker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trace == nil {
+				m.Trace = &trace.Trace{}
+			}
+			if err := m.Trace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBroker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBroker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7281,83 +7966,89 @@ func init() {
 }
 
 var fileDescriptorBroker = []byte{
-	// 1233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4d, 0x8f, 0xdb, 0x44,
-	0x18, 0xae, 0xf7, 0x23, 0xdb, 0x7d, 0xb3, 0xd9, 0x64, 0xa7, 0xdd, 0x5d, 0x37, 0xa5, 0xd9, 0x25,
-	0x48, 0x55, 0xa0, 0x34, 0x69, 0x83, 0x00, 0x21, 0x21, 0xaa, 0xdd, 0x6e, 0x05, 0x8b, 0x48, 0xa9,
-	0xdc, 0x94, 0x03, 0x42, 0x8a, 0x26, 0xf6, 0x5b, 0x67, 0x54, 0xc7, 0x76, 0x3d, 0xe3, 0xb4, 0xb9,
-	0x71, 0xe2, 0x82, 0x84, 0xf8, 0x09, 0xc0, 0x89, 0x2b, 0x47, 0x2e, 0x1c, 0x11, 0x47, 0x8e, 0x88,
-	0x03, 0xb4, 0xcb, 0x1f, 0x41, 0x1e, 0xcf, 0xd8, 0xc9, 0x6e, 0xd3, 0x56, 0x55, 0xc5, 0x87, 0xba,
-	0x97, 0xc4, 0xf3, 0xbc, 0x8f, 0x9f, 0x99, 0x79, 0xbf, 0x3c, 0x36, 0xbc, 0xed, 0x32, 0x31, 0x88,
-	0xfb, 0x4d, 0x3b, 0x18, 0xb6, 0xba, 0x03, 0xec, 0x0e, 0x98, 0xef, 0xf2, 0xeb, 0x28, 0xee, 0x05,
-	0xd1, 0x9d, 0x96, 0x10, 0x7e, 0x8b, 0x86, 0xac, 0xd5, 0x8f, 0x82, 0x3b, 0x18, 0xa9, 0xbf, 0x66,
-	0x18, 0x05, 0x22, 0x20, 0x85, 0x74, 0x54, 0x3d, 0xeb, 0x06, 0x81, 0xeb, 0x61, 0x4b, 0xa2, 0xfd,
-	0xf8, 0x76, 0x0b, 0x87, 0xa1, 0x18, 0xa7, 0xa4, 0xea, 0xc5, 0x09, 0x75, 0x37, 0x70, 0x83, 0x9c,
-	0x95, 0x8c, 0xe4, 0x40, 0x5e, 0x29, 0xfa, 0x9a, 0x9e, 0x90, 0x86, 0x4c, 0x41, 0x5b, 0x1a, 0x92,
-	0x43, 0x3b, 0xf0, 0xb2, 0x0b, 0x45, 0x38, 0xa7, 0x09, 0x2e, 0x15, 0x78, 0x8f, 0x8e, 0xf5, 0xbf,
-	0x32, 0x9f, 0xd1, 0x66, 0x11, 0x51, 0x1b, 0xd3, 0xdf, 0xd4, 0x54, 0xff, 0x62, 0x0e, 0x56, 0xf7,
-	0x82, 0x7b, 0xbe, 0xc7, 0xfc, 0x3b, 0x1f, 0x87, 0x82, 0x05, 0x3e, 0xa9, 0x01, 0x30, 0x07, 0x7d,
-	0xc1, 0x6e, 0x33, 0x8c, 0x4c, 0x63, 0xdb, 0x68, 0x2c, 0x5b, 0x13, 0x08, 0x39, 0x07, 0xa0, 0xe4,
-	0x7b, 0xcc, 0x31, 0xe7, 0xa4, 0x7d, 0x59, 0x21, 0xfb, 0x0e, 0x39, 0x0d, 0x8b, 0xdc, 0x0e, 0x22,
-	0x34, 0xe7, 0xb7, 0x8d, 0x46, 0xc9, 0x4a, 0x07, 0xa4, 0x0a, 0x27, 0x1d, 0xa4, 0x8e, 0xc7, 0x7c,
-	0x34, 0x17, 0xb6, 0x8d, 0xc6, 0xbc, 0x95, 0x8d, 0xc9, 0x2e, 0x94, 0xf5, 0x7e, 0x7a, 0x76, 0xe0,
-	0xdf, 0x66, 0xae, 0xb9, 0xb8, 0x6d, 0x34, 0x8a, 0xed, 0x33, 0xcd, 0x6c, 0x9f, 0xdd, 0xfb, 0x57,
-	0xa5, 0x25, 0x8e, 0x68, 0xb2, 0x48, 0x6b, 0x55, 0x5b, 0x52, 0x98, 0x5c, 0x81, 0x55, 0xbd, 0x28,
-	0x25, 0x51, 0x90, 0x12, 0x66, 0x53, 0xbb, 0xe2, 0xb0, 0x42, 0x49, 0x19, 0x52, 0xb4, 0xfe, 0xd5,
-	0x02, 0x94, 0x6e, 0x85, 0x89, 0x1b, 0x3a, 0xc8, 0x39, 0x75, 0x91, 0x98, 0xb0, 0x14, 0xd2, 0xb1,
-	0x17, 0x50, 0x47, 0x3a, 0x61, 0xc5, 0xd2, 0x43, 0x72, 0x01, 0x96, 0x86, 0x29, 0x49, 0x6e, 0xbf,
-	0xd8, 0x5e, 0xcb, 0x17, 0xaa, 0xee, 0xb6, 0x34, 0x83, 0x5c, 0x87, 0x25, 0x07, 0x47, 0x3d, 0x8c,
-	0x99, 0x59, 0x4c, 0x64, 0x76, 0xdf, 0xfc, 0xfd, 0x8f, 0xad, 0xcb, 0x4f, 0xca, 0xb8, 0xc4, 0x69,
-	0x2d, 0x31, 0x0e, 0x91, 0x37, 0xf7, 0x70, 0x74, 0xed, 0xd6, 0xbe, 0x55, 0x70, 0x70, 0x74, 0x2d,
-	0x66, 0x89, 0x1e, 0x0d, 0x43, 0xa9, 0xb7, 0xf2, 0x4c, 0x7a, 0x3b, 0x61, 0x28, 0xf5, 0x68, 0x18,
-	0x26, 0x7a, 0xeb, 0x90, 0x5c, 0x25, 0xa1, 0x2c, 0xc9, 0x50, 0x2e, 0xd2, 0x30, 0xdc, 0x77, 0x12,
-	0x38, 0x59, 0x36, 0x73, 0xcc, 0xd5, 0x14, 0x76, 0x70, 0xb4, 0xef, 0x90, 0x1d, 0x58, 0xcb, 0x62,
-	0x35, 0x44, 0x41, 0x1d, 0x2a, 0xa8, 0xb9, 0x2e, 0x9d, 0x70, 0x3a, 0x77, 0x82, 0x75, 0xbf, 0xa3,
-	0x6c, 0x56, 0x45, 0x83, 0x1a, 0x21, 0xef, 0x41, 0x45, 0x87, 0x2a, 0x53, 0xd8, 0x90, 0x0a, 0xa7,
-	0xb2, 0x60, 0x4d, 0x08, 0x94, 0x15, 0x96, 0xdd, 0xbf, 0x03, 0x15, 0x47, 0x65, 0x6c, 0x2f, 0x90,
-	0x29, 0xcb, 0xcd, 0xad, 0xed, 0xf9, 0x46, 0xb1, 0xbd, 0xd1, 0x54, 0xd5, 0x39, 0x9d, 0xd1, 0x56,
-	0xd9, 0x99, 0x1a, 0x73, 0x52, 0x87, 0x45, 0x59, 0x04, 0xe6, 0xab, 0x72, 0xde, 0x95, 0x66, 0x5a,
-	0x12, 0xdd, 0xe4, 0xd7, 0x4a, 0x4d, 0xf5, 0x2f, 0xe7, 0xa1, 0xac, 0x75, 0x8e, 0x53, 0xe2, 0x31,
-	0x29, 0x71, 0x05, 0xca, 0x87, 0xe2, 0xa1, 0x12, 0x62, 0x56, 0x38, 0x56, 0xa7, 0xc3, 0x91, 0x47,
-	0x63, 0x6b, 0x76, 0x34, 0x7e, 0x36, 0xc0, 0xdc, 0xc3, 0x11, 0xb3, 0x71, 0xc7, 0x16, 0x6c, 0x94,
-	0x96, 0x30, 0xf2, 0x30, 0xf0, 0xf9, 0x73, 0x0b, 0xcb, 0x23, 0x36, 0x52, 0x7c, 0xb6, 0x8d, 0xac,
-	0xcf, 0xde, 0xc8, 0x4f, 0x0b, 0x70, 0x66, 0x0f, 0x9d, 0x38, 0xf4, 0x98, 0x4d, 0x05, 0x3a, 0xc7,
-	0x3d, 0xe7, 0xdf, 0xeb, 0x39, 0xf3, 0x4f, 0xdd, 0x73, 0xb6, 0xa0, 0xc8, 0x31, 0x1a, 0x61, 0xd4,
-	0x13, 0x6c, 0x88, 0xe6, 0xa6, 0x7c, 0x82, 0x41, 0x0a, 0x75, 0xd9, 0x10, 0xc9, 0x1e, 0xac, 0x45,
-	0x2a, 0x1d, 0x7b, 0x02, 0x87, 0xa1, 0x47, 0x85, 0xce, 0xe7, 0xcd, 0xc3, 0xd9, 0xa3, 0xc3, 0x55,
-	0xd1, 0x77, 0x74, 0xd5, 0x0d, 0x4f, 0xd5, 0x97, 0x7e, 0x5c, 0x80, 0xcd, 0xa3, 0x95, 0x70, 0x37,
-	0x46, 0x2e, 0x5e, 0x94, 0xf4, 0xf9, 0x0f, 0x3c, 0x84, 0x3a, 0x70, 0x8a, 0x66, 0xee, 0xcf, 0x25,
-	0x36, 0xa5, 0xc4, 0x4b, 0xf9, 0x22, 0xf2, 0x18, 0x65, 0x5a, 0x84, 0x1e, 0xc1, 0xfe, 0xa9, 0x67,
-	0xda, 0x37, 0x8b, 0xf0, 0xca, 0x64, 0xf3, 0x79, 0xc1, 0xf3, 0xe8, 0x7f, 0xd7, 0x86, 0x9e, 0x73,
-	0xd6, 0x1d, 0xea, 0x6a, 0xe6, 0x91, 0xae, 0xd6, 0x99, 0xdd, 0xd5, 0xb6, 0xb3, 0xbc, 0x9c, 0xf1,
-	0x54, 0x7e, 0xc6, 0xf6, 0xf6, 0xc3, 0x1c, 0x54, 0x73, 0xb1, 0xab, 0x03, 0xea, 0x79, 0xe8, 0xbb,
-	0x78, 0x9c, 0x99, 0xb3, 0x33, 0xb3, 0xee, 0xc0, 0xd9, 0x47, 0xba, 0xec, 0xb9, 0x1e, 0x8f, 0xea,
-	0x04, 0x2a, 0x37, 0xe3, 0x3e, 0xb7, 0x23, 0xd6, 0xd7, 0xe1, 0xa8, 0x97, 0xa1, 0x74, 0x53, 0x50,
-	0x11, 0x73, 0x0d, 0xfc, 0x39, 0x0f, 0x85, 0x14, 0x21, 0x0d, 0x28, 0xf0, 0x31, 0x17, 0x38, 0x94,
-	0xb3, 0x16, 0xdb, 0x95, 0x66, 0xf2, 0x46, 0x7b, 0x53, 0x42, 0x09, 0x85, 0x5b, 0xca, 0x4e, 0x2e,
-	0xc3, 0xb2, 0x1d, 0x0c, 0xc3, 0xc0, 0x47, 0x5f, 0xa8, 0x85, 0x9c, 0x92, 0xe4, 0xab, 0x1a, 0x4d,
-	0xf9, 0x39, 0x8b, 0xd4, 0xa1, 0x10, 0xcb, 0x93, 0x93, 0x3a, 0xa2, 0x81, 0xe4, 0x5b, 0x54, 0x20,
-	0xb7, 0x94, 0x85, 0xb4, 0xa0, 0x94, 0x5e, 0xf5, 0x62, 0x9f, 0xdd, 0x8d, 0x51, 0x86, 0x66, 0x9a,
-	0xba, 0x92, 0x12, 0x6e, 0x49, 0x3b, 0x39, 0x0f, 0x27, 0x75, 0x57, 0x95, 0x7e, 0x9f, 0xe6, 0x66,
-	0x36, 0xf2, 0x3a, 0x14, 0xf3, 0x6a, 0xe2, 0x32, 0x16, 0xd3, 0xd4, 0x49, 0x33, 0x79, 0x07, 0x26,
-	0x6a, 0x8f, 0xeb, 0xb5, 0x94, 0x8f, 0xdc, 0xb4, 0x36, 0xc1, 0x52, 0x0b, 0x7a, 0x0b, 0x4a, 0x4e,
-	0xd6, 0xae, 0x93, 0xf3, 0x68, 0x65, 0xc2, 0x93, 0x37, 0x30, 0xb2, 0x93, 0x97, 0x72, 0x0f, 0xb9,
-	0x35, 0x4d, 0x23, 0x17, 0x60, 0xcd, 0x0e, 0x7c, 0x1f, 0x6d, 0x81, 0x4e, 0x2f, 0x0a, 0x62, 0x81,
-	0x11, 0x97, 0xad, 0xaa, 0x64, 0x55, 0x32, 0x83, 0x95, 0xe2, 0xe4, 0x22, 0x90, 0x9c, 0x3c, 0xa0,
-	0xbe, 0xe3, 0x25, 0xec, 0x0d, 0xc9, 0xce, 0x65, 0x3e, 0x50, 0x86, 0xfa, 0x27, 0x50, 0xdb, 0x09,
-	0xb3, 0xa9, 0x14, 0x6c, 0xa1, 0xcb, 0xb8, 0x48, 0xdf, 0xac, 0x27, 0x92, 0xd7, 0x98, 0x4c, 0xde,
-	0x73, 0x00, 0x4a, 0x7d, 0xe2, 0xbb, 0x81, 0x42, 0xf6, 0x9d, 0xf6, 0x77, 0x73, 0x50, 0xd8, 0x95,
-	0x2d, 0x85, 0x5c, 0x81, 0xe5, 0x1d, 0xce, 0x03, 0x9b, 0x25, 0x4d, 0x63, 0x5d, 0x37, 0x9a, 0xa9,
-	0x93, 0x72, 0x75, 0xd6, 0xa9, 0xaa, 0x61, 0x5c, 0x32, 0xc8, 0x87, 0xb0, 0x9c, 0xa5, 0x2a, 0x31,
-	0x35, 0xf3, 0x70, 0xf6, 0x56, 0x5f, 0xce, 0x7b, 0xd8, 0x8c, 0x03, 0xf9, 0x25, 0x83, 0xbc, 0x0b,
-	0x4b, 0x37, 0xe2, 0xbe, 0xc7, 0xf8, 0x80, 0xcc, 0x9a, 0xb3, 0xba, 0xd1, 0x4c, 0x3f, 0x00, 0x35,
-	0xf5, 0xa7, 0x9d, 0xe6, 0xb5, 0x61, 0x28, 0xc6, 0x0d, 0x83, 0x74, 0xe0, 0xa4, 0x2a, 0x4d, 0x24,
-	0x5b, 0xb3, 0x5b, 0x66, 0xba, 0x9e, 0x27, 0xf6, 0xd4, 0xf6, 0xb7, 0x06, 0x94, 0x52, 0x27, 0x75,
-	0xa8, 0x4f, 0x5d, 0x8c, 0xc8, 0x67, 0x50, 0x4d, 0x9d, 0x8f, 0xd1, 0xd1, 0xb0, 0x90, 0xf3, 0x5a,
-	0xf1, 0xf1, 0x21, 0x9b, 0xb5, 0x01, 0xd2, 0x86, 0xe5, 0xf7, 0x51, 0xa8, 0x82, 0xce, 0x22, 0x31,
-	0x55, 0xf2, 0xd5, 0xd5, 0x69, 0x78, 0xf7, 0xa3, 0xdf, 0x1e, 0xd6, 0x4e, 0x3c, 0x78, 0x58, 0x33,
-	0x3e, 0x3f, 0xa8, 0x19, 0xdf, 0x1f, 0xd4, 0x8c, 0x5f, 0x0e, 0x6a, 0xc6, 0xaf, 0x07, 0x35, 0xe3,
-	0xc1, 0x41, 0xcd, 0xf8, 0xfa, 0xaf, 0xda, 0x89, 0x4f, 0x5f, 0x7b, 0xfa, 0xef, 0x6d, 0xfd, 0x82,
-	0x5c, 0xd1, 0x1b, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x3b, 0xaf, 0x06, 0xa4, 0x13, 0x00,
-	0x00,
+	// 1331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xee, 0xc6, 0x89, 0xd3, 0x3c, 0xc7, 0x3f, 0x32, 0x6d, 0x92, 0x8d, 0x4b, 0x9d, 0xd4, 0x48,
+	0x95, 0xa1, 0xad, 0xdd, 0x1a, 0x01, 0x2a, 0x42, 0x54, 0x4e, 0x53, 0x15, 0x23, 0x52, 0xaa, 0xad,
+	0xcb, 0x01, 0x21, 0x59, 0xeb, 0xdd, 0xd7, 0xcd, 0x28, 0xeb, 0xdd, 0xed, 0xce, 0xac, 0xd3, 0xdc,
+	0x7a, 0xe2, 0x88, 0xf8, 0x13, 0xe0, 0xc6, 0x95, 0x23, 0x17, 0x8e, 0x88, 0x23, 0x47, 0xc4, 0xa1,
+	0xb4, 0xe1, 0x1f, 0x41, 0x3b, 0x3b, 0xb3, 0xfe, 0x55, 0xb7, 0x51, 0x55, 0xf1, 0xab, 0xbd, 0xd8,
+	0x3b, 0xdf, 0xfb, 0xf6, 0x9b, 0xd9, 0x79, 0xdf, 0xbe, 0xd9, 0x19, 0x78, 0xdf, 0xa1, 0x7c, 0x2f,
+	0xea, 0xd5, 0x2d, 0xbf, 0xdf, 0xe8, 0xec, 0x61, 0x67, 0x8f, 0x7a, 0x0e, 0xbb, 0x85, 0xfc, 0xc0,
+	0x0f, 0xf7, 0x1b, 0x9c, 0x7b, 0x0d, 0x33, 0xa0, 0x8d, 0x5e, 0xe8, 0xef, 0x63, 0x28, 0xff, 0xea,
+	0x41, 0xe8, 0x73, 0x9f, 0x64, 0x93, 0x56, 0xf9, 0x8c, 0xe3, 0xfb, 0x8e, 0x8b, 0x0d, 0x81, 0xf6,
+	0xa2, 0x7b, 0x0d, 0xec, 0x07, 0xfc, 0x30, 0x21, 0x95, 0x2f, 0x8d, 0xa8, 0x3b, 0xbe, 0xe3, 0x0f,
+	0x59, 0x71, 0x4b, 0x34, 0xc4, 0x95, 0xa4, 0xaf, 0xa8, 0x0e, 0xcd, 0x80, 0x4a, 0x68, 0x53, 0x41,
+	0xa2, 0x69, 0xf9, 0x6e, 0x7a, 0x21, 0x09, 0x67, 0x15, 0xc1, 0x31, 0x39, 0x1e, 0x98, 0x87, 0xea,
+	0x5f, 0x86, 0x37, 0x54, 0x98, 0x87, 0xa6, 0x85, 0xc9, 0x6f, 0x12, 0xaa, 0x7e, 0x35, 0x07, 0x85,
+	0x1d, 0xff, 0xc0, 0x73, 0xa9, 0xb7, 0xff, 0x59, 0xc0, 0xa9, 0xef, 0x91, 0x0a, 0x00, 0xb5, 0xd1,
+	0xe3, 0xf4, 0x1e, 0xc5, 0x50, 0xd7, 0xb6, 0xb4, 0xda, 0x92, 0x31, 0x82, 0x90, 0xb3, 0x00, 0x52,
+	0xbe, 0x4b, 0x6d, 0x7d, 0x4e, 0xc4, 0x97, 0x24, 0xd2, 0xb6, 0xc9, 0x69, 0x58, 0x60, 0x96, 0x1f,
+	0xa2, 0x9e, 0xd9, 0xd2, 0x6a, 0x79, 0x23, 0x69, 0x90, 0x32, 0x9c, 0xb4, 0xd1, 0xb4, 0x5d, 0xea,
+	0xa1, 0x3e, 0xbf, 0xa5, 0xd5, 0x32, 0x46, 0xda, 0x26, 0xdb, 0x50, 0x54, 0xcf, 0xd3, 0xb5, 0x7c,
+	0xef, 0x1e, 0x75, 0xf4, 0x85, 0x2d, 0xad, 0x96, 0x6b, 0x6e, 0xd4, 0xd3, 0xe7, 0xec, 0x3c, 0xb8,
+	0x2e, 0x22, 0x51, 0x68, 0xc6, 0x83, 0x34, 0x0a, 0x2a, 0x92, 0xc0, 0xe4, 0x1a, 0x14, 0xd4, 0xa0,
+	0xa4, 0x44, 0x56, 0x48, 0xe8, 0x75, 0x35, 0x15, 0x93, 0x0a, 0x79, 0x19, 0x48, 0xd0, 0xea, 0xd7,
+	0xf3, 0x90, 0xbf, 0x1b, 0xc4, 0xd3, 0xb0, 0x8b, 0x8c, 0x99, 0x0e, 0x12, 0x1d, 0x16, 0x03, 0xf3,
+	0xd0, 0xf5, 0x4d, 0x5b, 0x4c, 0xc2, 0xb2, 0xa1, 0x9a, 0xe4, 0x02, 0x2c, 0xf6, 0x13, 0x92, 0x78,
+	0xfc, 0x5c, 0x73, 0x65, 0x38, 0x50, 0x79, 0xb7, 0xa1, 0x18, 0xe4, 0x16, 0x2c, 0xda, 0x38, 0xe8,
+	0x62, 0x44, 0xf5, 0x5c, 0x2c, 0xb3, 0xfd, 0xee, 0xef, 0x8f, 0x36, 0xaf, 0x3c, 0xcf, 0x71, 0xf1,
+	0xa4, 0x35, 0xf8, 0x61, 0x80, 0xac, 0xbe, 0x83, 0x83, 0x1b, 0x77, 0xdb, 0x46, 0xd6, 0xc6, 0xc1,
+	0x8d, 0x88, 0xc6, 0x7a, 0x66, 0x10, 0x08, 0xbd, 0xe5, 0x17, 0xd2, 0x6b, 0x05, 0x81, 0xd0, 0x33,
+	0x83, 0x20, 0xd6, 0x5b, 0x85, 0xf8, 0x2a, 0x4e, 0x65, 0x5e, 0xa4, 0x72, 0xc1, 0x0c, 0x82, 0xb6,
+	0x1d, 0xc3, 0xf1, 0xb0, 0xa9, 0xad, 0x17, 0x12, 0xd8, 0xc6, 0x41, 0xdb, 0x26, 0x2d, 0x58, 0x49,
+	0x73, 0xd5, 0x47, 0x6e, 0xda, 0x26, 0x37, 0xf5, 0x55, 0x31, 0x09, 0xa7, 0x87, 0x93, 0x60, 0x3c,
+	0xd8, 0x95, 0x31, 0xa3, 0xa4, 0x40, 0x85, 0x90, 0x8f, 0xa0, 0xa4, 0x52, 0x95, 0x2a, 0xac, 0x09,
+	0x85, 0x53, 0x69, 0xb2, 0x46, 0x04, 0x8a, 0x12, 0x4b, 0xef, 0x6f, 0x41, 0xc9, 0x96, 0x8e, 0xed,
+	0xfa, 0xc2, 0xb2, 0x4c, 0xdf, 0xdc, 0xca, 0xd4, 0x72, 0xcd, 0xb5, 0xba, 0x7c, 0x3b, 0xc7, 0x1d,
+	0x6d, 0x14, 0xed, 0xb1, 0x36, 0x23, 0x55, 0x58, 0x10, 0x2f, 0x81, 0xfe, 0x96, 0xe8, 0x77, 0xb9,
+	0x2e, 0x5a, 0xf5, 0x4e, 0xfc, 0x6b, 0x24, 0xa1, 0xea, 0x8f, 0x19, 0x28, 0x2a, 0x9d, 0xd7, 0x96,
+	0x78, 0x86, 0x25, 0x2e, 0x02, 0xe9, 0x47, 0x2e, 0xa7, 0x96, 0xc9, 0x78, 0xd7, 0x09, 0xfd, 0x48,
+	0xdc, 0x59, 0x14, 0x94, 0x52, 0x1a, 0xb9, 0x19, 0x07, 0xda, 0x36, 0xb9, 0x06, 0xc5, 0x89, 0xec,
+	0x49, 0xfb, 0xcc, 0x4a, 0x5e, 0x61, 0x3c, 0x79, 0xc3, 0xdc, 0x6d, 0xce, 0xce, 0xdd, 0xcf, 0x1a,
+	0xe8, 0x3b, 0x38, 0xa0, 0x16, 0xb6, 0x2c, 0x4e, 0x07, 0xc9, 0x0b, 0x8f, 0x2c, 0xf0, 0x3d, 0xf6,
+	0xd2, 0x92, 0xf8, 0x94, 0x07, 0xc9, 0xbd, 0xd8, 0x83, 0xac, 0xce, 0x7e, 0x90, 0x9f, 0xe6, 0x61,
+	0x63, 0x07, 0xed, 0x28, 0x70, 0xa9, 0x65, 0x72, 0xb4, 0x5f, 0x57, 0xa8, 0x7f, 0xae, 0x42, 0x65,
+	0x8e, 0x5d, 0xa1, 0x36, 0x21, 0xc7, 0x30, 0x1c, 0x60, 0xd8, 0xe5, 0xb4, 0x8f, 0xfa, 0xba, 0x58,
+	0xef, 0x20, 0x81, 0x3a, 0xb4, 0x8f, 0x64, 0x07, 0x56, 0x42, 0x69, 0xc7, 0x2e, 0xc7, 0x7e, 0xe0,
+	0x9a, 0x5c, 0xf9, 0x79, 0x7d, 0xd2, 0x3d, 0x2a, 0x5d, 0x25, 0x75, 0x47, 0x47, 0xde, 0x70, 0xbc,
+	0x2a, 0x36, 0x0f, 0xeb, 0xd3, 0x6f, 0xc2, 0xfd, 0x08, 0x19, 0x7f, 0x55, 0xec, 0xf3, 0x2f, 0x58,
+	0xb2, 0x76, 0xe1, 0x94, 0x99, 0x4e, 0xff, 0x50, 0x62, 0x5d, 0x48, 0xbc, 0x31, 0x1c, 0xc4, 0x30,
+	0x47, 0xa9, 0x16, 0x31, 0xa7, 0xb0, 0xbf, 0x6b, 0x05, 0xfc, 0x76, 0x01, 0xde, 0x1c, 0x2d, 0x3e,
+	0xaf, 0xb8, 0x8f, 0xfe, 0x73, 0x65, 0xe8, 0x25, 0xbb, 0x6e, 0xa2, 0xaa, 0xe9, 0x53, 0x55, 0x6d,
+	0x77, 0x76, 0x55, 0xdb, 0x4a, 0x7d, 0x39, 0x63, 0x55, 0x7e, 0xc1, 0xf2, 0xf6, 0xc3, 0x1c, 0x94,
+	0x87, 0x62, 0xd7, 0xf7, 0x4c, 0xd7, 0x45, 0xcf, 0xc1, 0xd7, 0xce, 0x9c, 0xed, 0xcc, 0xaa, 0x0d,
+	0x67, 0x9e, 0x3a, 0x65, 0x2f, 0xf5, 0xf3, 0xa8, 0xfa, 0x30, 0x03, 0x2b, 0x9d, 0x07, 0x2d, 0x6b,
+	0xdf, 0xf3, 0x0f, 0x5c, 0xb4, 0x1d, 0xec, 0xa3, 0xc7, 0x63, 0x0f, 0xa5, 0x95, 0x8b, 0xda, 0x6a,
+	0x73, 0xa9, 0xa0, 0xb6, 0x4d, 0xae, 0x42, 0x36, 0x44, 0x16, 0xb9, 0x5c, 0x74, 0x51, 0x68, 0x9e,
+	0x1b, 0xd9, 0xbf, 0x4d, 0x88, 0xd5, 0x0d, 0x41, 0x34, 0xe4, 0x0d, 0x13, 0xfb, 0xd2, 0xcc, 0xe4,
+	0xbe, 0xf4, 0xff, 0x55, 0x5e, 0x8e, 0xf3, 0xf1, 0x48, 0xa0, 0x74, 0x27, 0xea, 0x31, 0x2b, 0xa4,
+	0x3d, 0xf5, 0x46, 0x54, 0x8b, 0x90, 0xbf, 0xc3, 0x4d, 0x1e, 0x31, 0x05, 0xfc, 0x91, 0x81, 0x6c,
+	0x82, 0x90, 0x1a, 0x64, 0xd9, 0x21, 0xe3, 0xd8, 0x17, 0x79, 0xc9, 0x35, 0x4b, 0xf5, 0xf8, 0x08,
+	0xe2, 0x8e, 0x80, 0x62, 0x0a, 0x33, 0x64, 0x9c, 0x5c, 0x81, 0x25, 0xcb, 0xef, 0x07, 0xbe, 0x87,
+	0x1e, 0x97, 0x5e, 0x38, 0x25, 0xc8, 0xd7, 0x15, 0x9a, 0xf0, 0x87, 0x2c, 0x52, 0x85, 0x6c, 0x24,
+	0x3e, 0x5e, 0xe5, 0x57, 0x32, 0x08, 0xbe, 0x61, 0x72, 0x64, 0x86, 0x8c, 0x90, 0x06, 0xe4, 0x93,
+	0xab, 0x6e, 0xe4, 0xd1, 0xfb, 0x11, 0xea, 0xcb, 0x53, 0xd4, 0xe5, 0x84, 0x70, 0x57, 0xc4, 0xc9,
+	0x79, 0x38, 0xa9, 0xbc, 0xa3, 0xe7, 0xa7, 0xb8, 0x69, 0x8c, 0x5c, 0x84, 0xdc, 0xb0, 0xa0, 0x31,
+	0xbd, 0x30, 0x45, 0x1d, 0x0d, 0x93, 0xab, 0x30, 0x52, 0xfe, 0x98, 0x1a, 0x4b, 0x71, 0xea, 0xa6,
+	0x95, 0x11, 0x96, 0x1c, 0xd0, 0x7b, 0x90, 0xb7, 0xd3, 0x15, 0x33, 0xde, 0x12, 0x94, 0x46, 0x66,
+	0xf2, 0x36, 0x86, 0x16, 0x7a, 0x9c, 0xba, 0xc8, 0x8c, 0x71, 0x1a, 0xb9, 0x00, 0x2b, 0x96, 0xef,
+	0x79, 0x68, 0x71, 0xb4, 0xbb, 0xa1, 0x1f, 0x71, 0x0c, 0x99, 0x48, 0x6d, 0xde, 0x28, 0xa5, 0x01,
+	0x23, 0xc1, 0xc9, 0x25, 0x20, 0x43, 0xf2, 0x9e, 0xe9, 0xd9, 0x6e, 0xcc, 0x5e, 0x13, 0xec, 0xa1,
+	0xcc, 0xc7, 0x32, 0x50, 0xfd, 0x1c, 0x2a, 0xad, 0x20, 0xed, 0x4a, 0xc2, 0x06, 0x3a, 0x94, 0xf1,
+	0xe4, 0x28, 0x64, 0xc4, 0x7a, 0xda, 0xa8, 0xf5, 0xce, 0x02, 0x48, 0xf5, 0x91, 0x83, 0x1e, 0x89,
+	0xb4, 0xed, 0xe6, 0xa3, 0x39, 0xc8, 0x6e, 0x8b, 0xaa, 0x4e, 0xae, 0xc1, 0x52, 0x8b, 0x31, 0xdf,
+	0xa2, 0x71, 0xdd, 0x5e, 0x55, 0xb5, 0x7e, 0x6c, 0xb3, 0x52, 0x9e, 0xf5, 0x61, 0x5b, 0xd3, 0x2e,
+	0x6b, 0xe4, 0x13, 0x58, 0x4a, 0xad, 0x4a, 0x74, 0xc5, 0x9c, 0x74, 0x6f, 0xf9, 0x5c, 0xaa, 0x31,
+	0x6b, 0x4f, 0x74, 0x59, 0x23, 0x1f, 0xc2, 0xe2, 0xed, 0xa8, 0xe7, 0x52, 0xb6, 0x47, 0x66, 0xf5,
+	0x59, 0x5e, 0xab, 0x27, 0x27, 0x76, 0x75, 0x75, 0x16, 0x57, 0xbf, 0x11, 0x9f, 0xd8, 0xd5, 0x34,
+	0xb2, 0x0b, 0x27, 0x65, 0x75, 0x44, 0xb2, 0x39, 0x7b, 0xd5, 0x4a, 0xc6, 0xf3, 0xdc, 0x65, 0x8d,
+	0x7c, 0x00, 0x0b, 0xa2, 0x70, 0x91, 0x0d, 0x45, 0x9d, 0xaa, 0x63, 0xb3, 0x06, 0xd3, 0xfc, 0x4e,
+	0x83, 0x7c, 0x32, 0xc1, 0xbb, 0xa6, 0x67, 0x3a, 0x18, 0x92, 0x2f, 0xa1, 0x9c, 0x24, 0x0e, 0xc3,
+	0xe9, 0x94, 0x92, 0xf3, 0xaa, 0x8b, 0x67, 0xa7, 0x7b, 0x56, 0x7f, 0xa4, 0x09, 0x4b, 0x37, 0x91,
+	0xcb, 0x62, 0x90, 0x66, 0x71, 0xac, 0x5c, 0x94, 0x0b, 0xe3, 0xf0, 0xf6, 0xa7, 0xbf, 0x3d, 0xa9,
+	0x9c, 0x78, 0xfc, 0xa4, 0xa2, 0x3d, 0x3c, 0xaa, 0x68, 0xdf, 0x1f, 0x55, 0xb4, 0x5f, 0x8e, 0x2a,
+	0xda, 0xaf, 0x47, 0x15, 0xed, 0xf1, 0x51, 0x45, 0xfb, 0xe6, 0xcf, 0xca, 0x89, 0x2f, 0xde, 0x3e,
+	0xfe, 0xe1, 0x6a, 0x2f, 0x2b, 0x46, 0xf4, 0xce, 0x5f, 0x03, 0x00, 0x3e, 0x8f, 0x1f, 0x41, 0x91,
+	0x15, 0x00, 0x00,
 }
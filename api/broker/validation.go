@@ -43,15 +43,22 @@ func (m *UplinkMessage) Validate() error {
 
 // Validate implements the api.Validator interface
 func (m *DownlinkMessage) Validate() error {
-	if err := api.NotEmptyAndValidID(m.DevId, "DevId"); err != nil {
+	if m.MulticastGroupId != "" {
+		if err := api.NotEmptyAndValidID(m.MulticastGroupId, "MulticastGroupId"); err != nil {
+			return err
+		}
+	} else if err := api.NotEmptyAndValidID(m.DevId, "DevId"); err != nil {
 		return err
 	}
 	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
 		return err
 	}
 
-	if err := api.NotNilAndValid(m.DownlinkOption, "DownlinkOption"); err != nil {
-		return err
+	// The DownlinkOption is selected by the NetworkServer for downlink that is not a response to an uplink (Class C)
+	if m.DownlinkOption != nil {
+		if err := api.NotNilAndValid(m.DownlinkOption, "DownlinkOption"); err != nil {
+			return err
+		}
 	}
 	if m.Message != nil {
 		if err := m.Message.Validate(); err != nil {
@@ -132,3 +139,20 @@ func (m *ApplicationHandlerRegistration) Validate() error {
 	}
 	return nil
 }
+
+// Validate implements the api.Validator interface
+func (m *TxAcknowledgement) Validate() error {
+	if m.DownlinkId == "" {
+		return errors.NewErrInvalidArgument("DownlinkId", "can not be empty")
+	}
+	if m.GatewayId == "" {
+		return errors.NewErrInvalidArgument("GatewayId", "can not be empty")
+	}
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if err := api.NotEmptyAndValidID(m.DevId, "DevId"); err != nil {
+		return err
+	}
+	return nil
+}
@@ -12,6 +12,7 @@
 		GPSMetadata
 		RxMetadata
 		TxConfiguration
+		TxAcknowledgement
 		Status
 */
 package gateway
@@ -39,6 +40,65 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type TxAcknowledgement_Result int32
+
+const (
+	TxAcknowledgement_SUCCESS       TxAcknowledgement_Result = 0
+	TxAcknowledgement_UNKNOWN_ERROR TxAcknowledgement_Result = 1
+	// The gateway received the downlink message too late to transmit it
+	TxAcknowledgement_TOO_LATE TxAcknowledgement_Result = 2
+	// The downlink message was scheduled too far in the future
+	TxAcknowledgement_TOO_EARLY TxAcknowledgement_Result = 3
+	// The downlink message collided with another downlink message
+	TxAcknowledgement_COLLISION_PACKET TxAcknowledgement_Result = 4
+	// The downlink message collided with a beacon
+	TxAcknowledgement_COLLISION_BEACON TxAcknowledgement_Result = 5
+	// The frequency is not supported by the gateway
+	TxAcknowledgement_TX_FREQ TxAcknowledgement_Result = 6
+	// The power is not supported by the gateway
+	TxAcknowledgement_TX_POWER TxAcknowledgement_Result = 7
+	// The downlink message could not be scheduled on GPS time because the gateway has no GPS lock
+	TxAcknowledgement_GPS_UNLOCKED TxAcknowledgement_Result = 8
+	// The router did not send the downlink message, because it would exceed the duty cycle of the gateway
+	TxAcknowledgement_DUTY_CYCLE TxAcknowledgement_Result = 9
+	// The router did not send the downlink message, because it would exceed the dwell time of the frequency plan
+	TxAcknowledgement_DWELL_TIME TxAcknowledgement_Result = 10
+)
+
+var TxAcknowledgement_Result_name = map[int32]string{
+	0:  "SUCCESS",
+	1:  "UNKNOWN_ERROR",
+	2:  "TOO_LATE",
+	3:  "TOO_EARLY",
+	4:  "COLLISION_PACKET",
+	5:  "COLLISION_BEACON",
+	6:  "TX_FREQ",
+	7:  "TX_POWER",
+	8:  "GPS_UNLOCKED",
+	9:  "DUTY_CYCLE",
+	10: "DWELL_TIME",
+}
+var TxAcknowledgement_Result_value = map[string]int32{
+	"SUCCESS":          0,
+	"UNKNOWN_ERROR":    1,
+	"TOO_LATE":         2,
+	"TOO_EARLY":        3,
+	"COLLISION_PACKET": 4,
+	"COLLISION_BEACON": 5,
+	"TX_FREQ":          6,
+	"TX_POWER":         7,
+	"GPS_UNLOCKED":     8,
+	"DUTY_CYCLE":       9,
+	"DWELL_TIME":       10,
+}
+
+func (x TxAcknowledgement_Result) String() string {
+	return proto.EnumName(TxAcknowledgement_Result_name, int32(x))
+}
+func (TxAcknowledgement_Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorGateway, []int{3, 0}
+}
+
 type GPSMetadata struct {
 	// Time in Unix nanoseconds
 	Time      int64   `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
@@ -242,7 +302,9 @@ func (m *RxMetadata_Antenna) GetEncryptedTime() []byte {
 type TxConfiguration struct {
 	// Timestamp (uptime of LoRa module) in microseconds with rollover
 	Timestamp uint32 `protobuf:"varint,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	RfChain   uint32 `protobuf:"varint,21,opt,name=rf_chain,json=rfChain,proto3" json:"rf_chain,omitempty"`
+	// GPS time (time since the GPS epoch) in microseconds. If set, gateways that are synchronized with GPS time transmit at this time instead of the timestamp
+	GpsTime uint64 `protobuf:"varint,12,opt,name=gps_time,json=gpsTime,proto3" json:"gps_time,omitempty"`
+	RfChain uint32 `protobuf:"varint,21,opt,name=rf_chain,json=rfChain,proto3" json:"rf_chain,omitempty"`
 	// Frequency in Hz
 	Frequency uint64 `protobuf:"varint,22,opt,name=frequency,proto3" json:"frequency,omitempty"`
 	// Transmit power in dBm
@@ -264,6 +326,13 @@ func (m *TxConfiguration) GetTimestamp() uint32 {
 	return 0
 }
 
+func (m *TxConfiguration) GetGpsTime() uint64 {
+	if m != nil {
+		return m.GpsTime
+	}
+	return 0
+}
+
 func (m *TxConfiguration) GetRfChain() uint32 {
 	if m != nil {
 		return m.RfChain
@@ -299,6 +368,31 @@ func (m *TxConfiguration) GetFrequencyDeviation() uint32 {
 	return 0
 }
 
+// message TxAcknowledgement is sent by a Gateway after it handled a downlink message
+type TxAcknowledgement struct {
+	// The ID of the downlink message
+	DownlinkId string                   `protobuf:"bytes,1,opt,name=downlink_id,json=downlinkId,proto3" json:"downlink_id,omitempty"`
+	Result     TxAcknowledgement_Result `protobuf:"varint,2,opt,name=result,proto3,enum=gateway.TxAcknowledgement.Result" json:"result,omitempty"`
+}
+
+func (m *TxAcknowledgement) Reset()                    { *m = TxAcknowledgement{} }
+func (*TxAcknowledgement) ProtoMessage()               {}
+func (*TxAcknowledgement) Descriptor() ([]byte, []int) { return fileDescriptorGateway, []int{3} }
+
+func (m *TxAcknowledgement) GetDownlinkId() string {
+	if m != nil {
+		return m.DownlinkId
+	}
+	return ""
+}
+
+func (m *TxAcknowledgement) GetResult() TxAcknowledgement_Result {
+	if m != nil {
+		return m.Result
+	}
+	return TxAcknowledgement_SUCCESS
+}
+
 // message Status represents a status update from a Gateway.
 type Status struct {
 	// Timestamp (uptime of gateway) in microseconds with rollover
@@ -345,13 +439,13 @@ type Status struct {
 	// Number of lost PPS pulses
 	LPps uint32            `protobuf:"varint,48,opt,name=l_pps,json=lPps,proto3" json:"l_pps,omitempty"`
 	Os   *Status_OSMetrics `protobuf:"bytes,51,opt,name=os" json:"os,omitempty"`
-	// messages for debugging
+	// debug or warning messages from the gateway
 	Messages []string `protobuf:"bytes,52,rep,name=messages" json:"messages,omitempty"`
 }
 
 func (m *Status) Reset()                    { *m = Status{} }
 func (*Status) ProtoMessage()               {}
-func (*Status) Descriptor() ([]byte, []int) { return fileDescriptorGateway, []int{3} }
+func (*Status) Descriptor() ([]byte, []int) { return fileDescriptorGateway, []int{4} }
 
 func (m *Status) GetTimestamp() uint32 {
 	if m != nil {
@@ -547,7 +641,7 @@ type Status_OSMetrics struct {
 
 func (m *Status_OSMetrics) Reset()                    { *m = Status_OSMetrics{} }
 func (*Status_OSMetrics) ProtoMessage()               {}
-func (*Status_OSMetrics) Descriptor() ([]byte, []int) { return fileDescriptorGateway, []int{3, 0} }
+func (*Status_OSMetrics) Descriptor() ([]byte, []int) { return fileDescriptorGateway, []int{4, 0} }
 
 func (m *Status_OSMetrics) GetLoad_1() float32 {
 	if m != nil {
@@ -596,8 +690,10 @@ func init() {
 	proto.RegisterType((*RxMetadata)(nil), "gateway.RxMetadata")
 	proto.RegisterType((*RxMetadata_Antenna)(nil), "gateway.RxMetadata.Antenna")
 	proto.RegisterType((*TxConfiguration)(nil), "gateway.TxConfiguration")
+	proto.RegisterType((*TxAcknowledgement)(nil), "gateway.TxAcknowledgement")
 	proto.RegisterType((*Status)(nil), "gateway.Status")
 	proto.RegisterType((*Status_OSMetrics)(nil), "gateway.Status.OSMetrics")
+	proto.RegisterEnum("gateway.TxAcknowledgement.Result", TxAcknowledgement_Result_name, TxAcknowledgement_Result_value)
 }
 func (this *GPSMetadata) VerboseEqual(that interface{}) error {
 	if that == nil {
@@ -925,6 +1021,9 @@ func (this *TxConfiguration) VerboseEqual(that interface{}) error {
 	if this.Timestamp != that1.Timestamp {
 		return fmt.Errorf("Timestamp this(%v) Not Equal that(%v)", this.Timestamp, that1.Timestamp)
 	}
+	if this.GpsTime != that1.GpsTime {
+		return fmt.Errorf("GpsTime this(%v) Not Equal that(%v)", this.GpsTime, that1.GpsTime)
+	}
 	if this.RfChain != that1.RfChain {
 		return fmt.Errorf("RfChain this(%v) Not Equal that(%v)", this.RfChain, that1.RfChain)
 	}
@@ -970,6 +1069,9 @@ func (this *TxConfiguration) Equal(that interface{}) bool {
 	if this.Timestamp != that1.Timestamp {
 		return false
 	}
+	if this.GpsTime != that1.GpsTime {
+		return false
+	}
 	if this.RfChain != that1.RfChain {
 		return false
 	}
@@ -987,6 +1089,72 @@ func (this *TxConfiguration) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TxAcknowledgement) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*TxAcknowledgement)
+	if !ok {
+		that2, ok := that.(TxAcknowledgement)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *TxAcknowledgement")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *TxAcknowledgement but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *TxAcknowledgement but is not nil && this == nil")
+	}
+	if this.DownlinkId != that1.DownlinkId {
+		return fmt.Errorf("DownlinkId this(%v) Not Equal that(%v)", this.DownlinkId, that1.DownlinkId)
+	}
+	if this.Result != that1.Result {
+		return fmt.Errorf("Result this(%v) Not Equal that(%v)", this.Result, that1.Result)
+	}
+	return nil
+}
+func (this *TxAcknowledgement) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*TxAcknowledgement)
+	if !ok {
+		that2, ok := that.(TxAcknowledgement)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.DownlinkId != that1.DownlinkId {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
+	return true
+}
 func (this *Status) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
@@ -1520,6 +1688,11 @@ func (m *TxConfiguration) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintGateway(dAtA, i, uint64(m.Timestamp))
 	}
+	if m.GpsTime != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintGateway(dAtA, i, uint64(m.GpsTime))
+	}
 	if m.RfChain != 0 {
 		dAtA[i] = 0xa8
 		i++
@@ -1563,6 +1736,35 @@ func (m *TxConfiguration) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TxAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.DownlinkId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.DownlinkId)))
+		i += copy(dAtA[i:], m.DownlinkId)
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintGateway(dAtA, i, uint64(m.Result))
+	}
+	return i, nil
+}
+
 func (m *Status) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1960,6 +2162,9 @@ func (m *TxConfiguration) Size() (n int) {
 	if m.Timestamp != 0 {
 		n += 1 + sovGateway(uint64(m.Timestamp))
 	}
+	if m.GpsTime != 0 {
+		n += 1 + sovGateway(uint64(m.GpsTime))
+	}
 	if m.RfChain != 0 {
 		n += 2 + sovGateway(uint64(m.RfChain))
 	}
@@ -1978,6 +2183,19 @@ func (m *TxConfiguration) Size() (n int) {
 	return n
 }
 
+func (m *TxAcknowledgement) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DownlinkId)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovGateway(uint64(m.Result))
+	}
+	return n
+}
+
 func (m *Status) Size() (n int) {
 	var l int
 	_ = l
@@ -2168,6 +2386,7 @@ func (this *TxConfiguration) String() string {
 	}
 	s := strings.Join([]string{`&TxConfiguration{`,
 		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`GpsTime:` + fmt.Sprintf("%v", this.GpsTime) + `,`,
 		`RfChain:` + fmt.Sprintf("%v", this.RfChain) + `,`,
 		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
 		`Power:` + fmt.Sprintf("%v", this.Power) + `,`,
@@ -2177,6 +2396,17 @@ func (this *TxConfiguration) String() string {
 	}, "")
 	return s
 }
+func (this *TxAcknowledgement) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxAcknowledgement{`,
+		`DownlinkId:` + fmt.Sprintf("%v", this.DownlinkId) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Status) String() string {
 	if this == nil {
 		return "nil"
@@ -2863,6 +3093,25 @@ func (m *TxConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GpsTime", wireType)
+			}
+			m.GpsTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GpsTime |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 21:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RfChain", wireType)
@@ -2980,6 +3229,104 @@ func (m *TxConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TxAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DownlinkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= (TxAcknowledgement_Result(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Status) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3887,65 +4234,79 @@ func init() {
 }
 
 var fileDescriptorGateway = []byte{
-	// 955 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4d, 0x73, 0xdb, 0x44,
-	0x18, 0xc7, 0x2b, 0xd9, 0x49, 0xec, 0x75, 0x9c, 0xa4, 0x9b, 0x97, 0x6e, 0x53, 0x30, 0x22, 0x0c,
-	0xe0, 0x12, 0x6a, 0x93, 0x96, 0x0c, 0xc3, 0x11, 0x0a, 0xc3, 0xe4, 0xd0, 0x36, 0xb3, 0xc9, 0x89,
-	0x8b, 0x67, 0x23, 0xad, 0xe5, 0x9d, 0x48, 0xbb, 0x62, 0x77, 0x55, 0x27, 0x9c, 0x38, 0x73, 0xe2,
-	0xc0, 0x87, 0xe0, 0xa3, 0xf4, 0xc8, 0x91, 0x63, 0x1b, 0x66, 0xf8, 0x08, 0x9c, 0x99, 0x7d, 0xf4,
-	0x62, 0x85, 0x06, 0x3a, 0x3d, 0x79, 0x9f, 0xdf, 0xf3, 0x5f, 0xe9, 0x79, 0xb5, 0xd0, 0x97, 0xb1,
-	0xb0, 0xb3, 0xfc, 0x6c, 0x14, 0xaa, 0x74, 0x7c, 0x3a, 0xe3, 0xa7, 0x33, 0x21, 0x63, 0xf3, 0x94,
-	0xdb, 0xb9, 0xd2, 0xe7, 0x63, 0x6b, 0xe5, 0x98, 0x65, 0x62, 0x1c, 0x33, 0xcb, 0xe7, 0xec, 0xb2,
-	0xfa, 0x1d, 0x65, 0x5a, 0x59, 0x85, 0x57, 0x4a, 0x73, 0xf7, 0x41, 0xe3, 0x19, 0xb1, 0x8a, 0xd5,
-	0x18, 0xfc, 0x67, 0xf9, 0x14, 0x2c, 0x30, 0xe0, 0x54, 0xdc, 0xdb, 0x9b, 0xa3, 0xde, 0x77, 0xc7,
-	0x27, 0x4f, 0xb8, 0x65, 0x11, 0xb3, 0x0c, 0x63, 0xd4, 0xb6, 0x22, 0xe5, 0xc4, 0x0b, 0xbc, 0x61,
-	0x8b, 0xc2, 0x19, 0xef, 0xa2, 0x4e, 0xc2, 0xac, 0xb0, 0x79, 0xc4, 0x89, 0x1f, 0x78, 0x43, 0x9f,
-	0xd6, 0x36, 0x7e, 0x07, 0x75, 0x13, 0x25, 0xe3, 0xc2, 0xd9, 0x02, 0xe7, 0x02, 0xb8, 0x9b, 0x2c,
-	0x29, 0x6f, 0xb6, 0x03, 0x6f, 0xb8, 0x44, 0x6b, 0x7b, 0xef, 0xd7, 0x36, 0x42, 0xf4, 0xa2, 0x7e,
-	0xf1, 0xbb, 0x08, 0x95, 0x19, 0x4c, 0x44, 0x04, 0xaf, 0xef, 0xd2, 0x6e, 0x49, 0x8e, 0x22, 0xfc,
-	0x31, 0x5a, 0xaf, 0xdc, 0x56, 0xe7, 0xc6, 0xf2, 0x08, 0x42, 0xe9, 0xd0, 0xb5, 0x12, 0x9f, 0x16,
-	0xd4, 0x05, 0xe4, 0x82, 0x36, 0x96, 0xa5, 0x19, 0xe9, 0x05, 0xde, 0xb0, 0x4f, 0x17, 0xa0, 0x4e,
-	0x6f, 0xb5, 0x91, 0xde, 0x87, 0x68, 0x8d, 0xcb, 0x50, 0x5f, 0x66, 0x96, 0x47, 0x13, 0xf0, 0xf6,
-	0x03, 0x6f, 0xb8, 0x4a, 0xfb, 0x35, 0x3d, 0x75, 0xb2, 0xbb, 0xa8, 0xa3, 0xa7, 0x93, 0x70, 0xc6,
-	0x84, 0x24, 0xdb, 0xf0, 0xdc, 0x15, 0x3d, 0x7d, 0xec, 0x4c, 0x4c, 0xd0, 0x4a, 0x38, 0x63, 0x52,
-	0xf2, 0x84, 0xec, 0x14, 0x9e, 0xd2, 0xc4, 0x5f, 0xa0, 0x0e, 0x93, 0x96, 0x4b, 0xc9, 0x0c, 0x19,
-	0x04, 0xad, 0x61, 0xef, 0xe1, 0xbd, 0x51, 0xd5, 0xb7, 0x45, 0xf2, 0xa3, 0xaf, 0x0a, 0x0d, 0xad,
-	0xc5, 0x2e, 0x8d, 0xa9, 0xe6, 0x3f, 0xe4, 0x5c, 0x86, 0x97, 0xe4, 0xbd, 0xc0, 0x1b, 0xb6, 0xe9,
-	0x02, 0xb8, 0x34, 0xb4, 0x31, 0x82, 0x04, 0x50, 0x70, 0x38, 0xe3, 0x0d, 0xd4, 0x32, 0x52, 0x93,
-	0xf7, 0x01, 0xb9, 0x23, 0xfe, 0x08, 0xb5, 0xe2, 0xcc, 0x90, 0xfb, 0x81, 0x37, 0xec, 0x3d, 0xdc,
-	0xaa, 0xdf, 0xdb, 0x68, 0x37, 0x75, 0x82, 0xdd, 0x9f, 0x3d, 0xb4, 0x52, 0x46, 0xe0, 0x52, 0x29,
-	0x63, 0x80, 0x1e, 0xf4, 0x69, 0x65, 0x36, 0x93, 0xf4, 0xaf, 0x27, 0x59, 0x45, 0xd3, 0x7a, 0x3d,
-	0x9a, 0xf6, 0x22, 0x9a, 0xd7, 0xcb, 0x8c, 0x6e, 0x28, 0xf3, 0xde, 0x5f, 0x1e, 0x5a, 0x3f, 0xbd,
-	0x78, 0xac, 0xe4, 0x54, 0xc4, 0xb9, 0x66, 0x56, 0x28, 0xf9, 0x86, 0x9e, 0xfe, 0x4f, 0x63, 0xae,
-	0x55, 0x71, 0xe7, 0xdf, 0x55, 0xdc, 0x42, 0x4b, 0x99, 0x9a, 0x73, 0x4d, 0xee, 0xc0, 0x68, 0x16,
-	0x06, 0x3e, 0x44, 0x3b, 0x99, 0x4a, 0x98, 0x16, 0x3f, 0xc2, 0xcb, 0x27, 0x42, 0x3e, 0xe7, 0xda,
-	0x08, 0x25, 0xa1, 0x0d, 0x1d, 0xba, 0xdd, 0xf4, 0x1e, 0x55, 0x4e, 0x3c, 0x46, 0x9b, 0xf5, 0x93,
-	0x27, 0x11, 0x7f, 0x2e, 0xc0, 0x0f, 0x1d, 0xea, 0x53, 0x5c, 0xbb, 0xbe, 0xa9, 0x3c, 0x7b, 0x7f,
-	0x2f, 0xa3, 0xe5, 0x13, 0xcb, 0x6c, 0x6e, 0xae, 0xe7, 0xe7, 0xfd, 0xd7, 0xcc, 0xfa, 0x8d, 0x99,
-	0xbd, 0x61, 0x1d, 0x5a, 0x37, 0xae, 0xc3, 0x3d, 0xd4, 0x3d, 0x53, 0xca, 0x16, 0x05, 0x6f, 0xc3,
-	0x13, 0x3a, 0x0e, 0xc0, 0x48, 0xaf, 0x21, 0x5f, 0xb8, 0x82, 0xb6, 0x86, 0x5d, 0xea, 0x8b, 0xcc,
-	0xad, 0x6b, 0x96, 0x30, 0x3b, 0x55, 0x3a, 0x85, 0x0d, 0xe9, 0xd2, 0xda, 0xc6, 0x1f, 0xa0, 0x7e,
-	0xa8, 0xa4, 0x65, 0xa1, 0x9d, 0xf0, 0x94, 0x89, 0x04, 0x96, 0xa4, 0x4b, 0x57, 0x4b, 0xf8, 0xad,
-	0x63, 0x38, 0x40, 0xbd, 0x88, 0x9b, 0x50, 0x8b, 0x0c, 0x92, 0x5f, 0x03, 0x49, 0x13, 0xb9, 0x29,
-	0x58, 0x94, 0x29, 0x4b, 0x98, 0x24, 0xeb, 0x20, 0xea, 0xd7, 0xf4, 0x38, 0x61, 0x12, 0xef, 0xa0,
-	0xe5, 0x33, 0x2d, 0xa2, 0x98, 0x93, 0x0d, 0x70, 0x97, 0x96, 0xe3, 0x5a, 0xe5, 0x96, 0x6b, 0x72,
-	0xbb, 0xe0, 0x85, 0xe5, 0x6a, 0x34, 0xcd, 0x62, 0x46, 0x30, 0x14, 0x0f, 0xce, 0x6e, 0x04, 0x23,
-	0x93, 0x91, 0x4d, 0x40, 0xee, 0xe8, 0xc8, 0x8c, 0x25, 0x64, 0x0b, 0xae, 0xba, 0x63, 0xb5, 0x22,
-	0xdb, 0x6f, 0x58, 0x11, 0x77, 0x53, 0x5b, 0x0b, 0x13, 0xd0, 0xa7, 0xee, 0x88, 0x37, 0xd1, 0x92,
-	0xbe, 0x98, 0x08, 0x09, 0xeb, 0xd5, 0xa7, 0x6d, 0x7d, 0x71, 0x24, 0x4b, 0xa8, 0xce, 0xc9, 0x27,
-	0x15, 0x7c, 0x76, 0xee, 0xa0, 0x05, 0xe5, 0x7e, 0x01, 0x6d, 0xa9, 0xb4, 0xa0, 0xfc, 0xb4, 0x82,
-	0x85, 0x32, 0x49, 0x1d, 0x7c, 0x50, 0xc0, 0x24, 0xad, 0xa1, 0xb1, 0x64, 0x54, 0xc1, 0x13, 0x5b,
-	0x42, 0x39, 0x27, 0xe3, 0x0a, 0x3e, 0x9d, 0x03, 0x9c, 0x64, 0x99, 0x21, 0x9f, 0x95, 0xf0, 0x38,
-	0x33, 0xf8, 0x3e, 0xf2, 0x95, 0x21, 0x8f, 0x20, 0xc1, 0xbb, 0x75, 0x82, 0xc5, 0xe0, 0x8d, 0x9e,
-	0xb9, 0x34, 0xb5, 0x08, 0x0d, 0xf5, 0x95, 0x71, 0xed, 0x4f, 0xb9, 0x31, 0x2c, 0xe6, 0x86, 0x7c,
-	0x0e, 0x43, 0x51, 0xdb, 0xbb, 0x2f, 0x3c, 0xd4, 0xad, 0xd5, 0x78, 0x1b, 0x2d, 0x27, 0x8a, 0x45,
-	0x93, 0x03, 0x98, 0x56, 0x9f, 0x2e, 0x39, 0xeb, 0xa0, 0xc6, 0x87, 0xe5, 0x67, 0x02, 0xf0, 0x21,
-	0xbe, 0x83, 0x56, 0x0a, 0xf5, 0x61, 0xf9, 0x17, 0x01, 0xaa, 0x83, 0x43, 0x37, 0x0c, 0x61, 0x96,
-	0x4f, 0x32, 0xae, 0x43, 0x2e, 0x2d, 0x8b, 0x39, 0x2c, 0xb7, 0x4f, 0xfb, 0x61, 0x96, 0x1f, 0xd7,
-	0x10, 0xef, 0xa3, 0xdb, 0x29, 0x4f, 0x95, 0xbe, 0x6c, 0x2a, 0xb7, 0x41, 0xb9, 0x51, 0x38, 0x1a,
-	0xe2, 0x00, 0xf5, 0x2c, 0x4f, 0x33, 0xae, 0x99, 0xcd, 0x35, 0x87, 0x8e, 0xf9, 0xb4, 0x89, 0xbe,
-	0x7e, 0xf2, 0xc7, 0xab, 0xc1, 0xad, 0x97, 0xaf, 0x06, 0xde, 0x4f, 0x57, 0x03, 0xef, 0xb7, 0xab,
-	0x81, 0xf7, 0xe2, 0x6a, 0xe0, 0xfd, 0x7e, 0x35, 0xf0, 0x5e, 0x5e, 0x0d, 0xbc, 0x5f, 0xfe, 0x1c,
-	0xdc, 0xfa, 0x7e, 0xff, 0x2d, 0x3e, 0xc3, 0x67, 0xcb, 0xf0, 0x1d, 0x7d, 0xf4, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xe8, 0x9d, 0xb2, 0xb8, 0xbc, 0x07, 0x00, 0x00,
+	// 1182 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xcf, 0x73, 0xdb, 0x44,
+	0x14, 0xc7, 0x2b, 0xd9, 0xf1, 0x8f, 0xe7, 0x38, 0x55, 0xb6, 0x49, 0xaa, 0xa6, 0xe0, 0xba, 0x61,
+	0x00, 0x97, 0x52, 0x9b, 0xb6, 0x64, 0x98, 0x1e, 0x53, 0x57, 0x74, 0x3c, 0x75, 0x6d, 0xb3, 0x76,
+	0xa6, 0x2d, 0x17, 0xcd, 0x46, 0x5a, 0x2b, 0x9a, 0xc8, 0x2b, 0xb1, 0x5a, 0xd7, 0x09, 0x27, 0xb8,
+	0x72, 0xe2, 0xc0, 0x1f, 0xc1, 0x91, 0xbf, 0x80, 0x73, 0x8f, 0x1c, 0x39, 0xb6, 0xe1, 0x7f, 0xe0,
+	0xcc, 0xec, 0xea, 0x87, 0x1d, 0x5a, 0xa6, 0xc3, 0xc9, 0xfb, 0x3e, 0xef, 0xbb, 0xab, 0xf7, 0xf6,
+	0xbd, 0x7d, 0x63, 0x78, 0xe0, 0xf9, 0xe2, 0x78, 0x7e, 0xd4, 0x76, 0xc2, 0x59, 0x67, 0x72, 0x4c,
+	0x27, 0xc7, 0x3e, 0xf3, 0xe2, 0x01, 0x15, 0x8b, 0x90, 0x9f, 0x74, 0x84, 0x60, 0x1d, 0x12, 0xf9,
+	0x1d, 0x8f, 0x08, 0xba, 0x20, 0x67, 0xd9, 0x6f, 0x3b, 0xe2, 0xa1, 0x08, 0x51, 0x39, 0x35, 0x77,
+	0xef, 0xac, 0x9c, 0xe1, 0x85, 0x5e, 0xd8, 0x51, 0xfe, 0xa3, 0xf9, 0x54, 0x59, 0xca, 0x50, 0xab,
+	0x64, 0xdf, 0xde, 0x02, 0x6a, 0x8f, 0x47, 0xe3, 0xa7, 0x54, 0x10, 0x97, 0x08, 0x82, 0x10, 0x14,
+	0x85, 0x3f, 0xa3, 0xa6, 0xd6, 0xd4, 0x5a, 0x05, 0xac, 0xd6, 0x68, 0x17, 0x2a, 0x01, 0x11, 0xbe,
+	0x98, 0xbb, 0xd4, 0xd4, 0x9b, 0x5a, 0x4b, 0xc7, 0xb9, 0x8d, 0x3e, 0x80, 0x6a, 0x10, 0x32, 0x2f,
+	0x71, 0x16, 0x94, 0x73, 0x09, 0xe4, 0x4e, 0x12, 0xa4, 0x3b, 0x8b, 0x4d, 0xad, 0xb5, 0x86, 0x73,
+	0x7b, 0xef, 0x97, 0x22, 0x00, 0x3e, 0xcd, 0x3f, 0xfc, 0x21, 0x40, 0x9a, 0x81, 0xed, 0xbb, 0xea,
+	0xf3, 0x55, 0x5c, 0x4d, 0x49, 0xcf, 0x45, 0x9f, 0xc2, 0xe5, 0xcc, 0x2d, 0xf8, 0x3c, 0x16, 0xd4,
+	0x55, 0xa1, 0x54, 0xf0, 0x46, 0x8a, 0x27, 0x09, 0x95, 0x01, 0xc9, 0xa0, 0x63, 0x41, 0x66, 0x91,
+	0x59, 0x6b, 0x6a, 0xad, 0x3a, 0x5e, 0x82, 0x3c, 0xbd, 0xf5, 0x95, 0xf4, 0x3e, 0x86, 0x0d, 0xca,
+	0x1c, 0x7e, 0x16, 0x09, 0xea, 0xda, 0xca, 0x5b, 0x6f, 0x6a, 0xad, 0x75, 0x5c, 0xcf, 0xe9, 0x44,
+	0xca, 0xae, 0x41, 0x85, 0x4f, 0x6d, 0xe7, 0x98, 0xf8, 0xcc, 0xdc, 0x56, 0xe7, 0x96, 0xf9, 0xb4,
+	0x2b, 0x4d, 0x64, 0x42, 0xd9, 0x39, 0x26, 0x8c, 0xd1, 0xc0, 0xdc, 0x49, 0x3c, 0xa9, 0x89, 0xbe,
+	0x82, 0x0a, 0x61, 0x82, 0x32, 0x46, 0x62, 0xb3, 0xd1, 0x2c, 0xb4, 0x6a, 0xf7, 0xae, 0xb7, 0xb3,
+	0xba, 0x2d, 0x93, 0x6f, 0x1f, 0x24, 0x1a, 0x9c, 0x8b, 0x65, 0x1a, 0x53, 0x4e, 0xbf, 0x9b, 0x53,
+	0xe6, 0x9c, 0x99, 0x37, 0x9a, 0x5a, 0xab, 0x88, 0x97, 0x40, 0xa6, 0xc1, 0xe3, 0xd8, 0x37, 0x9b,
+	0xea, 0xc2, 0xd5, 0x1a, 0x19, 0x50, 0x88, 0x19, 0x37, 0x6f, 0x2a, 0x24, 0x97, 0xe8, 0x13, 0x28,
+	0x78, 0x51, 0x6c, 0xde, 0x6a, 0x6a, 0xad, 0xda, 0xbd, 0xad, 0xfc, 0xbb, 0x2b, 0xe5, 0xc6, 0x52,
+	0xb0, 0xfb, 0x93, 0x06, 0xe5, 0x34, 0x02, 0x99, 0x4a, 0x1a, 0x83, 0xaa, 0x41, 0x1d, 0x97, 0xc9,
+	0xd2, 0x93, 0x25, 0xa9, 0x5f, 0x4c, 0x32, 0x8b, 0xa6, 0xf0, 0x76, 0x34, 0xc5, 0x65, 0x34, 0x6f,
+	0x5f, 0x33, 0xbc, 0xe3, 0x9a, 0xf7, 0x7e, 0xd4, 0xe1, 0xf2, 0xe4, 0xb4, 0x1b, 0xb2, 0xa9, 0xef,
+	0xcd, 0x39, 0x11, 0x7e, 0xc8, 0xde, 0x53, 0xd3, 0x6b, 0x50, 0xf1, 0xa2, 0xd8, 0xce, 0xeb, 0x5a,
+	0xc4, 0x65, 0x2f, 0x8a, 0xdf, 0x57, 0xb3, 0x0b, 0x17, 0xbc, 0xf3, 0xef, 0x0b, 0xde, 0x82, 0xb5,
+	0x28, 0x5c, 0x50, 0x6e, 0x5e, 0x55, 0x5d, 0x9b, 0x18, 0x68, 0x1f, 0x76, 0xa2, 0x30, 0x20, 0xdc,
+	0xff, 0x5e, 0xc5, 0x65, 0xfb, 0xec, 0x25, 0xe5, 0xb1, 0x1f, 0x32, 0x55, 0xa1, 0x0a, 0xde, 0x5e,
+	0xf5, 0xf6, 0x32, 0x27, 0xea, 0xc0, 0x95, 0xfc, 0x64, 0xdb, 0xa5, 0x2f, 0x7d, 0xe5, 0x57, 0xc5,
+	0xab, 0x63, 0x94, 0xbb, 0x1e, 0x65, 0x9e, 0xbd, 0xdf, 0x74, 0xd8, 0x9c, 0x9c, 0x1e, 0x38, 0x27,
+	0x2c, 0x5c, 0x04, 0xd4, 0xf5, 0xe8, 0x8c, 0x32, 0x81, 0x6e, 0x40, 0xcd, 0x0d, 0x17, 0x2c, 0xf0,
+	0xd9, 0xc9, 0xf2, 0x89, 0x40, 0x86, 0x7a, 0x2e, 0x7a, 0x00, 0x25, 0x4e, 0xe3, 0x79, 0x20, 0x54,
+	0x81, 0x36, 0xee, 0xdd, 0xcc, 0x4b, 0xfe, 0xd6, 0x61, 0x6d, 0xac, 0x84, 0x38, 0xdd, 0xb0, 0xf7,
+	0xbb, 0x06, 0xa5, 0x04, 0xa1, 0x1a, 0x94, 0xc7, 0x87, 0xdd, 0xae, 0x35, 0x1e, 0x1b, 0x97, 0xd0,
+	0x26, 0xd4, 0x0f, 0x07, 0x4f, 0x06, 0xc3, 0x67, 0x03, 0xdb, 0xc2, 0x78, 0x88, 0x0d, 0x0d, 0xad,
+	0x43, 0x65, 0x32, 0x1c, 0xda, 0xfd, 0x83, 0x89, 0x65, 0xe8, 0xa8, 0x0e, 0x55, 0x69, 0x59, 0x07,
+	0xb8, 0xff, 0xc2, 0x28, 0xa0, 0x2d, 0x30, 0xba, 0xc3, 0x7e, 0xbf, 0x37, 0xee, 0x0d, 0x07, 0xf6,
+	0xe8, 0xa0, 0xfb, 0xc4, 0x9a, 0x18, 0xc5, 0x8b, 0xf4, 0xa1, 0x75, 0xd0, 0x1d, 0x0e, 0x8c, 0x35,
+	0xf9, 0xa1, 0xc9, 0x73, 0xfb, 0x6b, 0x6c, 0x7d, 0x63, 0x94, 0xd4, 0xa9, 0xcf, 0xed, 0xd1, 0xf0,
+	0x99, 0x85, 0x8d, 0x32, 0x32, 0x60, 0xfd, 0xf1, 0x68, 0x6c, 0x1f, 0x0e, 0xfa, 0xc3, 0xee, 0x13,
+	0xeb, 0x91, 0x51, 0x41, 0x1b, 0x00, 0x8f, 0x0e, 0x27, 0x2f, 0xec, 0xee, 0x8b, 0x6e, 0xdf, 0x32,
+	0xaa, 0xca, 0x7e, 0x66, 0xf5, 0xfb, 0xf6, 0xa4, 0xf7, 0xd4, 0x32, 0x60, 0xef, 0xef, 0x12, 0x94,
+	0xc6, 0x82, 0x88, 0x79, 0x7c, 0xb1, 0x5b, 0xb4, 0xff, 0x9a, 0x00, 0xfa, 0xca, 0x04, 0x78, 0xc7,
+	0x70, 0x29, 0xbc, 0x73, 0xb8, 0x5c, 0x87, 0xea, 0x51, 0x18, 0x8a, 0xa4, 0xd7, 0x8a, 0xea, 0x84,
+	0x8a, 0x04, 0xaa, 0xd9, 0x36, 0x40, 0xf7, 0x65, 0x7b, 0x16, 0x5a, 0x55, 0xac, 0xfb, 0x91, 0x1c,
+	0x7e, 0x51, 0x40, 0xc4, 0x34, 0xe4, 0x33, 0xd5, 0x97, 0x55, 0x9c, 0xdb, 0xe8, 0x23, 0xa8, 0x3b,
+	0x21, 0x13, 0xc4, 0x11, 0x36, 0x9d, 0x11, 0x3f, 0x50, 0x23, 0xa7, 0x8a, 0xd7, 0x53, 0x68, 0x49,
+	0x86, 0x9a, 0x50, 0x73, 0x69, 0xec, 0x70, 0x3f, 0x52, 0xfd, 0xb2, 0xa1, 0x24, 0xab, 0x48, 0xbe,
+	0xa9, 0x65, 0x67, 0x45, 0x01, 0x61, 0xe6, 0x65, 0x25, 0xaa, 0xe7, 0x74, 0x14, 0x10, 0x86, 0x76,
+	0xa0, 0x74, 0xc4, 0x7d, 0xd7, 0xa3, 0xa6, 0xa1, 0xdc, 0xa9, 0x25, 0x39, 0x0f, 0xe7, 0x82, 0x72,
+	0x73, 0x33, 0xe1, 0x89, 0x25, 0xef, 0x68, 0x1a, 0x79, 0xc4, 0x44, 0xea, 0xf2, 0xd4, 0x5a, 0x3e,
+	0x68, 0x37, 0x8e, 0xcc, 0x2b, 0x0a, 0xc9, 0xa5, 0x24, 0xc7, 0x24, 0x30, 0xb7, 0xd4, 0x56, 0xb9,
+	0xcc, 0x06, 0xce, 0xf6, 0x7b, 0x06, 0x8e, 0xdc, 0xc9, 0x85, 0x50, 0x8f, 0xa6, 0x8e, 0xe5, 0x12,
+	0x5d, 0x81, 0x35, 0x7e, 0x6a, 0xfb, 0x4c, 0x0d, 0xab, 0x3a, 0x2e, 0xf2, 0xd3, 0x1e, 0x4b, 0x61,
+	0x78, 0x62, 0x7e, 0x96, 0xc1, 0xe1, 0x89, 0x84, 0x42, 0x29, 0x6f, 0x27, 0x50, 0xa4, 0x4a, 0xa1,
+	0x94, 0x9f, 0x67, 0x30, 0x51, 0x06, 0x33, 0x09, 0xef, 0x24, 0x30, 0x98, 0xe5, 0x30, 0x16, 0x66,
+	0x3b, 0x83, 0x63, 0x91, 0x42, 0xb6, 0x30, 0x3b, 0x19, 0x1c, 0x2c, 0x14, 0xb4, 0xa3, 0x28, 0x36,
+	0xbf, 0x48, 0xe1, 0x28, 0x8a, 0xd1, 0x2d, 0xd0, 0xc3, 0xd8, 0xbc, 0xaf, 0x12, 0xbc, 0x96, 0x27,
+	0x98, 0x34, 0x5e, 0x7b, 0x28, 0xd3, 0xe4, 0xbe, 0x13, 0x63, 0x3d, 0x8c, 0x65, 0xf9, 0x67, 0x34,
+	0x8e, 0x89, 0x47, 0x63, 0xf3, 0x4b, 0xd5, 0x14, 0xb9, 0xbd, 0xfb, 0x4a, 0x83, 0x6a, 0xae, 0x46,
+	0xdb, 0x50, 0x0a, 0x42, 0xe2, 0xda, 0x77, 0x55, 0xb7, 0xea, 0x78, 0x4d, 0x5a, 0x77, 0x73, 0xbc,
+	0x6f, 0xea, 0x4b, 0xbc, 0x8f, 0xae, 0x42, 0x39, 0x51, 0xef, 0xa7, 0x03, 0x57, 0xa9, 0xee, 0xee,
+	0xcb, 0x66, 0x70, 0xa2, 0xb9, 0x1d, 0x51, 0xee, 0x50, 0x26, 0x88, 0x47, 0xd5, 0xa8, 0xd4, 0x71,
+	0xdd, 0x89, 0xe6, 0xa3, 0x1c, 0xa2, 0xdb, 0xb0, 0x39, 0xa3, 0xb3, 0x90, 0x9f, 0xad, 0x2a, 0xb7,
+	0x95, 0xd2, 0x48, 0x1c, 0x2b, 0xe2, 0x26, 0xd4, 0x04, 0x9d, 0x45, 0x94, 0x13, 0x31, 0xe7, 0x54,
+	0x55, 0x4c, 0xc7, 0xab, 0xe8, 0xe1, 0xd3, 0x3f, 0xdf, 0x34, 0x2e, 0xbd, 0x7e, 0xd3, 0xd0, 0x7e,
+	0x38, 0x6f, 0x68, 0xbf, 0x9e, 0x37, 0xb4, 0x57, 0xe7, 0x0d, 0xed, 0x8f, 0xf3, 0x86, 0xf6, 0xfa,
+	0xbc, 0xa1, 0xfd, 0xfc, 0x57, 0xe3, 0xd2, 0xb7, 0xb7, 0xff, 0xc7, 0x9f, 0x9a, 0xa3, 0x92, 0xfa,
+	0x57, 0x72, 0xff, 0x9f, 0x01, 0x00, 0x98, 0x93, 0x24, 0x35, 0x0a, 0x09, 0x00, 0x00,
 }
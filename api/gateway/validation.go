@@ -23,6 +23,14 @@ func (m *Status) Validate() error {
 	return nil
 }
 
+// Validate implements the api.Validator interface
+func (m *TxAcknowledgement) Validate() error {
+	if m.DownlinkId == "" {
+		return errors.NewErrInvalidArgument("DownlinkId", "can not be empty")
+	}
+	return nil
+}
+
 // Validate implements the api.Validator interface
 func (m *GPSMetadata) Validate() error {
 	if m == nil || m.IsZero() {
@@ -20,6 +20,8 @@
 		DryDownlinkMessage
 		DryUplinkMessage
 		SimulatedUplinkMessage
+		MulticastGroupList
+		MulticastDownlinkMessage
 		LogEntry
 		DryUplinkResult
 		DryDownlinkResult
@@ -557,6 +559,72 @@ func (m *SimulatedUplinkMessage) GetPort() uint32 {
 	return 0
 }
 
+type MulticastGroupList struct {
+	Groups []*lorawan1.MulticastGroup `protobuf:"bytes,1,rep,name=groups" json:"groups,omitempty"`
+}
+
+func (m *MulticastGroupList) Reset()                    { *m = MulticastGroupList{} }
+func (*MulticastGroupList) ProtoMessage()               {}
+func (*MulticastGroupList) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{11} }
+
+func (m *MulticastGroupList) GetGroups() []*lorawan1.MulticastGroup {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+// MulticastDownlinkMessage is a downlink message for all members of a multicast group
+type MulticastDownlinkMessage struct {
+	AppId   string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	GroupId string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// The binary payload to use
+	Payload []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// JSON-encoded object with fields to encode
+	Fields string `protobuf:"bytes,4,opt,name=fields,proto3" json:"fields,omitempty"`
+	// The port number
+	Port uint32 `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (m *MulticastDownlinkMessage) Reset()                    { *m = MulticastDownlinkMessage{} }
+func (*MulticastDownlinkMessage) ProtoMessage()               {}
+func (*MulticastDownlinkMessage) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{12} }
+
+func (m *MulticastDownlinkMessage) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *MulticastDownlinkMessage) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *MulticastDownlinkMessage) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *MulticastDownlinkMessage) GetFields() string {
+	if m != nil {
+		return m.Fields
+	}
+	return ""
+}
+
+func (m *MulticastDownlinkMessage) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
 type LogEntry struct {
 	// The location where the log was created (what payload function)
 	Function string `protobuf:"bytes,1,opt,name=function,proto3" json:"function,omitempty"`
@@ -566,7 +634,7 @@ type LogEntry struct {
 
 func (m *LogEntry) Reset()                    { *m = LogEntry{} }
 func (*LogEntry) ProtoMessage()               {}
-func (*LogEntry) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{11} }
+func (*LogEntry) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{13} }
 
 func (m *LogEntry) GetFunction() string {
 	if m != nil {
@@ -596,7 +664,7 @@ type DryUplinkResult struct {
 
 func (m *DryUplinkResult) Reset()                    { *m = DryUplinkResult{} }
 func (*DryUplinkResult) ProtoMessage()               {}
-func (*DryUplinkResult) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{12} }
+func (*DryUplinkResult) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{14} }
 
 func (m *DryUplinkResult) GetPayload() []byte {
 	if m != nil {
@@ -636,7 +704,7 @@ type DryDownlinkResult struct {
 
 func (m *DryDownlinkResult) Reset()                    { *m = DryDownlinkResult{} }
 func (*DryDownlinkResult) ProtoMessage()               {}
-func (*DryDownlinkResult) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{13} }
+func (*DryDownlinkResult) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{15} }
 
 func (m *DryDownlinkResult) GetPayload() []byte {
 	if m != nil {
@@ -664,6 +732,8 @@ func init() {
 	proto.RegisterType((*DryDownlinkMessage)(nil), "handler.DryDownlinkMessage")
 	proto.RegisterType((*DryUplinkMessage)(nil), "handler.DryUplinkMessage")
 	proto.RegisterType((*SimulatedUplinkMessage)(nil), "handler.SimulatedUplinkMessage")
+	proto.RegisterType((*MulticastGroupList)(nil), "handler.MulticastGroupList")
+	proto.RegisterType((*MulticastDownlinkMessage)(nil), "handler.MulticastDownlinkMessage")
 	proto.RegisterType((*LogEntry)(nil), "handler.LogEntry")
 	proto.RegisterType((*DryUplinkResult)(nil), "handler.DryUplinkResult")
 	proto.RegisterType((*DryDownlinkResult)(nil), "handler.DryDownlinkResult")
@@ -1578,6 +1648,160 @@ func (this *SimulatedUplinkMessage) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MulticastGroupList) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MulticastGroupList)
+	if !ok {
+		that2, ok := that.(MulticastGroupList)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MulticastGroupList")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MulticastGroupList but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MulticastGroupList but is not nil && this == nil")
+	}
+	if len(this.Groups) != len(that1.Groups) {
+		return fmt.Errorf("Groups this(%v) Not Equal that(%v)", len(this.Groups), len(that1.Groups))
+	}
+	for i := range this.Groups {
+		if !this.Groups[i].Equal(that1.Groups[i]) {
+			return fmt.Errorf("Groups this[%v](%v) Not Equal that[%v](%v)", i, this.Groups[i], i, that1.Groups[i])
+		}
+	}
+	return nil
+}
+func (this *MulticastGroupList) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*MulticastGroupList)
+	if !ok {
+		that2, ok := that.(MulticastGroupList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Groups) != len(that1.Groups) {
+		return false
+	}
+	for i := range this.Groups {
+		if !this.Groups[i].Equal(that1.Groups[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MulticastDownlinkMessage) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MulticastDownlinkMessage)
+	if !ok {
+		that2, ok := that.(MulticastDownlinkMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MulticastDownlinkMessage")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MulticastDownlinkMessage but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MulticastDownlinkMessage but is not nil && this == nil")
+	}
+	if this.AppId != that1.AppId {
+		return fmt.Errorf("AppId this(%v) Not Equal that(%v)", this.AppId, that1.AppId)
+	}
+	if this.GroupId != that1.GroupId {
+		return fmt.Errorf("GroupId this(%v) Not Equal that(%v)", this.GroupId, that1.GroupId)
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return fmt.Errorf("Payload this(%v) Not Equal that(%v)", this.Payload, that1.Payload)
+	}
+	if this.Fields != that1.Fields {
+		return fmt.Errorf("Fields this(%v) Not Equal that(%v)", this.Fields, that1.Fields)
+	}
+	if this.Port != that1.Port {
+		return fmt.Errorf("Port this(%v) Not Equal that(%v)", this.Port, that1.Port)
+	}
+	return nil
+}
+func (this *MulticastDownlinkMessage) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*MulticastDownlinkMessage)
+	if !ok {
+		that2, ok := that.(MulticastDownlinkMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppId != that1.AppId {
+		return false
+	}
+	if this.GroupId != that1.GroupId {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	if this.Fields != that1.Fields {
+		return false
+	}
+	if this.Port != that1.Port {
+		return false
+	}
+	return true
+}
 func (this *LogEntry) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
@@ -1832,6 +2056,7 @@ const _ = grpc.SupportPackageIsVersion4
 type HandlerClient interface {
 	ActivationChallenge(ctx context.Context, in *broker.ActivationChallengeRequest, opts ...grpc.CallOption) (*broker.ActivationChallengeResponse, error)
 	Activate(ctx context.Context, in *broker.DeduplicatedDeviceActivationRequest, opts ...grpc.CallOption) (*DeviceActivationResponse, error)
+	TxAck(ctx context.Context, in *broker.TxAcknowledgement, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 }
 
 type handlerClient struct {
@@ -1860,11 +2085,21 @@ func (c *handlerClient) Activate(ctx context.Context, in *broker.DeduplicatedDev
 	return out, nil
 }
 
+func (c *handlerClient) TxAck(ctx context.Context, in *broker.TxAcknowledgement, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.Handler/TxAck", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Handler service
 
 type HandlerServer interface {
 	ActivationChallenge(context.Context, *broker.ActivationChallengeRequest) (*broker.ActivationChallengeResponse, error)
 	Activate(context.Context, *broker.DeduplicatedDeviceActivationRequest) (*DeviceActivationResponse, error)
+	TxAck(context.Context, *broker.TxAcknowledgement) (*google_protobuf.Empty, error)
 }
 
 func RegisterHandlerServer(s *grpc.Server, srv HandlerServer) {
@@ -1907,6 +2142,24 @@ func _Handler_Activate_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Handler_TxAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(broker.TxAcknowledgement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerServer).TxAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.Handler/TxAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerServer).TxAck(ctx, req.(*broker.TxAcknowledgement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Handler_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "handler.Handler",
 	HandlerType: (*HandlerServer)(nil),
@@ -1919,6 +2172,10 @@ var _Handler_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Activate",
 			Handler:    _Handler_Activate_Handler,
 		},
+		{
+			MethodName: "TxAck",
+			Handler:    _Handler_TxAck_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/TheThingsNetwork/ttn/api/handler/handler.proto",
@@ -1943,12 +2200,24 @@ type ApplicationManagerClient interface {
 	DeleteDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	// GetDevicesForApplication returns all devices that belong to the application with the given identifier (app_id)
 	GetDevicesForApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*DeviceList, error)
+	// GetDeviceStats returns link quality statistics of the device with the given identifier (app_id and dev_id)
+	GetDeviceStats(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*lorawan1.DeviceStats, error)
 	// DryUplink simulates processing a downlink message and returns the result
 	DryDownlink(ctx context.Context, in *DryDownlinkMessage, opts ...grpc.CallOption) (*DryDownlinkResult, error)
 	// DryUplink simulates processing an uplink message and returns the result
 	DryUplink(ctx context.Context, in *DryUplinkMessage, opts ...grpc.CallOption) (*DryUplinkResult, error)
 	// SimulateUplink simulates an uplink message
 	SimulateUplink(ctx context.Context, in *SimulatedUplinkMessage, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetMulticastGroup returns the multicast group with the given identifier (app_id and group_id)
+	GetMulticastGroup(ctx context.Context, in *lorawan1.MulticastGroupIdentifier, opts ...grpc.CallOption) (*lorawan1.MulticastGroup, error)
+	// SetMulticastGroup creates or updates a multicast group. The members are devices of the same application.
+	SetMulticastGroup(ctx context.Context, in *lorawan1.MulticastGroup, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// DeleteMulticastGroup deletes the multicast group with the given identifier (app_id and group_id)
+	DeleteMulticastGroup(ctx context.Context, in *lorawan1.MulticastGroupIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetMulticastGroupsForApplication returns all multicast groups that belong to the application with the given identifier (app_id)
+	GetMulticastGroupsForApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*MulticastGroupList, error)
+	// SendMulticastDownlink schedules a Class C downlink to all members of a multicast group
+	SendMulticastDownlink(ctx context.Context, in *MulticastDownlinkMessage, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 }
 
 type applicationManagerClient struct {
@@ -2031,6 +2300,15 @@ func (c *applicationManagerClient) GetDevicesForApplication(ctx context.Context,
 	return out, nil
 }
 
+func (c *applicationManagerClient) GetDeviceStats(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*lorawan1.DeviceStats, error) {
+	out := new(lorawan1.DeviceStats)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/GetDeviceStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationManagerClient) DryDownlink(ctx context.Context, in *DryDownlinkMessage, opts ...grpc.CallOption) (*DryDownlinkResult, error) {
 	out := new(DryDownlinkResult)
 	err := grpc.Invoke(ctx, "/handler.ApplicationManager/DryDownlink", in, out, c.cc, opts...)
@@ -2058,6 +2336,51 @@ func (c *applicationManagerClient) SimulateUplink(ctx context.Context, in *Simul
 	return out, nil
 }
 
+func (c *applicationManagerClient) GetMulticastGroup(ctx context.Context, in *lorawan1.MulticastGroupIdentifier, opts ...grpc.CallOption) (*lorawan1.MulticastGroup, error) {
+	out := new(lorawan1.MulticastGroup)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/GetMulticastGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SetMulticastGroup(ctx context.Context, in *lorawan1.MulticastGroup, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/SetMulticastGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) DeleteMulticastGroup(ctx context.Context, in *lorawan1.MulticastGroupIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/DeleteMulticastGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetMulticastGroupsForApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*MulticastGroupList, error) {
+	out := new(MulticastGroupList)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/GetMulticastGroupsForApplication", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SendMulticastDownlink(ctx context.Context, in *MulticastDownlinkMessage, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/SendMulticastDownlink", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ApplicationManager service
 
 type ApplicationManagerServer interface {
@@ -2077,12 +2400,24 @@ type ApplicationManagerServer interface {
 	DeleteDevice(context.Context, *DeviceIdentifier) (*google_protobuf.Empty, error)
 	// GetDevicesForApplication returns all devices that belong to the application with the given identifier (app_id)
 	GetDevicesForApplication(context.Context, *ApplicationIdentifier) (*DeviceList, error)
+	// GetDeviceStats returns link quality statistics of the device with the given identifier (app_id and dev_id)
+	GetDeviceStats(context.Context, *DeviceIdentifier) (*lorawan1.DeviceStats, error)
 	// DryUplink simulates processing a downlink message and returns the result
 	DryDownlink(context.Context, *DryDownlinkMessage) (*DryDownlinkResult, error)
 	// DryUplink simulates processing an uplink message and returns the result
 	DryUplink(context.Context, *DryUplinkMessage) (*DryUplinkResult, error)
 	// SimulateUplink simulates an uplink message
 	SimulateUplink(context.Context, *SimulatedUplinkMessage) (*google_protobuf.Empty, error)
+	// GetMulticastGroup returns the multicast group with the given identifier (app_id and group_id)
+	GetMulticastGroup(context.Context, *lorawan1.MulticastGroupIdentifier) (*lorawan1.MulticastGroup, error)
+	// SetMulticastGroup creates or updates a multicast group. The members are devices of the same application.
+	SetMulticastGroup(context.Context, *lorawan1.MulticastGroup) (*google_protobuf.Empty, error)
+	// DeleteMulticastGroup deletes the multicast group with the given identifier (app_id and group_id)
+	DeleteMulticastGroup(context.Context, *lorawan1.MulticastGroupIdentifier) (*google_protobuf.Empty, error)
+	// GetMulticastGroupsForApplication returns all multicast groups that belong to the application with the given identifier (app_id)
+	GetMulticastGroupsForApplication(context.Context, *ApplicationIdentifier) (*MulticastGroupList, error)
+	// SendMulticastDownlink schedules a Class C downlink to all members of a multicast group
+	SendMulticastDownlink(context.Context, *MulticastDownlinkMessage) (*google_protobuf.Empty, error)
 }
 
 func RegisterApplicationManagerServer(s *grpc.Server, srv ApplicationManagerServer) {
@@ -2233,6 +2568,24 @@ func _ApplicationManager_GetDevicesForApplication_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationManager_GetDeviceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetDeviceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/GetDeviceStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetDeviceStats(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationManager_DryDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DryDownlinkMessage)
 	if err := dec(in); err != nil {
@@ -2287,18 +2640,108 @@ func _ApplicationManager_SimulateUplink_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
-var _ApplicationManager_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "handler.ApplicationManager",
-	HandlerType: (*ApplicationManagerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterApplication",
-			Handler:    _ApplicationManager_RegisterApplication_Handler,
-		},
-		{
-			MethodName: "GetApplication",
-			Handler:    _ApplicationManager_GetApplication_Handler,
-		},
+func _ApplicationManager_GetMulticastGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(lorawan1.MulticastGroupIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetMulticastGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/GetMulticastGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetMulticastGroup(ctx, req.(*lorawan1.MulticastGroupIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SetMulticastGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(lorawan1.MulticastGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SetMulticastGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/SetMulticastGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SetMulticastGroup(ctx, req.(*lorawan1.MulticastGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_DeleteMulticastGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(lorawan1.MulticastGroupIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).DeleteMulticastGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/DeleteMulticastGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).DeleteMulticastGroup(ctx, req.(*lorawan1.MulticastGroupIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetMulticastGroupsForApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetMulticastGroupsForApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/GetMulticastGroupsForApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetMulticastGroupsForApplication(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SendMulticastDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MulticastDownlinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SendMulticastDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/SendMulticastDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SendMulticastDownlink(ctx, req.(*MulticastDownlinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ApplicationManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "handler.ApplicationManager",
+	HandlerType: (*ApplicationManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterApplication",
+			Handler:    _ApplicationManager_RegisterApplication_Handler,
+		},
+		{
+			MethodName: "GetApplication",
+			Handler:    _ApplicationManager_GetApplication_Handler,
+		},
 		{
 			MethodName: "SetApplication",
 			Handler:    _ApplicationManager_SetApplication_Handler,
@@ -2323,6 +2766,10 @@ var _ApplicationManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetDevicesForApplication",
 			Handler:    _ApplicationManager_GetDevicesForApplication_Handler,
 		},
+		{
+			MethodName: "GetDeviceStats",
+			Handler:    _ApplicationManager_GetDeviceStats_Handler,
+		},
 		{
 			MethodName: "DryDownlink",
 			Handler:    _ApplicationManager_DryDownlink_Handler,
@@ -2335,6 +2782,26 @@ var _ApplicationManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SimulateUplink",
 			Handler:    _ApplicationManager_SimulateUplink_Handler,
 		},
+		{
+			MethodName: "GetMulticastGroup",
+			Handler:    _ApplicationManager_GetMulticastGroup_Handler,
+		},
+		{
+			MethodName: "SetMulticastGroup",
+			Handler:    _ApplicationManager_SetMulticastGroup_Handler,
+		},
+		{
+			MethodName: "DeleteMulticastGroup",
+			Handler:    _ApplicationManager_DeleteMulticastGroup_Handler,
+		},
+		{
+			MethodName: "GetMulticastGroupsForApplication",
+			Handler:    _ApplicationManager_GetMulticastGroupsForApplication_Handler,
+		},
+		{
+			MethodName: "SendMulticastDownlink",
+			Handler:    _ApplicationManager_SendMulticastDownlink_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/TheThingsNetwork/ttn/api/handler/handler.proto",
@@ -2901,6 +3368,83 @@ func (m *SimulatedUplinkMessage) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *MulticastGroupList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MulticastGroupList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for _, msg := range m.Groups {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *MulticastDownlinkMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MulticastDownlinkMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.GroupId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.GroupId)))
+		i += copy(dAtA[i:], m.GroupId)
+	}
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if len(m.Fields) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Fields)))
+		i += copy(dAtA[i:], m.Fields)
+	}
+	if m.Port != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
+	}
+	return i, nil
+}
+
 func (m *LogEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3281,6 +3825,43 @@ func (m *SimulatedUplinkMessage) Size() (n int) {
 	return n
 }
 
+func (m *MulticastGroupList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MulticastDownlinkMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Fields)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovHandler(uint64(m.Port))
+	}
+	return n
+}
+
 func (m *LogEntry) Size() (n int) {
 	var l int
 	_ = l
@@ -3402,11 +3983,11 @@ func (this *Application) String() string {
 	}
 	s := strings.Join([]string{`&Application{`,
 		`AppId:` + fmt.Sprintf("%v", this.AppId) + `,`,
+		`PayloadFormat:` + fmt.Sprintf("%v", this.PayloadFormat) + `,`,
 		`Decoder:` + fmt.Sprintf("%v", this.Decoder) + `,`,
 		`Converter:` + fmt.Sprintf("%v", this.Converter) + `,`,
 		`Validator:` + fmt.Sprintf("%v", this.Validator) + `,`,
 		`Encoder:` + fmt.Sprintf("%v", this.Encoder) + `,`,
-		`PayloadFormat:` + fmt.Sprintf("%v", this.PayloadFormat) + `,`,
 		`RegisterOnJoinAccessKey:` + fmt.Sprintf("%v", this.RegisterOnJoinAccessKey) + `,`,
 		`}`,
 	}, "")
@@ -3497,6 +4078,30 @@ func (this *SimulatedUplinkMessage) String() string {
 	}, "")
 	return s
 }
+func (this *MulticastGroupList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MulticastGroupList{`,
+		`Groups:` + strings.Replace(fmt.Sprintf("%v", this.Groups), "MulticastGroup", "lorawan1.MulticastGroup", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MulticastDownlinkMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MulticastDownlinkMessage{`,
+		`AppId:` + fmt.Sprintf("%v", this.AppId) + `,`,
+		`GroupId:` + fmt.Sprintf("%v", this.GroupId) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
+		`Port:` + fmt.Sprintf("%v", this.Port) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *LogEntry) String() string {
 	if this == nil {
 		return "nil"
@@ -5208,6 +5813,274 @@ func (m *SimulatedUplinkMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MulticastGroupList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MulticastGroupList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MulticastGroupList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, &lorawan1.MulticastGroup{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MulticastDownlinkMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MulticastDownlinkMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MulticastDownlinkMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LogEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5699,89 +6572,100 @@ func init() {
 }
 
 var fileDescriptorHandler = []byte{
-	// 1332 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0x1b, 0xc5,
-	0x17, 0xef, 0xe6, 0xc3, 0x89, 0x8f, 0x13, 0xa7, 0x99, 0xb4, 0xe9, 0xd6, 0xa9, 0xdc, 0xfc, 0xb7,
-	0x6a, 0xff, 0x69, 0x5a, 0xd9, 0x22, 0x20, 0x51, 0x2a, 0x54, 0xfa, 0x91, 0xa6, 0x0d, 0x34, 0x54,
-	0xda, 0x84, 0x9b, 0x5c, 0x60, 0x4d, 0x76, 0x4f, 0xec, 0x25, 0xeb, 0x9d, 0x65, 0x67, 0x9c, 0xc8,
-	0xaa, 0x8a, 0xaa, 0x3e, 0x01, 0x12, 0xe2, 0x0d, 0xb8, 0x40, 0xe2, 0x39, 0x90, 0xb8, 0x44, 0xe2,
-	0x86, 0x3b, 0xda, 0x94, 0x27, 0xe0, 0x8e, 0x3b, 0xb4, 0x33, 0xb3, 0x1f, 0x89, 0xed, 0x24, 0x46,
-	0xdc, 0xd8, 0x3e, 0xe7, 0xf7, 0x9b, 0xf3, 0xb5, 0x67, 0xce, 0x59, 0xc3, 0x47, 0x4d, 0x4f, 0xb4,
-	0x3a, 0x3b, 0x35, 0x87, 0xb5, 0xeb, 0x5b, 0x2d, 0xdc, 0x6a, 0x79, 0x41, 0x93, 0x7f, 0x8e, 0xe2,
-	0x80, 0x45, 0x7b, 0x75, 0x21, 0x82, 0x3a, 0x0d, 0xbd, 0x7a, 0x8b, 0x06, 0xae, 0x8f, 0x51, 0xf2,
-	0x5d, 0x0b, 0x23, 0x26, 0x18, 0x99, 0xd0, 0x62, 0x65, 0xa1, 0xc9, 0x58, 0xd3, 0xc7, 0xba, 0x54,
-	0xef, 0x74, 0x76, 0xeb, 0xd8, 0x0e, 0x45, 0x57, 0xb1, 0x2a, 0x57, 0x34, 0x18, 0xdb, 0xa1, 0x41,
-	0xc0, 0x04, 0x15, 0x1e, 0x0b, 0xb8, 0x46, 0x67, 0x13, 0x17, 0x34, 0xf4, 0xb4, 0x6a, 0x21, 0x51,
-	0xed, 0x44, 0x6c, 0x0f, 0x23, 0xfd, 0xa5, 0xc1, 0xab, 0x09, 0x28, 0x45, 0x87, 0xf9, 0xe9, 0x0f,
-	0x4d, 0xb8, 0xde, 0x43, 0xf0, 0x59, 0x44, 0x0f, 0x68, 0x50, 0x77, 0x71, 0xdf, 0x73, 0x50, 0xd3,
-	0x2e, 0x27, 0x34, 0x11, 0x51, 0x07, 0xd5, 0xa7, 0x82, 0xac, 0xef, 0x47, 0xc0, 0x5c, 0x95, 0xdc,
-	0x07, 0x8e, 0xf0, 0xf6, 0x65, 0xb8, 0x36, 0xf2, 0x90, 0x05, 0x1c, 0x89, 0x09, 0x13, 0x21, 0xed,
-	0xfa, 0x8c, 0xba, 0xa6, 0xb1, 0x68, 0x2c, 0x4d, 0xd9, 0x89, 0x48, 0x6e, 0xc1, 0x44, 0x1b, 0x39,
-	0xa7, 0x4d, 0x34, 0x47, 0x16, 0x8d, 0xa5, 0xd2, 0xca, 0x6c, 0x2d, 0x0d, 0x6d, 0x43, 0x01, 0x76,
-	0xc2, 0x20, 0x9f, 0xc0, 0x8c, 0xcb, 0x0e, 0x02, 0xdf, 0x0b, 0xf6, 0x1a, 0x2c, 0x8c, 0x3d, 0x98,
-	0x25, 0x79, 0x68, 0xbe, 0xa6, 0xd3, 0x5d, 0xd5, 0xf0, 0x73, 0x89, 0xda, 0x65, 0xf7, 0x88, 0x4c,
-	0x36, 0x60, 0x8e, 0xa6, 0xd1, 0x35, 0xda, 0x28, 0xa8, 0x4b, 0x05, 0x35, 0x2f, 0x49, 0x23, 0x57,
-	0x32, 0xcf, 0x59, 0x0a, 0x1b, 0x9a, 0x63, 0x13, 0xda, 0xa3, 0x23, 0x16, 0x8c, 0xcb, 0x12, 0x98,
-	0x57, 0xa5, 0x81, 0xa9, 0x9a, 0x2a, 0xc8, 0x56, 0xfc, 0x69, 0x2b, 0xc8, 0x9a, 0x81, 0xe9, 0x4d,
-	0x41, 0x45, 0x87, 0xdb, 0xf8, 0x75, 0x07, 0xb9, 0xb0, 0xfe, 0x30, 0xa0, 0xa0, 0x34, 0x64, 0x09,
-	0x0a, 0xbc, 0xcb, 0x05, 0xb6, 0x65, 0x55, 0x4a, 0x2b, 0xe7, 0x6b, 0xf1, 0xf3, 0xdc, 0x94, 0xaa,
-	0x98, 0xc2, 0x6d, 0x8d, 0x93, 0xf7, 0xa0, 0xe8, 0xb0, 0x76, 0xc8, 0x02, 0x0c, 0x84, 0x2e, 0xd4,
-	0x9c, 0x24, 0x3f, 0x4a, 0xb4, 0x8a, 0x9f, 0xb1, 0x88, 0x05, 0x85, 0x4e, 0x18, 0xe7, 0xae, 0x6b,
-	0x04, 0x92, 0x6f, 0x53, 0x81, 0xdc, 0xd6, 0x08, 0xb9, 0x01, 0x93, 0x49, 0x85, 0xcc, 0xa9, 0x1e,
-	0x56, 0x8a, 0x91, 0xdb, 0x50, 0xca, 0xd2, 0xe7, 0xe6, 0x74, 0x0f, 0x35, 0x0f, 0x5b, 0x35, 0xb8,
-	0xf8, 0x20, 0x0c, 0x7d, 0xcf, 0x91, 0xf2, 0xba, 0x8b, 0x81, 0xf0, 0x76, 0x3d, 0x8c, 0xc8, 0x45,
-	0x28, 0xd0, 0x30, 0x6c, 0x78, 0xaa, 0x0b, 0x8a, 0xf6, 0x38, 0x0d, 0xc3, 0x75, 0xd7, 0xfa, 0xdb,
-	0x80, 0x52, 0xee, 0xc0, 0x00, 0x5a, 0xdc, 0x44, 0x2e, 0x3a, 0xcc, 0xc5, 0x48, 0x56, 0xa0, 0x68,
-	0x27, 0x22, 0xb9, 0x12, 0x57, 0x27, 0xd8, 0xc7, 0x48, 0x60, 0x64, 0x8e, 0x4a, 0x2c, 0x53, 0xc4,
-	0xe8, 0x3e, 0xf5, 0x3d, 0x97, 0x0a, 0x16, 0x99, 0x63, 0x0a, 0x4d, 0x15, 0xb1, 0x55, 0x0c, 0x94,
-	0xd5, 0x71, 0x65, 0x55, 0x8b, 0xe4, 0x3a, 0x94, 0x75, 0x97, 0x36, 0x76, 0x59, 0xd4, 0xa6, 0xc2,
-	0x2c, 0x48, 0xc2, 0xb4, 0xd6, 0xae, 0x49, 0x25, 0xf9, 0x18, 0x16, 0x22, 0x6c, 0x7a, 0x5c, 0x60,
-	0xd4, 0x60, 0x41, 0xe3, 0x2b, 0xe6, 0x05, 0x0d, 0xea, 0x38, 0xc8, 0x79, 0x63, 0x0f, 0xbb, 0xe6,
-	0x84, 0x3c, 0x73, 0x29, 0xa1, 0x3c, 0x0f, 0x3e, 0x65, 0x5e, 0xf0, 0x40, 0xe2, 0x9f, 0x61, 0xd7,
-	0xba, 0x0f, 0xe7, 0xd5, 0xad, 0x39, 0xb5, 0x4c, 0xb1, 0xda, 0xc5, 0xfd, 0x58, 0xad, 0xd2, 0x1f,
-	0x77, 0x71, 0x7f, 0xdd, 0xb5, 0xfe, 0x32, 0xa0, 0xa0, 0x4c, 0x0c, 0x77, 0x90, 0xdc, 0x81, 0xb2,
-	0xbe, 0xe4, 0x0d, 0x75, 0xc9, 0x65, 0xe9, 0x4a, 0x2b, 0x33, 0x35, 0xad, 0xae, 0x29, 0xb3, 0x4f,
-	0xcf, 0xd9, 0xd3, 0x5a, 0xa3, 0xfd, 0x54, 0x60, 0xd2, 0xa7, 0xc2, 0x13, 0x1d, 0x17, 0x4d, 0x58,
-	0x34, 0x96, 0x46, 0xec, 0x54, 0x8e, 0xab, 0xed, 0xb3, 0xa0, 0xa9, 0xc0, 0x92, 0x04, 0x33, 0x45,
-	0x7c, 0x92, 0xfa, 0xfa, 0x64, 0xdc, 0x70, 0xe3, 0x76, 0x2a, 0x93, 0x45, 0x28, 0xb9, 0xc8, 0x9d,
-	0xc8, 0x53, 0x37, 0xfb, 0x82, 0x8c, 0x35, 0xaf, 0x7a, 0x38, 0x29, 0x13, 0xf1, 0x1c, 0xb4, 0x3e,
-	0x04, 0x50, 0xb1, 0x3c, 0xf3, 0xb8, 0x20, 0x37, 0xe3, 0xce, 0x88, 0x25, 0x6e, 0x1a, 0x8b, 0xa3,
-	0x32, 0x85, 0x64, 0xe6, 0x2a, 0x96, 0x9d, 0xe0, 0xd6, 0x6b, 0x03, 0xc8, 0x6a, 0xd4, 0x4d, 0xe6,
-	0x84, 0x1e, 0x31, 0x27, 0x0c, 0xa8, 0x79, 0x28, 0xec, 0x7a, 0xe8, 0xbb, 0x5c, 0x17, 0x4f, 0x4b,
-	0xe4, 0x06, 0x8c, 0xd2, 0x30, 0xd4, 0x25, 0xbb, 0x90, 0xfa, 0xcb, 0xf5, 0xb1, 0x1d, 0x13, 0x08,
-	0x81, 0xb1, 0x90, 0x45, 0x42, 0x36, 0xde, 0xb4, 0x2d, 0x7f, 0x5b, 0x2d, 0x38, 0xbf, 0x1a, 0x75,
-	0xbf, 0x08, 0xcf, 0x16, 0x81, 0xf6, 0x34, 0x72, 0x56, 0x4f, 0xa3, 0x39, 0x4f, 0x02, 0xe6, 0x37,
-	0xbd, 0x76, 0xc7, 0xa7, 0x02, 0xdd, 0xa3, 0xfe, 0x86, 0xeb, 0x95, 0x5c, 0x74, 0xa3, 0x47, 0xa3,
-	0xeb, 0x97, 0xdf, 0x3d, 0x98, 0x7c, 0xc6, 0x9a, 0x8f, 0x03, 0x11, 0x75, 0xe3, 0x27, 0xbe, 0xdb,
-	0x09, 0x1c, 0xf9, 0x48, 0x95, 0xa7, 0x54, 0x3e, 0x52, 0xdb, 0xd1, 0xac, 0xb6, 0xd6, 0x2b, 0x03,
-	0x66, 0xd2, 0x02, 0xd9, 0xc8, 0x3b, 0xbe, 0xf8, 0x17, 0x4f, 0xe8, 0x02, 0x8c, 0xcb, 0x6b, 0x2e,
-	0x23, 0x9e, 0xb4, 0x95, 0x40, 0xae, 0xc3, 0x98, 0xcf, 0x9a, 0xdc, 0x1c, 0x93, 0x8d, 0x32, 0x9b,
-	0x96, 0x33, 0x09, 0xd8, 0x96, 0xb0, 0xb5, 0x05, 0xb3, 0xb9, 0x36, 0x39, 0x35, 0x86, 0xc4, 0xea,
-	0xc8, 0x89, 0x56, 0x57, 0x7e, 0x36, 0x60, 0xe2, 0xa9, 0x82, 0xc8, 0x97, 0x30, 0x97, 0xad, 0x99,
-	0x47, 0x2d, 0xea, 0xfb, 0x18, 0x34, 0x91, 0x58, 0xc9, 0x2a, 0xeb, 0x03, 0xea, 0x15, 0x52, 0xb9,
-	0x76, 0x22, 0x47, 0xef, 0xdc, 0x6d, 0x98, 0xd4, 0x30, 0x92, 0x5b, 0xe9, 0x7e, 0x44, 0xb7, 0xa3,
-	0xda, 0x06, 0xdd, 0xde, 0x6d, 0xad, 0xac, 0xff, 0xef, 0xd8, 0xe5, 0xe9, 0xdd, 0xe7, 0x2b, 0xef,
-	0x8a, 0x40, 0x72, 0xfd, 0xb7, 0x41, 0x03, 0xda, 0xc4, 0x88, 0x34, 0x61, 0xce, 0xd6, 0x73, 0x2e,
-	0x3f, 0xcf, 0xab, 0xfd, 0x7a, 0x36, 0x9b, 0x77, 0x95, 0xf9, 0x9a, 0x7a, 0xd9, 0xa9, 0x25, 0x6f,
-	0x42, 0xb5, 0xc7, 0xf1, 0x9b, 0x90, 0x65, 0xbe, 0xfe, 0xed, 0xcf, 0xef, 0x46, 0x88, 0x35, 0x5d,
-	0xa7, 0xd9, 0x39, 0x7e, 0xd7, 0x58, 0x26, 0xbb, 0x50, 0x7e, 0x82, 0x62, 0x18, 0x1f, 0x7d, 0xef,
-	0x8d, 0x55, 0x95, 0x1e, 0x4c, 0x32, 0x7f, 0xc4, 0x43, 0xfd, 0x85, 0xba, 0x19, 0x2f, 0xc9, 0x37,
-	0x50, 0xde, 0x3c, 0xea, 0xa7, 0xaf, 0x9d, 0x81, 0x19, 0xdc, 0x93, 0xf6, 0xef, 0x58, 0x03, 0xec,
-	0xdf, 0x35, 0x96, 0xb7, 0x17, 0x2a, 0x83, 0x41, 0xb2, 0x07, 0xb3, 0xab, 0xe8, 0xa3, 0xc0, 0xff,
-	0xa2, 0x9c, 0x3a, 0xd9, 0xe5, 0x41, 0xc9, 0xb6, 0xa0, 0xf8, 0x04, 0x85, 0x1e, 0xf1, 0x97, 0x8f,
-	0x35, 0x41, 0xce, 0xfe, 0xf1, 0xe1, 0x6a, 0xd5, 0xa5, 0xe1, 0x9b, 0xe4, 0xff, 0xfd, 0x0d, 0xeb,
-	0x57, 0x48, 0x5e, 0x7f, 0xa1, 0x26, 0xcb, 0x4b, 0x72, 0x68, 0x40, 0x71, 0x33, 0x75, 0x75, 0xdc,
-	0xde, 0xc0, 0x04, 0x7e, 0x32, 0xa4, 0xa3, 0x1f, 0x0c, 0xeb, 0xac, 0x9e, 0xe2, 0x02, 0xdf, 0xae,
-	0x0c, 0xc3, 0xbe, 0x66, 0x55, 0x4f, 0x66, 0x4b, 0x52, 0xe5, 0x74, 0x12, 0x89, 0x60, 0x4a, 0x3d,
-	0xbb, 0xd3, 0x2b, 0x3a, 0x28, 0x61, 0x5d, 0xd8, 0xe5, 0x33, 0x17, 0xf6, 0x00, 0xcc, 0xf4, 0x11,
-	0xf2, 0x35, 0x36, 0xd4, 0x2d, 0x9c, 0x3b, 0x16, 0x5f, 0xbc, 0x59, 0xad, 0x1b, 0x32, 0x82, 0x45,
-	0x72, 0x4a, 0xbe, 0x64, 0x0d, 0x4a, 0xb9, 0x71, 0x49, 0x16, 0x32, 0x5b, 0x3d, 0xbb, 0xb6, 0x52,
-	0xe9, 0x07, 0xea, 0x09, 0x7b, 0x1f, 0x8a, 0xe9, 0xe0, 0xcf, 0x57, 0xec, 0xd8, 0xb6, 0xac, 0x98,
-	0xbd, 0x90, 0xb6, 0xb0, 0x0e, 0xe5, 0x64, 0xe3, 0x69, 0x33, 0x57, 0x53, 0x6e, 0xff, 0x55, 0x38,
-	0xa8, 0xfc, 0x2b, 0x6b, 0x50, 0xd6, 0xc3, 0x3a, 0x19, 0x70, 0x1f, 0xc8, 0x2b, 0xa2, 0xdf, 0xde,
-	0xe7, 0x33, 0xbb, 0xf9, 0x17, 0xfc, 0xdc, 0xfd, 0x50, 0xfa, 0x87, 0x1b, 0xbf, 0xbf, 0xad, 0x9e,
-	0x7b, 0xf3, 0xb6, 0x6a, 0xbc, 0x3a, 0xac, 0x1a, 0x3f, 0x1e, 0x56, 0x8d, 0x5f, 0x0e, 0xab, 0xc6,
-	0xaf, 0x87, 0x55, 0xe3, 0xcd, 0x61, 0xd5, 0xf8, 0xf6, 0x5d, 0xf5, 0xdc, 0xf6, 0xad, 0x21, 0xfe,
-	0x4e, 0xee, 0x14, 0x64, 0x98, 0xef, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x6d, 0x84, 0x05,
-	0x84, 0x0e, 0x00, 0x00,
+	// 1517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5f, 0x4f, 0x1b, 0xc7,
+	0x16, 0xcf, 0x02, 0x36, 0x70, 0x8c, 0x4d, 0x18, 0x08, 0x59, 0x4c, 0xe4, 0x38, 0x1b, 0x25, 0x97,
+	0x90, 0xc8, 0xd6, 0xe5, 0x5e, 0xe9, 0xe6, 0xa2, 0x2a, 0x0d, 0x09, 0x81, 0xd0, 0x86, 0x46, 0x5d,
+	0xd3, 0x17, 0x1e, 0x6a, 0x0d, 0xbb, 0xc3, 0x7a, 0xcb, 0x7a, 0x67, 0xbb, 0x3b, 0x86, 0x5a, 0x51,
+	0xaa, 0x34, 0x9f, 0xa0, 0x6a, 0xd5, 0x6f, 0xd0, 0x87, 0x4a, 0xfd, 0x1c, 0x95, 0xfa, 0x58, 0xa9,
+	0x2f, 0x7d, 0x6b, 0x42, 0xfb, 0x09, 0xfa, 0xd6, 0xb7, 0x6a, 0x67, 0x66, 0xff, 0xf8, 0xcf, 0x02,
+	0xae, 0xfa, 0x82, 0x7d, 0xce, 0xef, 0x37, 0xe7, 0xdf, 0x9c, 0x99, 0x39, 0x06, 0xfe, 0x6f, 0xd9,
+	0xac, 0xd5, 0x39, 0xa8, 0x19, 0xb4, 0x5d, 0xdf, 0x6b, 0x91, 0xbd, 0x96, 0xed, 0x5a, 0xc1, 0x07,
+	0x84, 0x9d, 0x50, 0xff, 0xa8, 0xce, 0x98, 0x5b, 0xc7, 0x9e, 0x5d, 0x6f, 0x61, 0xd7, 0x74, 0x88,
+	0x1f, 0x7d, 0xd6, 0x3c, 0x9f, 0x32, 0x8a, 0x26, 0xa5, 0x58, 0x5e, 0xb6, 0x28, 0xb5, 0x1c, 0x52,
+	0xe7, 0xea, 0x83, 0xce, 0x61, 0x9d, 0xb4, 0x3d, 0xd6, 0x15, 0xac, 0xf2, 0x35, 0x09, 0x86, 0x76,
+	0xb0, 0xeb, 0x52, 0x86, 0x99, 0x4d, 0xdd, 0x40, 0xa2, 0x73, 0x91, 0x0b, 0xec, 0xd9, 0x52, 0xb5,
+	0x1c, 0xa9, 0x0e, 0x7c, 0x7a, 0x44, 0x7c, 0xf9, 0x21, 0xc1, 0xeb, 0x11, 0xc8, 0x45, 0x83, 0x3a,
+	0xf1, 0x17, 0x49, 0xb8, 0x35, 0x40, 0x70, 0xa8, 0x8f, 0x4f, 0xb0, 0x5b, 0x37, 0xc9, 0xb1, 0x6d,
+	0x10, 0x49, 0x5b, 0xc9, 0xa4, 0xb5, 0x3b, 0x0e, 0xb3, 0x0d, 0x1c, 0x30, 0xc9, 0x5c, 0x8a, 0x98,
+	0xcc, 0xc7, 0x06, 0x11, 0x7f, 0x05, 0xa4, 0x7d, 0x33, 0x06, 0xea, 0x26, 0xb7, 0xba, 0x61, 0x30,
+	0xfb, 0x98, 0x27, 0xa6, 0x93, 0xc0, 0xa3, 0x6e, 0x40, 0x90, 0x0a, 0x93, 0x1e, 0xee, 0x3a, 0x14,
+	0x9b, 0xaa, 0x52, 0x55, 0x56, 0x66, 0xf4, 0x48, 0x44, 0x77, 0x61, 0xb2, 0x4d, 0x82, 0x00, 0x5b,
+	0x44, 0x1d, 0xab, 0x2a, 0x2b, 0x85, 0xb5, 0xb9, 0x5a, 0x9c, 0xc4, 0xae, 0x00, 0xf4, 0x88, 0x81,
+	0xde, 0x85, 0x59, 0x93, 0x9e, 0xb8, 0x8e, 0xed, 0x1e, 0x35, 0xa9, 0x17, 0x7a, 0x50, 0x0b, 0x7c,
+	0xd1, 0x62, 0x4d, 0x16, 0x66, 0x53, 0xc2, 0xcf, 0x39, 0xaa, 0x97, 0xcc, 0x1e, 0x19, 0xed, 0xc2,
+	0x3c, 0x8e, 0xa3, 0x6b, 0xb6, 0x09, 0xc3, 0x26, 0x66, 0x58, 0xbd, 0xca, 0x8d, 0x5c, 0x4b, 0x3c,
+	0x27, 0x29, 0xec, 0x4a, 0x8e, 0x8e, 0xf0, 0x80, 0x0e, 0x69, 0x90, 0xe3, 0x25, 0x50, 0xaf, 0x73,
+	0x03, 0x33, 0x35, 0x2e, 0xd5, 0xf6, 0xc2, 0xbf, 0xba, 0x80, 0xb4, 0x59, 0x28, 0x36, 0x18, 0x66,
+	0x9d, 0x40, 0x27, 0x9f, 0x76, 0x48, 0xc0, 0xb4, 0x5f, 0x15, 0xc8, 0x0b, 0x0d, 0x5a, 0x81, 0x7c,
+	0xd0, 0x0d, 0x18, 0x69, 0xf3, 0xaa, 0x14, 0xd6, 0x2e, 0xd7, 0xc2, 0x9d, 0x6f, 0x70, 0x55, 0x48,
+	0x09, 0x74, 0x89, 0xa3, 0x7f, 0xc3, 0xb4, 0x41, 0xdb, 0x1e, 0x75, 0x89, 0xcb, 0x64, 0xa1, 0xe6,
+	0x39, 0xf9, 0x71, 0xa4, 0x15, 0xfc, 0x84, 0x85, 0x34, 0xc8, 0x77, 0xbc, 0x30, 0x77, 0x59, 0x23,
+	0xe0, 0x7c, 0x1d, 0x33, 0x12, 0xe8, 0x12, 0x41, 0xb7, 0x61, 0x2a, 0xaa, 0x90, 0x3a, 0x33, 0xc0,
+	0x8a, 0x31, 0x74, 0x0f, 0x0a, 0x49, 0xfa, 0x81, 0x5a, 0x1c, 0xa0, 0xa6, 0x61, 0xad, 0x06, 0x57,
+	0x36, 0x3c, 0xcf, 0xb1, 0x0d, 0x2e, 0xef, 0x98, 0xc4, 0x65, 0xf6, 0xa1, 0x4d, 0x7c, 0x74, 0x05,
+	0xf2, 0xd8, 0xf3, 0x9a, 0xb6, 0xe8, 0x82, 0x69, 0x3d, 0x87, 0x3d, 0x6f, 0xc7, 0xd4, 0xfe, 0x54,
+	0xa0, 0x90, 0x5a, 0x90, 0x41, 0x43, 0xb7, 0xa0, 0x24, 0xbb, 0xa6, 0x79, 0x48, 0xfd, 0x36, 0x66,
+	0x6a, 0x9e, 0xc3, 0x45, 0xa9, 0xdd, 0xe2, 0xca, 0xb0, 0xd7, 0x4c, 0x62, 0x50, 0x93, 0xf8, 0xbc,
+	0x50, 0xd3, 0x7a, 0x24, 0xa2, 0x6b, 0x61, 0x11, 0xdd, 0x63, 0xe2, 0x33, 0xe2, 0xab, 0xe3, 0x1c,
+	0x4b, 0x14, 0x21, 0x7a, 0x8c, 0x1d, 0xdb, 0xc4, 0x8c, 0xfa, 0xea, 0x84, 0x40, 0x63, 0x45, 0x68,
+	0x95, 0xb8, 0xc2, 0x6a, 0x4e, 0x58, 0x95, 0x22, 0x7a, 0x07, 0x96, 0x7d, 0x62, 0xd9, 0x01, 0x23,
+	0x7e, 0x93, 0xba, 0xcd, 0x4f, 0xa8, 0xed, 0x36, 0xb1, 0x61, 0x90, 0x20, 0x68, 0x1e, 0x91, 0xae,
+	0x3a, 0xc9, 0xd9, 0x57, 0x23, 0xca, 0x73, 0xf7, 0x3d, 0x6a, 0xbb, 0x1b, 0x1c, 0x7f, 0x9f, 0x74,
+	0xb5, 0x87, 0x70, 0x59, 0x9c, 0x9a, 0x73, 0xcb, 0x14, 0xaa, 0x4d, 0x72, 0x1c, 0xaa, 0x45, 0x5e,
+	0x39, 0x93, 0x1c, 0xef, 0x98, 0xda, 0x1f, 0x0a, 0xe4, 0x85, 0x89, 0xd1, 0x16, 0xa2, 0xfb, 0x50,
+	0x92, 0xe7, 0xbc, 0x29, 0xae, 0x03, 0x5e, 0x93, 0xc2, 0xda, 0x6c, 0x4d, 0xaa, 0x6b, 0xc2, 0xec,
+	0xd3, 0x4b, 0x7a, 0x51, 0x6a, 0xa4, 0x9f, 0x32, 0x4c, 0x39, 0x98, 0xd9, 0xac, 0x63, 0x12, 0x15,
+	0xaa, 0xca, 0xca, 0x98, 0x1e, 0xcb, 0x61, 0x19, 0x1d, 0xea, 0x5a, 0x02, 0x2c, 0x70, 0x30, 0x51,
+	0x84, 0x2b, 0xb1, 0x23, 0x57, 0x86, 0x0d, 0x97, 0xd3, 0x63, 0x19, 0x55, 0xa1, 0x60, 0x92, 0xc0,
+	0xf0, 0x6d, 0x71, 0xb2, 0x17, 0x78, 0xac, 0x69, 0xd5, 0xa3, 0x29, 0x9e, 0x88, 0x6d, 0x10, 0xed,
+	0x7f, 0x00, 0x22, 0x96, 0x67, 0x76, 0xc0, 0xd0, 0x9d, 0x70, 0xcb, 0x43, 0x29, 0x50, 0x95, 0xea,
+	0x38, 0x4f, 0x21, 0xba, 0x9d, 0x05, 0x4b, 0x8f, 0x70, 0xed, 0xb5, 0x02, 0x68, 0xd3, 0xef, 0x46,
+	0xf7, 0x84, 0xbc, 0x62, 0xce, 0xb8, 0xa0, 0x16, 0x21, 0x7f, 0x68, 0x13, 0xc7, 0x0c, 0x64, 0xf1,
+	0xa4, 0x84, 0x6e, 0xc3, 0x38, 0xf6, 0x3c, 0x59, 0xb2, 0x85, 0xd8, 0x5f, 0xaa, 0x8f, 0xf5, 0x90,
+	0x80, 0x10, 0x4c, 0x78, 0xd4, 0x67, 0xbc, 0xa3, 0x8a, 0x3a, 0xff, 0xae, 0xb5, 0xe0, 0xf2, 0xa6,
+	0xdf, 0xfd, 0xc8, 0xbb, 0x58, 0x04, 0xd2, 0xd3, 0xd8, 0x45, 0x3d, 0x8d, 0xa7, 0x3c, 0x31, 0x58,
+	0x6c, 0xd8, 0xed, 0x8e, 0x83, 0x19, 0x31, 0x7b, 0xfd, 0x8d, 0xd6, 0x2b, 0xa9, 0xe8, 0xc6, 0x7b,
+	0xa3, 0x1b, 0x96, 0xdf, 0x13, 0x40, 0xbb, 0xd1, 0xcb, 0xb1, 0xed, 0xd3, 0x8e, 0xc7, 0x77, 0xa9,
+	0x0e, 0x79, 0x2b, 0x14, 0xa2, 0x4d, 0xba, 0x1a, 0xf7, 0x59, 0x2f, 0x59, 0x97, 0x34, 0xed, 0x2b,
+	0x05, 0xd4, 0x18, 0xea, 0xdf, 0xb1, 0x8c, 0xf8, 0x97, 0x60, 0x8a, 0xaf, 0x4e, 0x32, 0x98, 0xe4,
+	0xf2, 0x99, 0x39, 0x24, 0x7b, 0x3c, 0xd1, 0xb3, 0xc7, 0x51, 0x6e, 0xb9, 0x54, 0x6e, 0x0f, 0x60,
+	0xea, 0x19, 0xb5, 0x9e, 0xb8, 0xcc, 0xef, 0x86, 0xdd, 0x7c, 0xd8, 0x71, 0x0d, 0xde, 0xae, 0x22,
+	0x8a, 0x58, 0xee, 0xe9, 0x9b, 0xf1, 0xc4, 0xa6, 0xf6, 0x4a, 0x81, 0xd9, 0x78, 0xf3, 0x75, 0x12,
+	0x74, 0x1c, 0xf6, 0x37, 0xba, 0x6f, 0x01, 0x72, 0xfc, 0x6e, 0xe2, 0x99, 0x4c, 0xe9, 0x42, 0x40,
+	0xb7, 0x60, 0xc2, 0xa1, 0x56, 0x98, 0xc5, 0x38, 0x7f, 0x49, 0xa3, 0x56, 0x89, 0x02, 0xd6, 0x39,
+	0xac, 0xed, 0xc1, 0x5c, 0xea, 0x08, 0x9c, 0x1b, 0x43, 0x64, 0x75, 0xec, 0x4c, 0xab, 0x6b, 0x5f,
+	0x8c, 0xc1, 0xe4, 0x53, 0x01, 0xa1, 0x8f, 0x61, 0x3e, 0x79, 0x42, 0x1f, 0xb7, 0xb0, 0xe3, 0x10,
+	0xd7, 0x22, 0x48, 0x8b, 0x9e, 0xe9, 0x21, 0xa0, 0x7c, 0x1e, 0xcb, 0x37, 0xcf, 0xe4, 0xc8, 0x79,
+	0x62, 0x1f, 0xa6, 0x24, 0x4c, 0xd0, 0xdd, 0xf8, 0xed, 0x27, 0x66, 0x47, 0x1c, 0x09, 0x62, 0x0e,
+	0x4e, 0x22, 0xc2, 0xfa, 0x8d, 0xbe, 0x8b, 0x61, 0xc8, 0xac, 0xb2, 0x0e, 0xb9, 0xbd, 0xcf, 0x36,
+	0x8c, 0x23, 0xb4, 0x14, 0x19, 0xe6, 0xa2, 0x4b, 0x4f, 0x1c, 0x62, 0x5a, 0xa4, 0x4d, 0x5c, 0x56,
+	0x5e, 0xac, 0x89, 0x41, 0xae, 0x16, 0x4d, 0x79, 0xb5, 0x27, 0xe1, 0x94, 0xb7, 0xf6, 0x43, 0x11,
+	0x50, 0xea, 0x5c, 0xee, 0x62, 0x17, 0x5b, 0xc4, 0x47, 0x16, 0xcc, 0xeb, 0xf2, 0xfe, 0x4f, 0xa1,
+	0xa8, 0x32, 0xec, 0x2c, 0x27, 0xef, 0x40, 0x96, 0x17, 0x4d, 0x7d, 0xfd, 0xf3, 0xef, 0x5f, 0x8f,
+	0xa1, 0x75, 0x65, 0x55, 0x2b, 0xd6, 0x71, 0xb2, 0x34, 0x40, 0x87, 0x50, 0xda, 0x26, 0x6c, 0x14,
+	0x1f, 0x43, 0xef, 0x13, 0xad, 0xc2, 0x3d, 0xa8, 0x68, 0xb1, 0xc7, 0x7c, 0xfd, 0x85, 0x38, 0x71,
+	0x2f, 0xd1, 0xe7, 0x50, 0x6a, 0xf4, 0xfa, 0x19, 0x6a, 0x27, 0x33, 0x83, 0x07, 0xdc, 0xfe, 0xfd,
+	0x75, 0x65, 0x75, 0x7f, 0x79, 0x5d, 0x59, 0x2d, 0x67, 0xf8, 0xd1, 0xb2, 0xfc, 0x1f, 0xc1, 0xdc,
+	0x26, 0x71, 0x08, 0x23, 0xff, 0x44, 0x39, 0x65, 0xb2, 0xab, 0x59, 0xce, 0x5a, 0x30, 0xbd, 0x4d,
+	0x98, 0x7c, 0xfa, 0x96, 0xfa, 0x1a, 0x28, 0x65, 0xbf, 0xff, 0xd1, 0xd1, 0xea, 0xdc, 0xf0, 0x1d,
+	0xf4, 0xaf, 0xe1, 0x86, 0xe5, 0x10, 0x1e, 0xd4, 0x5f, 0x88, 0x1b, 0xf7, 0x25, 0x3a, 0x55, 0x60,
+	0xba, 0x11, 0xbb, 0xea, 0xb7, 0x97, 0x99, 0xc0, 0xf7, 0x0a, 0x77, 0xf4, 0xad, 0x12, 0xd6, 0xf3,
+	0x5e, 0x58, 0xcf, 0x8b, 0x7a, 0xdc, 0xbf, 0x19, 0x36, 0x51, 0xe5, 0x6c, 0x36, 0x27, 0x95, 0xcf,
+	0x21, 0x69, 0x17, 0x4e, 0xd2, 0x87, 0x19, 0xb1, 0x77, 0xe7, 0x57, 0x34, 0x2b, 0x61, 0x59, 0xd8,
+	0xd5, 0x0b, 0xfb, 0x3c, 0x01, 0x35, 0xde, 0xc2, 0x60, 0x8b, 0x8e, 0x74, 0x0a, 0xe7, 0xfb, 0xe2,
+	0x0b, 0xdf, 0x32, 0xed, 0x36, 0x8f, 0xa0, 0x8a, 0xce, 0xa9, 0x0a, 0xda, 0xe0, 0x07, 0x52, 0x2c,
+	0xe4, 0x13, 0xfa, 0x59, 0xe9, 0x2e, 0xf4, 0x0d, 0x5e, 0x62, 0xc1, 0x16, 0x14, 0x52, 0xb7, 0x35,
+	0x5a, 0x4e, 0xd6, 0x0f, 0x8c, 0x31, 0xe5, 0xf2, 0x30, 0x50, 0x5e, 0xf0, 0x0f, 0x61, 0x3a, 0x7e,
+	0x77, 0xd2, 0x51, 0xf4, 0x0d, 0x22, 0x65, 0x75, 0x10, 0x92, 0x16, 0x76, 0xa0, 0x14, 0x0d, 0x13,
+	0xd2, 0xcc, 0xf5, 0x98, 0x3b, 0x7c, 0xca, 0xc8, 0xda, 0x41, 0xf4, 0x1c, 0xe6, 0xb6, 0x09, 0xeb,
+	0x7d, 0xf7, 0xd1, 0x8d, 0x8c, 0x81, 0x20, 0x55, 0xa2, 0xac, 0x99, 0x01, 0x6d, 0xc2, 0x5c, 0x63,
+	0xc0, 0x60, 0x16, 0x3b, 0x33, 0xac, 0x0f, 0x61, 0x41, 0xf4, 0xe6, 0xe8, 0x91, 0x65, 0x99, 0x6c,
+	0x42, 0x75, 0x20, 0xd3, 0x51, 0x5b, 0x30, 0xd9, 0xf3, 0x21, 0x63, 0x95, 0x0e, 0x57, 0x1a, 0xc4,
+	0x35, 0x07, 0x06, 0x25, 0x74, 0x63, 0x70, 0x55, 0x7f, 0xbf, 0x64, 0xbd, 0x63, 0x5b, 0x50, 0x92,
+	0x4f, 0x79, 0xf4, 0x84, 0xfd, 0x97, 0x5f, 0x82, 0xf2, 0x77, 0xeb, 0x62, 0xb2, 0xed, 0xe9, 0x9f,
+	0xb6, 0xe5, 0xd9, 0x3e, 0xfd, 0xa3, 0xdd, 0x5f, 0xde, 0x56, 0x2e, 0xbd, 0x79, 0x5b, 0x51, 0x5e,
+	0x9d, 0x56, 0x94, 0xef, 0x4e, 0x2b, 0xca, 0x8f, 0xa7, 0x15, 0xe5, 0xa7, 0xd3, 0x8a, 0xf2, 0xe6,
+	0xb4, 0xa2, 0x7c, 0xf9, 0x5b, 0xe5, 0xd2, 0xfe, 0xdd, 0x11, 0xfe, 0xe5, 0x72, 0x90, 0xe7, 0x61,
+	0xfe, 0xe7, 0xaf, 0x01, 0x00, 0xd6, 0x42, 0x97, 0x9a, 0xa8, 0x11, 0x00, 0x00,
 }
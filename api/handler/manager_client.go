@@ -127,6 +127,15 @@ func (h *ManagerClient) DeleteDevice(appID string, devID string) error {
 	return errors.Wrap(errors.FromGRPCError(err), "Could not delete device from Handler")
 }
 
+// GetDeviceStats retrieves link quality statistics of a device from the Handler
+func (h *ManagerClient) GetDeviceStats(appID string, devID string) (*lorawan.DeviceStats, error) {
+	res, err := h.applicationManagerClient.GetDeviceStats(h.GetContext(), &DeviceIdentifier{AppId: appID, DevId: devID})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not get device stats from Handler")
+	}
+	return res, nil
+}
+
 // GetDevicesForApplication retrieves all devices for an application from the Handler.
 // Pass a limit to indicate the maximum number of results you want to receive, and the offset to indicate how many results should be skipped.
 func (h *ManagerClient) GetDevicesForApplication(appID string, limit, offset int) (devices []*Device, err error) {
@@ -213,6 +222,70 @@ func (h *ManagerClient) SimulateUplink(appID string, devID string, port uint32,
 	return nil
 }
 
+// GetMulticastGroup retrieves a multicast group from the Handler
+func (h *ManagerClient) GetMulticastGroup(appID string, groupID string) (*lorawan.MulticastGroup, error) {
+	res, err := h.applicationManagerClient.GetMulticastGroup(h.GetContext(), &lorawan.MulticastGroupIdentifier{AppId: appID, GroupId: groupID})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not get multicast group from Handler")
+	}
+	return res, nil
+}
+
+// SetMulticastGroup sets a multicast group on the Handler
+func (h *ManagerClient) SetMulticastGroup(in *lorawan.MulticastGroup) error {
+	_, err := h.applicationManagerClient.SetMulticastGroup(h.GetContext(), in)
+	return errors.Wrap(errors.FromGRPCError(err), "Could not set multicast group on Handler")
+}
+
+// DeleteMulticastGroup deletes a multicast group from the Handler
+func (h *ManagerClient) DeleteMulticastGroup(appID string, groupID string) error {
+	_, err := h.applicationManagerClient.DeleteMulticastGroup(h.GetContext(), &lorawan.MulticastGroupIdentifier{AppId: appID, GroupId: groupID})
+	return errors.Wrap(errors.FromGRPCError(err), "Could not delete multicast group from Handler")
+}
+
+// GetMulticastGroupsForApplication retrieves all multicast groups for an application from the Handler.
+// Pass a limit to indicate the maximum number of results you want to receive, and the offset to indicate how many results should be skipped.
+func (h *ManagerClient) GetMulticastGroupsForApplication(appID string, limit, offset int) ([]*lorawan.MulticastGroup, error) {
+	res, err := h.applicationManagerClient.GetMulticastGroupsForApplication(h.GetContextWithLimitAndOffset(limit, offset), &ApplicationIdentifier{AppId: appID})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not get multicast groups for application from Handler")
+	}
+	return res.Groups, nil
+}
+
+// SendMulticastDownlinkWithPayload schedules a downlink with the given payload for all members of a multicast group
+func (h *ManagerClient) SendMulticastDownlinkWithPayload(appID string, groupID string, port uint32, payload []byte) error {
+	_, err := h.applicationManagerClient.SendMulticastDownlink(h.GetContext(), &MulticastDownlinkMessage{
+		AppId:   appID,
+		GroupId: groupID,
+		Port:    port,
+		Payload: payload,
+	})
+	if err != nil {
+		return errors.Wrap(errors.FromGRPCError(err), "Could not send multicast downlink")
+	}
+	return nil
+}
+
+// SendMulticastDownlinkWithFields schedules a downlink with the given fields for all members of a multicast group.
+// The fields are encoded by the payload functions of the application.
+func (h *ManagerClient) SendMulticastDownlinkWithFields(appID string, groupID string, port uint32, fields map[string]interface{}) error {
+	marshalled, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	_, err = h.applicationManagerClient.SendMulticastDownlink(h.GetContext(), &MulticastDownlinkMessage{
+		AppId:   appID,
+		GroupId: groupID,
+		Port:    port,
+		Fields:  string(marshalled),
+	})
+	if err != nil {
+		return errors.Wrap(errors.FromGRPCError(err), "Could not send multicast downlink")
+	}
+	return nil
+}
+
 // Close closes the client
 func (h *ManagerClient) Close() error {
 	return h.conn.Close()
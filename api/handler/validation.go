@@ -83,3 +83,17 @@ func (m *SimulatedUplinkMessage) Validate() error {
 	}
 	return nil
 }
+
+// Validate implements the api.Validator interface
+func (m *MulticastDownlinkMessage) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if err := api.NotEmptyAndValidID(m.GroupId, "GroupId"); err != nil {
+		return err
+	}
+	if m.Port == 0 || m.Port > 223 {
+		return errors.NewErrInvalidArgument("Port", "must be between 1 and 223")
+	}
+	return nil
+}
@@ -11,6 +11,7 @@
 	It has these top-level messages:
 		DevicesRequest
 		DevicesResponse
+		MulticastDownlinkResponse
 		StatusRequest
 		Status
 */
@@ -81,27 +82,46 @@ func (m *DevicesResponse) GetResults() []*lorawan.Device {
 	return nil
 }
 
+type MulticastDownlinkResponse struct {
+	// The downlink messages for each of the gateways that transmit the multicast downlink
+	Downlinks []*broker.DownlinkMessage `protobuf:"bytes,1,rep,name=downlinks" json:"downlinks,omitempty"`
+}
+
+func (m *MulticastDownlinkResponse) Reset()      { *m = MulticastDownlinkResponse{} }
+func (*MulticastDownlinkResponse) ProtoMessage() {}
+func (*MulticastDownlinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorNetworkserver, []int{2}
+}
+
+func (m *MulticastDownlinkResponse) GetDownlinks() []*broker.DownlinkMessage {
+	if m != nil {
+		return m.Downlinks
+	}
+	return nil
+}
+
 // message StatusRequest is used to request the status of this NetworkServer
 type StatusRequest struct {
 }
 
 func (m *StatusRequest) Reset()                    { *m = StatusRequest{} }
 func (*StatusRequest) ProtoMessage()               {}
-func (*StatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorNetworkserver, []int{2} }
+func (*StatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorNetworkserver, []int{3} }
 
 // message Status is the response to the StatusRequest
 type Status struct {
-	System            *api.SystemStats    `protobuf:"bytes,1,opt,name=system" json:"system,omitempty"`
-	Component         *api.ComponentStats `protobuf:"bytes,2,opt,name=component" json:"component,omitempty"`
-	Uplink            *api.Rates          `protobuf:"bytes,11,opt,name=uplink" json:"uplink,omitempty"`
-	Downlink          *api.Rates          `protobuf:"bytes,12,opt,name=downlink" json:"downlink,omitempty"`
-	Activations       *api.Rates          `protobuf:"bytes,13,opt,name=activations" json:"activations,omitempty"`
-	DevicesPerAddress *api.Percentiles    `protobuf:"bytes,21,opt,name=devices_per_address,json=devicesPerAddress" json:"devices_per_address,omitempty"`
+	System            *api.SystemStats       `protobuf:"bytes,1,opt,name=system" json:"system,omitempty"`
+	Component         *api.ComponentStats    `protobuf:"bytes,2,opt,name=component" json:"component,omitempty"`
+	Uplink            *api.Rates             `protobuf:"bytes,11,opt,name=uplink" json:"uplink,omitempty"`
+	Downlink          *api.Rates             `protobuf:"bytes,12,opt,name=downlink" json:"downlink,omitempty"`
+	Activations       *api.Rates             `protobuf:"bytes,13,opt,name=activations" json:"activations,omitempty"`
+	DevicesPerAddress *api.Percentiles       `protobuf:"bytes,21,opt,name=devices_per_address,json=devicesPerAddress" json:"devices_per_address,omitempty"`
+	Prefixes          []*Status_PrefixStatus `protobuf:"bytes,22,rep,name=prefixes" json:"prefixes,omitempty"`
 }
 
 func (m *Status) Reset()                    { *m = Status{} }
 func (*Status) ProtoMessage()               {}
-func (*Status) Descriptor() ([]byte, []int) { return fileDescriptorNetworkserver, []int{3} }
+func (*Status) Descriptor() ([]byte, []int) { return fileDescriptorNetworkserver, []int{4} }
 
 func (m *Status) GetSystem() *api.SystemStats {
 	if m != nil {
@@ -145,11 +165,63 @@ func (m *Status) GetDevicesPerAddress() *api.Percentiles {
 	return nil
 }
 
+func (m *Status) GetPrefixes() []*Status_PrefixStatus {
+	if m != nil {
+		return m.Prefixes
+	}
+	return nil
+}
+
+type Status_PrefixStatus struct {
+	Prefix string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Usage  []string `protobuf:"bytes,2,rep,name=usage" json:"usage,omitempty"`
+	// Number of addresses in the prefix that are assigned to devices
+	UsedAddresses uint64 `protobuf:"varint,3,opt,name=used_addresses,json=usedAddresses,proto3" json:"used_addresses,omitempty"`
+	// Number of addresses in the prefix
+	TotalAddresses uint64 `protobuf:"varint,4,opt,name=total_addresses,json=totalAddresses,proto3" json:"total_addresses,omitempty"`
+}
+
+func (m *Status_PrefixStatus) Reset()      { *m = Status_PrefixStatus{} }
+func (*Status_PrefixStatus) ProtoMessage() {}
+func (*Status_PrefixStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptorNetworkserver, []int{4, 0}
+}
+
+func (m *Status_PrefixStatus) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *Status_PrefixStatus) GetUsage() []string {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+func (m *Status_PrefixStatus) GetUsedAddresses() uint64 {
+	if m != nil {
+		return m.UsedAddresses
+	}
+	return 0
+}
+
+func (m *Status_PrefixStatus) GetTotalAddresses() uint64 {
+	if m != nil {
+		return m.TotalAddresses
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*DevicesRequest)(nil), "networkserver.DevicesRequest")
 	proto.RegisterType((*DevicesResponse)(nil), "networkserver.DevicesResponse")
+	proto.RegisterType((*MulticastDownlinkResponse)(nil), "networkserver.MulticastDownlinkResponse")
 	proto.RegisterType((*StatusRequest)(nil), "networkserver.StatusRequest")
 	proto.RegisterType((*Status)(nil), "networkserver.Status")
+	proto.RegisterType((*Status_PrefixStatus)(nil), "networkserver.Status.PrefixStatus")
 }
 func (this *DevicesRequest) VerboseEqual(that interface{}) error {
 	if that == nil {
@@ -295,6 +367,76 @@ func (this *DevicesResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MulticastDownlinkResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MulticastDownlinkResponse)
+	if !ok {
+		that2, ok := that.(MulticastDownlinkResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MulticastDownlinkResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MulticastDownlinkResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MulticastDownlinkResponse but is not nil && this == nil")
+	}
+	if len(this.Downlinks) != len(that1.Downlinks) {
+		return fmt.Errorf("Downlinks this(%v) Not Equal that(%v)", len(this.Downlinks), len(that1.Downlinks))
+	}
+	for i := range this.Downlinks {
+		if !this.Downlinks[i].Equal(that1.Downlinks[i]) {
+			return fmt.Errorf("Downlinks this[%v](%v) Not Equal that[%v](%v)", i, this.Downlinks[i], i, that1.Downlinks[i])
+		}
+	}
+	return nil
+}
+func (this *MulticastDownlinkResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*MulticastDownlinkResponse)
+	if !ok {
+		that2, ok := that.(MulticastDownlinkResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Downlinks) != len(that1.Downlinks) {
+		return false
+	}
+	for i := range this.Downlinks {
+		if !this.Downlinks[i].Equal(that1.Downlinks[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *StatusRequest) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
@@ -392,6 +534,14 @@ func (this *Status) VerboseEqual(that interface{}) error {
 	if !this.DevicesPerAddress.Equal(that1.DevicesPerAddress) {
 		return fmt.Errorf("DevicesPerAddress this(%v) Not Equal that(%v)", this.DevicesPerAddress, that1.DevicesPerAddress)
 	}
+	if len(this.Prefixes) != len(that1.Prefixes) {
+		return fmt.Errorf("Prefixes this(%v) Not Equal that(%v)", len(this.Prefixes), len(that1.Prefixes))
+	}
+	for i := range this.Prefixes {
+		if !this.Prefixes[i].Equal(that1.Prefixes[i]) {
+			return fmt.Errorf("Prefixes this[%v](%v) Not Equal that[%v](%v)", i, this.Prefixes[i], i, that1.Prefixes[i])
+		}
+	}
 	return nil
 }
 func (this *Status) Equal(that interface{}) bool {
@@ -437,6 +587,102 @@ func (this *Status) Equal(that interface{}) bool {
 	if !this.DevicesPerAddress.Equal(that1.DevicesPerAddress) {
 		return false
 	}
+	if len(this.Prefixes) != len(that1.Prefixes) {
+		return false
+	}
+	for i := range this.Prefixes {
+		if !this.Prefixes[i].Equal(that1.Prefixes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Status_PrefixStatus) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Status_PrefixStatus)
+	if !ok {
+		that2, ok := that.(Status_PrefixStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Status_PrefixStatus")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Status_PrefixStatus but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Status_PrefixStatus but is not nil && this == nil")
+	}
+	if this.Prefix != that1.Prefix {
+		return fmt.Errorf("Prefix this(%v) Not Equal that(%v)", this.Prefix, that1.Prefix)
+	}
+	if len(this.Usage) != len(that1.Usage) {
+		return fmt.Errorf("Usage this(%v) Not Equal that(%v)", len(this.Usage), len(that1.Usage))
+	}
+	for i := range this.Usage {
+		if this.Usage[i] != that1.Usage[i] {
+			return fmt.Errorf("Usage this[%v](%v) Not Equal that[%v](%v)", i, this.Usage[i], i, that1.Usage[i])
+		}
+	}
+	if this.UsedAddresses != that1.UsedAddresses {
+		return fmt.Errorf("UsedAddresses this(%v) Not Equal that(%v)", this.UsedAddresses, that1.UsedAddresses)
+	}
+	if this.TotalAddresses != that1.TotalAddresses {
+		return fmt.Errorf("TotalAddresses this(%v) Not Equal that(%v)", this.TotalAddresses, that1.TotalAddresses)
+	}
+	return nil
+}
+func (this *Status_PrefixStatus) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Status_PrefixStatus)
+	if !ok {
+		that2, ok := that.(Status_PrefixStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Prefix != that1.Prefix {
+		return false
+	}
+	if len(this.Usage) != len(that1.Usage) {
+		return false
+	}
+	for i := range this.Usage {
+		if this.Usage[i] != that1.Usage[i] {
+			return false
+		}
+	}
+	if this.UsedAddresses != that1.UsedAddresses {
+		return false
+	}
+	if this.TotalAddresses != that1.TotalAddresses {
+		return false
+	}
 	return true
 }
 
@@ -461,6 +707,8 @@ type NetworkServerClient interface {
 	Uplink(ctx context.Context, in *broker.DeduplicatedUplinkMessage, opts ...grpc.CallOption) (*broker.DeduplicatedUplinkMessage, error)
 	// Broker informs Network Server about Downlink, NetworkServer may add MAC commands and re-set MIC
 	Downlink(ctx context.Context, in *broker.DownlinkMessage, opts ...grpc.CallOption) (*broker.DownlinkMessage, error)
+	// Broker informs Network Server about Downlink to a multicast group, NetworkServer selects the gateways and sets the MIC
+	MulticastDownlink(ctx context.Context, in *broker.DownlinkMessage, opts ...grpc.CallOption) (*MulticastDownlinkResponse, error)
 }
 
 type networkServerClient struct {
@@ -516,6 +764,15 @@ func (c *networkServerClient) Downlink(ctx context.Context, in *broker.DownlinkM
 	return out, nil
 }
 
+func (c *networkServerClient) MulticastDownlink(ctx context.Context, in *broker.DownlinkMessage, opts ...grpc.CallOption) (*MulticastDownlinkResponse, error) {
+	out := new(MulticastDownlinkResponse)
+	err := grpc.Invoke(ctx, "/networkserver.NetworkServer/MulticastDownlink", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for NetworkServer service
 
 type NetworkServerServer interface {
@@ -529,6 +786,8 @@ type NetworkServerServer interface {
 	Uplink(context.Context, *broker.DeduplicatedUplinkMessage) (*broker.DeduplicatedUplinkMessage, error)
 	// Broker informs Network Server about Downlink, NetworkServer may add MAC commands and re-set MIC
 	Downlink(context.Context, *broker.DownlinkMessage) (*broker.DownlinkMessage, error)
+	// Broker informs Network Server about Downlink to a multicast group, NetworkServer selects the gateways and sets the MIC
+	MulticastDownlink(context.Context, *broker.DownlinkMessage) (*MulticastDownlinkResponse, error)
 }
 
 func RegisterNetworkServerServer(s *grpc.Server, srv NetworkServerServer) {
@@ -625,6 +884,24 @@ func _NetworkServer_Downlink_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NetworkServer_MulticastDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(broker.DownlinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServerServer).MulticastDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/networkserver.NetworkServer/MulticastDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServerServer).MulticastDownlink(ctx, req.(*broker.DownlinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NetworkServer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "networkserver.NetworkServer",
 	HandlerType: (*NetworkServerServer)(nil),
@@ -649,6 +926,10 @@ var _NetworkServer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Downlink",
 			Handler:    _NetworkServer_Downlink_Handler,
 		},
+		{
+			MethodName: "MulticastDownlink",
+			Handler:    _NetworkServer_MulticastDownlink_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/TheThingsNetwork/ttn/api/networkserver/networkserver.proto",
@@ -781,6 +1062,36 @@ func (m *DevicesResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *MulticastDownlinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MulticastDownlinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Downlinks) > 0 {
+		for _, msg := range m.Downlinks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintNetworkserver(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func (m *StatusRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -876,6 +1187,69 @@ func (m *Status) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n7
 	}
+	if len(m.Prefixes) > 0 {
+		for _, msg := range m.Prefixes {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintNetworkserver(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Status_PrefixStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Status_PrefixStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prefix) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNetworkserver(dAtA, i, uint64(len(m.Prefix)))
+		i += copy(dAtA[i:], m.Prefix)
+	}
+	if len(m.Usage) > 0 {
+		for _, s := range m.Usage {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.UsedAddresses != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.UsedAddresses))
+	}
+	if m.TotalAddresses != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.TotalAddresses))
+	}
 	return i, nil
 }
 
@@ -931,6 +1305,18 @@ func (m *DevicesResponse) Size() (n int) {
 	return n
 }
 
+func (m *MulticastDownlinkResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Downlinks) > 0 {
+		for _, e := range m.Downlinks {
+			l = e.Size()
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *StatusRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -964,6 +1350,34 @@ func (m *Status) Size() (n int) {
 		l = m.DevicesPerAddress.Size()
 		n += 2 + l + sovNetworkserver(uint64(l))
 	}
+	if len(m.Prefixes) > 0 {
+		for _, e := range m.Prefixes {
+			l = e.Size()
+			n += 2 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Status_PrefixStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if len(m.Usage) > 0 {
+		for _, s := range m.Usage {
+			l = len(s)
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	if m.UsedAddresses != 0 {
+		n += 1 + sovNetworkserver(uint64(m.UsedAddresses))
+	}
+	if m.TotalAddresses != 0 {
+		n += 1 + sovNetworkserver(uint64(m.TotalAddresses))
+	}
 	return n
 }
 
@@ -1001,6 +1415,16 @@ func (this *DevicesResponse) String() string {
 	}, "")
 	return s
 }
+func (this *MulticastDownlinkResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MulticastDownlinkResponse{`,
+		`Downlinks:` + strings.Replace(fmt.Sprintf("%v", this.Downlinks), "DownlinkMessage", "broker.DownlinkMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *StatusRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -1021,6 +1445,20 @@ func (this *Status) String() string {
 		`Downlink:` + strings.Replace(fmt.Sprintf("%v", this.Downlink), "Rates", "api.Rates", 1) + `,`,
 		`Activations:` + strings.Replace(fmt.Sprintf("%v", this.Activations), "Rates", "api.Rates", 1) + `,`,
 		`DevicesPerAddress:` + strings.Replace(fmt.Sprintf("%v", this.DevicesPerAddress), "Percentiles", "api.Percentiles", 1) + `,`,
+		`Prefixes:` + strings.Replace(fmt.Sprintf("%v", this.Prefixes), "Status_PrefixStatus", "Status_PrefixStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Status_PrefixStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Status_PrefixStatus{`,
+		`Prefix:` + fmt.Sprintf("%v", this.Prefix) + `,`,
+		`Usage:` + fmt.Sprintf("%v", this.Usage) + `,`,
+		`UsedAddresses:` + fmt.Sprintf("%v", this.UsedAddresses) + `,`,
+		`TotalAddresses:` + fmt.Sprintf("%v", this.TotalAddresses) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1215,6 +1653,87 @@ func (m *DevicesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MulticastDownlinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MulticastDownlinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MulticastDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Downlinks = append(m.Downlinks, &broker.DownlinkMessage{})
+			if err := m.Downlinks[len(m.Downlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *StatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1492,6 +2011,183 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefixes = append(m.Prefixes, &Status_PrefixStatus{})
+			if err := m.Prefixes[len(m.Prefixes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Status_PrefixStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrefixStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrefixStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usage = append(m.Usage, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedAddresses", wireType)
+			}
+			m.UsedAddresses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedAddresses |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAddresses", wireType)
+			}
+			m.TotalAddresses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalAddresses |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetworkserver(dAtA[iNdEx:])
@@ -1623,45 +2319,53 @@ func init() {
 }
 
 var fileDescriptorNetworkserver = []byte{
-	// 626 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x4f, 0x13, 0x41,
-	0x18, 0xc6, 0x59, 0xd0, 0x52, 0xde, 0x52, 0x91, 0x41, 0x62, 0x53, 0x65, 0x85, 0x26, 0x9a, 0x1a,
-	0x75, 0x37, 0xd4, 0xc4, 0x13, 0x07, 0xfe, 0xd4, 0x70, 0x30, 0x90, 0xba, 0xc5, 0xc4, 0x78, 0x21,
-	0xd3, 0xdd, 0x97, 0x76, 0x43, 0x3b, 0xb3, 0xce, 0x4c, 0x8b, 0xdc, 0xfc, 0x08, 0x7e, 0x05, 0x6f,
-	0x26, 0x7e, 0x11, 0x8f, 0x1e, 0x8d, 0x07, 0x03, 0xf5, 0x8b, 0x98, 0xce, 0xcc, 0x16, 0x56, 0x20,
-	0x84, 0xd3, 0xee, 0xfb, 0x3c, 0xbf, 0x9d, 0x79, 0x77, 0x9e, 0x77, 0x17, 0x5e, 0xb7, 0x63, 0xd5,
-	0xe9, 0xb7, 0xbc, 0x90, 0xf7, 0xfc, 0xbd, 0x0e, 0xee, 0x75, 0x62, 0xd6, 0x96, 0xbb, 0xa8, 0x8e,
-	0xb8, 0x38, 0xf4, 0x95, 0x62, 0x3e, 0x4d, 0x62, 0x9f, 0x99, 0x5a, 0xa2, 0x18, 0xa0, 0xc8, 0x56,
-	0x5e, 0x22, 0xb8, 0xe2, 0xa4, 0x98, 0x11, 0xcb, 0x2f, 0xce, 0xad, 0xda, 0xe6, 0x6d, 0xee, 0x6b,
-	0xaa, 0xd5, 0x3f, 0xd0, 0x95, 0x2e, 0xf4, 0x9d, 0x79, 0xba, 0x3c, 0x9f, 0x6e, 0x44, 0x93, 0xd8,
-	0x4a, 0x8f, 0x53, 0x49, 0x97, 0x21, 0xef, 0xfa, 0x5d, 0x2e, 0xe8, 0x11, 0x65, 0x7e, 0x84, 0x83,
-	0x38, 0x44, 0x8b, 0x3d, 0x48, 0xb1, 0x96, 0xe0, 0x87, 0x28, 0xec, 0xc5, 0x9a, 0x4b, 0xa9, 0xd9,
-	0xa1, 0x2c, 0xea, 0xa2, 0x48, 0xaf, 0xc6, 0xae, 0x7c, 0x82, 0x3b, 0x75, 0xbd, 0x96, 0x0c, 0xf0,
-	0x63, 0x1f, 0xa5, 0x22, 0x6f, 0x21, 0x1f, 0xe1, 0x60, 0x9f, 0x46, 0x91, 0x28, 0x39, 0xcb, 0x4e,
-	0x75, 0x76, 0xf3, 0xd5, 0xef, 0x3f, 0x8f, 0x6a, 0xd7, 0x1d, 0x51, 0xc8, 0x05, 0xfa, 0xea, 0x38,
-	0x41, 0xe9, 0xd5, 0x71, 0xb0, 0x11, 0x45, 0x22, 0x98, 0x8e, 0xcc, 0x0d, 0x59, 0x80, 0xdb, 0x07,
-	0xfb, 0x21, 0x53, 0xa5, 0xc9, 0x65, 0xa7, 0x5a, 0x0c, 0x6e, 0x1d, 0x6c, 0x31, 0x55, 0x59, 0x83,
-	0xb9, 0xf1, 0xce, 0x32, 0xe1, 0x4c, 0x22, 0x79, 0x0a, 0xd3, 0x02, 0x65, 0xbf, 0xab, 0x64, 0xc9,
-	0x59, 0x9e, 0xaa, 0x16, 0x6a, 0x73, 0x9e, 0x7d, 0x61, 0xcf, 0xa0, 0x41, 0xea, 0x57, 0xe6, 0xa0,
-	0xd8, 0x54, 0x54, 0xf5, 0xd3, 0xb6, 0x2b, 0x5f, 0x27, 0x21, 0x67, 0x14, 0x52, 0x85, 0x9c, 0x3c,
-	0x96, 0x0a, 0x7b, 0xba, 0xff, 0x42, 0xed, 0xae, 0x37, 0x3a, 0xd2, 0xa6, 0x96, 0x46, 0x88, 0x0c,
-	0xac, 0x4f, 0x56, 0x61, 0x26, 0xe4, 0xbd, 0x84, 0x33, 0xb4, 0xcd, 0x15, 0x6a, 0x0b, 0x1a, 0xde,
-	0x4a, 0x55, 0xc3, 0x9f, 0x51, 0xa4, 0x02, 0xb9, 0x7e, 0xd2, 0x8d, 0xd9, 0x61, 0xa9, 0xa0, 0x79,
-	0xd0, 0x7c, 0x40, 0x15, 0xca, 0xc0, 0x3a, 0xe4, 0x09, 0xe4, 0x23, 0x7e, 0xc4, 0x34, 0x35, 0x7b,
-	0x81, 0x1a, 0x7b, 0xe4, 0x39, 0x14, 0x68, 0xa8, 0xe2, 0x01, 0x55, 0x31, 0x67, 0xb2, 0x54, 0xbc,
-	0x80, 0x9e, 0xb7, 0xc9, 0x3a, 0x2c, 0x98, 0xd8, 0xe5, 0x7e, 0x82, 0x42, 0x07, 0x84, 0x52, 0x96,
-	0x16, 0xcf, 0xbd, 0x63, 0x03, 0x45, 0x88, 0x4c, 0xc5, 0x5d, 0x94, 0xc1, 0xbc, 0x85, 0x1b, 0x28,
-	0x36, 0x0c, 0x5a, 0xfb, 0x3e, 0x05, 0x45, 0x9b, 0x59, 0x53, 0xcf, 0x28, 0x79, 0x03, 0xb0, 0x8d,
-	0xca, 0xe6, 0x40, 0x96, 0xbc, 0xec, 0x58, 0x67, 0x27, 0xa3, 0xec, 0x5e, 0x65, 0xdb, 0xf8, 0x7a,
-	0x30, 0xdf, 0x10, 0x98, 0x50, 0x81, 0x1b, 0xe3, 0xb6, 0xc9, 0x33, 0xcf, 0x8e, 0x63, 0x1d, 0xa3,
-	0xd1, 0xf1, 0x84, 0x54, 0x61, 0x64, 0x9e, 0x3c, 0xa3, 0xd2, 0x1d, 0x6e, 0x02, 0x93, 0x06, 0xe4,
-	0xad, 0x88, 0x64, 0xc5, 0x4b, 0xc7, 0xfa, 0x22, 0x6d, 0xba, 0x2b, 0x5f, 0x8f, 0x90, 0x5d, 0xc8,
-	0xbd, 0x33, 0x09, 0xae, 0x5c, 0xd6, 0x88, 0xf1, 0x76, 0x50, 0x4a, 0xda, 0x1e, 0xad, 0x77, 0x1d,
-	0x42, 0xd6, 0x20, 0x5f, 0x4f, 0xb3, 0xbe, 0x3f, 0xc6, 0xad, 0x92, 0xae, 0x73, 0x95, 0x51, 0x7b,
-	0x0f, 0xf7, 0x32, 0x61, 0xed, 0x50, 0x46, 0xdb, 0x28, 0xc8, 0x3a, 0xcc, 0x6c, 0xa3, 0xb2, 0xb3,
-	0xfe, 0xf0, 0xbf, 0x4c, 0x32, 0x1f, 0x45, 0x79, 0xf1, 0x52, 0x77, 0xb3, 0xf9, 0xeb, 0xd4, 0x9d,
-	0x38, 0x39, 0x75, 0x9d, 0xcf, 0x43, 0xd7, 0xf9, 0x36, 0x74, 0x9d, 0x1f, 0x43, 0xd7, 0xf9, 0x39,
-	0x74, 0x9d, 0x93, 0xa1, 0xeb, 0x7c, 0xf9, 0xeb, 0x4e, 0x7c, 0x58, 0xbd, 0xf1, 0x1f, 0xb1, 0x95,
-	0xd3, 0x3f, 0x94, 0x97, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x54, 0x15, 0x56, 0x4d, 0x05,
-	0x00, 0x00,
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbb, 0xb5, 0xeb, 0xda, 0xcf, 0x71, 0x8c, 0x27, 0x6d, 0x59, 0x0c, 0x5d, 0x5c, 0x4b,
+	0x05, 0x23, 0x60, 0x57, 0x35, 0x82, 0x53, 0x85, 0xea, 0x36, 0xa8, 0x07, 0x94, 0xca, 0xac, 0x1b,
+	0x84, 0xb8, 0x44, 0xe3, 0xdd, 0x67, 0x7b, 0x95, 0xf5, 0xce, 0x32, 0x33, 0xeb, 0x24, 0x37, 0x24,
+	0xae, 0x1c, 0xf8, 0x33, 0xf8, 0x53, 0x38, 0x72, 0x44, 0x1c, 0x50, 0x62, 0xfe, 0x01, 0xfe, 0x04,
+	0xe4, 0xd9, 0x19, 0xc7, 0x8e, 0x63, 0x45, 0x39, 0x79, 0xdf, 0xfb, 0x7e, 0x66, 0xe6, 0xcd, 0xfb,
+	0x31, 0x86, 0x6f, 0xc6, 0x91, 0x9c, 0x64, 0x43, 0x37, 0x60, 0x53, 0xef, 0xed, 0x04, 0xdf, 0x4e,
+	0xa2, 0x64, 0x2c, 0xde, 0xa0, 0x3c, 0x61, 0xfc, 0xd8, 0x93, 0x32, 0xf1, 0x68, 0x1a, 0x79, 0x49,
+	0x6e, 0x0b, 0xe4, 0x33, 0xe4, 0xeb, 0x96, 0x9b, 0x72, 0x26, 0x19, 0xa9, 0xad, 0x39, 0x9b, 0x9f,
+	0xaf, 0xec, 0x3a, 0x66, 0x63, 0xe6, 0x29, 0x6a, 0x98, 0x8d, 0x94, 0xa5, 0x0c, 0xf5, 0x95, 0xaf,
+	0x6e, 0x36, 0xcc, 0x41, 0x34, 0x8d, 0xb4, 0xeb, 0xa9, 0x71, 0x29, 0x33, 0x60, 0xb1, 0x17, 0x33,
+	0x4e, 0x4f, 0x68, 0xe2, 0x85, 0x38, 0x8b, 0x02, 0xd4, 0xd8, 0xfb, 0x06, 0x1b, 0x72, 0x76, 0x8c,
+	0x5c, 0xff, 0x68, 0xf1, 0xb1, 0x11, 0x27, 0x34, 0x09, 0x63, 0xe4, 0xe6, 0x37, 0x97, 0xdb, 0xa7,
+	0xb0, 0xbb, 0xaf, 0xf6, 0x12, 0x3e, 0xfe, 0x94, 0xa1, 0x90, 0xe4, 0x3b, 0x28, 0x87, 0x38, 0x3b,
+	0xa2, 0x61, 0xc8, 0x6d, 0xab, 0x65, 0x75, 0x76, 0x5e, 0x7e, 0xf5, 0xf7, 0x3f, 0x1f, 0x76, 0x6f,
+	0x4a, 0x51, 0xc0, 0x38, 0x7a, 0xf2, 0x2c, 0x45, 0xe1, 0xee, 0xe3, 0xac, 0x17, 0x86, 0xdc, 0xbf,
+	0x1f, 0xe6, 0x1f, 0x64, 0x0f, 0xee, 0x8d, 0x8e, 0x82, 0x44, 0xda, 0x77, 0x5b, 0x56, 0xa7, 0xe6,
+	0x17, 0x47, 0xaf, 0x12, 0xd9, 0x7e, 0x0e, 0xf5, 0xe5, 0xc9, 0x22, 0x65, 0x89, 0x40, 0xf2, 0x09,
+	0xdc, 0xe7, 0x28, 0xb2, 0x58, 0x0a, 0xdb, 0x6a, 0x15, 0x3a, 0xd5, 0x6e, 0xdd, 0xd5, 0x17, 0x76,
+	0x73, 0xd4, 0x37, 0x7a, 0xdb, 0x87, 0xf7, 0x0e, 0xb2, 0x58, 0x46, 0x01, 0x15, 0x72, 0x9f, 0x9d,
+	0x24, 0x71, 0x94, 0x1c, 0x2f, 0xf7, 0xf9, 0x12, 0x2a, 0xa1, 0xf6, 0x99, 0x9d, 0xde, 0x75, 0x75,
+	0x56, 0x0c, 0x7c, 0x80, 0x42, 0xd0, 0x31, 0xfa, 0x97, 0x64, 0xbb, 0x0e, 0xb5, 0x81, 0xa4, 0x32,
+	0x33, 0xa9, 0x68, 0xff, 0x57, 0x80, 0x52, 0xee, 0x21, 0x1d, 0x28, 0x89, 0x33, 0x21, 0x71, 0xaa,
+	0x72, 0x52, 0xed, 0xbe, 0xe3, 0x2e, 0xca, 0x34, 0x50, 0xae, 0x05, 0x22, 0x7c, 0xad, 0x93, 0x67,
+	0x50, 0x09, 0xd8, 0x34, 0x65, 0x09, 0xea, 0x0b, 0x57, 0xbb, 0x7b, 0x0a, 0x7e, 0x65, 0xbc, 0x39,
+	0x7f, 0x49, 0x91, 0x36, 0x94, 0xb2, 0x74, 0x11, 0x83, 0x5d, 0x55, 0x3c, 0x28, 0xde, 0xa7, 0x12,
+	0x85, 0xaf, 0x15, 0xf2, 0x11, 0x94, 0x4d, 0xa4, 0xf6, 0xce, 0x06, 0xb5, 0xd4, 0xc8, 0x67, 0x50,
+	0xa5, 0x81, 0x8c, 0x66, 0x54, 0x46, 0x2c, 0x11, 0x76, 0x6d, 0x03, 0x5d, 0x95, 0xc9, 0x0b, 0xd8,
+	0xcb, 0x5b, 0x49, 0x1c, 0xa5, 0xc8, 0x55, 0xd1, 0x51, 0x08, 0xfb, 0xe1, 0xca, 0x1d, 0xfb, 0xc8,
+	0x03, 0x4c, 0x64, 0x14, 0xa3, 0xf0, 0x1b, 0x1a, 0xee, 0x23, 0xef, 0xe5, 0x28, 0xf9, 0x1a, 0xca,
+	0x29, 0xc7, 0x51, 0x74, 0x8a, 0xc2, 0x7e, 0xa4, 0x52, 0xdd, 0x76, 0xd7, 0x87, 0x23, 0xcf, 0xa0,
+	0xdb, 0x57, 0x94, 0x4e, 0xf0, 0x72, 0x4d, 0xf3, 0x57, 0x0b, 0x76, 0x56, 0x25, 0xf2, 0x08, 0x4a,
+	0xb9, 0xa8, 0x32, 0x5d, 0xf1, 0xb5, 0x45, 0x1e, 0xc0, 0xbd, 0x6c, 0x51, 0x31, 0xfb, 0x6e, 0xab,
+	0xd0, 0xa9, 0xf8, 0xb9, 0x41, 0x9e, 0xc2, 0x6e, 0x26, 0x30, 0x34, 0x91, 0xa3, 0xb0, 0x0b, 0x2d,
+	0xab, 0x53, 0xf4, 0x6b, 0x0b, 0x6f, 0xcf, 0x38, 0xc9, 0xc7, 0x50, 0x97, 0x4c, 0xd2, 0x78, 0x85,
+	0x2b, 0x2a, 0x6e, 0x57, 0xb9, 0x97, 0x60, 0xf7, 0x97, 0x22, 0xd4, 0x74, 0x5b, 0x0f, 0x54, 0xf8,
+	0xe4, 0x5b, 0x80, 0xd7, 0x28, 0x75, 0xab, 0x92, 0xc7, 0x57, 0x2e, 0xb7, 0x3e, 0x3c, 0x4d, 0x67,
+	0x9b, 0xac, 0x3b, 0x73, 0x0a, 0x8d, 0x3e, 0xc7, 0x94, 0x72, 0xec, 0x2d, 0xab, 0x40, 0x3e, 0x5d,
+	0xf6, 0x26, 0x86, 0x8b, 0x6a, 0x07, 0x54, 0x62, 0x98, 0xaf, 0xbc, 0xa4, 0xcc, 0x09, 0xb7, 0x81,
+	0x49, 0x1f, 0xca, 0xda, 0x89, 0xe4, 0x89, 0x6b, 0x26, 0x7f, 0x93, 0xce, 0xa3, 0x6b, 0xde, 0x8c,
+	0x90, 0x37, 0x50, 0x3a, 0xcc, 0x1b, 0xf2, 0xc9, 0x75, 0x81, 0x1c, 0xa6, 0x2b, 0xb3, 0xd5, 0xbc,
+	0x19, 0x21, 0xcf, 0xa1, 0x6c, 0x26, 0x92, 0x6c, 0x9b, 0xd1, 0xe6, 0x36, 0x81, 0x7c, 0x0f, 0x8d,
+	0x8d, 0x57, 0x60, 0xfb, 0x36, 0x9d, 0x2b, 0xc5, 0xd9, 0xfa, 0x80, 0x74, 0x7f, 0x80, 0x07, 0x6b,
+	0x4d, 0x70, 0x40, 0x13, 0x3a, 0x46, 0x4e, 0x5e, 0x40, 0xe5, 0x35, 0x4a, 0xdd, 0xa8, 0x1f, 0x5c,
+	0xdb, 0xe7, 0xa6, 0x4e, 0x0f, 0xaf, 0x55, 0x5f, 0x0e, 0xfe, 0xba, 0x70, 0xee, 0x9c, 0x5f, 0x38,
+	0xd6, 0xcf, 0x73, 0xc7, 0xfa, 0x7d, 0xee, 0x58, 0x7f, 0xcc, 0x1d, 0xeb, 0xcf, 0xb9, 0x63, 0x9d,
+	0xcf, 0x1d, 0xeb, 0xb7, 0x7f, 0x9d, 0x3b, 0x3f, 0x3e, 0xbb, 0xf5, 0x9f, 0xd1, 0xb0, 0xa4, 0xde,
+	0xf2, 0x2f, 0xfe, 0x1f, 0x00, 0x65, 0xf8, 0x7f, 0x7e, 0xc8, 0x06, 0x00, 0x00,
 }
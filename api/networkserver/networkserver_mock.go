@@ -112,6 +112,22 @@ func (_mr *_MockNetworkServerClientRecorder) Downlink(arg0, arg1 interface{}, ar
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Downlink", _s...)
 }
 
+func (_m *MockNetworkServerClient) MulticastDownlink(ctx context.Context, in *broker.DownlinkMessage, opts ...grpc.CallOption) (*MulticastDownlinkResponse, error) {
+	_s := []interface{}{ctx, in}
+	for _, _x := range opts {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "MulticastDownlink", _s...)
+	ret0, _ := ret[0].(*MulticastDownlinkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockNetworkServerClientRecorder) MulticastDownlink(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MulticastDownlink", _s...)
+}
+
 // Mock of NetworkServerServer interface
 type MockNetworkServerServer struct {
 	ctrl     *gomock.Controller
@@ -188,6 +204,17 @@ func (_mr *_MockNetworkServerServerRecorder) Downlink(arg0, arg1 interface{}) *g
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Downlink", arg0, arg1)
 }
 
+func (_m *MockNetworkServerServer) MulticastDownlink(_param0 context.Context, _param1 *broker.DownlinkMessage) (*MulticastDownlinkResponse, error) {
+	ret := _m.ctrl.Call(_m, "MulticastDownlink", _param0, _param1)
+	ret0, _ := ret[0].(*MulticastDownlinkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockNetworkServerServerRecorder) MulticastDownlink(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MulticastDownlink", arg0, arg1)
+}
+
 // Mock of NetworkServerManagerClient interface
 type MockNetworkServerManagerClient struct {
 	ctrl     *gomock.Controller
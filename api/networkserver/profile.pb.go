@@ -0,0 +1,2646 @@
+// Code generated by protoc-gen-gogo.
+// source: github.com/TheThingsNetwork/ttn/api/networkserver/profile.proto
+// DO NOT EDIT!
+
+/*
+	Package networkserver is a generated protocol buffer package.
+
+	It is generated from these files:
+		github.com/TheThingsNetwork/ttn/api/networkserver/profile.proto
+
+	It has these top-level messages:
+		ProfileIdentifier
+		ProfilesRequest
+		DeviceProfile
+		DeviceProfileList
+		ServiceProfile
+		ServiceProfileList
+*/
+package networkserver
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import google_protobuf "github.com/golang/protobuf/ptypes/empty"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+import lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import strings "strings"
+import reflect "reflect"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type ProfileIdentifier struct {
+	// The ProfileID is a unique identifier for the profile. It can contain lowercase letters, numbers, - and _.
+	ProfileId string `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+}
+
+func (m *ProfileIdentifier) Reset()                    { *m = ProfileIdentifier{} }
+func (*ProfileIdentifier) ProtoMessage()               {}
+func (*ProfileIdentifier) Descriptor() ([]byte, []int) { return fileDescriptorProfile, []int{0} }
+
+func (m *ProfileIdentifier) GetProfileId() string {
+	if m != nil {
+		return m.ProfileId
+	}
+	return ""
+}
+
+type ProfilesRequest struct {
+}
+
+func (m *ProfilesRequest) Reset()                    { *m = ProfilesRequest{} }
+func (*ProfilesRequest) ProtoMessage()               {}
+func (*ProfilesRequest) Descriptor() ([]byte, []int) { return fileDescriptorProfile, []int{1} }
+
+// A DeviceProfile contains the LoRaWAN capabilities of a device model. Devices that refer to a device profile use
+// these settings instead of their own.
+type DeviceProfile struct {
+	// The ProfileID is a unique identifier for the profile. It can contain lowercase letters, numbers, - and _.
+	ProfileId   string `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The MACVersion is the LoRaWAN version that the device implements.
+	MacVersion lorawan.MACVersion `protobuf:"varint,3,opt,name=mac_version,json=macVersion,proto3,enum=lorawan.MACVersion" json:"mac_version,omitempty"`
+	// The RegionalParametersRevision is the revision of the LoRaWAN Regional Parameters that the device implements (for example 1.0.2-rB).
+	RegionalParametersRevision string `protobuf:"bytes,4,opt,name=regional_parameters_revision,json=regionalParametersRevision,proto3" json:"regional_parameters_revision,omitempty"`
+	// Indicates that the device supports Class B (ping slots)
+	SupportsClassB bool `protobuf:"varint,5,opt,name=supports_class_b,json=supportsClassB,proto3" json:"supports_class_b,omitempty"`
+	// Indicates that the device supports Class C (continuous reception)
+	SupportsClassC bool `protobuf:"varint,6,opt,name=supports_class_c,json=supportsClassC,proto3" json:"supports_class_c,omitempty"`
+	// Indicates that the device supports OTAA
+	SupportsJoin bool `protobuf:"varint,7,opt,name=supports_join,json=supportsJoin,proto3" json:"supports_join,omitempty"`
+	// The MaxEIRP is the maximum radiated power (in dBm) of the device. Zero uses the default of the frequency plan.
+	MaxEirp float32 `protobuf:"fixed32,8,opt,name=max_eirp,json=maxEirp,proto3" json:"max_eirp,omitempty"`
+	// The Uses32BitFCnt option indicates that the device keeps track of full 32 bit frame counters.
+	Uses32BitFCnt bool `protobuf:"varint,9,opt,name=uses32_bit_f_cnt,json=uses32BitFCnt,proto3" json:"uses32_bit_f_cnt,omitempty"`
+	// The RX1DROffset is the offset between the uplink data rate and the downlink data rate in RX1. Zero uses the default of the frequency plan.
+	Rx1DrOffset uint32 `protobuf:"varint,10,opt,name=rx1_dr_offset,json=rx1DrOffset,proto3" json:"rx1_dr_offset,omitempty"`
+	// The RX2DataRate is the data rate that is used in RX2 (for example SF12BW125). Empty uses the default of the frequency plan.
+	Rx2DataRate string `protobuf:"bytes,11,opt,name=rx2_data_rate,json=rx2DataRate,proto3" json:"rx2_data_rate,omitempty"`
+	// The RX2Frequency is the frequency (in Hz) that is used in RX2. Zero uses the default of the frequency plan.
+	Rx2Frequency uint64 `protobuf:"varint,12,opt,name=rx2_frequency,json=rx2Frequency,proto3" json:"rx2_frequency,omitempty"`
+	// The RXDelay is the delay (in seconds) between the end of the uplink and RX1. Zero uses the default of 1 second.
+	RxDelay uint32 `protobuf:"varint,13,opt,name=rx_delay,json=rxDelay,proto3" json:"rx_delay,omitempty"`
+}
+
+func (m *DeviceProfile) Reset()                    { *m = DeviceProfile{} }
+func (*DeviceProfile) ProtoMessage()               {}
+func (*DeviceProfile) Descriptor() ([]byte, []int) { return fileDescriptorProfile, []int{2} }
+
+func (m *DeviceProfile) GetProfileId() string {
+	if m != nil {
+		return m.ProfileId
+	}
+	return ""
+}
+
+func (m *DeviceProfile) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DeviceProfile) GetMacVersion() lorawan.MACVersion {
+	if m != nil {
+		return m.MacVersion
+	}
+	return lorawan.MACVersion_LORAWAN_1_0
+}
+
+func (m *DeviceProfile) GetRegionalParametersRevision() string {
+	if m != nil {
+		return m.RegionalParametersRevision
+	}
+	return ""
+}
+
+func (m *DeviceProfile) GetSupportsClassB() bool {
+	if m != nil {
+		return m.SupportsClassB
+	}
+	return false
+}
+
+func (m *DeviceProfile) GetSupportsClassC() bool {
+	if m != nil {
+		return m.SupportsClassC
+	}
+	return false
+}
+
+func (m *DeviceProfile) GetSupportsJoin() bool {
+	if m != nil {
+		return m.SupportsJoin
+	}
+	return false
+}
+
+func (m *DeviceProfile) GetMaxEirp() float32 {
+	if m != nil {
+		return m.MaxEirp
+	}
+	return 0
+}
+
+func (m *DeviceProfile) GetUses32BitFCnt() bool {
+	if m != nil {
+		return m.Uses32BitFCnt
+	}
+	return false
+}
+
+func (m *DeviceProfile) GetRx1DrOffset() uint32 {
+	if m != nil {
+		return m.Rx1DrOffset
+	}
+	return 0
+}
+
+func (m *DeviceProfile) GetRx2DataRate() string {
+	if m != nil {
+		return m.Rx2DataRate
+	}
+	return ""
+}
+
+func (m *DeviceProfile) GetRx2Frequency() uint64 {
+	if m != nil {
+		return m.Rx2Frequency
+	}
+	return 0
+}
+
+func (m *DeviceProfile) GetRxDelay() uint32 {
+	if m != nil {
+		return m.RxDelay
+	}
+	return 0
+}
+
+type DeviceProfileList struct {
+	Profiles []*DeviceProfile `protobuf:"bytes,1,rep,name=profiles" json:"profiles,omitempty"`
+}
+
+func (m *DeviceProfileList) Reset()                    { *m = DeviceProfileList{} }
+func (*DeviceProfileList) ProtoMessage()               {}
+func (*DeviceProfileList) Descriptor() ([]byte, []int) { return fileDescriptorProfile, []int{3} }
+
+func (m *DeviceProfileList) GetProfiles() []*DeviceProfile {
+	if m != nil {
+		return m.Profiles
+	}
+	return nil
+}
+
+// A ServiceProfile contains the settings of the network service for a group of devices. Devices that refer to a
+// service profile use these settings instead of their own.
+type ServiceProfile struct {
+	// The ProfileID is a unique identifier for the profile. It can contain lowercase letters, numbers, - and _.
+	ProfileId   string `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The ActivationContstraints are used to allocate a device address for a device (comma-separated).
+	ActivationConstraints string `protobuf:"bytes,3,opt,name=activation_constraints,json=activationConstraints,proto3" json:"activation_constraints,omitempty"`
+	// The DisableFCntCheck option disables the frame counter check.
+	DisableFCntCheck bool `protobuf:"varint,4,opt,name=disable_f_cnt_check,json=disableFCntCheck,proto3" json:"disable_f_cnt_check,omitempty"`
+	// The DevStatusInterval is the interval (in seconds) in which the NetworkServer requests the status of the device. Zero uses the default of the NetworkServer.
+	DevStatusInterval uint32 `protobuf:"varint,5,opt,name=dev_status_interval,json=devStatusInterval,proto3" json:"dev_status_interval,omitempty"`
+	// The ADRStrategy is the name of the ADR strategy that the NetworkServer uses for the device. Empty uses the default strategy.
+	AdrStrategy string `protobuf:"bytes,6,opt,name=adr_strategy,json=adrStrategy,proto3" json:"adr_strategy,omitempty"`
+	// The ADRMargin is the margin (in dB) that the ADR strategy keeps on top of the demodulation floor. Zero uses the default of the NetworkServer.
+	AdrMargin uint32 `protobuf:"varint,7,opt,name=adr_margin,json=adrMargin,proto3" json:"adr_margin,omitempty"`
+}
+
+func (m *ServiceProfile) Reset()                    { *m = ServiceProfile{} }
+func (*ServiceProfile) ProtoMessage()               {}
+func (*ServiceProfile) Descriptor() ([]byte, []int) { return fileDescriptorProfile, []int{4} }
+
+func (m *ServiceProfile) GetProfileId() string {
+	if m != nil {
+		return m.ProfileId
+	}
+	return ""
+}
+
+func (m *ServiceProfile) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ServiceProfile) GetActivationConstraints() string {
+	if m != nil {
+		return m.ActivationConstraints
+	}
+	return ""
+}
+
+func (m *ServiceProfile) GetDisableFCntCheck() bool {
+	if m != nil {
+		return m.DisableFCntCheck
+	}
+	return false
+}
+
+func (m *ServiceProfile) GetDevStatusInterval() uint32 {
+	if m != nil {
+		return m.DevStatusInterval
+	}
+	return 0
+}
+
+func (m *ServiceProfile) GetAdrStrategy() string {
+	if m != nil {
+		return m.AdrStrategy
+	}
+	return ""
+}
+
+func (m *ServiceProfile) GetAdrMargin() uint32 {
+	if m != nil {
+		return m.AdrMargin
+	}
+	return 0
+}
+
+type ServiceProfileList struct {
+	Profiles []*ServiceProfile `protobuf:"bytes,1,rep,name=profiles" json:"profiles,omitempty"`
+}
+
+func (m *ServiceProfileList) Reset()                    { *m = ServiceProfileList{} }
+func (*ServiceProfileList) ProtoMessage()               {}
+func (*ServiceProfileList) Descriptor() ([]byte, []int) { return fileDescriptorProfile, []int{5} }
+
+func (m *ServiceProfileList) GetProfiles() []*ServiceProfile {
+	if m != nil {
+		return m.Profiles
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ProfileIdentifier)(nil), "networkserver.ProfileIdentifier")
+	proto.RegisterType((*ProfilesRequest)(nil), "networkserver.ProfilesRequest")
+	proto.RegisterType((*DeviceProfile)(nil), "networkserver.DeviceProfile")
+	proto.RegisterType((*DeviceProfileList)(nil), "networkserver.DeviceProfileList")
+	proto.RegisterType((*ServiceProfile)(nil), "networkserver.ServiceProfile")
+	proto.RegisterType((*ServiceProfileList)(nil), "networkserver.ServiceProfileList")
+}
+func (this *ProfileIdentifier) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ProfileIdentifier)
+	if !ok {
+		that2, ok := that.(ProfileIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ProfileIdentifier")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ProfileIdentifier but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ProfileIdentifier but is not nil && this == nil")
+	}
+	if this.ProfileId != that1.ProfileId {
+		return fmt.Errorf("ProfileId this(%v) Not Equal that(%v)", this.ProfileId, that1.ProfileId)
+	}
+	return nil
+}
+func (this *ProfileIdentifier) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ProfileIdentifier)
+	if !ok {
+		that2, ok := that.(ProfileIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.ProfileId != that1.ProfileId {
+		return false
+	}
+	return true
+}
+func (this *ProfilesRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ProfilesRequest)
+	if !ok {
+		that2, ok := that.(ProfilesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ProfilesRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ProfilesRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ProfilesRequest but is not nil && this == nil")
+	}
+	return nil
+}
+func (this *ProfilesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ProfilesRequest)
+	if !ok {
+		that2, ok := that.(ProfilesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *DeviceProfile) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DeviceProfile)
+	if !ok {
+		that2, ok := that.(DeviceProfile)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DeviceProfile")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DeviceProfile but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DeviceProfile but is not nil && this == nil")
+	}
+	if this.ProfileId != that1.ProfileId {
+		return fmt.Errorf("ProfileId this(%v) Not Equal that(%v)", this.ProfileId, that1.ProfileId)
+	}
+	if this.Description != that1.Description {
+		return fmt.Errorf("Description this(%v) Not Equal that(%v)", this.Description, that1.Description)
+	}
+	if this.MacVersion != that1.MacVersion {
+		return fmt.Errorf("MacVersion this(%v) Not Equal that(%v)", this.MacVersion, that1.MacVersion)
+	}
+	if this.RegionalParametersRevision != that1.RegionalParametersRevision {
+		return fmt.Errorf("RegionalParametersRevision this(%v) Not Equal that(%v)", this.RegionalParametersRevision, that1.RegionalParametersRevision)
+	}
+	if this.SupportsClassB != that1.SupportsClassB {
+		return fmt.Errorf("SupportsClassB this(%v) Not Equal that(%v)", this.SupportsClassB, that1.SupportsClassB)
+	}
+	if this.SupportsClassC != that1.SupportsClassC {
+		return fmt.Errorf("SupportsClassC this(%v) Not Equal that(%v)", this.SupportsClassC, that1.SupportsClassC)
+	}
+	if this.SupportsJoin != that1.SupportsJoin {
+		return fmt.Errorf("SupportsJoin this(%v) Not Equal that(%v)", this.SupportsJoin, that1.SupportsJoin)
+	}
+	if this.MaxEirp != that1.MaxEirp {
+		return fmt.Errorf("MaxEirp this(%v) Not Equal that(%v)", this.MaxEirp, that1.MaxEirp)
+	}
+	if this.Uses32BitFCnt != that1.Uses32BitFCnt {
+		return fmt.Errorf("Uses32BitFCnt this(%v) Not Equal that(%v)", this.Uses32BitFCnt, that1.Uses32BitFCnt)
+	}
+	if this.Rx1DrOffset != that1.Rx1DrOffset {
+		return fmt.Errorf("Rx1DrOffset this(%v) Not Equal that(%v)", this.Rx1DrOffset, that1.Rx1DrOffset)
+	}
+	if this.Rx2DataRate != that1.Rx2DataRate {
+		return fmt.Errorf("Rx2DataRate this(%v) Not Equal that(%v)", this.Rx2DataRate, that1.Rx2DataRate)
+	}
+	if this.Rx2Frequency != that1.Rx2Frequency {
+		return fmt.Errorf("Rx2Frequency this(%v) Not Equal that(%v)", this.Rx2Frequency, that1.Rx2Frequency)
+	}
+	if this.RxDelay != that1.RxDelay {
+		return fmt.Errorf("RxDelay this(%v) Not Equal that(%v)", this.RxDelay, that1.RxDelay)
+	}
+	return nil
+}
+func (this *DeviceProfile) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DeviceProfile)
+	if !ok {
+		that2, ok := that.(DeviceProfile)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.ProfileId != that1.ProfileId {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.MacVersion != that1.MacVersion {
+		return false
+	}
+	if this.RegionalParametersRevision != that1.RegionalParametersRevision {
+		return false
+	}
+	if this.SupportsClassB != that1.SupportsClassB {
+		return false
+	}
+	if this.SupportsClassC != that1.SupportsClassC {
+		return false
+	}
+	if this.SupportsJoin != that1.SupportsJoin {
+		return false
+	}
+	if this.MaxEirp != that1.MaxEirp {
+		return false
+	}
+	if this.Uses32BitFCnt != that1.Uses32BitFCnt {
+		return false
+	}
+	if this.Rx1DrOffset != that1.Rx1DrOffset {
+		return false
+	}
+	if this.Rx2DataRate != that1.Rx2DataRate {
+		return false
+	}
+	if this.Rx2Frequency != that1.Rx2Frequency {
+		return false
+	}
+	if this.RxDelay != that1.RxDelay {
+		return false
+	}
+	return true
+}
+func (this *DeviceProfileList) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DeviceProfileList)
+	if !ok {
+		that2, ok := that.(DeviceProfileList)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DeviceProfileList")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DeviceProfileList but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DeviceProfileList but is not nil && this == nil")
+	}
+	if len(this.Profiles) != len(that1.Profiles) {
+		return fmt.Errorf("Profiles this(%v) Not Equal that(%v)", len(this.Profiles), len(that1.Profiles))
+	}
+	for i := range this.Profiles {
+		if !this.Profiles[i].Equal(that1.Profiles[i]) {
+			return fmt.Errorf("Profiles this[%v](%v) Not Equal that[%v](%v)", i, this.Profiles[i], i, that1.Profiles[i])
+		}
+	}
+	return nil
+}
+func (this *DeviceProfileList) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DeviceProfileList)
+	if !ok {
+		that2, ok := that.(DeviceProfileList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Profiles) != len(that1.Profiles) {
+		return false
+	}
+	for i := range this.Profiles {
+		if !this.Profiles[i].Equal(that1.Profiles[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ServiceProfile) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ServiceProfile)
+	if !ok {
+		that2, ok := that.(ServiceProfile)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ServiceProfile")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ServiceProfile but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ServiceProfile but is not nil && this == nil")
+	}
+	if this.ProfileId != that1.ProfileId {
+		return fmt.Errorf("ProfileId this(%v) Not Equal that(%v)", this.ProfileId, that1.ProfileId)
+	}
+	if this.Description != that1.Description {
+		return fmt.Errorf("Description this(%v) Not Equal that(%v)", this.Description, that1.Description)
+	}
+	if this.ActivationConstraints != that1.ActivationConstraints {
+		return fmt.Errorf("ActivationConstraints this(%v) Not Equal that(%v)", this.ActivationConstraints, that1.ActivationConstraints)
+	}
+	if this.DisableFCntCheck != that1.DisableFCntCheck {
+		return fmt.Errorf("DisableFCntCheck this(%v) Not Equal that(%v)", this.DisableFCntCheck, that1.DisableFCntCheck)
+	}
+	if this.DevStatusInterval != that1.DevStatusInterval {
+		return fmt.Errorf("DevStatusInterval this(%v) Not Equal that(%v)", this.DevStatusInterval, that1.DevStatusInterval)
+	}
+	if this.AdrStrategy != that1.AdrStrategy {
+		return fmt.Errorf("AdrStrategy this(%v) Not Equal that(%v)", this.AdrStrategy, that1.AdrStrategy)
+	}
+	if this.AdrMargin != that1.AdrMargin {
+		return fmt.Errorf("AdrMargin this(%v) Not Equal that(%v)", this.AdrMargin, that1.AdrMargin)
+	}
+	return nil
+}
+func (this *ServiceProfile) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ServiceProfile)
+	if !ok {
+		that2, ok := that.(ServiceProfile)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.ProfileId != that1.ProfileId {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.ActivationConstraints != that1.ActivationConstraints {
+		return false
+	}
+	if this.DisableFCntCheck != that1.DisableFCntCheck {
+		return false
+	}
+	if this.DevStatusInterval != that1.DevStatusInterval {
+		return false
+	}
+	if this.AdrStrategy != that1.AdrStrategy {
+		return false
+	}
+	if this.AdrMargin != that1.AdrMargin {
+		return false
+	}
+	return true
+}
+func (this *ServiceProfileList) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ServiceProfileList)
+	if !ok {
+		that2, ok := that.(ServiceProfileList)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ServiceProfileList")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ServiceProfileList but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ServiceProfileList but is not nil && this == nil")
+	}
+	if len(this.Profiles) != len(that1.Profiles) {
+		return fmt.Errorf("Profiles this(%v) Not Equal that(%v)", len(this.Profiles), len(that1.Profiles))
+	}
+	for i := range this.Profiles {
+		if !this.Profiles[i].Equal(that1.Profiles[i]) {
+			return fmt.Errorf("Profiles this[%v](%v) Not Equal that[%v](%v)", i, this.Profiles[i], i, that1.Profiles[i])
+		}
+	}
+	return nil
+}
+func (this *ServiceProfileList) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ServiceProfileList)
+	if !ok {
+		that2, ok := that.(ServiceProfileList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Profiles) != len(that1.Profiles) {
+		return false
+	}
+	for i := range this.Profiles {
+		if !this.Profiles[i].Equal(that1.Profiles[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ProfileManager service
+
+type ProfileManagerClient interface {
+	// GetDeviceProfile returns the device profile with the given identifier (profile_id)
+	GetDeviceProfile(ctx context.Context, in *ProfileIdentifier, opts ...grpc.CallOption) (*DeviceProfile, error)
+	// SetDeviceProfile creates or updates a device profile. All fields must be supplied.
+	SetDeviceProfile(ctx context.Context, in *DeviceProfile, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// DeleteDeviceProfile deletes the device profile with the given identifier (profile_id)
+	DeleteDeviceProfile(ctx context.Context, in *ProfileIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetDeviceProfiles returns all device profiles
+	GetDeviceProfiles(ctx context.Context, in *ProfilesRequest, opts ...grpc.CallOption) (*DeviceProfileList, error)
+	// GetServiceProfile returns the service profile with the given identifier (profile_id)
+	GetServiceProfile(ctx context.Context, in *ProfileIdentifier, opts ...grpc.CallOption) (*ServiceProfile, error)
+	// SetServiceProfile creates or updates a service profile. All fields must be supplied.
+	SetServiceProfile(ctx context.Context, in *ServiceProfile, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// DeleteServiceProfile deletes the service profile with the given identifier (profile_id)
+	DeleteServiceProfile(ctx context.Context, in *ProfileIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetServiceProfiles returns all service profiles
+	GetServiceProfiles(ctx context.Context, in *ProfilesRequest, opts ...grpc.CallOption) (*ServiceProfileList, error)
+}
+
+type profileManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProfileManagerClient(cc *grpc.ClientConn) ProfileManagerClient {
+	return &profileManagerClient{cc}
+}
+
+func (c *profileManagerClient) GetDeviceProfile(ctx context.Context, in *ProfileIdentifier, opts ...grpc.CallOption) (*DeviceProfile, error) {
+	out := new(DeviceProfile)
+	err := grpc.Invoke(ctx, "/networkserver.ProfileManager/GetDeviceProfile", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileManagerClient) SetDeviceProfile(ctx context.Context, in *DeviceProfile, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/networkserver.ProfileManager/SetDeviceProfile", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileManagerClient) DeleteDeviceProfile(ctx context.Context, in *ProfileIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/networkserver.ProfileManager/DeleteDeviceProfile", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileManagerClient) GetDeviceProfiles(ctx context.Context, in *ProfilesRequest, opts ...grpc.CallOption) (*DeviceProfileList, error) {
+	out := new(DeviceProfileList)
+	err := grpc.Invoke(ctx, "/networkserver.ProfileManager/GetDeviceProfiles", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileManagerClient) GetServiceProfile(ctx context.Context, in *ProfileIdentifier, opts ...grpc.CallOption) (*ServiceProfile, error) {
+	out := new(ServiceProfile)
+	err := grpc.Invoke(ctx, "/networkserver.ProfileManager/GetServiceProfile", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileManagerClient) SetServiceProfile(ctx context.Context, in *ServiceProfile, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/networkserver.ProfileManager/SetServiceProfile", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileManagerClient) DeleteServiceProfile(ctx context.Context, in *ProfileIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/networkserver.ProfileManager/DeleteServiceProfile", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileManagerClient) GetServiceProfiles(ctx context.Context, in *ProfilesRequest, opts ...grpc.CallOption) (*ServiceProfileList, error) {
+	out := new(ServiceProfileList)
+	err := grpc.Invoke(ctx, "/networkserver.ProfileManager/GetServiceProfiles", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ProfileManager service
+
+type ProfileManagerServer interface {
+	// GetDeviceProfile returns the device profile with the given identifier (profile_id)
+	GetDeviceProfile(context.Context, *ProfileIdentifier) (*DeviceProfile, error)
+	// SetDeviceProfile creates or updates a device profile. All fields must be supplied.
+	SetDeviceProfile(context.Context, *DeviceProfile) (*google_protobuf.Empty, error)
+	// DeleteDeviceProfile deletes the device profile with the given identifier (profile_id)
+	DeleteDeviceProfile(context.Context, *ProfileIdentifier) (*google_protobuf.Empty, error)
+	// GetDeviceProfiles returns all device profiles
+	GetDeviceProfiles(context.Context, *ProfilesRequest) (*DeviceProfileList, error)
+	// GetServiceProfile returns the service profile with the given identifier (profile_id)
+	GetServiceProfile(context.Context, *ProfileIdentifier) (*ServiceProfile, error)
+	// SetServiceProfile creates or updates a service profile. All fields must be supplied.
+	SetServiceProfile(context.Context, *ServiceProfile) (*google_protobuf.Empty, error)
+	// DeleteServiceProfile deletes the service profile with the given identifier (profile_id)
+	DeleteServiceProfile(context.Context, *ProfileIdentifier) (*google_protobuf.Empty, error)
+	// GetServiceProfiles returns all service profiles
+	GetServiceProfiles(context.Context, *ProfilesRequest) (*ServiceProfileList, error)
+}
+
+func RegisterProfileManagerServer(s *grpc.Server, srv ProfileManagerServer) {
+	s.RegisterService(&_ProfileManager_serviceDesc, srv)
+}
+
+func _ProfileManager_GetDeviceProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileManagerServer).GetDeviceProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/networkserver.ProfileManager/GetDeviceProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileManagerServer).GetDeviceProfile(ctx, req.(*ProfileIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileManager_SetDeviceProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileManagerServer).SetDeviceProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/networkserver.ProfileManager/SetDeviceProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileManagerServer).SetDeviceProfile(ctx, req.(*DeviceProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileManager_DeleteDeviceProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileManagerServer).DeleteDeviceProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/networkserver.ProfileManager/DeleteDeviceProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileManagerServer).DeleteDeviceProfile(ctx, req.(*ProfileIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileManager_GetDeviceProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileManagerServer).GetDeviceProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/networkserver.ProfileManager/GetDeviceProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileManagerServer).GetDeviceProfiles(ctx, req.(*ProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileManager_GetServiceProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileManagerServer).GetServiceProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/networkserver.ProfileManager/GetServiceProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileManagerServer).GetServiceProfile(ctx, req.(*ProfileIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileManager_SetServiceProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileManagerServer).SetServiceProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/networkserver.ProfileManager/SetServiceProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileManagerServer).SetServiceProfile(ctx, req.(*ServiceProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileManager_DeleteServiceProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileManagerServer).DeleteServiceProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/networkserver.ProfileManager/DeleteServiceProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileManagerServer).DeleteServiceProfile(ctx, req.(*ProfileIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileManager_GetServiceProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileManagerServer).GetServiceProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/networkserver.ProfileManager/GetServiceProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileManagerServer).GetServiceProfiles(ctx, req.(*ProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProfileManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "networkserver.ProfileManager",
+	HandlerType: (*ProfileManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDeviceProfile",
+			Handler:    _ProfileManager_GetDeviceProfile_Handler,
+		},
+		{
+			MethodName: "SetDeviceProfile",
+			Handler:    _ProfileManager_SetDeviceProfile_Handler,
+		},
+		{
+			MethodName: "DeleteDeviceProfile",
+			Handler:    _ProfileManager_DeleteDeviceProfile_Handler,
+		},
+		{
+			MethodName: "GetDeviceProfiles",
+			Handler:    _ProfileManager_GetDeviceProfiles_Handler,
+		},
+		{
+			MethodName: "GetServiceProfile",
+			Handler:    _ProfileManager_GetServiceProfile_Handler,
+		},
+		{
+			MethodName: "SetServiceProfile",
+			Handler:    _ProfileManager_SetServiceProfile_Handler,
+		},
+		{
+			MethodName: "DeleteServiceProfile",
+			Handler:    _ProfileManager_DeleteServiceProfile_Handler,
+		},
+		{
+			MethodName: "GetServiceProfiles",
+			Handler:    _ProfileManager_GetServiceProfiles_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/TheThingsNetwork/ttn/api/networkserver/profile.proto",
+}
+
+func (m *ProfileIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfileIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProfileId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.ProfileId)))
+		i += copy(dAtA[i:], m.ProfileId)
+	}
+	return i, nil
+}
+
+func (m *ProfilesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfilesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *DeviceProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceProfile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProfileId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.ProfileId)))
+		i += copy(dAtA[i:], m.ProfileId)
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if m.MacVersion != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintProfile(dAtA, i, uint64(m.MacVersion))
+	}
+	if len(m.RegionalParametersRevision) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.RegionalParametersRevision)))
+		i += copy(dAtA[i:], m.RegionalParametersRevision)
+	}
+	if m.SupportsClassB {
+		dAtA[i] = 0x28
+		i++
+		if m.SupportsClassB {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.SupportsClassC {
+		dAtA[i] = 0x30
+		i++
+		if m.SupportsClassC {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.SupportsJoin {
+		dAtA[i] = 0x38
+		i++
+		if m.SupportsJoin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MaxEirp != 0 {
+		dAtA[i] = 0x45
+		i++
+		i = encodeFixed32Profile(dAtA, i, uint32(math.Float32bits(float32(m.MaxEirp))))
+	}
+	if m.Uses32BitFCnt {
+		dAtA[i] = 0x48
+		i++
+		if m.Uses32BitFCnt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Rx1DrOffset != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintProfile(dAtA, i, uint64(m.Rx1DrOffset))
+	}
+	if len(m.Rx2DataRate) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Rx2DataRate)))
+		i += copy(dAtA[i:], m.Rx2DataRate)
+	}
+	if m.Rx2Frequency != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintProfile(dAtA, i, uint64(m.Rx2Frequency))
+	}
+	if m.RxDelay != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintProfile(dAtA, i, uint64(m.RxDelay))
+	}
+	return i, nil
+}
+
+func (m *DeviceProfileList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceProfileList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Profiles) > 0 {
+		for _, msg := range m.Profiles {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintProfile(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ServiceProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceProfile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProfileId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.ProfileId)))
+		i += copy(dAtA[i:], m.ProfileId)
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if len(m.ActivationConstraints) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.ActivationConstraints)))
+		i += copy(dAtA[i:], m.ActivationConstraints)
+	}
+	if m.DisableFCntCheck {
+		dAtA[i] = 0x20
+		i++
+		if m.DisableFCntCheck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.DevStatusInterval != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintProfile(dAtA, i, uint64(m.DevStatusInterval))
+	}
+	if len(m.AdrStrategy) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.AdrStrategy)))
+		i += copy(dAtA[i:], m.AdrStrategy)
+	}
+	if m.AdrMargin != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintProfile(dAtA, i, uint64(m.AdrMargin))
+	}
+	return i, nil
+}
+
+func (m *ServiceProfileList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceProfileList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Profiles) > 0 {
+		for _, msg := range m.Profiles {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintProfile(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeFixed64Profile(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Profile(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintProfile(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ProfileIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProfileId)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	return n
+}
+
+func (m *ProfilesRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DeviceProfile) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProfileId)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.MacVersion != 0 {
+		n += 1 + sovProfile(uint64(m.MacVersion))
+	}
+	l = len(m.RegionalParametersRevision)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.SupportsClassB {
+		n += 2
+	}
+	if m.SupportsClassC {
+		n += 2
+	}
+	if m.SupportsJoin {
+		n += 2
+	}
+	if m.MaxEirp != 0 {
+		n += 5
+	}
+	if m.Uses32BitFCnt {
+		n += 2
+	}
+	if m.Rx1DrOffset != 0 {
+		n += 1 + sovProfile(uint64(m.Rx1DrOffset))
+	}
+	l = len(m.Rx2DataRate)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.Rx2Frequency != 0 {
+		n += 1 + sovProfile(uint64(m.Rx2Frequency))
+	}
+	if m.RxDelay != 0 {
+		n += 1 + sovProfile(uint64(m.RxDelay))
+	}
+	return n
+}
+
+func (m *DeviceProfileList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Profiles) > 0 {
+		for _, e := range m.Profiles {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServiceProfile) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProfileId)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.ActivationConstraints)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.DisableFCntCheck {
+		n += 2
+	}
+	if m.DevStatusInterval != 0 {
+		n += 1 + sovProfile(uint64(m.DevStatusInterval))
+	}
+	l = len(m.AdrStrategy)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.AdrMargin != 0 {
+		n += 1 + sovProfile(uint64(m.AdrMargin))
+	}
+	return n
+}
+
+func (m *ServiceProfileList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Profiles) > 0 {
+		for _, e := range m.Profiles {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProfile(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozProfile(x uint64) (n int) {
+	return sovProfile(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ProfileIdentifier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProfileIdentifier{`,
+		`ProfileId:` + fmt.Sprintf("%v", this.ProfileId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProfilesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProfilesRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceProfile) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceProfile{`,
+		`ProfileId:` + fmt.Sprintf("%v", this.ProfileId) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`MacVersion:` + fmt.Sprintf("%v", this.MacVersion) + `,`,
+		`RegionalParametersRevision:` + fmt.Sprintf("%v", this.RegionalParametersRevision) + `,`,
+		`SupportsClassB:` + fmt.Sprintf("%v", this.SupportsClassB) + `,`,
+		`SupportsClassC:` + fmt.Sprintf("%v", this.SupportsClassC) + `,`,
+		`SupportsJoin:` + fmt.Sprintf("%v", this.SupportsJoin) + `,`,
+		`MaxEirp:` + fmt.Sprintf("%v", this.MaxEirp) + `,`,
+		`Uses32BitFCnt:` + fmt.Sprintf("%v", this.Uses32BitFCnt) + `,`,
+		`Rx1DrOffset:` + fmt.Sprintf("%v", this.Rx1DrOffset) + `,`,
+		`Rx2DataRate:` + fmt.Sprintf("%v", this.Rx2DataRate) + `,`,
+		`Rx2Frequency:` + fmt.Sprintf("%v", this.Rx2Frequency) + `,`,
+		`RxDelay:` + fmt.Sprintf("%v", this.RxDelay) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceProfileList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceProfileList{`,
+		`Profiles:` + strings.Replace(fmt.Sprintf("%v", this.Profiles), "DeviceProfile", "DeviceProfile", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServiceProfile) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServiceProfile{`,
+		`ProfileId:` + fmt.Sprintf("%v", this.ProfileId) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`ActivationConstraints:` + fmt.Sprintf("%v", this.ActivationConstraints) + `,`,
+		`DisableFCntCheck:` + fmt.Sprintf("%v", this.DisableFCntCheck) + `,`,
+		`DevStatusInterval:` + fmt.Sprintf("%v", this.DevStatusInterval) + `,`,
+		`AdrStrategy:` + fmt.Sprintf("%v", this.AdrStrategy) + `,`,
+		`AdrMargin:` + fmt.Sprintf("%v", this.AdrMargin) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServiceProfileList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServiceProfileList{`,
+		`Profiles:` + strings.Replace(fmt.Sprintf("%v", this.Profiles), "ServiceProfile", "ServiceProfile", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringProfile(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ProfileIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfileIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfileIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfileId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfilesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfilesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfilesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfileId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MacVersion", wireType)
+			}
+			m.MacVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MacVersion |= (lorawan.MACVersion(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionalParametersRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegionalParametersRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassB", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupportsClassB = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassC", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupportsClassC = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsJoin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupportsJoin = bool(v != 0)
+		case 8:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEirp", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.MaxEirp = float32(math.Float32frombits(v))
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uses32BitFCnt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Uses32BitFCnt = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DrOffset", wireType)
+			}
+			m.Rx1DrOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rx1DrOffset |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rx2DataRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
+			}
+			m.Rx2Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rx2Frequency |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxDelay", wireType)
+			}
+			m.RxDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RxDelay |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceProfileList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceProfileList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceProfileList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profiles = append(m.Profiles, &DeviceProfile{})
+			if err := m.Profiles[len(m.Profiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfileId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationConstraints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivationConstraints = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableFCntCheck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableFCntCheck = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevStatusInterval", wireType)
+			}
+			m.DevStatusInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DevStatusInterval |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdrStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdrStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdrMargin", wireType)
+			}
+			m.AdrMargin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdrMargin |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceProfileList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceProfileList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceProfileList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profiles = append(m.Profiles, &ServiceProfile{})
+			if err := m.Profiles[len(m.Profiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProfile(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthProfile
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowProfile
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipProfile(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthProfile = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProfile   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() {
+	proto.RegisterFile("github.com/TheThingsNetwork/ttn/api/networkserver/profile.proto", fileDescriptorProfile)
+}
+
+var fileDescriptorProfile = []byte{
+	// 953 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xee, 0xa6, 0xa1, 0xb1, 0xc7, 0x71, 0xb0, 0x27, 0x50, 0x6d, 0x4d, 0xb2, 0x6c, 0x37, 0xa2,
+	0x58, 0x91, 0xea, 0x55, 0x5c, 0x90, 0xa0, 0x17, 0x20, 0x76, 0x03, 0x45, 0x4d, 0x5b, 0xad, 0x2b,
+	0x0e, 0xbd, 0x8c, 0xc6, 0xbb, 0xcf, 0xce, 0xd0, 0xf5, 0xce, 0x32, 0x33, 0x76, 0x6d, 0x01, 0x12,
+	0xe2, 0x8e, 0x84, 0x84, 0xd4, 0x03, 0xbf, 0x00, 0xfe, 0x09, 0x47, 0x24, 0x2e, 0x1c, 0x5b, 0xc3,
+	0x0f, 0x41, 0x3b, 0xbb, 0x9b, 0x60, 0x3b, 0xb1, 0x15, 0xa9, 0xa7, 0x64, 0xdf, 0xfb, 0xf6, 0x7d,
+	0xdf, 0x7e, 0xef, 0x9b, 0x91, 0xd1, 0x27, 0x7d, 0xa6, 0x4e, 0x86, 0xdd, 0x86, 0xcf, 0x07, 0xee,
+	0x93, 0x13, 0x78, 0x72, 0xc2, 0xa2, 0xbe, 0x7c, 0x08, 0xea, 0x39, 0x17, 0xcf, 0x5c, 0xa5, 0x22,
+	0x97, 0xc6, 0xcc, 0x8d, 0xd2, 0x67, 0x09, 0x62, 0x04, 0xc2, 0x8d, 0x05, 0xef, 0xb1, 0x10, 0x1a,
+	0xb1, 0xe0, 0x8a, 0xe3, 0xf2, 0x4c, 0xb3, 0xf6, 0x4e, 0x9f, 0xf3, 0x7e, 0x08, 0xae, 0x6e, 0x76,
+	0x87, 0x3d, 0x17, 0x06, 0xb1, 0x9a, 0xa4, 0xd8, 0xda, 0x4e, 0xd6, 0x4c, 0x66, 0xd2, 0x28, 0xe2,
+	0x8a, 0x2a, 0xc6, 0x23, 0x99, 0x75, 0x6f, 0xe5, 0x74, 0xfa, 0xd1, 0xe7, 0xa1, 0x1b, 0x72, 0x41,
+	0x9f, 0xd3, 0x28, 0xff, 0x9b, 0xe2, 0x9c, 0x26, 0xaa, 0x3e, 0x4e, 0x25, 0xdc, 0x0f, 0x20, 0x52,
+	0xac, 0xc7, 0x40, 0xe0, 0x5d, 0x84, 0x32, 0x5d, 0x84, 0x05, 0xa6, 0x61, 0x1b, 0xf5, 0xa2, 0x57,
+	0x8c, 0x73, 0x98, 0x53, 0x45, 0x6f, 0x66, 0xef, 0x48, 0x0f, 0xbe, 0x19, 0x82, 0x54, 0xce, 0x4f,
+	0xeb, 0xa8, 0xdc, 0x86, 0x11, 0xf3, 0x21, 0xeb, 0xac, 0x98, 0x81, 0x6d, 0x54, 0x0a, 0x40, 0xfa,
+	0x82, 0xc5, 0x89, 0x6a, 0x73, 0x4d, 0xf7, 0xff, 0x5f, 0xc2, 0x1f, 0xa0, 0xd2, 0x80, 0xfa, 0x64,
+	0x04, 0x42, 0x26, 0x88, 0xab, 0xb6, 0x51, 0xdf, 0x6a, 0x6e, 0x37, 0x72, 0xf9, 0xc7, 0x9f, 0xb5,
+	0xbe, 0x4a, 0x5b, 0x1e, 0x1a, 0x50, 0x3f, 0xfb, 0x1f, 0x7f, 0x8a, 0x76, 0x04, 0xf4, 0x19, 0x8f,
+	0x68, 0x48, 0x62, 0x2a, 0xe8, 0x00, 0x14, 0x08, 0x49, 0x04, 0x8c, 0x98, 0x1e, 0xb3, 0xae, 0x89,
+	0x6a, 0x39, 0xe6, 0xf1, 0x29, 0xc4, 0xcb, 0x10, 0xb8, 0x8e, 0x2a, 0x72, 0x18, 0xc7, 0x5c, 0x28,
+	0x49, 0xfc, 0x90, 0x4a, 0x49, 0xba, 0xe6, 0x1b, 0xb6, 0x51, 0x2f, 0x78, 0x5b, 0x79, 0xbd, 0x95,
+	0x94, 0x0f, 0xcf, 0x41, 0xfa, 0xe6, 0xb5, 0x73, 0x90, 0x2d, 0xbc, 0x87, 0xca, 0xa7, 0xc8, 0xaf,
+	0x39, 0x8b, 0xcc, 0x0d, 0x0d, 0xdb, 0xcc, 0x8b, 0x5f, 0x72, 0x16, 0xe1, 0x1b, 0xa8, 0x30, 0xa0,
+	0x63, 0x02, 0x4c, 0xc4, 0x66, 0xc1, 0x36, 0xea, 0x6b, 0xde, 0xc6, 0x80, 0x8e, 0xef, 0x31, 0x11,
+	0xe3, 0xf7, 0x51, 0x65, 0x28, 0x41, 0xde, 0x69, 0x92, 0x2e, 0x53, 0xa4, 0x47, 0xfc, 0x48, 0x99,
+	0x45, 0x3d, 0xa2, 0x9c, 0xd6, 0x0f, 0x99, 0x3a, 0x6a, 0x45, 0x0a, 0x3b, 0xa8, 0x2c, 0xc6, 0x07,
+	0x24, 0x10, 0x84, 0xf7, 0x7a, 0x12, 0x94, 0x89, 0x6c, 0xa3, 0x5e, 0xf6, 0x4a, 0x62, 0x7c, 0xd0,
+	0x16, 0x8f, 0x74, 0x29, 0xc5, 0x34, 0x49, 0x40, 0x15, 0x25, 0x82, 0x2a, 0x30, 0x4b, 0xa9, 0xf9,
+	0x62, 0xdc, 0x6c, 0x53, 0x45, 0x3d, 0xaa, 0x00, 0xef, 0xa5, 0x98, 0x9e, 0x48, 0xf6, 0x1b, 0xf9,
+	0x13, 0x73, 0xd3, 0x36, 0xea, 0xeb, 0xde, 0xa6, 0x18, 0x37, 0x8f, 0xf2, 0x5a, 0x22, 0x58, 0x8c,
+	0x49, 0x00, 0x21, 0x9d, 0x98, 0x65, 0xcd, 0xb3, 0x21, 0xc6, 0xed, 0xe4, 0xd1, 0x39, 0x46, 0xd5,
+	0x99, 0x38, 0x3c, 0x60, 0x52, 0xe1, 0x8f, 0x50, 0x21, 0x0b, 0x80, 0x34, 0x0d, 0xfb, 0x6a, 0xbd,
+	0xd4, 0xdc, 0x69, 0xcc, 0x04, 0xbe, 0x31, 0xf3, 0x8e, 0x77, 0x8a, 0x76, 0x7e, 0x5f, 0x43, 0x5b,
+	0x1d, 0x10, 0xaf, 0x35, 0x5f, 0x1f, 0xa2, 0xeb, 0xd4, 0x57, 0x6c, 0xa4, 0x8f, 0x0d, 0xf1, 0x79,
+	0x24, 0x95, 0xa0, 0x2c, 0x52, 0x52, 0x47, 0xad, 0xe8, 0xbd, 0x7d, 0xd6, 0x6d, 0x9d, 0x35, 0xf1,
+	0x6d, 0xb4, 0x1d, 0x30, 0x49, 0xbb, 0x21, 0xa4, 0x7b, 0x20, 0xfe, 0x09, 0xf8, 0xcf, 0x74, 0xae,
+	0x0a, 0x5e, 0x25, 0x6b, 0x25, 0xbb, 0x68, 0x25, 0x75, 0xdc, 0x40, 0xdb, 0x01, 0x8c, 0x88, 0x54,
+	0x54, 0x0d, 0x25, 0x61, 0x91, 0x02, 0x31, 0xa2, 0xa1, 0x0e, 0x54, 0xd9, 0xab, 0x06, 0x30, 0xea,
+	0xe8, 0xce, 0xfd, 0xac, 0x81, 0x6f, 0xa2, 0x4d, 0x1a, 0x08, 0x92, 0xd0, 0x29, 0xe8, 0x4f, 0x74,
+	0x9e, 0x8a, 0x5e, 0x89, 0x06, 0xa2, 0x93, 0x95, 0x92, 0x2f, 0x4f, 0x20, 0x03, 0x2a, 0xfa, 0x59,
+	0x92, 0xca, 0x5e, 0x91, 0x06, 0xe2, 0x58, 0x17, 0x9c, 0x47, 0x08, 0xcf, 0x5a, 0xa5, 0xbd, 0xff,
+	0x78, 0xc1, 0xfb, 0xdd, 0x39, 0xef, 0x67, 0x5f, 0x3a, 0x33, 0xbf, 0xf9, 0xa2, 0x80, 0xb6, 0xb2,
+	0xea, 0x31, 0x8d, 0x68, 0x1f, 0x04, 0x9e, 0xa0, 0xca, 0xe7, 0xa0, 0x66, 0x0f, 0xbc, 0x3d, 0x37,
+	0x6f, 0xe1, 0x5a, 0xa9, 0x2d, 0xdd, 0xb6, 0xf3, 0xde, 0x8f, 0x7f, 0xfd, 0xfb, 0xcb, 0xda, 0xbb,
+	0x78, 0xd7, 0x0d, 0x74, 0xfd, 0x76, 0x2e, 0xc0, 0xfd, 0xf6, 0x6c, 0xd1, 0xdf, 0xe3, 0x17, 0x06,
+	0xaa, 0x74, 0xe6, 0xb9, 0x97, 0x4e, 0xae, 0x5d, 0x6f, 0xa4, 0x57, 0x65, 0x23, 0xbf, 0x47, 0x1b,
+	0xf7, 0x92, 0x7b, 0xd4, 0x79, 0xa0, 0x19, 0x8f, 0xee, 0x1a, 0xfb, 0x4f, 0x9d, 0xbb, 0xc6, 0x7e,
+	0x6d, 0x39, 0xb3, 0xb3, 0x42, 0xd8, 0x08, 0x6d, 0xb7, 0x21, 0x04, 0x05, 0x97, 0xb5, 0xe5, 0x22,
+	0x79, 0x99, 0x21, 0xfb, 0x2b, 0x78, 0x39, 0xaa, 0xce, 0xef, 0x42, 0x62, 0xeb, 0x7c, 0xd6, 0xfc,
+	0xbe, 0xae, 0xd9, 0xcb, 0x0c, 0x4b, 0x02, 0xe3, 0x98, 0x9a, 0x1d, 0xe3, 0xca, 0x3c, 0x3b, 0xfe,
+	0x4e, 0x13, 0xce, 0x1d, 0xc7, 0xd5, 0x9f, 0xb9, 0x3c, 0x6f, 0xce, 0x2d, 0xcd, 0x67, 0x63, 0xcb,
+	0x95, 0x20, 0x66, 0x08, 0x67, 0x3f, 0xf7, 0x57, 0x03, 0x55, 0x3b, 0x0b, 0xf4, 0xcb, 0x87, 0x5f,
+	0x68, 0xf1, 0x43, 0x4d, 0xfa, 0x45, 0x92, 0x80, 0xbd, 0x24, 0x01, 0x2b, 0xc8, 0x9d, 0x55, 0xe2,
+	0xc6, 0xe8, 0xad, 0x34, 0x03, 0x97, 0x76, 0xe7, 0x22, 0x85, 0x99, 0x2d, 0xfb, 0xab, 0x98, 0x05,
+	0xc2, 0x0b, 0x4b, 0x59, 0x1d, 0x83, 0x9b, 0x4b, 0x6d, 0xd3, 0x39, 0xb8, 0xa1, 0x05, 0x6c, 0xe3,
+	0xea, 0x82, 0x80, 0xc3, 0xce, 0xdf, 0xaf, 0xac, 0x2b, 0x2f, 0x5f, 0x59, 0xc6, 0x0f, 0x53, 0xcb,
+	0xf8, 0x6d, 0x6a, 0x19, 0x7f, 0x4c, 0x2d, 0xe3, 0xcf, 0xa9, 0x65, 0xbc, 0x9c, 0x5a, 0xc6, 0xcf,
+	0xff, 0x58, 0x57, 0x9e, 0x1e, 0x5c, 0xfa, 0x07, 0x51, 0xf7, 0x9a, 0x36, 0xe0, 0xce, 0x7f, 0x03,
+	0x00, 0x90, 0x3b, 0xb9, 0x4a, 0x4c, 0x09, 0x00, 0x00,
+}
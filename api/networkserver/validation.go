@@ -3,7 +3,12 @@
 
 package networkserver
 
-import "github.com/TheThingsNetwork/ttn/utils/errors"
+import (
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
 
 // Validate implements the api.Validator interface
 func (m *DevicesRequest) Validate() error {
@@ -12,3 +17,44 @@ func (m *DevicesRequest) Validate() error {
 	}
 	return nil
 }
+
+// Validate implements the api.Validator interface
+func (m *ProfileIdentifier) Validate() error {
+	if err := api.NotEmptyAndValidID(m.ProfileId, "ProfileId"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *DeviceProfile) Validate() error {
+	if err := api.NotEmptyAndValidID(m.ProfileId, "ProfileId"); err != nil {
+		return err
+	}
+	if _, ok := lorawan.MACVersion_name[int32(m.MacVersion)]; !ok {
+		return errors.NewErrInvalidArgument("MacVersion", "unknown LoRaWAN version")
+	}
+	if m.MaxEirp < 0 {
+		return errors.NewErrInvalidArgument("MaxEirp", "can not be negative")
+	}
+	if m.Rx1DrOffset > 7 {
+		return errors.NewErrInvalidArgument("Rx1DrOffset", "must be at most 7")
+	}
+	if m.Rx2DataRate != "" {
+		if _, err := types.ParseDataRate(m.Rx2DataRate); err != nil {
+			return errors.NewErrInvalidArgument("Rx2DataRate", err.Error())
+		}
+	}
+	if m.RxDelay > 15 {
+		return errors.NewErrInvalidArgument("RxDelay", "must be at most 15")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *ServiceProfile) Validate() error {
+	if err := api.NotEmptyAndValidID(m.ProfileId, "ProfileId"); err != nil {
+		return err
+	}
+	return nil
+}
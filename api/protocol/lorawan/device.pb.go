@@ -11,6 +11,7 @@
 	It has these top-level messages:
 		DeviceIdentifier
 		Device
+		DeviceStats
 */
 package lorawan
 
@@ -43,6 +44,33 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type DeviceClass int32
+
+const (
+	// Class A devices only listen for downlink in the receive windows after an uplink
+	DeviceClass_CLASS_A DeviceClass = 0
+	// Class B devices additionally listen for downlink in ping slots, that are synchronized with the beacons of GPS-synchronized gateways
+	DeviceClass_CLASS_B DeviceClass = 1
+	// Class C devices continuously listen for downlink on the RX2 parameters, unless they are transmitting
+	DeviceClass_CLASS_C DeviceClass = 2
+)
+
+var DeviceClass_name = map[int32]string{
+	0: "CLASS_A",
+	1: "CLASS_B",
+	2: "CLASS_C",
+}
+var DeviceClass_value = map[string]int32{
+	"CLASS_A": 0,
+	"CLASS_B": 1,
+	"CLASS_C": 2,
+}
+
+func (x DeviceClass) String() string {
+	return proto.EnumName(DeviceClass_name, int32(x))
+}
+func (DeviceClass) EnumDescriptor() ([]byte, []int) { return fileDescriptorDevice, []int{0} }
+
 type DeviceIdentifier struct {
 	// The AppEUI is a unique, 8 byte identifier for the application a device belongs to.
 	AppEui *github_com_TheThingsNetwork_ttn_core_types.AppEUI `protobuf:"bytes,1,opt,name=app_eui,json=appEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.AppEUI" json:"app_eui,omitempty"`
@@ -66,7 +94,7 @@ type Device struct {
 	// The DevAddr is a dynamic, 4 byte session address for the device.
 	DevAddr *github_com_TheThingsNetwork_ttn_core_types.DevAddr `protobuf:"bytes,5,opt,name=dev_addr,json=devAddr,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.DevAddr" json:"dev_addr,omitempty"`
 	// The NwkSKey is a 16 byte session key that is known by the device and the network. It is used for routing and MAC related functionality.
-	// This key is negotiated during the OTAA join procedure, or statically configured using ABP.
+	// This key is negotiated during the OTAA join procedure, or statically configured using ABP. For LoRaWAN 1.1 devices, this is the FNwkSIntKey.
 	NwkSKey *github_com_TheThingsNetwork_ttn_core_types.NwkSKey `protobuf:"bytes,6,opt,name=nwk_s_key,json=nwkSKey,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.NwkSKey" json:"nwk_s_key,omitempty"`
 	// The AppSKey is a 16 byte session key that is known by the device and the application. It is used for payload encryption.
 	// This key is negotiated during the OTAA join procedure, or statically configured using ABP.
@@ -84,8 +112,49 @@ type Device struct {
 	// The ActivationContstraints are used to allocate a device address for a device (comma-separated).
 	// There are different prefixes for `otaa`, `abp`, `world`, `local`, `private`, `testing`.
 	ActivationConstraints string `protobuf:"bytes,13,opt,name=activation_constraints,json=activationConstraints,proto3" json:"activation_constraints,omitempty"`
+	// The DevStatusInterval is the interval (in seconds) in which the NetworkServer requests the status of the device. Zero uses the default of the NetworkServer.
+	DevStatusInterval uint32 `protobuf:"varint,14,opt,name=dev_status_interval,json=devStatusInterval,proto3" json:"dev_status_interval,omitempty"`
+	// The DisableDevStatus option disables the DevStatusReq of the NetworkServer for the device, regardless of the DevStatusInterval.
+	DisableDevStatus bool `protobuf:"varint,34,opt,name=disable_dev_status,json=disableDevStatus,proto3" json:"disable_dev_status,omitempty"`
+	// The RX1DROffset is the offset between the uplink data rate and the downlink data rate in RX1. Zero uses the default of the frequency plan.
+	// When returned by the NetworkServer to the Broker, this and the following settings contain the values that were acknowledged by the device.
+	Rx1DrOffset uint32 `protobuf:"varint,15,opt,name=rx1_dr_offset,json=rx1DrOffset,proto3" json:"rx1_dr_offset,omitempty"`
+	// The RX2DataRate is the data rate that is used in RX2 (for example SF12BW125). Empty uses the default of the frequency plan.
+	Rx2DataRate string `protobuf:"bytes,16,opt,name=rx2_data_rate,json=rx2DataRate,proto3" json:"rx2_data_rate,omitempty"`
+	// The RX2Frequency is the frequency (in Hz) that is used in RX2. Zero uses the default of the frequency plan.
+	Rx2Frequency uint64 `protobuf:"varint,17,opt,name=rx2_frequency,json=rx2Frequency,proto3" json:"rx2_frequency,omitempty"`
+	// The RXDelay is the delay (in seconds) between the end of the uplink and RX1. Zero uses the default of 1 second.
+	RxDelay uint32 `protobuf:"varint,18,opt,name=rx_delay,json=rxDelay,proto3" json:"rx_delay,omitempty"`
+	// The ADRStrategy is the name of the ADR strategy that the NetworkServer uses for the device: default, conservative, mobile or disabled. Empty uses the default strategy.
+	AdrStrategy string `protobuf:"bytes,19,opt,name=adr_strategy,json=adrStrategy,proto3" json:"adr_strategy,omitempty"`
+	// The ADRMargin is the margin (in dB) that the ADR strategy keeps on top of the demodulation floor. Zero uses the default of the NetworkServer.
+	AdrMargin uint32 `protobuf:"varint,20,opt,name=adr_margin,json=adrMargin,proto3" json:"adr_margin,omitempty"`
+	// The DeviceClass indicates when the device listens for downlink. Class C devices receive downlink without waiting for an uplink.
+	DeviceClass DeviceClass `protobuf:"varint,25,opt,name=device_class,json=deviceClass,proto3,enum=lorawan.DeviceClass" json:"device_class,omitempty"`
+	// The BeaconFrequency is the frequency (in Hz) of the beacons for Class B devices. Zero uses the default of the frequency plan.
+	BeaconFrequency uint64 `protobuf:"varint,26,opt,name=beacon_frequency,json=beaconFrequency,proto3" json:"beacon_frequency,omitempty"`
+	// The PingSlotPeriodicity indicates that a Class B device opens a ping slot every 2^periodicity seconds, as reported by the device in its last PingSlotInfoReq.
+	PingSlotPeriodicity uint32 `protobuf:"varint,27,opt,name=ping_slot_periodicity,json=pingSlotPeriodicity,proto3" json:"ping_slot_periodicity,omitempty"`
+	// The MACVersion is the LoRaWAN version that the device implements.
+	MacVersion MACVersion `protobuf:"varint,28,opt,name=mac_version,json=macVersion,proto3,enum=lorawan.MACVersion" json:"mac_version,omitempty"`
+	// The NwkKey is a 16 byte static key that is known by the device and the network. It is used for negotiating the network session keys of LoRaWAN 1.1 devices (OTAA).
+	NwkKey *github_com_TheThingsNetwork_ttn_core_types.AppKey `protobuf:"bytes,29,opt,name=nwk_key,json=nwkKey,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.AppKey" json:"nwk_key,omitempty"`
+	// The SNwkSIntKey is a 16 byte session key of LoRaWAN 1.1 devices. It is used for the MIC of messages that are handled by the serving network.
+	SNwkSIntKey *github_com_TheThingsNetwork_ttn_core_types.NwkSKey `protobuf:"bytes,30,opt,name=s_nwk_s_int_key,json=sNwkSIntKey,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.NwkSKey" json:"s_nwk_s_int_key,omitempty"`
+	// The NwkSEncKey is a 16 byte session key of LoRaWAN 1.1 devices. It is used for encrypting MAC commands.
+	NwkSEncKey *github_com_TheThingsNetwork_ttn_core_types.NwkSKey `protobuf:"bytes,31,opt,name=nwk_s_enc_key,json=nwkSEncKey,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.NwkSKey" json:"nwk_s_enc_key,omitempty"`
+	// The DeviceProfileID refers to a device profile on the NetworkServer. Its settings are used instead of the settings of the device.
+	DeviceProfileId string `protobuf:"bytes,32,opt,name=device_profile_id,json=deviceProfileId,proto3" json:"device_profile_id,omitempty"`
+	// The ServiceProfileID refers to a service profile on the NetworkServer. Its settings are used instead of the settings of the device.
+	ServiceProfileId string `protobuf:"bytes,33,opt,name=service_profile_id,json=serviceProfileId,proto3" json:"service_profile_id,omitempty"`
 	// When the device was last seen (Unix nanoseconds)
 	LastSeen int64 `protobuf:"varint,21,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	// The battery level of the device, as reported in its last DevStatusAns: 0 for an external power source, 1..254 for the battery level, 255 if the device could not measure it
+	Battery uint32 `protobuf:"varint,22,opt,name=battery,proto3" json:"battery,omitempty"`
+	// The demodulation margin (in dB) of the last DevStatusReq, as reported by the device
+	Margin int32 `protobuf:"varint,23,opt,name=margin,proto3" json:"margin,omitempty"`
+	// When the device last reported its status (Unix nanoseconds)
+	LastStatus int64 `protobuf:"varint,24,opt,name=last_status,json=lastStatus,proto3" json:"last_status,omitempty"`
 }
 
 func (m *Device) Reset()                    { *m = Device{} }
@@ -141,6 +210,104 @@ func (m *Device) GetActivationConstraints() string {
 	return ""
 }
 
+func (m *Device) GetDevStatusInterval() uint32 {
+	if m != nil {
+		return m.DevStatusInterval
+	}
+	return 0
+}
+
+func (m *Device) GetDisableDevStatus() bool {
+	if m != nil {
+		return m.DisableDevStatus
+	}
+	return false
+}
+
+func (m *Device) GetRx1DrOffset() uint32 {
+	if m != nil {
+		return m.Rx1DrOffset
+	}
+	return 0
+}
+
+func (m *Device) GetRx2DataRate() string {
+	if m != nil {
+		return m.Rx2DataRate
+	}
+	return ""
+}
+
+func (m *Device) GetRx2Frequency() uint64 {
+	if m != nil {
+		return m.Rx2Frequency
+	}
+	return 0
+}
+
+func (m *Device) GetRxDelay() uint32 {
+	if m != nil {
+		return m.RxDelay
+	}
+	return 0
+}
+
+func (m *Device) GetAdrStrategy() string {
+	if m != nil {
+		return m.AdrStrategy
+	}
+	return ""
+}
+
+func (m *Device) GetAdrMargin() uint32 {
+	if m != nil {
+		return m.AdrMargin
+	}
+	return 0
+}
+
+func (m *Device) GetDeviceClass() DeviceClass {
+	if m != nil {
+		return m.DeviceClass
+	}
+	return DeviceClass_CLASS_A
+}
+
+func (m *Device) GetBeaconFrequency() uint64 {
+	if m != nil {
+		return m.BeaconFrequency
+	}
+	return 0
+}
+
+func (m *Device) GetPingSlotPeriodicity() uint32 {
+	if m != nil {
+		return m.PingSlotPeriodicity
+	}
+	return 0
+}
+
+func (m *Device) GetMacVersion() MACVersion {
+	if m != nil {
+		return m.MacVersion
+	}
+	return MACVersion_LORAWAN_1_0
+}
+
+func (m *Device) GetDeviceProfileId() string {
+	if m != nil {
+		return m.DeviceProfileId
+	}
+	return ""
+}
+
+func (m *Device) GetServiceProfileId() string {
+	if m != nil {
+		return m.ServiceProfileId
+	}
+	return ""
+}
+
 func (m *Device) GetLastSeen() int64 {
 	if m != nil {
 		return m.LastSeen
@@ -148,9 +315,255 @@ func (m *Device) GetLastSeen() int64 {
 	return 0
 }
 
+func (m *Device) GetBattery() uint32 {
+	if m != nil {
+		return m.Battery
+	}
+	return 0
+}
+
+func (m *Device) GetMargin() int32 {
+	if m != nil {
+		return m.Margin
+	}
+	return 0
+}
+
+func (m *Device) GetLastStatus() int64 {
+	if m != nil {
+		return m.LastStatus
+	}
+	return 0
+}
+
+// DeviceStats contains link quality statistics of a device, calculated from its recent uplink messages
+type DeviceStats struct {
+	// The AppEUI is a unique, 8 byte identifier for the application a device belongs to.
+	AppEui *github_com_TheThingsNetwork_ttn_core_types.AppEUI `protobuf:"bytes,1,opt,name=app_eui,json=appEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.AppEUI" json:"app_eui,omitempty"`
+	// The DevEUI is a unique, 8 byte identifier for the device.
+	DevEui *github_com_TheThingsNetwork_ttn_core_types.DevEUI `protobuf:"bytes,2,opt,name=dev_eui,json=devEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.DevEUI" json:"dev_eui,omitempty"`
+	// The number of uplink messages that the statistics are based on
+	Frames uint32 `protobuf:"varint,3,opt,name=frames,proto3" json:"frames,omitempty"`
+	// The FCnt of the first and the last of these uplink messages
+	FirstFCnt uint32 `protobuf:"varint,4,opt,name=first_f_cnt,json=firstFCnt,proto3" json:"first_f_cnt,omitempty"`
+	LastFCnt  uint32 `protobuf:"varint,5,opt,name=last_f_cnt,json=lastFCnt,proto3" json:"last_f_cnt,omitempty"`
+	// The fraction (0..1) of uplink messages that was lost, based on the gaps between the frame counters
+	PacketLoss float32 `protobuf:"fixed32,6,opt,name=packet_loss,json=packetLoss,proto3" json:"packet_loss,omitempty"`
+	// The SNR (in dB) of the gateway with the best reception of each uplink message
+	Snr *DeviceStats_Percentiles `protobuf:"bytes,7,opt,name=snr" json:"snr,omitempty"`
+	// The RSSI (in dBm) of the gateway with the best reception of each uplink message
+	Rssi *DeviceStats_Percentiles `protobuf:"bytes,8,opt,name=rssi" json:"rssi,omitempty"`
+	// The average number of gateways that received an uplink message
+	AverageGateways float32 `protobuf:"fixed32,9,opt,name=average_gateways,json=averageGateways,proto3" json:"average_gateways,omitempty"`
+	// The gateways that received the uplink messages, sorted by the number of uplink messages that they received
+	Gateways []*DeviceStats_Gateway `protobuf:"bytes,10,rep,name=gateways" json:"gateways,omitempty"`
+	// The number of uplink messages per data rate, sorted by data rate
+	DataRates []*DeviceStats_DataRate `protobuf:"bytes,11,rep,name=data_rates,json=dataRates" json:"data_rates,omitempty"`
+	// The number of uplink messages per frequency, sorted by frequency
+	Frequencies []*DeviceStats_Frequency `protobuf:"bytes,12,rep,name=frequencies" json:"frequencies,omitempty"`
+}
+
+func (m *DeviceStats) Reset()                    { *m = DeviceStats{} }
+func (*DeviceStats) ProtoMessage()               {}
+func (*DeviceStats) Descriptor() ([]byte, []int) { return fileDescriptorDevice, []int{2} }
+
+func (m *DeviceStats) GetFrames() uint32 {
+	if m != nil {
+		return m.Frames
+	}
+	return 0
+}
+
+func (m *DeviceStats) GetFirstFCnt() uint32 {
+	if m != nil {
+		return m.FirstFCnt
+	}
+	return 0
+}
+
+func (m *DeviceStats) GetLastFCnt() uint32 {
+	if m != nil {
+		return m.LastFCnt
+	}
+	return 0
+}
+
+func (m *DeviceStats) GetPacketLoss() float32 {
+	if m != nil {
+		return m.PacketLoss
+	}
+	return 0
+}
+
+func (m *DeviceStats) GetSnr() *DeviceStats_Percentiles {
+	if m != nil {
+		return m.Snr
+	}
+	return nil
+}
+
+func (m *DeviceStats) GetRssi() *DeviceStats_Percentiles {
+	if m != nil {
+		return m.Rssi
+	}
+	return nil
+}
+
+func (m *DeviceStats) GetAverageGateways() float32 {
+	if m != nil {
+		return m.AverageGateways
+	}
+	return 0
+}
+
+func (m *DeviceStats) GetGateways() []*DeviceStats_Gateway {
+	if m != nil {
+		return m.Gateways
+	}
+	return nil
+}
+
+func (m *DeviceStats) GetDataRates() []*DeviceStats_DataRate {
+	if m != nil {
+		return m.DataRates
+	}
+	return nil
+}
+
+func (m *DeviceStats) GetFrequencies() []*DeviceStats_Frequency {
+	if m != nil {
+		return m.Frequencies
+	}
+	return nil
+}
+
+type DeviceStats_Percentiles struct {
+	Min    float32 `protobuf:"fixed32,1,opt,name=min,proto3" json:"min,omitempty"`
+	P10    float32 `protobuf:"fixed32,2,opt,name=p10,proto3" json:"p10,omitempty"`
+	Median float32 `protobuf:"fixed32,3,opt,name=median,proto3" json:"median,omitempty"`
+	P90    float32 `protobuf:"fixed32,4,opt,name=p90,proto3" json:"p90,omitempty"`
+	Max    float32 `protobuf:"fixed32,5,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *DeviceStats_Percentiles) Reset()                    { *m = DeviceStats_Percentiles{} }
+func (*DeviceStats_Percentiles) ProtoMessage()               {}
+func (*DeviceStats_Percentiles) Descriptor() ([]byte, []int) { return fileDescriptorDevice, []int{2, 0} }
+
+func (m *DeviceStats_Percentiles) GetMin() float32 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *DeviceStats_Percentiles) GetP10() float32 {
+	if m != nil {
+		return m.P10
+	}
+	return 0
+}
+
+func (m *DeviceStats_Percentiles) GetMedian() float32 {
+	if m != nil {
+		return m.Median
+	}
+	return 0
+}
+
+func (m *DeviceStats_Percentiles) GetP90() float32 {
+	if m != nil {
+		return m.P90
+	}
+	return 0
+}
+
+func (m *DeviceStats_Percentiles) GetMax() float32 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+type DeviceStats_Gateway struct {
+	GatewayId string `protobuf:"bytes,1,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	// The number of uplink messages that were received by the gateway
+	Frames uint32 `protobuf:"varint,2,opt,name=frames,proto3" json:"frames,omitempty"`
+}
+
+func (m *DeviceStats_Gateway) Reset()                    { *m = DeviceStats_Gateway{} }
+func (*DeviceStats_Gateway) ProtoMessage()               {}
+func (*DeviceStats_Gateway) Descriptor() ([]byte, []int) { return fileDescriptorDevice, []int{2, 1} }
+
+func (m *DeviceStats_Gateway) GetGatewayId() string {
+	if m != nil {
+		return m.GatewayId
+	}
+	return ""
+}
+
+func (m *DeviceStats_Gateway) GetFrames() uint32 {
+	if m != nil {
+		return m.Frames
+	}
+	return 0
+}
+
+type DeviceStats_DataRate struct {
+	DataRate string `protobuf:"bytes,1,opt,name=data_rate,json=dataRate,proto3" json:"data_rate,omitempty"`
+	Frames   uint32 `protobuf:"varint,2,opt,name=frames,proto3" json:"frames,omitempty"`
+}
+
+func (m *DeviceStats_DataRate) Reset()                    { *m = DeviceStats_DataRate{} }
+func (*DeviceStats_DataRate) ProtoMessage()               {}
+func (*DeviceStats_DataRate) Descriptor() ([]byte, []int) { return fileDescriptorDevice, []int{2, 2} }
+
+func (m *DeviceStats_DataRate) GetDataRate() string {
+	if m != nil {
+		return m.DataRate
+	}
+	return ""
+}
+
+func (m *DeviceStats_DataRate) GetFrames() uint32 {
+	if m != nil {
+		return m.Frames
+	}
+	return 0
+}
+
+type DeviceStats_Frequency struct {
+	// The frequency in Hz
+	Frequency uint64 `protobuf:"varint,1,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Frames    uint32 `protobuf:"varint,2,opt,name=frames,proto3" json:"frames,omitempty"`
+}
+
+func (m *DeviceStats_Frequency) Reset()                    { *m = DeviceStats_Frequency{} }
+func (*DeviceStats_Frequency) ProtoMessage()               {}
+func (*DeviceStats_Frequency) Descriptor() ([]byte, []int) { return fileDescriptorDevice, []int{2, 3} }
+
+func (m *DeviceStats_Frequency) GetFrequency() uint64 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+func (m *DeviceStats_Frequency) GetFrames() uint32 {
+	if m != nil {
+		return m.Frames
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*DeviceIdentifier)(nil), "lorawan.DeviceIdentifier")
 	proto.RegisterType((*Device)(nil), "lorawan.Device")
+	proto.RegisterType((*DeviceStats)(nil), "lorawan.DeviceStats")
+	proto.RegisterType((*DeviceStats_Percentiles)(nil), "lorawan.DeviceStats.Percentiles")
+	proto.RegisterType((*DeviceStats_Gateway)(nil), "lorawan.DeviceStats.Gateway")
+	proto.RegisterType((*DeviceStats_DataRate)(nil), "lorawan.DeviceStats.DataRate")
+	proto.RegisterType((*DeviceStats_Frequency)(nil), "lorawan.DeviceStats.Frequency")
+	proto.RegisterEnum("lorawan.DeviceClass", DeviceClass_name, DeviceClass_value)
 }
 func (this *DeviceIdentifier) VerboseEqual(that interface{}) error {
 	if that == nil {
@@ -322,9 +735,81 @@ func (this *Device) VerboseEqual(that interface{}) error {
 	if this.ActivationConstraints != that1.ActivationConstraints {
 		return fmt.Errorf("ActivationConstraints this(%v) Not Equal that(%v)", this.ActivationConstraints, that1.ActivationConstraints)
 	}
+	if this.DevStatusInterval != that1.DevStatusInterval {
+		return fmt.Errorf("DevStatusInterval this(%v) Not Equal that(%v)", this.DevStatusInterval, that1.DevStatusInterval)
+	}
+	if this.DisableDevStatus != that1.DisableDevStatus {
+		return fmt.Errorf("DisableDevStatus this(%v) Not Equal that(%v)", this.DisableDevStatus, that1.DisableDevStatus)
+	}
+	if this.Rx1DrOffset != that1.Rx1DrOffset {
+		return fmt.Errorf("Rx1DrOffset this(%v) Not Equal that(%v)", this.Rx1DrOffset, that1.Rx1DrOffset)
+	}
+	if this.Rx2DataRate != that1.Rx2DataRate {
+		return fmt.Errorf("Rx2DataRate this(%v) Not Equal that(%v)", this.Rx2DataRate, that1.Rx2DataRate)
+	}
+	if this.Rx2Frequency != that1.Rx2Frequency {
+		return fmt.Errorf("Rx2Frequency this(%v) Not Equal that(%v)", this.Rx2Frequency, that1.Rx2Frequency)
+	}
+	if this.RxDelay != that1.RxDelay {
+		return fmt.Errorf("RxDelay this(%v) Not Equal that(%v)", this.RxDelay, that1.RxDelay)
+	}
+	if this.AdrStrategy != that1.AdrStrategy {
+		return fmt.Errorf("AdrStrategy this(%v) Not Equal that(%v)", this.AdrStrategy, that1.AdrStrategy)
+	}
+	if this.AdrMargin != that1.AdrMargin {
+		return fmt.Errorf("AdrMargin this(%v) Not Equal that(%v)", this.AdrMargin, that1.AdrMargin)
+	}
+	if this.DeviceClass != that1.DeviceClass {
+		return fmt.Errorf("DeviceClass this(%v) Not Equal that(%v)", this.DeviceClass, that1.DeviceClass)
+	}
+	if this.BeaconFrequency != that1.BeaconFrequency {
+		return fmt.Errorf("BeaconFrequency this(%v) Not Equal that(%v)", this.BeaconFrequency, that1.BeaconFrequency)
+	}
+	if this.PingSlotPeriodicity != that1.PingSlotPeriodicity {
+		return fmt.Errorf("PingSlotPeriodicity this(%v) Not Equal that(%v)", this.PingSlotPeriodicity, that1.PingSlotPeriodicity)
+	}
+	if this.MacVersion != that1.MacVersion {
+		return fmt.Errorf("MacVersion this(%v) Not Equal that(%v)", this.MacVersion, that1.MacVersion)
+	}
+	if that1.NwkKey == nil {
+		if this.NwkKey != nil {
+			return fmt.Errorf("this.NwkKey != nil && that1.NwkKey == nil")
+		}
+	} else if !this.NwkKey.Equal(*that1.NwkKey) {
+		return fmt.Errorf("NwkKey this(%v) Not Equal that(%v)", this.NwkKey, that1.NwkKey)
+	}
+	if that1.SNwkSIntKey == nil {
+		if this.SNwkSIntKey != nil {
+			return fmt.Errorf("this.SNwkSIntKey != nil && that1.SNwkSIntKey == nil")
+		}
+	} else if !this.SNwkSIntKey.Equal(*that1.SNwkSIntKey) {
+		return fmt.Errorf("SNwkSIntKey this(%v) Not Equal that(%v)", this.SNwkSIntKey, that1.SNwkSIntKey)
+	}
+	if that1.NwkSEncKey == nil {
+		if this.NwkSEncKey != nil {
+			return fmt.Errorf("this.NwkSEncKey != nil && that1.NwkSEncKey == nil")
+		}
+	} else if !this.NwkSEncKey.Equal(*that1.NwkSEncKey) {
+		return fmt.Errorf("NwkSEncKey this(%v) Not Equal that(%v)", this.NwkSEncKey, that1.NwkSEncKey)
+	}
+	if this.DeviceProfileId != that1.DeviceProfileId {
+		return fmt.Errorf("DeviceProfileId this(%v) Not Equal that(%v)", this.DeviceProfileId, that1.DeviceProfileId)
+	}
+	if this.ServiceProfileId != that1.ServiceProfileId {
+		return fmt.Errorf("ServiceProfileId this(%v) Not Equal that(%v)", this.ServiceProfileId, that1.ServiceProfileId)
+	}
 	if this.LastSeen != that1.LastSeen {
 		return fmt.Errorf("LastSeen this(%v) Not Equal that(%v)", this.LastSeen, that1.LastSeen)
 	}
+	if this.Battery != that1.Battery {
+		return fmt.Errorf("Battery this(%v) Not Equal that(%v)", this.Battery, that1.Battery)
+	}
+	if this.Margin != that1.Margin {
+		return fmt.Errorf("Margin this(%v) Not Equal that(%v)", this.Margin, that1.Margin)
+	}
+	if this.LastStatus != that1.LastStatus {
+		return fmt.Errorf("LastStatus this(%v) Not Equal that(%v)", this.LastStatus, that1.LastStatus)
+	}
 	return nil
 }
 func (this *Device) Equal(that interface{}) bool {
@@ -415,475 +900,2711 @@ func (this *Device) Equal(that interface{}) bool {
 	if this.ActivationConstraints != that1.ActivationConstraints {
 		return false
 	}
-	if this.LastSeen != that1.LastSeen {
+	if this.DevStatusInterval != that1.DevStatusInterval {
 		return false
 	}
-	return true
-}
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// Client API for DeviceManager service
-
-type DeviceManagerClient interface {
-	GetDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*Device, error)
-	SetDevice(ctx context.Context, in *Device, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	DeleteDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-}
-
-type deviceManagerClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewDeviceManagerClient(cc *grpc.ClientConn) DeviceManagerClient {
-	return &deviceManagerClient{cc}
-}
-
-func (c *deviceManagerClient) GetDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*Device, error) {
-	out := new(Device)
-	err := grpc.Invoke(ctx, "/lorawan.DeviceManager/GetDevice", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	if this.DisableDevStatus != that1.DisableDevStatus {
+		return false
 	}
-	return out, nil
-}
-
-func (c *deviceManagerClient) SetDevice(ctx context.Context, in *Device, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/lorawan.DeviceManager/SetDevice", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	if this.Rx1DrOffset != that1.Rx1DrOffset {
+		return false
 	}
-	return out, nil
-}
-
-func (c *deviceManagerClient) DeleteDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/lorawan.DeviceManager/DeleteDevice", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	if this.Rx2DataRate != that1.Rx2DataRate {
+		return false
 	}
-	return out, nil
-}
-
-// Server API for DeviceManager service
-
-type DeviceManagerServer interface {
-	GetDevice(context.Context, *DeviceIdentifier) (*Device, error)
-	SetDevice(context.Context, *Device) (*google_protobuf.Empty, error)
-	DeleteDevice(context.Context, *DeviceIdentifier) (*google_protobuf.Empty, error)
-}
-
-func RegisterDeviceManagerServer(s *grpc.Server, srv DeviceManagerServer) {
-	s.RegisterService(&_DeviceManager_serviceDesc, srv)
-}
-
-func _DeviceManager_GetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeviceIdentifier)
-	if err := dec(in); err != nil {
-		return nil, err
+	if this.Rx2Frequency != that1.Rx2Frequency {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(DeviceManagerServer).GetDevice(ctx, in)
+	if this.RxDelay != that1.RxDelay {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lorawan.DeviceManager/GetDevice",
+	if this.AdrStrategy != that1.AdrStrategy {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DeviceManagerServer).GetDevice(ctx, req.(*DeviceIdentifier))
+	if this.AdrMargin != that1.AdrMargin {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _DeviceManager_SetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Device)
-	if err := dec(in); err != nil {
-		return nil, err
+	if this.DeviceClass != that1.DeviceClass {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(DeviceManagerServer).SetDevice(ctx, in)
+	if this.BeaconFrequency != that1.BeaconFrequency {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lorawan.DeviceManager/SetDevice",
+	if this.PingSlotPeriodicity != that1.PingSlotPeriodicity {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DeviceManagerServer).SetDevice(ctx, req.(*Device))
+	if this.MacVersion != that1.MacVersion {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _DeviceManager_DeleteDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeviceIdentifier)
-	if err := dec(in); err != nil {
-		return nil, err
+	if that1.NwkKey == nil {
+		if this.NwkKey != nil {
+			return false
+		}
+	} else if !this.NwkKey.Equal(*that1.NwkKey) {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(DeviceManagerServer).DeleteDevice(ctx, in)
+	if that1.SNwkSIntKey == nil {
+		if this.SNwkSIntKey != nil {
+			return false
+		}
+	} else if !this.SNwkSIntKey.Equal(*that1.SNwkSIntKey) {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lorawan.DeviceManager/DeleteDevice",
+	if that1.NwkSEncKey == nil {
+		if this.NwkSEncKey != nil {
+			return false
+		}
+	} else if !this.NwkSEncKey.Equal(*that1.NwkSEncKey) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DeviceManagerServer).DeleteDevice(ctx, req.(*DeviceIdentifier))
+	if this.DeviceProfileId != that1.DeviceProfileId {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _DeviceManager_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lorawan.DeviceManager",
-	HandlerType: (*DeviceManagerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetDevice",
-			Handler:    _DeviceManager_GetDevice_Handler,
-		},
-		{
-			MethodName: "SetDevice",
-			Handler:    _DeviceManager_SetDevice_Handler,
-		},
-		{
-			MethodName: "DeleteDevice",
-			Handler:    _DeviceManager_DeleteDevice_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "github.com/TheThingsNetwork/ttn/api/protocol/lorawan/device.proto",
-}
-
-func (m *DeviceIdentifier) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.ServiceProfileId != that1.ServiceProfileId {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.LastSeen != that1.LastSeen {
+		return false
+	}
+	if this.Battery != that1.Battery {
+		return false
+	}
+	if this.Margin != that1.Margin {
+		return false
+	}
+	if this.LastStatus != that1.LastStatus {
+		return false
+	}
+	return true
 }
+func (this *DeviceStats) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *DeviceIdentifier) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.AppEui != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDevice(dAtA, i, uint64(m.AppEui.Size()))
-		n1, err := m.AppEui.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*DeviceStats)
+	if !ok {
+		that2, ok := that.(DeviceStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DeviceStats")
 		}
-		i += n1
 	}
-	if m.DevEui != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintDevice(dAtA, i, uint64(m.DevEui.Size()))
-		n2, err := m.DevEui.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
-		i += n2
+		return fmt.Errorf("that is type *DeviceStats but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DeviceStats but is not nil && this == nil")
 	}
-	return i, nil
-}
-
-func (m *Device) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if that1.AppEui == nil {
+		if this.AppEui != nil {
+			return fmt.Errorf("this.AppEui != nil && that1.AppEui == nil")
+		}
+	} else if !this.AppEui.Equal(*that1.AppEui) {
+		return fmt.Errorf("AppEui this(%v) Not Equal that(%v)", this.AppEui, that1.AppEui)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Device) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.AppEui != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDevice(dAtA, i, uint64(m.AppEui.Size()))
-		n3, err := m.AppEui.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if that1.DevEui == nil {
+		if this.DevEui != nil {
+			return fmt.Errorf("this.DevEui != nil && that1.DevEui == nil")
 		}
-		i += n3
+	} else if !this.DevEui.Equal(*that1.DevEui) {
+		return fmt.Errorf("DevEui this(%v) Not Equal that(%v)", this.DevEui, that1.DevEui)
 	}
-	if m.DevEui != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintDevice(dAtA, i, uint64(m.DevEui.Size()))
-		n4, err := m.DevEui.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if this.Frames != that1.Frames {
+		return fmt.Errorf("Frames this(%v) Not Equal that(%v)", this.Frames, that1.Frames)
+	}
+	if this.FirstFCnt != that1.FirstFCnt {
+		return fmt.Errorf("FirstFCnt this(%v) Not Equal that(%v)", this.FirstFCnt, that1.FirstFCnt)
+	}
+	if this.LastFCnt != that1.LastFCnt {
+		return fmt.Errorf("LastFCnt this(%v) Not Equal that(%v)", this.LastFCnt, that1.LastFCnt)
+	}
+	if this.PacketLoss != that1.PacketLoss {
+		return fmt.Errorf("PacketLoss this(%v) Not Equal that(%v)", this.PacketLoss, that1.PacketLoss)
+	}
+	if !this.Snr.Equal(that1.Snr) {
+		return fmt.Errorf("Snr this(%v) Not Equal that(%v)", this.Snr, that1.Snr)
+	}
+	if !this.Rssi.Equal(that1.Rssi) {
+		return fmt.Errorf("Rssi this(%v) Not Equal that(%v)", this.Rssi, that1.Rssi)
+	}
+	if this.AverageGateways != that1.AverageGateways {
+		return fmt.Errorf("AverageGateways this(%v) Not Equal that(%v)", this.AverageGateways, that1.AverageGateways)
+	}
+	if len(this.Gateways) != len(that1.Gateways) {
+		return fmt.Errorf("Gateways this(%v) Not Equal that(%v)", len(this.Gateways), len(that1.Gateways))
+	}
+	for i := range this.Gateways {
+		if !this.Gateways[i].Equal(that1.Gateways[i]) {
+			return fmt.Errorf("Gateways this[%v](%v) Not Equal that[%v](%v)", i, this.Gateways[i], i, that1.Gateways[i])
 		}
-		i += n4
 	}
-	if len(m.AppId) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintDevice(dAtA, i, uint64(len(m.AppId)))
-		i += copy(dAtA[i:], m.AppId)
+	if len(this.DataRates) != len(that1.DataRates) {
+		return fmt.Errorf("DataRates this(%v) Not Equal that(%v)", len(this.DataRates), len(that1.DataRates))
 	}
-	if len(m.DevId) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintDevice(dAtA, i, uint64(len(m.DevId)))
-		i += copy(dAtA[i:], m.DevId)
+	for i := range this.DataRates {
+		if !this.DataRates[i].Equal(that1.DataRates[i]) {
+			return fmt.Errorf("DataRates this[%v](%v) Not Equal that[%v](%v)", i, this.DataRates[i], i, that1.DataRates[i])
+		}
 	}
-	if m.DevAddr != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintDevice(dAtA, i, uint64(m.DevAddr.Size()))
-		n5, err := m.DevAddr.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(this.Frequencies) != len(that1.Frequencies) {
+		return fmt.Errorf("Frequencies this(%v) Not Equal that(%v)", len(this.Frequencies), len(that1.Frequencies))
+	}
+	for i := range this.Frequencies {
+		if !this.Frequencies[i].Equal(that1.Frequencies[i]) {
+			return fmt.Errorf("Frequencies this[%v](%v) Not Equal that[%v](%v)", i, this.Frequencies[i], i, that1.Frequencies[i])
 		}
-		i += n5
 	}
-	if m.NwkSKey != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintDevice(dAtA, i, uint64(m.NwkSKey.Size()))
-		n6, err := m.NwkSKey.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	return nil
+}
+func (this *DeviceStats) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
 		}
-		i += n6
+		return false
 	}
-	if m.AppSKey != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintDevice(dAtA, i, uint64(m.AppSKey.Size()))
-		n7, err := m.AppSKey.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+
+	that1, ok := that.(*DeviceStats)
+	if !ok {
+		that2, ok := that.(DeviceStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n7
 	}
-	if m.AppKey != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintDevice(dAtA, i, uint64(m.AppKey.Size()))
-		n8, err := m.AppKey.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		if this == nil {
+			return true
 		}
-		i += n8
-	}
-	if m.FCntUp != 0 {
-		dAtA[i] = 0x48
-		i++
-		i = encodeVarintDevice(dAtA, i, uint64(m.FCntUp))
-	}
-	if m.FCntDown != 0 {
-		dAtA[i] = 0x50
-		i++
-		i = encodeVarintDevice(dAtA, i, uint64(m.FCntDown))
+		return false
+	} else if this == nil {
+		return false
 	}
-	if m.DisableFCntCheck {
-		dAtA[i] = 0x58
-		i++
-		if m.DisableFCntCheck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if that1.AppEui == nil {
+		if this.AppEui != nil {
+			return false
 		}
-		i++
+	} else if !this.AppEui.Equal(*that1.AppEui) {
+		return false
 	}
-	if m.Uses32BitFCnt {
-		dAtA[i] = 0x60
-		i++
-		if m.Uses32BitFCnt {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if that1.DevEui == nil {
+		if this.DevEui != nil {
+			return false
 		}
-		i++
+	} else if !this.DevEui.Equal(*that1.DevEui) {
+		return false
 	}
-	if len(m.ActivationConstraints) > 0 {
-		dAtA[i] = 0x6a
-		i++
-		i = encodeVarintDevice(dAtA, i, uint64(len(m.ActivationConstraints)))
-		i += copy(dAtA[i:], m.ActivationConstraints)
+	if this.Frames != that1.Frames {
+		return false
 	}
-	if m.LastSeen != 0 {
-		dAtA[i] = 0xa8
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintDevice(dAtA, i, uint64(m.LastSeen))
+	if this.FirstFCnt != that1.FirstFCnt {
+		return false
 	}
-	return i, nil
-}
-
-func encodeFixed64Device(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Device(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintDevice(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if this.LastFCnt != that1.LastFCnt {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *DeviceIdentifier) Size() (n int) {
-	var l int
-	_ = l
-	if m.AppEui != nil {
-		l = m.AppEui.Size()
-		n += 1 + l + sovDevice(uint64(l))
+	if this.PacketLoss != that1.PacketLoss {
+		return false
 	}
-	if m.DevEui != nil {
-		l = m.DevEui.Size()
-		n += 1 + l + sovDevice(uint64(l))
+	if !this.Snr.Equal(that1.Snr) {
+		return false
 	}
-	return n
-}
-
-func (m *Device) Size() (n int) {
-	var l int
-	_ = l
-	if m.AppEui != nil {
-		l = m.AppEui.Size()
-		n += 1 + l + sovDevice(uint64(l))
+	if !this.Rssi.Equal(that1.Rssi) {
+		return false
 	}
-	if m.DevEui != nil {
-		l = m.DevEui.Size()
-		n += 1 + l + sovDevice(uint64(l))
+	if this.AverageGateways != that1.AverageGateways {
+		return false
 	}
-	l = len(m.AppId)
-	if l > 0 {
-		n += 1 + l + sovDevice(uint64(l))
+	if len(this.Gateways) != len(that1.Gateways) {
+		return false
 	}
-	l = len(m.DevId)
-	if l > 0 {
-		n += 1 + l + sovDevice(uint64(l))
+	for i := range this.Gateways {
+		if !this.Gateways[i].Equal(that1.Gateways[i]) {
+			return false
+		}
 	}
-	if m.DevAddr != nil {
-		l = m.DevAddr.Size()
-		n += 1 + l + sovDevice(uint64(l))
+	if len(this.DataRates) != len(that1.DataRates) {
+		return false
 	}
-	if m.NwkSKey != nil {
-		l = m.NwkSKey.Size()
-		n += 1 + l + sovDevice(uint64(l))
+	for i := range this.DataRates {
+		if !this.DataRates[i].Equal(that1.DataRates[i]) {
+			return false
+		}
 	}
-	if m.AppSKey != nil {
-		l = m.AppSKey.Size()
-		n += 1 + l + sovDevice(uint64(l))
+	if len(this.Frequencies) != len(that1.Frequencies) {
+		return false
 	}
-	if m.AppKey != nil {
-		l = m.AppKey.Size()
-		n += 1 + l + sovDevice(uint64(l))
+	for i := range this.Frequencies {
+		if !this.Frequencies[i].Equal(that1.Frequencies[i]) {
+			return false
+		}
 	}
-	if m.FCntUp != 0 {
-		n += 1 + sovDevice(uint64(m.FCntUp))
+	return true
+}
+func (this *DeviceStats_Percentiles) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	if m.FCntDown != 0 {
-		n += 1 + sovDevice(uint64(m.FCntDown))
+
+	that1, ok := that.(*DeviceStats_Percentiles)
+	if !ok {
+		that2, ok := that.(DeviceStats_Percentiles)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DeviceStats_Percentiles")
+		}
 	}
-	if m.DisableFCntCheck {
-		n += 2
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DeviceStats_Percentiles but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DeviceStats_Percentiles but is not nil && this == nil")
 	}
-	if m.Uses32BitFCnt {
-		n += 2
+	if this.Min != that1.Min {
+		return fmt.Errorf("Min this(%v) Not Equal that(%v)", this.Min, that1.Min)
 	}
-	l = len(m.ActivationConstraints)
-	if l > 0 {
-		n += 1 + l + sovDevice(uint64(l))
+	if this.P10 != that1.P10 {
+		return fmt.Errorf("P10 this(%v) Not Equal that(%v)", this.P10, that1.P10)
 	}
-	if m.LastSeen != 0 {
-		n += 2 + sovDevice(uint64(m.LastSeen))
+	if this.Median != that1.Median {
+		return fmt.Errorf("Median this(%v) Not Equal that(%v)", this.Median, that1.Median)
 	}
-	return n
+	if this.P90 != that1.P90 {
+		return fmt.Errorf("P90 this(%v) Not Equal that(%v)", this.P90, that1.P90)
+	}
+	if this.Max != that1.Max {
+		return fmt.Errorf("Max this(%v) Not Equal that(%v)", this.Max, that1.Max)
+	}
+	return nil
 }
+func (this *DeviceStats_Percentiles) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-func sovDevice(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+	that1, ok := that.(*DeviceStats_Percentiles)
+	if !ok {
+		that2, ok := that.(DeviceStats_Percentiles)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
-}
-func sozDevice(x uint64) (n int) {
-	return sovDevice(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *DeviceIdentifier) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&DeviceIdentifier{`,
-		`AppEui:` + fmt.Sprintf("%v", this.AppEui) + `,`,
-		`DevEui:` + fmt.Sprintf("%v", this.DevEui) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Device) String() string {
-	if this == nil {
-		return "nil"
+	if this.Min != that1.Min {
+		return false
 	}
-	s := strings.Join([]string{`&Device{`,
-		`AppEui:` + fmt.Sprintf("%v", this.AppEui) + `,`,
-		`DevEui:` + fmt.Sprintf("%v", this.DevEui) + `,`,
-		`AppId:` + fmt.Sprintf("%v", this.AppId) + `,`,
-		`DevId:` + fmt.Sprintf("%v", this.DevId) + `,`,
-		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
-		`NwkSKey:` + fmt.Sprintf("%v", this.NwkSKey) + `,`,
-		`AppSKey:` + fmt.Sprintf("%v", this.AppSKey) + `,`,
-		`AppKey:` + fmt.Sprintf("%v", this.AppKey) + `,`,
-		`FCntUp:` + fmt.Sprintf("%v", this.FCntUp) + `,`,
-		`FCntDown:` + fmt.Sprintf("%v", this.FCntDown) + `,`,
-		`DisableFCntCheck:` + fmt.Sprintf("%v", this.DisableFCntCheck) + `,`,
-		`Uses32BitFCnt:` + fmt.Sprintf("%v", this.Uses32BitFCnt) + `,`,
-		`ActivationConstraints:` + fmt.Sprintf("%v", this.ActivationConstraints) + `,`,
-		`LastSeen:` + fmt.Sprintf("%v", this.LastSeen) + `,`,
-		`}`,
-	}, "")
-	return s
+	if this.P10 != that1.P10 {
+		return false
+	}
+	if this.Median != that1.Median {
+		return false
+	}
+	if this.P90 != that1.P90 {
+		return false
+	}
+	if this.Max != that1.Max {
+		return false
+	}
+	return true
 }
-func valueToStringDevice(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+func (this *DeviceStats_Gateway) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DeviceStats_Gateway)
+	if !ok {
+		that2, ok := that.(DeviceStats_Gateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DeviceStats_Gateway")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DeviceStats_Gateway but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DeviceStats_Gateway but is not nil && this == nil")
+	}
+	if this.GatewayId != that1.GatewayId {
+		return fmt.Errorf("GatewayId this(%v) Not Equal that(%v)", this.GatewayId, that1.GatewayId)
+	}
+	if this.Frames != that1.Frames {
+		return fmt.Errorf("Frames this(%v) Not Equal that(%v)", this.Frames, that1.Frames)
+	}
+	return nil
+}
+func (this *DeviceStats_Gateway) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DeviceStats_Gateway)
+	if !ok {
+		that2, ok := that.(DeviceStats_Gateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.GatewayId != that1.GatewayId {
+		return false
+	}
+	if this.Frames != that1.Frames {
+		return false
+	}
+	return true
+}
+func (this *DeviceStats_DataRate) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DeviceStats_DataRate)
+	if !ok {
+		that2, ok := that.(DeviceStats_DataRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DeviceStats_DataRate")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DeviceStats_DataRate but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DeviceStats_DataRate but is not nil && this == nil")
+	}
+	if this.DataRate != that1.DataRate {
+		return fmt.Errorf("DataRate this(%v) Not Equal that(%v)", this.DataRate, that1.DataRate)
+	}
+	if this.Frames != that1.Frames {
+		return fmt.Errorf("Frames this(%v) Not Equal that(%v)", this.Frames, that1.Frames)
+	}
+	return nil
+}
+func (this *DeviceStats_DataRate) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DeviceStats_DataRate)
+	if !ok {
+		that2, ok := that.(DeviceStats_DataRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.DataRate != that1.DataRate {
+		return false
+	}
+	if this.Frames != that1.Frames {
+		return false
+	}
+	return true
+}
+func (this *DeviceStats_Frequency) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DeviceStats_Frequency)
+	if !ok {
+		that2, ok := that.(DeviceStats_Frequency)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DeviceStats_Frequency")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DeviceStats_Frequency but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DeviceStats_Frequency but is not nil && this == nil")
+	}
+	if this.Frequency != that1.Frequency {
+		return fmt.Errorf("Frequency this(%v) Not Equal that(%v)", this.Frequency, that1.Frequency)
+	}
+	if this.Frames != that1.Frames {
+		return fmt.Errorf("Frames this(%v) Not Equal that(%v)", this.Frames, that1.Frames)
+	}
+	return nil
+}
+func (this *DeviceStats_Frequency) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DeviceStats_Frequency)
+	if !ok {
+		that2, ok := that.(DeviceStats_Frequency)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
+		return false
+	}
+	if this.Frames != that1.Frames {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for DeviceManager service
+
+type DeviceManagerClient interface {
+	GetDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*Device, error)
+	SetDevice(ctx context.Context, in *Device, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	DeleteDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	GetDeviceStats(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*DeviceStats, error)
+}
+
+type deviceManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDeviceManagerClient(cc *grpc.ClientConn) DeviceManagerClient {
+	return &deviceManagerClient{cc}
+}
+
+func (c *deviceManagerClient) GetDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*Device, error) {
+	out := new(Device)
+	err := grpc.Invoke(ctx, "/lorawan.DeviceManager/GetDevice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceManagerClient) SetDevice(ctx context.Context, in *Device, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/lorawan.DeviceManager/SetDevice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceManagerClient) DeleteDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/lorawan.DeviceManager/DeleteDevice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceManagerClient) GetDeviceStats(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*DeviceStats, error) {
+	out := new(DeviceStats)
+	err := grpc.Invoke(ctx, "/lorawan.DeviceManager/GetDeviceStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for DeviceManager service
+
+type DeviceManagerServer interface {
+	GetDevice(context.Context, *DeviceIdentifier) (*Device, error)
+	SetDevice(context.Context, *Device) (*google_protobuf.Empty, error)
+	DeleteDevice(context.Context, *DeviceIdentifier) (*google_protobuf.Empty, error)
+	GetDeviceStats(context.Context, *DeviceIdentifier) (*DeviceStats, error)
+}
+
+func RegisterDeviceManagerServer(s *grpc.Server, srv DeviceManagerServer) {
+	s.RegisterService(&_DeviceManager_serviceDesc, srv)
+}
+
+func _DeviceManager_GetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceManagerServer).GetDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lorawan.DeviceManager/GetDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceManagerServer).GetDevice(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceManager_SetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Device)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceManagerServer).SetDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lorawan.DeviceManager/SetDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceManagerServer).SetDevice(ctx, req.(*Device))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceManager_DeleteDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceManagerServer).DeleteDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lorawan.DeviceManager/DeleteDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceManagerServer).DeleteDevice(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceManager_GetDeviceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceManagerServer).GetDeviceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lorawan.DeviceManager/GetDeviceStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceManagerServer).GetDeviceStats(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DeviceManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lorawan.DeviceManager",
+	HandlerType: (*DeviceManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDevice",
+			Handler:    _DeviceManager_GetDevice_Handler,
+		},
+		{
+			MethodName: "SetDevice",
+			Handler:    _DeviceManager_SetDevice_Handler,
+		},
+		{
+			MethodName: "DeleteDevice",
+			Handler:    _DeviceManager_DeleteDevice_Handler,
+		},
+		{
+			MethodName: "GetDeviceStats",
+			Handler:    _DeviceManager_GetDeviceStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/TheThingsNetwork/ttn/api/protocol/lorawan/device.proto",
+}
+
+func (m *DeviceIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.AppEui != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.AppEui.Size()))
+		n1, err := m.AppEui.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.DevEui != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.DevEui.Size()))
+		n2, err := m.DevEui.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+
+func (m *Device) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Device) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.AppEui != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.AppEui.Size()))
+		n3, err := m.AppEui.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.DevEui != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.DevEui.Size()))
+		n4, err := m.DevEui.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if m.DevAddr != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.DevAddr.Size()))
+		n5, err := m.DevAddr.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.NwkSKey != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.NwkSKey.Size()))
+		n6, err := m.NwkSKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.AppSKey != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.AppSKey.Size()))
+		n7, err := m.AppSKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.AppKey != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.AppKey.Size()))
+		n8, err := m.AppKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.FCntUp != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.FCntUp))
+	}
+	if m.FCntDown != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.FCntDown))
+	}
+	if m.DisableFCntCheck {
+		dAtA[i] = 0x58
+		i++
+		if m.DisableFCntCheck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Uses32BitFCnt {
+		dAtA[i] = 0x60
+		i++
+		if m.Uses32BitFCnt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.ActivationConstraints) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.ActivationConstraints)))
+		i += copy(dAtA[i:], m.ActivationConstraints)
+	}
+	if m.DevStatusInterval != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.DevStatusInterval))
+	}
+	if m.Rx1DrOffset != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.Rx1DrOffset))
+	}
+	if len(m.Rx2DataRate) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Rx2DataRate)))
+		i += copy(dAtA[i:], m.Rx2DataRate)
+	}
+	if m.Rx2Frequency != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.Rx2Frequency))
+	}
+	if m.RxDelay != 0 {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.RxDelay))
+	}
+	if len(m.AdrStrategy) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.AdrStrategy)))
+		i += copy(dAtA[i:], m.AdrStrategy)
+	}
+	if m.AdrMargin != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.AdrMargin))
+	}
+	if m.LastSeen != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.LastSeen))
+	}
+	if m.Battery != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.Battery))
+	}
+	if m.Margin != 0 {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.Margin))
+	}
+	if m.LastStatus != 0 {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.LastStatus))
+	}
+	if m.DeviceClass != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.DeviceClass))
+	}
+	if m.BeaconFrequency != 0 {
+		dAtA[i] = 0xd0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.BeaconFrequency))
+	}
+	if m.PingSlotPeriodicity != 0 {
+		dAtA[i] = 0xd8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.PingSlotPeriodicity))
+	}
+	if m.MacVersion != 0 {
+		dAtA[i] = 0xe0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.MacVersion))
+	}
+	if m.NwkKey != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.NwkKey.Size()))
+		n9, err := m.NwkKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.SNwkSIntKey != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.SNwkSIntKey.Size()))
+		n10, err := m.SNwkSIntKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.NwkSEncKey != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.NwkSEncKey.Size()))
+		n11, err := m.NwkSEncKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if len(m.DeviceProfileId) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.DeviceProfileId)))
+		i += copy(dAtA[i:], m.DeviceProfileId)
+	}
+	if len(m.ServiceProfileId) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.ServiceProfileId)))
+		i += copy(dAtA[i:], m.ServiceProfileId)
+	}
+	if m.DisableDevStatus {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.DisableDevStatus {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *DeviceStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.AppEui != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.AppEui.Size()))
+		n12, err := m.AppEui.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.DevEui != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.DevEui.Size()))
+		n13, err := m.DevEui.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.Frames != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.Frames))
+	}
+	if m.FirstFCnt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.FirstFCnt))
+	}
+	if m.LastFCnt != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.LastFCnt))
+	}
+	if m.PacketLoss != 0 {
+		dAtA[i] = 0x35
+		i++
+		i = encodeFixed32Device(dAtA, i, uint32(math.Float32bits(float32(m.PacketLoss))))
+	}
+	if m.Snr != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.Snr.Size()))
+		n14, err := m.Snr.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.Rssi != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.Rssi.Size()))
+		n15, err := m.Rssi.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.AverageGateways != 0 {
+		dAtA[i] = 0x4d
+		i++
+		i = encodeFixed32Device(dAtA, i, uint32(math.Float32bits(float32(m.AverageGateways))))
+	}
+	if len(m.Gateways) > 0 {
+		for _, msg := range m.Gateways {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintDevice(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.DataRates) > 0 {
+		for _, msg := range m.DataRates {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintDevice(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Frequencies) > 0 {
+		for _, msg := range m.Frequencies {
+			dAtA[i] = 0x62
+			i++
+			i = encodeVarintDevice(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DeviceStats_Percentiles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceStats_Percentiles) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Min != 0 {
+		dAtA[i] = 0xd
+		i++
+		i = encodeFixed32Device(dAtA, i, uint32(math.Float32bits(float32(m.Min))))
+	}
+	if m.P10 != 0 {
+		dAtA[i] = 0x15
+		i++
+		i = encodeFixed32Device(dAtA, i, uint32(math.Float32bits(float32(m.P10))))
+	}
+	if m.Median != 0 {
+		dAtA[i] = 0x1d
+		i++
+		i = encodeFixed32Device(dAtA, i, uint32(math.Float32bits(float32(m.Median))))
+	}
+	if m.P90 != 0 {
+		dAtA[i] = 0x25
+		i++
+		i = encodeFixed32Device(dAtA, i, uint32(math.Float32bits(float32(m.P90))))
+	}
+	if m.Max != 0 {
+		dAtA[i] = 0x2d
+		i++
+		i = encodeFixed32Device(dAtA, i, uint32(math.Float32bits(float32(m.Max))))
+	}
+	return i, nil
+}
+
+func (m *DeviceStats_Gateway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceStats_Gateway) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.GatewayId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.GatewayId)))
+		i += copy(dAtA[i:], m.GatewayId)
+	}
+	if m.Frames != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.Frames))
+	}
+	return i, nil
+}
+
+func (m *DeviceStats_DataRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceStats_DataRate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataRate) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.DataRate)))
+		i += copy(dAtA[i:], m.DataRate)
+	}
+	if m.Frames != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.Frames))
+	}
+	return i, nil
+}
+
+func (m *DeviceStats_Frequency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceStats_Frequency) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Frequency != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.Frequency))
+	}
+	if m.Frames != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.Frames))
+	}
+	return i, nil
+}
+
+func encodeFixed64Device(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Device(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintDevice(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *DeviceIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	if m.AppEui != nil {
+		l = m.AppEui.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.DevEui != nil {
+		l = m.DevEui.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *Device) Size() (n int) {
+	var l int
+	_ = l
+	if m.AppEui != nil {
+		l = m.AppEui.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.DevEui != nil {
+		l = m.DevEui.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.DevAddr != nil {
+		l = m.DevAddr.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.NwkSKey != nil {
+		l = m.NwkSKey.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.AppSKey != nil {
+		l = m.AppSKey.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.AppKey != nil {
+		l = m.AppKey.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.FCntUp != 0 {
+		n += 1 + sovDevice(uint64(m.FCntUp))
+	}
+	if m.FCntDown != 0 {
+		n += 1 + sovDevice(uint64(m.FCntDown))
+	}
+	if m.DisableFCntCheck {
+		n += 2
+	}
+	if m.Uses32BitFCnt {
+		n += 2
+	}
+	l = len(m.ActivationConstraints)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.DevStatusInterval != 0 {
+		n += 1 + sovDevice(uint64(m.DevStatusInterval))
+	}
+	if m.Rx1DrOffset != 0 {
+		n += 1 + sovDevice(uint64(m.Rx1DrOffset))
+	}
+	l = len(m.Rx2DataRate)
+	if l > 0 {
+		n += 2 + l + sovDevice(uint64(l))
+	}
+	if m.Rx2Frequency != 0 {
+		n += 2 + sovDevice(uint64(m.Rx2Frequency))
+	}
+	if m.RxDelay != 0 {
+		n += 2 + sovDevice(uint64(m.RxDelay))
+	}
+	l = len(m.AdrStrategy)
+	if l > 0 {
+		n += 2 + l + sovDevice(uint64(l))
+	}
+	if m.AdrMargin != 0 {
+		n += 2 + sovDevice(uint64(m.AdrMargin))
+	}
+	if m.LastSeen != 0 {
+		n += 2 + sovDevice(uint64(m.LastSeen))
+	}
+	if m.Battery != 0 {
+		n += 2 + sovDevice(uint64(m.Battery))
+	}
+	if m.Margin != 0 {
+		n += 2 + sovDevice(uint64(m.Margin))
+	}
+	if m.LastStatus != 0 {
+		n += 2 + sovDevice(uint64(m.LastStatus))
+	}
+	if m.DeviceClass != 0 {
+		n += 2 + sovDevice(uint64(m.DeviceClass))
+	}
+	if m.BeaconFrequency != 0 {
+		n += 2 + sovDevice(uint64(m.BeaconFrequency))
+	}
+	if m.PingSlotPeriodicity != 0 {
+		n += 2 + sovDevice(uint64(m.PingSlotPeriodicity))
+	}
+	if m.MacVersion != 0 {
+		n += 2 + sovDevice(uint64(m.MacVersion))
+	}
+	if m.NwkKey != nil {
+		l = m.NwkKey.Size()
+		n += 2 + l + sovDevice(uint64(l))
+	}
+	if m.SNwkSIntKey != nil {
+		l = m.SNwkSIntKey.Size()
+		n += 2 + l + sovDevice(uint64(l))
+	}
+	if m.NwkSEncKey != nil {
+		l = m.NwkSEncKey.Size()
+		n += 2 + l + sovDevice(uint64(l))
+	}
+	l = len(m.DeviceProfileId)
+	if l > 0 {
+		n += 2 + l + sovDevice(uint64(l))
+	}
+	l = len(m.ServiceProfileId)
+	if l > 0 {
+		n += 2 + l + sovDevice(uint64(l))
+	}
+	if m.DisableDevStatus {
+		n += 3
+	}
+	return n
+}
+
+func (m *DeviceStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.AppEui != nil {
+		l = m.AppEui.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.DevEui != nil {
+		l = m.DevEui.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.Frames != 0 {
+		n += 1 + sovDevice(uint64(m.Frames))
+	}
+	if m.FirstFCnt != 0 {
+		n += 1 + sovDevice(uint64(m.FirstFCnt))
+	}
+	if m.LastFCnt != 0 {
+		n += 1 + sovDevice(uint64(m.LastFCnt))
+	}
+	if m.PacketLoss != 0 {
+		n += 5
+	}
+	if m.Snr != nil {
+		l = m.Snr.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.Rssi != nil {
+		l = m.Rssi.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.AverageGateways != 0 {
+		n += 5
+	}
+	if len(m.Gateways) > 0 {
+		for _, e := range m.Gateways {
+			l = e.Size()
+			n += 1 + l + sovDevice(uint64(l))
+		}
+	}
+	if len(m.DataRates) > 0 {
+		for _, e := range m.DataRates {
+			l = e.Size()
+			n += 1 + l + sovDevice(uint64(l))
+		}
+	}
+	if len(m.Frequencies) > 0 {
+		for _, e := range m.Frequencies {
+			l = e.Size()
+			n += 1 + l + sovDevice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeviceStats_Percentiles) Size() (n int) {
+	var l int
+	_ = l
+	if m.Min != 0 {
+		n += 5
+	}
+	if m.P10 != 0 {
+		n += 5
+	}
+	if m.Median != 0 {
+		n += 5
+	}
+	if m.P90 != 0 {
+		n += 5
+	}
+	if m.Max != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *DeviceStats_Gateway) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.GatewayId)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.Frames != 0 {
+		n += 1 + sovDevice(uint64(m.Frames))
+	}
+	return n
+}
+
+func (m *DeviceStats_DataRate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DataRate)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.Frames != 0 {
+		n += 1 + sovDevice(uint64(m.Frames))
+	}
+	return n
+}
+
+func (m *DeviceStats_Frequency) Size() (n int) {
+	var l int
+	_ = l
+	if m.Frequency != 0 {
+		n += 1 + sovDevice(uint64(m.Frequency))
+	}
+	if m.Frames != 0 {
+		n += 1 + sovDevice(uint64(m.Frames))
+	}
+	return n
+}
+
+func sovDevice(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozDevice(x uint64) (n int) {
+	return sovDevice(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DeviceIdentifier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceIdentifier{`,
+		`AppEui:` + fmt.Sprintf("%v", this.AppEui) + `,`,
+		`DevEui:` + fmt.Sprintf("%v", this.DevEui) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Device) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Device{`,
+		`AppEui:` + fmt.Sprintf("%v", this.AppEui) + `,`,
+		`DevEui:` + fmt.Sprintf("%v", this.DevEui) + `,`,
+		`AppId:` + fmt.Sprintf("%v", this.AppId) + `,`,
+		`DevId:` + fmt.Sprintf("%v", this.DevId) + `,`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`NwkSKey:` + fmt.Sprintf("%v", this.NwkSKey) + `,`,
+		`AppSKey:` + fmt.Sprintf("%v", this.AppSKey) + `,`,
+		`AppKey:` + fmt.Sprintf("%v", this.AppKey) + `,`,
+		`FCntUp:` + fmt.Sprintf("%v", this.FCntUp) + `,`,
+		`FCntDown:` + fmt.Sprintf("%v", this.FCntDown) + `,`,
+		`DisableFCntCheck:` + fmt.Sprintf("%v", this.DisableFCntCheck) + `,`,
+		`Uses32BitFCnt:` + fmt.Sprintf("%v", this.Uses32BitFCnt) + `,`,
+		`ActivationConstraints:` + fmt.Sprintf("%v", this.ActivationConstraints) + `,`,
+		`DevStatusInterval:` + fmt.Sprintf("%v", this.DevStatusInterval) + `,`,
+		`DisableDevStatus:` + fmt.Sprintf("%v", this.DisableDevStatus) + `,`,
+		`Rx1DrOffset:` + fmt.Sprintf("%v", this.Rx1DrOffset) + `,`,
+		`Rx2DataRate:` + fmt.Sprintf("%v", this.Rx2DataRate) + `,`,
+		`Rx2Frequency:` + fmt.Sprintf("%v", this.Rx2Frequency) + `,`,
+		`RxDelay:` + fmt.Sprintf("%v", this.RxDelay) + `,`,
+		`AdrStrategy:` + fmt.Sprintf("%v", this.AdrStrategy) + `,`,
+		`AdrMargin:` + fmt.Sprintf("%v", this.AdrMargin) + `,`,
+		`DeviceClass:` + fmt.Sprintf("%v", this.DeviceClass) + `,`,
+		`BeaconFrequency:` + fmt.Sprintf("%v", this.BeaconFrequency) + `,`,
+		`PingSlotPeriodicity:` + fmt.Sprintf("%v", this.PingSlotPeriodicity) + `,`,
+		`MacVersion:` + fmt.Sprintf("%v", this.MacVersion) + `,`,
+		`NwkKey:` + fmt.Sprintf("%v", this.NwkKey) + `,`,
+		`SNwkSIntKey:` + fmt.Sprintf("%v", this.SNwkSIntKey) + `,`,
+		`NwkSEncKey:` + fmt.Sprintf("%v", this.NwkSEncKey) + `,`,
+		`DeviceProfileId:` + fmt.Sprintf("%v", this.DeviceProfileId) + `,`,
+		`ServiceProfileId:` + fmt.Sprintf("%v", this.ServiceProfileId) + `,`,
+		`LastSeen:` + fmt.Sprintf("%v", this.LastSeen) + `,`,
+		`Battery:` + fmt.Sprintf("%v", this.Battery) + `,`,
+		`Margin:` + fmt.Sprintf("%v", this.Margin) + `,`,
+		`LastStatus:` + fmt.Sprintf("%v", this.LastStatus) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceStats{`,
+		`AppEui:` + fmt.Sprintf("%v", this.AppEui) + `,`,
+		`DevEui:` + fmt.Sprintf("%v", this.DevEui) + `,`,
+		`Frames:` + fmt.Sprintf("%v", this.Frames) + `,`,
+		`FirstFCnt:` + fmt.Sprintf("%v", this.FirstFCnt) + `,`,
+		`LastFCnt:` + fmt.Sprintf("%v", this.LastFCnt) + `,`,
+		`PacketLoss:` + fmt.Sprintf("%v", this.PacketLoss) + `,`,
+		`Snr:` + strings.Replace(fmt.Sprintf("%v", this.Snr), "DeviceStats_Percentiles", "DeviceStats_Percentiles", 1) + `,`,
+		`Rssi:` + strings.Replace(fmt.Sprintf("%v", this.Rssi), "DeviceStats_Percentiles", "DeviceStats_Percentiles", 1) + `,`,
+		`AverageGateways:` + fmt.Sprintf("%v", this.AverageGateways) + `,`,
+		`Gateways:` + strings.Replace(fmt.Sprintf("%v", this.Gateways), "DeviceStats_Gateway", "DeviceStats_Gateway", 1) + `,`,
+		`DataRates:` + strings.Replace(fmt.Sprintf("%v", this.DataRates), "DeviceStats_DataRate", "DeviceStats_DataRate", 1) + `,`,
+		`Frequencies:` + strings.Replace(fmt.Sprintf("%v", this.Frequencies), "DeviceStats_Frequency", "DeviceStats_Frequency", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceStats_Percentiles) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceStats_Percentiles{`,
+		`Min:` + fmt.Sprintf("%v", this.Min) + `,`,
+		`P10:` + fmt.Sprintf("%v", this.P10) + `,`,
+		`Median:` + fmt.Sprintf("%v", this.Median) + `,`,
+		`P90:` + fmt.Sprintf("%v", this.P90) + `,`,
+		`Max:` + fmt.Sprintf("%v", this.Max) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceStats_Gateway) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceStats_Gateway{`,
+		`GatewayId:` + fmt.Sprintf("%v", this.GatewayId) + `,`,
+		`Frames:` + fmt.Sprintf("%v", this.Frames) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceStats_DataRate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceStats_DataRate{`,
+		`DataRate:` + fmt.Sprintf("%v", this.DataRate) + `,`,
+		`Frames:` + fmt.Sprintf("%v", this.Frames) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceStats_Frequency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceStats_Frequency{`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`Frames:` + fmt.Sprintf("%v", this.Frames) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDevice(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DeviceIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.AppEUI
+			m.AppEui = &v
+			if err := m.AppEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.DevEUI
+			m.DevEui = &v
+			if err := m.DevEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Device) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Device: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Device: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.AppEUI
+			m.AppEui = &v
+			if err := m.AppEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.DevEUI
+			m.DevEui = &v
+			if err := m.DevEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.DevAddr
+			m.DevAddr = &v
+			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NwkSKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.NwkSKey
+			m.NwkSKey = &v
+			if err := m.NwkSKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.AppSKey
+			m.AppSKey = &v
+			if err := m.AppSKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.AppKey
+			m.AppKey = &v
+			if err := m.AppKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FCntUp", wireType)
+			}
+			m.FCntUp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FCntUp |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FCntDown", wireType)
+			}
+			m.FCntDown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FCntDown |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableFCntCheck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableFCntCheck = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uses32BitFCnt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Uses32BitFCnt = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationConstraints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivationConstraints = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevStatusInterval", wireType)
+			}
+			m.DevStatusInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DevStatusInterval |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DrOffset", wireType)
+			}
+			m.Rx1DrOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rx1DrOffset |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rx2DataRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
+			}
+			m.Rx2Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rx2Frequency |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxDelay", wireType)
+			}
+			m.RxDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RxDelay |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdrStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdrStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdrMargin", wireType)
+			}
+			m.AdrMargin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdrMargin |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeen", wireType)
+			}
+			m.LastSeen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSeen |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Battery", wireType)
+			}
+			m.Battery = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Battery |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			m.Margin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Margin |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStatus", wireType)
+			}
+			m.LastStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastStatus |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceClass", wireType)
+			}
+			m.DeviceClass = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviceClass |= (DeviceClass(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFrequency", wireType)
+			}
+			m.BeaconFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeaconFrequency |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotPeriodicity", wireType)
+			}
+			m.PingSlotPeriodicity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PingSlotPeriodicity |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MacVersion", wireType)
+			}
+			m.MacVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MacVersion |= (MACVersion(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NwkKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.AppKey
+			m.NwkKey = &v
+			if err := m.NwkKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SNwkSIntKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.NwkSKey
+			m.SNwkSIntKey = &v
+			if err := m.SNwkSIntKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NwkSEncKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.NwkSKey
+			m.NwkSEncKey = &v
+			if err := m.NwkSEncKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceProfileId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceProfileId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceProfileId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceProfileId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableDevStatus", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableDevStatus = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *DeviceIdentifier) Unmarshal(dAtA []byte) error {
+func (m *DeviceStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -893,30 +3614,165 @@ func (m *DeviceIdentifier) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowDevice
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.AppEUI
+			m.AppEui = &v
+			if err := m.AppEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.DevEUI
+			m.DevEui = &v
+			if err := m.DevEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
+			}
+			m.Frames = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frames |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstFCnt", wireType)
+			}
+			m.FirstFCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstFCnt |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFCnt", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.LastFCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFCnt |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeviceIdentifier: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeviceIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketLoss", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.PacketLoss = float32(math.Float32frombits(v))
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppEui", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snr", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -926,29 +3782,30 @@ func (m *DeviceIdentifier) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_TheThingsNetwork_ttn_core_types.AppEUI
-			m.AppEui = &v
-			if err := m.AppEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Snr == nil {
+				m.Snr = &DeviceStats_Percentiles{}
+			}
+			if err := m.Snr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevEui", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rssi", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -958,79 +3815,44 @@ func (m *DeviceIdentifier) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_TheThingsNetwork_ttn_core_types.DevEUI
-			m.DevEui = &v
-			if err := m.DevEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Rssi == nil {
+				m.Rssi = &DeviceStats_Percentiles{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDevice(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Rssi.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDevice
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Device) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDevice
+			iNdEx = postIndex
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageGateways", wireType)
 			}
-			if iNdEx >= l {
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Device: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Device: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.AverageGateways = float32(math.Float32frombits(v))
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppEui", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gateways", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -1040,29 +3862,28 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_TheThingsNetwork_ttn_core_types.AppEUI
-			m.AppEui = &v
-			if err := m.AppEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Gateways = append(m.Gateways, &DeviceStats_Gateway{})
+			if err := m.Gateways[len(m.Gateways)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevEui", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRates", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -1072,29 +3893,28 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_TheThingsNetwork_ttn_core_types.DevEUI
-			m.DevEui = &v
-			if err := m.DevEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DataRates = append(m.DataRates, &DeviceStats_DataRate{})
+			if err := m.DataRates[len(m.DataRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequencies", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -1104,24 +3924,196 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppId = string(dAtA[iNdEx:postIndex])
+			m.Frequencies = append(m.Frequencies, &DeviceStats_Frequency{})
+			if err := m.Frequencies[len(m.Frequencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceStats_Percentiles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Percentiles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Percentiles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Min = float32(math.Float32frombits(v))
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P10", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.P10 = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Median = float32(math.Float32frombits(v))
 		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P90", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.P90 = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Max = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceStats_Gateway) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gateway: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gateway: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1146,13 +4138,13 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DevId = string(dAtA[iNdEx:postIndex])
+			m.GatewayId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
 			}
-			var byteLen int
+			m.Frames = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -1162,93 +4154,66 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Frames |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthDevice
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_TheThingsNetwork_ttn_core_types.DevAddr
-			m.DevAddr = &v
-			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NwkSKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_TheThingsNetwork_ttn_core_types.NwkSKey
-			m.NwkSKey = &v
-			if err := m.NwkSKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppSKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthDevice
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceStats_DataRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_TheThingsNetwork_ttn_core_types.AppSKey
-			m.AppSKey = &v
-			if err := m.AppSKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRate", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -1258,29 +4223,26 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_TheThingsNetwork_ttn_core_types.AppKey
-			m.AppKey = &v
-			if err := m.AppKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DataRate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FCntUp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
 			}
-			m.FCntUp = 0
+			m.Frames = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -1290,75 +4252,66 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FCntUp |= (uint32(b) & 0x7F) << shift
+				m.Frames |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FCntDown", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.FCntDown = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FCntDown |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableFCntCheck", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceStats_Frequency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
 			}
-			m.DisableFCntCheck = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uses32BitFCnt", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Uses32BitFCnt = bool(v != 0)
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationConstraints", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Frequency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Frequency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
 			}
-			var stringLen uint64
+			m.Frequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -1368,26 +4321,16 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Frequency |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDevice
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActivationConstraints = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 21:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSeen", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
 			}
-			m.LastSeen = 0
+			m.Frames = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -1397,7 +4340,7 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastSeen |= (int64(b) & 0x7F) << shift
+				m.Frames |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1533,43 +4476,91 @@ func init() {
 }
 
 var fileDescriptorDevice = []byte{
-	// 602 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x3f, 0x6f, 0x13, 0x31,
-	0x18, 0xc6, 0x6b, 0x4a, 0xf3, 0xc7, 0x34, 0xa2, 0x32, 0x6a, 0x75, 0xa4, 0xe8, 0x1a, 0x75, 0x21,
-	0x4b, 0xef, 0x44, 0xff, 0xc0, 0x9c, 0x26, 0x01, 0x45, 0x88, 0x4a, 0x5c, 0x5b, 0x06, 0x96, 0x93,
-	0x73, 0x7e, 0x73, 0xb1, 0x92, 0xda, 0xd6, 0x9d, 0x2f, 0xa7, 0x6c, 0x2c, 0xec, 0x7c, 0x0c, 0x66,
-	0xbe, 0x01, 0x1b, 0x23, 0x23, 0xea, 0x50, 0xb5, 0xe1, 0x8b, 0x20, 0x9f, 0x53, 0x8a, 0x2a, 0xa1,
-	0x8a, 0x4c, 0x6c, 0xef, 0x3d, 0xcf, 0xe3, 0xdf, 0x6b, 0xc7, 0xf1, 0x8b, 0x5b, 0x31, 0xd7, 0xc3,
-	0xac, 0xef, 0x45, 0xf2, 0xcc, 0x3f, 0x19, 0xc2, 0xc9, 0x90, 0x8b, 0x38, 0x3d, 0x02, 0x9d, 0xcb,
-	0x64, 0xe4, 0x6b, 0x2d, 0x7c, 0xaa, 0xb8, 0xaf, 0x12, 0xa9, 0x65, 0x24, 0xc7, 0xfe, 0x58, 0x26,
-	0x34, 0xa7, 0xc2, 0x67, 0x30, 0xe1, 0x11, 0x78, 0x85, 0x4e, 0xca, 0x73, 0xb5, 0xbe, 0x19, 0x4b,
-	0x19, 0x8f, 0xc1, 0xc6, 0xfb, 0xd9, 0xc0, 0x87, 0x33, 0xa5, 0xa7, 0x36, 0x55, 0xdf, 0xf9, 0xa3,
-	0x51, 0x2c, 0x63, 0x79, 0x93, 0x32, 0x5f, 0xc5, 0x47, 0x51, 0xd9, 0xf8, 0xf6, 0x17, 0x84, 0xd7,
-	0x3a, 0x45, 0x97, 0x1e, 0x03, 0xa1, 0xf9, 0x80, 0x43, 0x42, 0x8e, 0x70, 0x99, 0x2a, 0x15, 0x42,
-	0xc6, 0x1d, 0xd4, 0x40, 0xcd, 0xd5, 0xc3, 0x83, 0xf3, 0x8b, 0xad, 0x67, 0x77, 0x9d, 0x20, 0x92,
-	0x09, 0xf8, 0x7a, 0xaa, 0x20, 0xf5, 0x5a, 0x4a, 0x75, 0x4f, 0x7b, 0x41, 0x89, 0x2a, 0xd5, 0xcd,
-	0xb8, 0xe1, 0x31, 0x98, 0x14, 0xbc, 0x7b, 0x0b, 0xf1, 0x3a, 0x30, 0x29, 0x78, 0x0c, 0x26, 0xdd,
-	0x8c, 0x6f, 0x7f, 0x2c, 0xe1, 0x92, 0xdd, 0xf4, 0xff, 0xbe, 0x55, 0xb2, 0x8e, 0x0d, 0x39, 0xe4,
-	0xcc, 0x59, 0x6e, 0xa0, 0x66, 0x35, 0x58, 0xa1, 0x4a, 0xf5, 0x98, 0x91, 0x4d, 0x1b, 0xce, 0x9c,
-	0xfb, 0x56, 0x66, 0x30, 0xe9, 0x31, 0xf2, 0x16, 0x57, 0x8c, 0x4c, 0x19, 0x4b, 0x9c, 0x95, 0xa2,
-	0xfd, 0xf3, 0xf3, 0x8b, 0xad, 0xdd, 0x7f, 0x6b, 0xdf, 0x62, 0x2c, 0x09, 0xcc, 0x29, 0x4c, 0x41,
-	0x02, 0x5c, 0x15, 0xf9, 0x28, 0x4c, 0xc3, 0x11, 0x4c, 0x9d, 0xd2, 0x42, 0xcc, 0xa3, 0x7c, 0x74,
-	0xfc, 0x1a, 0xa6, 0x41, 0x59, 0xd8, 0xc2, 0x30, 0xcd, 0xa1, 0x2c, 0xb3, 0xbc, 0x10, 0xb3, 0xa5,
-	0x94, 0x65, 0x52, 0x5b, 0x5c, 0x5f, 0xa4, 0x21, 0x56, 0x16, 0xbd, 0x48, 0x03, 0x34, 0x3f, 0xb7,
-	0xe1, 0x39, 0xb8, 0x32, 0x08, 0x23, 0xa1, 0xc3, 0x4c, 0x39, 0xd5, 0x06, 0x6a, 0xd6, 0x82, 0xd2,
-	0xa0, 0x2d, 0xf4, 0xa9, 0x22, 0x4f, 0x30, 0xb6, 0x0e, 0x93, 0xb9, 0x70, 0x70, 0xe1, 0x55, 0x8c,
-	0xd7, 0x91, 0xb9, 0x20, 0x3b, 0xf8, 0x11, 0xe3, 0x29, 0xed, 0x8f, 0x21, 0xb4, 0xa9, 0x68, 0x08,
-	0xd1, 0xc8, 0x79, 0xd0, 0x40, 0xcd, 0x4a, 0xb0, 0x36, 0xb7, 0x5e, 0xb6, 0x85, 0x6e, 0x1b, 0x9d,
-	0x3c, 0xc5, 0x6b, 0x59, 0x0a, 0xe9, 0xde, 0x6e, 0xd8, 0xe7, 0xda, 0xae, 0x70, 0x56, 0x8b, 0x6c,
-	0xcd, 0xea, 0x87, 0x5c, 0x9b, 0x34, 0x39, 0xc0, 0x1b, 0x34, 0xd2, 0x7c, 0x42, 0x35, 0x97, 0x22,
-	0x8c, 0xa4, 0x48, 0x75, 0x42, 0xb9, 0xd0, 0xa9, 0x53, 0x2b, 0xfe, 0x01, 0xeb, 0x37, 0x6e, 0xfb,
-	0xc6, 0x24, 0x9b, 0xb8, 0x3a, 0xa6, 0xa9, 0x0e, 0x53, 0x00, 0xe1, 0xac, 0x37, 0x50, 0x73, 0x39,
-	0xa8, 0x18, 0xe1, 0x18, 0x40, 0xec, 0x7e, 0x45, 0xb8, 0x66, 0xdf, 0xc1, 0x1b, 0x2a, 0x68, 0x0c,
-	0x09, 0x79, 0x81, 0xab, 0xaf, 0x40, 0xcf, 0xdf, 0xc6, 0x63, 0x6f, 0x3e, 0x31, 0xbc, 0xdb, 0x2f,
-	0xbc, 0xfe, 0xf0, 0x96, 0x45, 0xf6, 0x71, 0xf5, 0xf8, 0xf7, 0xc2, 0xdb, 0x6e, 0x7d, 0xc3, 0xb3,
-	0x23, 0xc7, 0xbb, 0x1e, 0x26, 0x5e, 0xd7, 0x8c, 0x1c, 0xd2, 0xc2, 0xab, 0x1d, 0x18, 0x83, 0x86,
-	0xbb, 0x3b, 0xfe, 0x05, 0x71, 0xf8, 0xee, 0xc7, 0x95, 0xbb, 0x74, 0x79, 0xe5, 0xa2, 0x0f, 0x33,
-	0x17, 0x7d, 0x9e, 0xb9, 0xe8, 0xdb, 0xcc, 0x45, 0xdf, 0x67, 0x2e, 0xba, 0x9c, 0xb9, 0xe8, 0xd3,
-	0x4f, 0x77, 0xe9, 0xfd, 0xfe, 0x22, 0xa3, 0xb3, 0x5f, 0x2a, 0x94, 0xbd, 0x5f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xe6, 0xf8, 0xc9, 0xd5, 0x79, 0x05, 0x00, 0x00,
+	// 1362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6e, 0x1b, 0xb7,
+	0x16, 0xce, 0xc8, 0x3f, 0x92, 0xce, 0xd8, 0xb1, 0x42, 0xc7, 0xbe, 0x8c, 0xec, 0xc8, 0x8a, 0x2f,
+	0x70, 0xaf, 0x6e, 0x70, 0x23, 0xc5, 0x8a, 0xd3, 0x34, 0x40, 0x81, 0x46, 0x96, 0x9c, 0x40, 0x68,
+	0x92, 0xa6, 0xa3, 0x24, 0x40, 0x8b, 0x02, 0x03, 0x7a, 0x86, 0x92, 0x09, 0x4b, 0x9c, 0x29, 0x49,
+	0xfd, 0xed, 0xba, 0xe8, 0x03, 0xf4, 0x31, 0xb2, 0x6d, 0x9f, 0xa2, 0xcb, 0x2e, 0x8b, 0x2c, 0x82,
+	0xc4, 0x7d, 0x91, 0x82, 0xe4, 0x48, 0x72, 0x0d, 0xbb, 0x69, 0x9d, 0x4d, 0x56, 0xe2, 0xf9, 0xce,
+	0x39, 0x1f, 0x0f, 0x67, 0xce, 0xf9, 0xa8, 0x81, 0x5a, 0x87, 0xa9, 0xc3, 0xfe, 0x41, 0x39, 0x88,
+	0x7a, 0x95, 0xe7, 0x87, 0xf4, 0xf9, 0x21, 0xe3, 0x1d, 0xf9, 0x94, 0xaa, 0x61, 0x24, 0x8e, 0x2a,
+	0x4a, 0xf1, 0x0a, 0x89, 0x59, 0x25, 0x16, 0x91, 0x8a, 0x82, 0xa8, 0x5b, 0xe9, 0x46, 0x82, 0x0c,
+	0x09, 0xaf, 0x84, 0x74, 0xc0, 0x02, 0x5a, 0x36, 0x38, 0x4a, 0x27, 0x68, 0x7e, 0xa3, 0x13, 0x45,
+	0x9d, 0x2e, 0xb5, 0xe1, 0x07, 0xfd, 0x76, 0x85, 0xf6, 0x62, 0x35, 0xb6, 0x51, 0xf9, 0x5b, 0x27,
+	0x36, 0xea, 0x44, 0x9d, 0x68, 0x16, 0xa5, 0x2d, 0x63, 0x98, 0x55, 0x12, 0xfe, 0x9f, 0x73, 0xf7,
+	0x4e, 0x7e, 0x6d, 0xdc, 0xf6, 0xcf, 0x0e, 0xe4, 0x1a, 0xa6, 0x9a, 0x66, 0x48, 0xb9, 0x62, 0x6d,
+	0x46, 0x05, 0x7a, 0x0a, 0x69, 0x12, 0xc7, 0x3e, 0xed, 0x33, 0xec, 0x14, 0x9d, 0xd2, 0xd2, 0xde,
+	0xdd, 0xd7, 0x6f, 0xb6, 0x76, 0xde, 0x77, 0xd2, 0x20, 0x12, 0xb4, 0xa2, 0xc6, 0x31, 0x95, 0xe5,
+	0x5a, 0x1c, 0xef, 0xbf, 0x68, 0x7a, 0x8b, 0x24, 0x8e, 0xf7, 0xfb, 0x4c, 0xf3, 0x85, 0x74, 0x60,
+	0xf8, 0x52, 0x17, 0xe2, 0x6b, 0xd0, 0x81, 0xe1, 0x0b, 0xe9, 0x60, 0xbf, 0xcf, 0xb6, 0x7f, 0x5a,
+	0x86, 0x45, 0x5b, 0xf4, 0xc7, 0x5e, 0x2a, 0x5a, 0x03, 0xcd, 0xec, 0xb3, 0x10, 0xcf, 0x15, 0x9d,
+	0x52, 0xd6, 0x5b, 0x20, 0x71, 0xdc, 0x0c, 0x35, 0xac, 0xb7, 0x61, 0x21, 0x9e, 0xb7, 0x70, 0x48,
+	0x07, 0xcd, 0x10, 0x7d, 0x05, 0x19, 0x0d, 0x93, 0x30, 0x14, 0x78, 0xc1, 0x6c, 0xff, 0xc9, 0xeb,
+	0x37, 0x5b, 0xd5, 0x7f, 0xb6, 0x7d, 0x2d, 0x0c, 0x85, 0x97, 0x0e, 0xed, 0x02, 0x79, 0x90, 0xe5,
+	0xc3, 0x23, 0x5f, 0xfa, 0x47, 0x74, 0x8c, 0x17, 0x2f, 0xc4, 0xf9, 0x74, 0x78, 0xd4, 0xfa, 0x82,
+	0x8e, 0xbd, 0x34, 0xb7, 0x0b, 0xcd, 0xa9, 0x0f, 0x65, 0x39, 0xd3, 0x17, 0xe2, 0xac, 0xc5, 0xb1,
+	0xe5, 0x24, 0x76, 0x31, 0x79, 0x91, 0x9a, 0x31, 0x73, 0xd1, 0x17, 0xa9, 0x09, 0xf5, 0xe3, 0xd6,
+	0x7c, 0x18, 0x32, 0x6d, 0x3f, 0xe0, 0xca, 0xef, 0xc7, 0x38, 0x5b, 0x74, 0x4a, 0xcb, 0xde, 0x62,
+	0xbb, 0xce, 0xd5, 0x8b, 0x18, 0x6d, 0x02, 0x58, 0x4f, 0x18, 0x0d, 0x39, 0x06, 0xe3, 0xcb, 0x68,
+	0x5f, 0x23, 0x1a, 0x72, 0x74, 0x0b, 0x56, 0x43, 0x26, 0xc9, 0x41, 0x97, 0xfa, 0x36, 0x2a, 0x38,
+	0xa4, 0xc1, 0x11, 0x76, 0x8b, 0x4e, 0x29, 0xe3, 0xe5, 0x12, 0xd7, 0xc3, 0x3a, 0x57, 0x75, 0x8d,
+	0xa3, 0xff, 0x42, 0xae, 0x2f, 0xa9, 0xbc, 0x53, 0xf5, 0x0f, 0x98, 0xb2, 0x19, 0x78, 0xc9, 0xc4,
+	0x2e, 0x5b, 0x7c, 0x8f, 0x29, 0x1d, 0x8d, 0xee, 0xc2, 0x3a, 0x09, 0x14, 0x1b, 0x10, 0xc5, 0x22,
+	0xee, 0x07, 0x11, 0x97, 0x4a, 0x10, 0xc6, 0x95, 0xc4, 0xcb, 0xa6, 0x03, 0xd6, 0x66, 0xde, 0xfa,
+	0xcc, 0x89, 0xca, 0xb0, 0xaa, 0x3b, 0x42, 0x2a, 0xa2, 0xfa, 0xd2, 0x67, 0x5c, 0x51, 0x31, 0x20,
+	0x5d, 0x7c, 0xd9, 0x54, 0x7d, 0x25, 0xa4, 0x83, 0x96, 0xf1, 0x34, 0x13, 0x07, 0xfa, 0x3f, 0xa0,
+	0x49, 0xf9, 0xb3, 0x3c, 0xbc, 0xfd, 0xa7, 0xea, 0x1b, 0x93, 0x2c, 0xb4, 0x0d, 0xcb, 0x62, 0xb4,
+	0xe3, 0x87, 0xc2, 0x8f, 0xda, 0x6d, 0x49, 0x15, 0x5e, 0x31, 0xbc, 0xae, 0x18, 0xed, 0x34, 0xc4,
+	0x97, 0x06, 0xb2, 0x31, 0x55, 0x3f, 0x24, 0x8a, 0xf8, 0x82, 0x28, 0x8a, 0x73, 0xa6, 0x5e, 0x57,
+	0x8c, 0xaa, 0x0d, 0xa2, 0x88, 0x47, 0x14, 0x45, 0xff, 0xb6, 0x31, 0x6d, 0x41, 0xbf, 0xeb, 0x53,
+	0x1e, 0x8c, 0xf1, 0x95, 0xa2, 0x53, 0x9a, 0xf7, 0x96, 0xc4, 0xa8, 0xfa, 0x70, 0x82, 0xa1, 0x6b,
+	0x90, 0x11, 0x23, 0x3f, 0xa4, 0x5d, 0x32, 0xc6, 0xc8, 0xec, 0x93, 0x16, 0xa3, 0x86, 0x36, 0xd1,
+	0x0d, 0x58, 0x22, 0xa1, 0xf0, 0xf5, 0xa9, 0x15, 0xed, 0x8c, 0xf1, 0xaa, 0xdd, 0x82, 0x84, 0xa2,
+	0x95, 0x40, 0xe8, 0x3a, 0x80, 0x0e, 0xe9, 0x11, 0xd1, 0x61, 0x1c, 0x5f, 0x35, 0xf9, 0x59, 0x12,
+	0x8a, 0x27, 0x06, 0x40, 0xf7, 0x60, 0xc9, 0x8a, 0xaa, 0x1f, 0x74, 0x89, 0x94, 0xf8, 0x5a, 0xd1,
+	0x29, 0x5d, 0xae, 0x5e, 0x2d, 0x4f, 0xd4, 0xce, 0xca, 0x45, 0x5d, 0xfb, 0x3c, 0x37, 0x9c, 0x19,
+	0xe8, 0x7f, 0x90, 0x3b, 0xa0, 0x24, 0x88, 0xf8, 0x89, 0xea, 0xf3, 0xa6, 0xfa, 0x15, 0x8b, 0xcf,
+	0x0e, 0x50, 0x85, 0xb5, 0x98, 0xf1, 0x8e, 0x2f, 0xbb, 0x91, 0xf2, 0x63, 0x2a, 0x58, 0x14, 0xb2,
+	0x80, 0xa9, 0x31, 0xde, 0x30, 0xd5, 0xac, 0x6a, 0x67, 0xab, 0x1b, 0xa9, 0x67, 0x33, 0x17, 0xda,
+	0x05, 0xb7, 0x47, 0x02, 0x7f, 0x40, 0x85, 0x64, 0x11, 0xc7, 0x9b, 0xa6, 0xac, 0xd5, 0x69, 0x59,
+	0x4f, 0x6a, 0xf5, 0x97, 0xd6, 0xe5, 0x41, 0x8f, 0x04, 0xc9, 0x5a, 0x0f, 0x83, 0x1e, 0x5a, 0x3d,
+	0x0c, 0xd7, 0x3f, 0x68, 0x18, 0xf8, 0xf0, 0x48, 0x0f, 0xc3, 0xb7, 0xb0, 0x22, 0x7d, 0x2b, 0x03,
+	0x8c, 0x2b, 0xc3, 0x5b, 0xf8, 0x20, 0x29, 0x70, 0xa5, 0x5e, 0x35, 0xb9, 0xd2, 0xec, 0x5f, 0xc3,
+	0xb2, 0xe5, 0xa6, 0x3c, 0x30, 0xdc, 0x5b, 0x1f, 0xc4, 0x0d, 0x5a, 0x66, 0xf6, 0x79, 0xa0, 0xa9,
+	0x6f, 0xc2, 0x95, 0xe4, 0xb5, 0xc6, 0x22, 0x6a, 0xb3, 0x2e, 0xd5, 0x92, 0x59, 0x34, 0xdd, 0xb1,
+	0x62, 0x1d, 0xcf, 0x2c, 0xde, 0x0c, 0x75, 0xeb, 0x4b, 0x2a, 0x4e, 0x07, 0xdf, 0x30, 0xc1, 0xb9,
+	0xc4, 0x33, 0x8b, 0xde, 0x80, 0x6c, 0x97, 0x48, 0xe5, 0x4b, 0x4a, 0x39, 0x5e, 0x2b, 0x3a, 0xa5,
+	0x39, 0x2f, 0xa3, 0x81, 0x16, 0xa5, 0x1c, 0x61, 0x48, 0x1f, 0x10, 0xa5, 0xa8, 0x18, 0xe3, 0x75,
+	0xdb, 0xa9, 0x89, 0x89, 0xd6, 0x61, 0x31, 0x69, 0xc1, 0x7f, 0x15, 0x9d, 0xd2, 0x82, 0x97, 0x58,
+	0x68, 0x0b, 0x5c, 0x4b, 0x67, 0x07, 0x0e, 0x1b, 0x42, 0x30, 0x84, 0x06, 0xd9, 0x7e, 0x95, 0x06,
+	0xd7, 0x36, 0xa1, 0x06, 0xe4, 0x47, 0x7f, 0x71, 0xad, 0xc3, 0x62, 0x5b, 0x90, 0x1e, 0x95, 0x78,
+	0x2e, 0x51, 0x4f, 0x63, 0xa1, 0x02, 0xb8, 0x6d, 0x26, 0xe4, 0x44, 0xeb, 0xe6, 0xed, 0x20, 0x1a,
+	0xc8, 0xe8, 0xdc, 0x26, 0x98, 0x53, 0x27, 0xee, 0x05, 0xab, 0xae, 0x5d, 0x92, 0x78, 0xb7, 0xc0,
+	0x8d, 0x49, 0x70, 0x44, 0x95, 0xdf, 0x8d, 0xa4, 0x34, 0xf7, 0x51, 0xca, 0x03, 0x0b, 0x3d, 0x8e,
+	0xa4, 0x44, 0x55, 0x98, 0x93, 0x5c, 0x98, 0x4b, 0xc5, 0xad, 0x16, 0x4f, 0x8d, 0xaf, 0x79, 0x72,
+	0xe5, 0x67, 0x54, 0x04, 0xfa, 0xaf, 0x4a, 0x97, 0x4a, 0x4f, 0x07, 0xa3, 0x5d, 0x98, 0x17, 0x52,
+	0x32, 0x9c, 0xf9, 0x9b, 0x49, 0x26, 0x5a, 0x0f, 0x3e, 0x19, 0x50, 0x41, 0x3a, 0xd4, 0xef, 0x10,
+	0x45, 0x87, 0x64, 0x2c, 0xcd, 0x45, 0x91, 0xf2, 0x56, 0x12, 0xfc, 0x51, 0x02, 0xa3, 0x4f, 0x21,
+	0x33, 0x0d, 0x81, 0xe2, 0x5c, 0xc9, 0xad, 0x6e, 0x9e, 0xb9, 0x49, 0x92, 0xe0, 0x4d, 0xa3, 0xd1,
+	0x67, 0x00, 0x53, 0xe1, 0x94, 0xd8, 0x35, 0xb9, 0xd7, 0xcf, 0xcc, 0x9d, 0x68, 0xa9, 0x97, 0x0d,
+	0x93, 0x95, 0x44, 0x0f, 0xc0, 0x9d, 0x88, 0x12, 0xa3, 0x12, 0x2f, 0x99, 0xf4, 0xc2, 0x99, 0xe9,
+	0x53, 0x95, 0xf2, 0x4e, 0xa6, 0xe4, 0x7b, 0xe0, 0x9e, 0x38, 0x39, 0xca, 0xc1, 0x5c, 0x8f, 0x71,
+	0xd3, 0x70, 0x29, 0x4f, 0x2f, 0x35, 0x12, 0xef, 0xdc, 0x36, 0x2d, 0x93, 0xf2, 0xf4, 0xd2, 0x74,
+	0x38, 0x0d, 0x19, 0xe1, 0xe6, 0xc5, 0xa7, 0xbc, 0xc4, 0x32, 0x91, 0xf7, 0x6f, 0xe3, 0xf9, 0x24,
+	0xf2, 0xfe, 0x6d, 0xc3, 0x46, 0x46, 0x78, 0x21, 0x61, 0x23, 0xa3, 0xfc, 0x03, 0x48, 0x27, 0xcf,
+	0x40, 0xeb, 0x75, 0xf2, 0x14, 0xf4, 0x14, 0x3a, 0x66, 0x0a, 0xb3, 0x09, 0xd2, 0x0c, 0x4f, 0xb4,
+	0x57, 0xea, 0x64, 0x7b, 0xe5, 0x3f, 0x87, 0xcc, 0xf4, 0x56, 0xd9, 0x80, 0xec, 0xec, 0xd6, 0xb1,
+	0x0c, 0x99, 0xc9, 0xc3, 0x39, 0x97, 0xa0, 0x06, 0xd9, 0x99, 0x62, 0x6f, 0x42, 0x76, 0xa6, 0xea,
+	0x8e, 0x51, 0xf5, 0x19, 0x70, 0x1e, 0xc5, 0xcd, 0xdd, 0xc9, 0xa4, 0xda, 0x1b, 0xc2, 0x85, 0x74,
+	0xfd, 0x71, 0xad, 0xd5, 0xf2, 0x6b, 0xb9, 0x4b, 0x33, 0x63, 0x2f, 0xe7, 0xcc, 0x8c, 0x7a, 0x2e,
+	0x55, 0xfd, 0x21, 0x05, 0xcb, 0x36, 0xed, 0x09, 0xe1, 0xa4, 0x43, 0x05, 0xba, 0x07, 0xd9, 0x47,
+	0x54, 0x59, 0x0c, 0x5d, 0x3b, 0xf5, 0xda, 0x66, 0x7f, 0xb7, 0xf3, 0x2b, 0xa7, 0x5c, 0x68, 0x17,
+	0xb2, 0xad, 0x69, 0xe2, 0x69, 0x6f, 0x7e, 0xbd, 0x6c, 0xbf, 0x13, 0xca, 0x93, 0x2f, 0x80, 0xf2,
+	0xbe, 0xfe, 0x4e, 0x40, 0x35, 0x58, 0x6a, 0xd0, 0x2e, 0x55, 0xf4, 0xfd, 0x3b, 0x9e, 0x4f, 0x71,
+	0x79, 0x5a, 0xb1, 0x95, 0xa9, 0xbf, 0x20, 0xb9, 0x7a, 0x56, 0x23, 0xee, 0xbd, 0xfc, 0xed, 0x5d,
+	0xe1, 0xd2, 0xdb, 0x77, 0x05, 0xe7, 0xfb, 0xe3, 0x82, 0xf3, 0xea, 0xb8, 0xe0, 0xfc, 0x72, 0x5c,
+	0x70, 0x7e, 0x3d, 0x2e, 0x38, 0x6f, 0x8f, 0x0b, 0xce, 0x8f, 0xbf, 0x17, 0x2e, 0x7d, 0xb3, 0x7b,
+	0x91, 0x4f, 0xa6, 0x83, 0x45, 0x83, 0xdc, 0xf9, 0x63, 0x00, 0x81, 0xb2, 0x40, 0x5a, 0x71, 0x0d,
+	0x00, 0x00,
 }
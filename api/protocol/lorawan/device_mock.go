@@ -79,6 +79,22 @@ func (_mr *_MockDeviceManagerClientRecorder) DeleteDevice(arg0, arg1 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteDevice", _s...)
 }
 
+func (_m *MockDeviceManagerClient) GetDeviceStats(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*DeviceStats, error) {
+	_s := []interface{}{ctx, in}
+	for _, _x := range opts {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GetDeviceStats", _s...)
+	ret0, _ := ret[0].(*DeviceStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockDeviceManagerClientRecorder) GetDeviceStats(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetDeviceStats", _s...)
+}
+
 // Mock of DeviceManagerServer interface
 type MockDeviceManagerServer struct {
 	ctrl     *gomock.Controller
@@ -132,3 +148,14 @@ func (_m *MockDeviceManagerServer) DeleteDevice(_param0 context.Context, _param1
 func (_mr *_MockDeviceManagerServerRecorder) DeleteDevice(arg0, arg1 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteDevice", arg0, arg1)
 }
+
+func (_m *MockDeviceManagerServer) GetDeviceStats(_param0 context.Context, _param1 *DeviceIdentifier) (*DeviceStats, error) {
+	ret := _m.ctrl.Call(_m, "GetDeviceStats", _param0, _param1)
+	ret0, _ := ret[0].(*DeviceStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockDeviceManagerServerRecorder) GetDeviceStats(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetDeviceStats", arg0, arg1)
+}
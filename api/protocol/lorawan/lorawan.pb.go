@@ -22,6 +22,8 @@
 		JoinAcceptPayload
 		DLSettings
 		CFList
+		FCntAnomaly
+		DeviceStatus
 */
 package lorawan
 
@@ -134,6 +136,29 @@ func (x Major) String() string {
 }
 func (Major) EnumDescriptor() ([]byte, []int) { return fileDescriptorLorawan, []int{2} }
 
+type MACVersion int32
+
+const (
+	// LoRaWAN 1.0.x
+	MACVersion_LORAWAN_1_0 MACVersion = 0
+	// LoRaWAN 1.1
+	MACVersion_LORAWAN_1_1 MACVersion = 1
+)
+
+var MACVersion_name = map[int32]string{
+	0: "LORAWAN_1_0",
+	1: "LORAWAN_1_1",
+}
+var MACVersion_value = map[string]int32{
+	"LORAWAN_1_0": 0,
+	"LORAWAN_1_1": 1,
+}
+
+func (x MACVersion) String() string {
+	return proto.EnumName(MACVersion_name, int32(x))
+}
+func (MACVersion) EnumDescriptor() ([]byte, []int) { return fileDescriptorLorawan, []int{3} }
+
 type MType int32
 
 const (
@@ -165,7 +190,38 @@ var MType_value = map[string]int32{
 func (x MType) String() string {
 	return proto.EnumName(MType_name, int32(x))
 }
-func (MType) EnumDescriptor() ([]byte, []int) { return fileDescriptorLorawan, []int{3} }
+func (MType) EnumDescriptor() ([]byte, []int) { return fileDescriptorLorawan, []int{4} }
+
+type FCntAnomaly_Type int32
+
+const (
+	// The frame counter is lower than the previous frame counter
+	FCntAnomaly_RESET FCntAnomaly_Type = 0
+	// The frame counter is much higher than the previous frame counter
+	FCntAnomaly_GAP FCntAnomaly_Type = 1
+	// The 16 bit frame counter rolled over, or the frame counter of a device with 16 bit frame counters exceeded 16 bits
+	FCntAnomaly_ROLLOVER_16 FCntAnomaly_Type = 2
+	// The 32 bit frame counter rolled over
+	FCntAnomaly_ROLLOVER_32 FCntAnomaly_Type = 3
+)
+
+var FCntAnomaly_Type_name = map[int32]string{
+	0: "RESET",
+	1: "GAP",
+	2: "ROLLOVER_16",
+	3: "ROLLOVER_32",
+}
+var FCntAnomaly_Type_value = map[string]int32{
+	"RESET":       0,
+	"GAP":         1,
+	"ROLLOVER_16": 2,
+	"ROLLOVER_32": 3,
+}
+
+func (x FCntAnomaly_Type) String() string {
+	return proto.EnumName(FCntAnomaly_Type_name, int32(x))
+}
+func (FCntAnomaly_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptorLorawan, []int{13, 0} }
 
 type Metadata struct {
 	Modulation Modulation `protobuf:"varint,11,opt,name=modulation,proto3,enum=lorawan.Modulation" json:"modulation,omitempty"`
@@ -178,6 +234,10 @@ type Metadata struct {
 	// Store the full 32 bit FCnt (deprecated; do not use)
 	FCnt          uint32        `protobuf:"varint,15,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
 	FrequencyPlan FrequencyPlan `protobuf:"varint,16,opt,name=frequency_plan,json=frequencyPlan,proto3,enum=lorawan.FrequencyPlan" json:"frequency_plan,omitempty"`
+	// The status of the device, as last reported in a DevStatusAns (set by the NetworkServer)
+	DeviceStatus *DeviceStatus `protobuf:"bytes,17,opt,name=device_status,json=deviceStatus" json:"device_status,omitempty"`
+	// Unexpected changes of the frame counters of the device since the previous uplink message (set by the NetworkServer)
+	FCntAnomalies []*FCntAnomaly `protobuf:"bytes,18,rep,name=f_cnt_anomalies,json=fCntAnomalies" json:"f_cnt_anomalies,omitempty"`
 }
 
 func (m *Metadata) Reset()                    { *m = Metadata{} }
@@ -226,6 +286,20 @@ func (m *Metadata) GetFrequencyPlan() FrequencyPlan {
 	return FrequencyPlan_EU_863_870
 }
 
+func (m *Metadata) GetDeviceStatus() *DeviceStatus {
+	if m != nil {
+		return m.DeviceStatus
+	}
+	return nil
+}
+
+func (m *Metadata) GetFCntAnomalies() []*FCntAnomaly {
+	if m != nil {
+		return m.FCntAnomalies
+	}
+	return nil
+}
+
 type TxConfiguration struct {
 	Modulation Modulation `protobuf:"varint,11,opt,name=modulation,proto3,enum=lorawan.Modulation" json:"modulation,omitempty"`
 	// LoRa data rate - SF{spreadingfactor}BW{bandwidth}
@@ -278,10 +352,14 @@ func (m *TxConfiguration) GetFCnt() uint32 {
 }
 
 type ActivationMetadata struct {
-	AppEui        *github_com_TheThingsNetwork_ttn_core_types.AppEUI  `protobuf:"bytes,1,opt,name=app_eui,json=appEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.AppEUI" json:"app_eui,omitempty"`
-	DevEui        *github_com_TheThingsNetwork_ttn_core_types.DevEUI  `protobuf:"bytes,2,opt,name=dev_eui,json=devEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.DevEUI" json:"dev_eui,omitempty"`
-	DevAddr       *github_com_TheThingsNetwork_ttn_core_types.DevAddr `protobuf:"bytes,3,opt,name=dev_addr,json=devAddr,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.DevAddr" json:"dev_addr,omitempty"`
-	NwkSKey       *github_com_TheThingsNetwork_ttn_core_types.NwkSKey `protobuf:"bytes,4,opt,name=nwk_s_key,json=nwkSKey,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.NwkSKey" json:"nwk_s_key,omitempty"`
+	AppEui  *github_com_TheThingsNetwork_ttn_core_types.AppEUI  `protobuf:"bytes,1,opt,name=app_eui,json=appEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.AppEUI" json:"app_eui,omitempty"`
+	DevEui  *github_com_TheThingsNetwork_ttn_core_types.DevEUI  `protobuf:"bytes,2,opt,name=dev_eui,json=devEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.DevEUI" json:"dev_eui,omitempty"`
+	DevAddr *github_com_TheThingsNetwork_ttn_core_types.DevAddr `protobuf:"bytes,3,opt,name=dev_addr,json=devAddr,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.DevAddr" json:"dev_addr,omitempty"`
+	NwkSKey *github_com_TheThingsNetwork_ttn_core_types.NwkSKey `protobuf:"bytes,4,opt,name=nwk_s_key,json=nwkSKey,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.NwkSKey" json:"nwk_s_key,omitempty"`
+	// LoRaWAN 1.1 session keys; the NwkSKey is the FNwkSIntKey
+	SNwkSIntKey   *github_com_TheThingsNetwork_ttn_core_types.NwkSKey `protobuf:"bytes,5,opt,name=s_nwk_s_int_key,json=sNwkSIntKey,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.NwkSKey" json:"s_nwk_s_int_key,omitempty"`
+	NwkSEncKey    *github_com_TheThingsNetwork_ttn_core_types.NwkSKey `protobuf:"bytes,6,opt,name=nwk_s_enc_key,json=nwkSEncKey,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.NwkSKey" json:"nwk_s_enc_key,omitempty"`
+	MacVersion    MACVersion                                          `protobuf:"varint,7,opt,name=mac_version,json=macVersion,proto3,enum=lorawan.MACVersion" json:"mac_version,omitempty"`
 	Rx1DrOffset   uint32                                              `protobuf:"varint,11,opt,name=rx1_dr_offset,json=rx1DrOffset,proto3" json:"rx1_dr_offset,omitempty"`
 	Rx2Dr         uint32                                              `protobuf:"varint,12,opt,name=rx2_dr,json=rx2Dr,proto3" json:"rx2_dr,omitempty"`
 	RxDelay       uint32                                              `protobuf:"varint,13,opt,name=rx_delay,json=rxDelay,proto3" json:"rx_delay,omitempty"`
@@ -293,6 +371,13 @@ func (m *ActivationMetadata) Reset()                    { *m = ActivationMetadat
 func (*ActivationMetadata) ProtoMessage()               {}
 func (*ActivationMetadata) Descriptor() ([]byte, []int) { return fileDescriptorLorawan, []int{2} }
 
+func (m *ActivationMetadata) GetMacVersion() MACVersion {
+	if m != nil {
+		return m.MacVersion
+	}
+	return MACVersion_LORAWAN_1_0
+}
+
 func (m *ActivationMetadata) GetRx1DrOffset() uint32 {
 	if m != nil {
 		return m.Rx1DrOffset
@@ -709,6 +794,80 @@ func (m *CFList) GetFreq() []uint32 {
 	return nil
 }
 
+type FCntAnomaly struct {
+	Type FCntAnomaly_Type `protobuf:"varint,1,opt,name=type,proto3,enum=lorawan.FCntAnomaly.Type" json:"type,omitempty"`
+	// Indicates whether the anomaly is in the downlink frame counter
+	Downlink     bool   `protobuf:"varint,2,opt,name=downlink,proto3" json:"downlink,omitempty"`
+	FCnt         uint32 `protobuf:"varint,3,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	PreviousFCnt uint32 `protobuf:"varint,4,opt,name=previous_f_cnt,json=previousFCnt,proto3" json:"previous_f_cnt,omitempty"`
+}
+
+func (m *FCntAnomaly) Reset()                    { *m = FCntAnomaly{} }
+func (*FCntAnomaly) ProtoMessage()               {}
+func (*FCntAnomaly) Descriptor() ([]byte, []int) { return fileDescriptorLorawan, []int{13} }
+
+func (m *FCntAnomaly) GetType() FCntAnomaly_Type {
+	if m != nil {
+		return m.Type
+	}
+	return FCntAnomaly_RESET
+}
+
+func (m *FCntAnomaly) GetDownlink() bool {
+	if m != nil {
+		return m.Downlink
+	}
+	return false
+}
+
+func (m *FCntAnomaly) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func (m *FCntAnomaly) GetPreviousFCnt() uint32 {
+	if m != nil {
+		return m.PreviousFCnt
+	}
+	return 0
+}
+
+type DeviceStatus struct {
+	// Battery level: 0 for an external power source, 1..254 for the battery level, 255 if the device could not measure it
+	Battery uint32 `protobuf:"varint,1,opt,name=battery,proto3" json:"battery,omitempty"`
+	// Demodulation margin (in dB) of the last DevStatusReq
+	Margin int32 `protobuf:"varint,2,opt,name=margin,proto3" json:"margin,omitempty"`
+	// When the device reported this status (Unix nanoseconds)
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *DeviceStatus) Reset()                    { *m = DeviceStatus{} }
+func (*DeviceStatus) ProtoMessage()               {}
+func (*DeviceStatus) Descriptor() ([]byte, []int) { return fileDescriptorLorawan, []int{14} }
+
+func (m *DeviceStatus) GetBattery() uint32 {
+	if m != nil {
+		return m.Battery
+	}
+	return 0
+}
+
+func (m *DeviceStatus) GetMargin() int32 {
+	if m != nil {
+		return m.Margin
+	}
+	return 0
+}
+
+func (m *DeviceStatus) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Metadata)(nil), "lorawan.Metadata")
 	proto.RegisterType((*TxConfiguration)(nil), "lorawan.TxConfiguration")
@@ -723,10 +882,14 @@ func init() {
 	proto.RegisterType((*JoinAcceptPayload)(nil), "lorawan.JoinAcceptPayload")
 	proto.RegisterType((*DLSettings)(nil), "lorawan.DLSettings")
 	proto.RegisterType((*CFList)(nil), "lorawan.CFList")
+	proto.RegisterType((*FCntAnomaly)(nil), "lorawan.FCntAnomaly")
+	proto.RegisterType((*DeviceStatus)(nil), "lorawan.DeviceStatus")
 	proto.RegisterEnum("lorawan.Modulation", Modulation_name, Modulation_value)
 	proto.RegisterEnum("lorawan.FrequencyPlan", FrequencyPlan_name, FrequencyPlan_value)
 	proto.RegisterEnum("lorawan.Major", Major_name, Major_value)
+	proto.RegisterEnum("lorawan.MACVersion", MACVersion_name, MACVersion_value)
 	proto.RegisterEnum("lorawan.MType", MType_name, MType_value)
+	proto.RegisterEnum("lorawan.FCntAnomaly.Type", FCntAnomaly_Type_name, FCntAnomaly_Type_value)
 }
 func (this *Metadata) VerboseEqual(that interface{}) error {
 	if that == nil {
@@ -771,6 +934,17 @@ func (this *Metadata) VerboseEqual(that interface{}) error {
 	if this.FrequencyPlan != that1.FrequencyPlan {
 		return fmt.Errorf("FrequencyPlan this(%v) Not Equal that(%v)", this.FrequencyPlan, that1.FrequencyPlan)
 	}
+	if !this.DeviceStatus.Equal(that1.DeviceStatus) {
+		return fmt.Errorf("DeviceStatus this(%v) Not Equal that(%v)", this.DeviceStatus, that1.DeviceStatus)
+	}
+	if len(this.FCntAnomalies) != len(that1.FCntAnomalies) {
+		return fmt.Errorf("FCntAnomalies this(%v) Not Equal that(%v)", len(this.FCntAnomalies), len(that1.FCntAnomalies))
+	}
+	for i := range this.FCntAnomalies {
+		if !this.FCntAnomalies[i].Equal(that1.FCntAnomalies[i]) {
+			return fmt.Errorf("FCntAnomalies this[%v](%v) Not Equal that[%v](%v)", i, this.FCntAnomalies[i], i, that1.FCntAnomalies[i])
+		}
+	}
 	return nil
 }
 func (this *Metadata) Equal(that interface{}) bool {
@@ -816,6 +990,17 @@ func (this *Metadata) Equal(that interface{}) bool {
 	if this.FrequencyPlan != that1.FrequencyPlan {
 		return false
 	}
+	if !this.DeviceStatus.Equal(that1.DeviceStatus) {
+		return false
+	}
+	if len(this.FCntAnomalies) != len(that1.FCntAnomalies) {
+		return false
+	}
+	for i := range this.FCntAnomalies {
+		if !this.FCntAnomalies[i].Equal(that1.FCntAnomalies[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *TxConfiguration) VerboseEqual(that interface{}) error {
@@ -955,6 +1140,23 @@ func (this *ActivationMetadata) VerboseEqual(that interface{}) error {
 	} else if !this.NwkSKey.Equal(*that1.NwkSKey) {
 		return fmt.Errorf("NwkSKey this(%v) Not Equal that(%v)", this.NwkSKey, that1.NwkSKey)
 	}
+	if that1.SNwkSIntKey == nil {
+		if this.SNwkSIntKey != nil {
+			return fmt.Errorf("this.SNwkSIntKey != nil && that1.SNwkSIntKey == nil")
+		}
+	} else if !this.SNwkSIntKey.Equal(*that1.SNwkSIntKey) {
+		return fmt.Errorf("SNwkSIntKey this(%v) Not Equal that(%v)", this.SNwkSIntKey, that1.SNwkSIntKey)
+	}
+	if that1.NwkSEncKey == nil {
+		if this.NwkSEncKey != nil {
+			return fmt.Errorf("this.NwkSEncKey != nil && that1.NwkSEncKey == nil")
+		}
+	} else if !this.NwkSEncKey.Equal(*that1.NwkSEncKey) {
+		return fmt.Errorf("NwkSEncKey this(%v) Not Equal that(%v)", this.NwkSEncKey, that1.NwkSEncKey)
+	}
+	if this.MacVersion != that1.MacVersion {
+		return fmt.Errorf("MacVersion this(%v) Not Equal that(%v)", this.MacVersion, that1.MacVersion)
+	}
 	if this.Rx1DrOffset != that1.Rx1DrOffset {
 		return fmt.Errorf("Rx1DrOffset this(%v) Not Equal that(%v)", this.Rx1DrOffset, that1.Rx1DrOffset)
 	}
@@ -1025,6 +1227,23 @@ func (this *ActivationMetadata) Equal(that interface{}) bool {
 	} else if !this.NwkSKey.Equal(*that1.NwkSKey) {
 		return false
 	}
+	if that1.SNwkSIntKey == nil {
+		if this.SNwkSIntKey != nil {
+			return false
+		}
+	} else if !this.SNwkSIntKey.Equal(*that1.SNwkSIntKey) {
+		return false
+	}
+	if that1.NwkSEncKey == nil {
+		if this.NwkSEncKey != nil {
+			return false
+		}
+	} else if !this.NwkSEncKey.Equal(*that1.NwkSEncKey) {
+		return false
+	}
+	if this.MacVersion != that1.MacVersion {
+		return false
+	}
 	if this.Rx1DrOffset != that1.Rx1DrOffset {
 		return false
 	}
@@ -1980,6 +2199,156 @@ func (this *CFList) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *FCntAnomaly) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*FCntAnomaly)
+	if !ok {
+		that2, ok := that.(FCntAnomaly)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *FCntAnomaly")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *FCntAnomaly but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *FCntAnomaly but is not nil && this == nil")
+	}
+	if this.Type != that1.Type {
+		return fmt.Errorf("Type this(%v) Not Equal that(%v)", this.Type, that1.Type)
+	}
+	if this.Downlink != that1.Downlink {
+		return fmt.Errorf("Downlink this(%v) Not Equal that(%v)", this.Downlink, that1.Downlink)
+	}
+	if this.FCnt != that1.FCnt {
+		return fmt.Errorf("FCnt this(%v) Not Equal that(%v)", this.FCnt, that1.FCnt)
+	}
+	if this.PreviousFCnt != that1.PreviousFCnt {
+		return fmt.Errorf("PreviousFCnt this(%v) Not Equal that(%v)", this.PreviousFCnt, that1.PreviousFCnt)
+	}
+	return nil
+}
+func (this *FCntAnomaly) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*FCntAnomaly)
+	if !ok {
+		that2, ok := that.(FCntAnomaly)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Downlink != that1.Downlink {
+		return false
+	}
+	if this.FCnt != that1.FCnt {
+		return false
+	}
+	if this.PreviousFCnt != that1.PreviousFCnt {
+		return false
+	}
+	return true
+}
+func (this *DeviceStatus) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DeviceStatus)
+	if !ok {
+		that2, ok := that.(DeviceStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DeviceStatus")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DeviceStatus but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DeviceStatus but is not nil && this == nil")
+	}
+	if this.Battery != that1.Battery {
+		return fmt.Errorf("Battery this(%v) Not Equal that(%v)", this.Battery, that1.Battery)
+	}
+	if this.Margin != that1.Margin {
+		return fmt.Errorf("Margin this(%v) Not Equal that(%v)", this.Margin, that1.Margin)
+	}
+	if this.Time != that1.Time {
+		return fmt.Errorf("Time this(%v) Not Equal that(%v)", this.Time, that1.Time)
+	}
+	return nil
+}
+func (this *DeviceStatus) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DeviceStatus)
+	if !ok {
+		that2, ok := that.(DeviceStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Battery != that1.Battery {
+		return false
+	}
+	if this.Margin != that1.Margin {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	return true
+}
 func (m *Metadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2029,6 +2398,32 @@ func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintLorawan(dAtA, i, uint64(m.FrequencyPlan))
 	}
+	if m.DeviceStatus != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintLorawan(dAtA, i, uint64(m.DeviceStatus.Size()))
+		n1, err := m.DeviceStatus.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.FCntAnomalies) > 0 {
+		for _, msg := range m.FCntAnomalies {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintLorawan(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -2096,41 +2491,66 @@ func (m *ActivationMetadata) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintLorawan(dAtA, i, uint64(m.AppEui.Size()))
-		n1, err := m.AppEui.MarshalTo(dAtA[i:])
+		n2, err := m.AppEui.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n1
+		i += n2
 	}
 	if m.DevEui != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintLorawan(dAtA, i, uint64(m.DevEui.Size()))
-		n2, err := m.DevEui.MarshalTo(dAtA[i:])
+		n3, err := m.DevEui.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n3
 	}
 	if m.DevAddr != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintLorawan(dAtA, i, uint64(m.DevAddr.Size()))
-		n3, err := m.DevAddr.MarshalTo(dAtA[i:])
+		n4, err := m.DevAddr.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n4
 	}
 	if m.NwkSKey != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintLorawan(dAtA, i, uint64(m.NwkSKey.Size()))
-		n4, err := m.NwkSKey.MarshalTo(dAtA[i:])
+		n5, err := m.NwkSKey.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n5
+	}
+	if m.SNwkSIntKey != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintLorawan(dAtA, i, uint64(m.SNwkSIntKey.Size()))
+		n6, err := m.SNwkSIntKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.NwkSEncKey != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintLorawan(dAtA, i, uint64(m.NwkSEncKey.Size()))
+		n7, err := m.NwkSEncKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.MacVersion != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MacVersion))
 	}
 	if m.Rx1DrOffset != 0 {
 		dAtA[i] = 0x58
@@ -2151,11 +2571,11 @@ func (m *ActivationMetadata) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x72
 		i++
 		i = encodeVarintLorawan(dAtA, i, uint64(m.CfList.Size()))
-		n5, err := m.CfList.MarshalTo(dAtA[i:])
+		n8, err := m.CfList.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n8
 	}
 	if m.FrequencyPlan != 0 {
 		dAtA[i] = 0x78
@@ -2183,11 +2603,11 @@ func (m *Message) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintLorawan(dAtA, i, uint64(m.MHDR.Size()))
-	n6, err := m.MHDR.MarshalTo(dAtA[i:])
+	n9, err := m.MHDR.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n9
 	if len(m.Mic) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -2195,11 +2615,11 @@ func (m *Message) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], m.Mic)
 	}
 	if m.Payload != nil {
-		nn7, err := m.Payload.MarshalTo(dAtA[i:])
+		nn10, err := m.Payload.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn7
+		i += nn10
 	}
 	return i, nil
 }
@@ -2210,11 +2630,11 @@ func (m *Message_MacPayload) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintLorawan(dAtA, i, uint64(m.MacPayload.Size()))
-		n8, err := m.MacPayload.MarshalTo(dAtA[i:])
+		n11, err := m.MacPayload.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n11
 	}
 	return i, nil
 }
@@ -2224,11 +2644,11 @@ func (m *Message_JoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintLorawan(dAtA, i, uint64(m.JoinRequestPayload.Size()))
-		n9, err := m.JoinRequestPayload.MarshalTo(dAtA[i:])
+		n12, err := m.JoinRequestPayload.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n12
 	}
 	return i, nil
 }
@@ -2238,11 +2658,11 @@ func (m *Message_JoinAcceptPayload) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintLorawan(dAtA, i, uint64(m.JoinAcceptPayload.Size()))
-		n10, err := m.JoinAcceptPayload.MarshalTo(dAtA[i:])
+		n13, err := m.JoinAcceptPayload.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n13
 	}
 	return i, nil
 }
@@ -2292,11 +2712,11 @@ func (m *MACPayload) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintLorawan(dAtA, i, uint64(m.FHDR.Size()))
-	n11, err := m.FHDR.MarshalTo(dAtA[i:])
+	n14, err := m.FHDR.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n14
 	if m.FPort != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -2329,19 +2749,19 @@ func (m *FHDR) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintLorawan(dAtA, i, uint64(m.DevAddr.Size()))
-	n12, err := m.DevAddr.MarshalTo(dAtA[i:])
+	n15, err := m.DevAddr.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n15
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintLorawan(dAtA, i, uint64(m.FCtrl.Size()))
-	n13, err := m.FCtrl.MarshalTo(dAtA[i:])
+	n16, err := m.FCtrl.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n13
+	i += n16
 	if m.FCnt != 0 {
 		dAtA[i] = 0x18
 		i++
@@ -2467,27 +2887,27 @@ func (m *JoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintLorawan(dAtA, i, uint64(m.AppEui.Size()))
-	n14, err := m.AppEui.MarshalTo(dAtA[i:])
+	n17, err := m.AppEui.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n17
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintLorawan(dAtA, i, uint64(m.DevEui.Size()))
-	n15, err := m.DevEui.MarshalTo(dAtA[i:])
+	n18, err := m.DevEui.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
+	i += n18
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintLorawan(dAtA, i, uint64(m.DevNonce.Size()))
-	n16, err := m.DevNonce.MarshalTo(dAtA[i:])
+	n19, err := m.DevNonce.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n19
 	return i, nil
 }
 
@@ -2515,35 +2935,35 @@ func (m *JoinAcceptPayload) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintLorawan(dAtA, i, uint64(m.AppNonce.Size()))
-	n17, err := m.AppNonce.MarshalTo(dAtA[i:])
+	n20, err := m.AppNonce.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n20
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintLorawan(dAtA, i, uint64(m.NetId.Size()))
-	n18, err := m.NetId.MarshalTo(dAtA[i:])
+	n21, err := m.NetId.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n21
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintLorawan(dAtA, i, uint64(m.DevAddr.Size()))
-	n19, err := m.DevAddr.MarshalTo(dAtA[i:])
+	n22, err := m.DevAddr.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n22
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintLorawan(dAtA, i, uint64(m.DLSettings.Size()))
-	n20, err := m.DLSettings.MarshalTo(dAtA[i:])
+	n23, err := m.DLSettings.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n23
 	if m.RxDelay != 0 {
 		dAtA[i] = 0x30
 		i++
@@ -2553,11 +2973,11 @@ func (m *JoinAcceptPayload) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintLorawan(dAtA, i, uint64(m.CfList.Size()))
-		n21, err := m.CfList.MarshalTo(dAtA[i:])
+		n24, err := m.CfList.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n24
 	}
 	return i, nil
 }
@@ -2606,21 +3026,97 @@ func (m *CFList) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.Freq) > 0 {
-		dAtA23 := make([]byte, len(m.Freq)*10)
-		var j22 int
+		dAtA26 := make([]byte, len(m.Freq)*10)
+		var j25 int
 		for _, num := range m.Freq {
 			for num >= 1<<7 {
-				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j22++
+				j25++
 			}
-			dAtA23[j22] = uint8(num)
-			j22++
+			dAtA26[j25] = uint8(num)
+			j25++
 		}
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintLorawan(dAtA, i, uint64(j22))
-		i += copy(dAtA[i:], dAtA23[:j22])
+		i = encodeVarintLorawan(dAtA, i, uint64(j25))
+		i += copy(dAtA[i:], dAtA26[:j25])
+	}
+	return i, nil
+}
+
+func (m *FCntAnomaly) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FCntAnomaly) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Type != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Type))
+	}
+	if m.Downlink {
+		dAtA[i] = 0x10
+		i++
+		if m.Downlink {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.FCnt != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintLorawan(dAtA, i, uint64(m.FCnt))
+	}
+	if m.PreviousFCnt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintLorawan(dAtA, i, uint64(m.PreviousFCnt))
+	}
+	return i, nil
+}
+
+func (m *DeviceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Battery != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Battery))
+	}
+	if m.Margin != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Margin))
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Time))
 	}
 	return i, nil
 }
@@ -2675,6 +3171,16 @@ func (m *Metadata) Size() (n int) {
 	if m.FrequencyPlan != 0 {
 		n += 2 + sovLorawan(uint64(m.FrequencyPlan))
 	}
+	if m.DeviceStatus != nil {
+		l = m.DeviceStatus.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	if len(m.FCntAnomalies) > 0 {
+		for _, e := range m.FCntAnomalies {
+			l = e.Size()
+			n += 2 + l + sovLorawan(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2720,6 +3226,17 @@ func (m *ActivationMetadata) Size() (n int) {
 		l = m.NwkSKey.Size()
 		n += 1 + l + sovLorawan(uint64(l))
 	}
+	if m.SNwkSIntKey != nil {
+		l = m.SNwkSIntKey.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	if m.NwkSEncKey != nil {
+		l = m.NwkSEncKey.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	if m.MacVersion != 0 {
+		n += 1 + sovLorawan(uint64(m.MacVersion))
+	}
 	if m.Rx1DrOffset != 0 {
 		n += 1 + sovLorawan(uint64(m.Rx1DrOffset))
 	}
@@ -2920,6 +3437,39 @@ func (m *CFList) Size() (n int) {
 	return n
 }
 
+func (m *FCntAnomaly) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovLorawan(uint64(m.Type))
+	}
+	if m.Downlink {
+		n += 2
+	}
+	if m.FCnt != 0 {
+		n += 1 + sovLorawan(uint64(m.FCnt))
+	}
+	if m.PreviousFCnt != 0 {
+		n += 1 + sovLorawan(uint64(m.PreviousFCnt))
+	}
+	return n
+}
+
+func (m *DeviceStatus) Size() (n int) {
+	var l int
+	_ = l
+	if m.Battery != 0 {
+		n += 1 + sovLorawan(uint64(m.Battery))
+	}
+	if m.Margin != 0 {
+		n += 1 + sovLorawan(uint64(m.Margin))
+	}
+	if m.Time != 0 {
+		n += 1 + sovLorawan(uint64(m.Time))
+	}
+	return n
+}
+
 func sovLorawan(x uint64) (n int) {
 	for {
 		n++
@@ -2944,6 +3494,8 @@ func (this *Metadata) String() string {
 		`CodingRate:` + fmt.Sprintf("%v", this.CodingRate) + `,`,
 		`FCnt:` + fmt.Sprintf("%v", this.FCnt) + `,`,
 		`FrequencyPlan:` + fmt.Sprintf("%v", this.FrequencyPlan) + `,`,
+		`DeviceStatus:` + strings.Replace(fmt.Sprintf("%v", this.DeviceStatus), "DeviceStatus", "DeviceStatus", 1) + `,`,
+		`FCntAnomalies:` + strings.Replace(fmt.Sprintf("%v", this.FCntAnomalies), "FCntAnomaly", "FCntAnomaly", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2971,6 +3523,9 @@ func (this *ActivationMetadata) String() string {
 		`DevEui:` + fmt.Sprintf("%v", this.DevEui) + `,`,
 		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
 		`NwkSKey:` + fmt.Sprintf("%v", this.NwkSKey) + `,`,
+		`SNwkSIntKey:` + fmt.Sprintf("%v", this.SNwkSIntKey) + `,`,
+		`NwkSEncKey:` + fmt.Sprintf("%v", this.NwkSEncKey) + `,`,
+		`MacVersion:` + fmt.Sprintf("%v", this.MacVersion) + `,`,
 		`Rx1DrOffset:` + fmt.Sprintf("%v", this.Rx1DrOffset) + `,`,
 		`Rx2Dr:` + fmt.Sprintf("%v", this.Rx2Dr) + `,`,
 		`RxDelay:` + fmt.Sprintf("%v", this.RxDelay) + `,`,
@@ -3131,6 +3686,31 @@ func (this *CFList) String() string {
 	}, "")
 	return s
 }
+func (this *FCntAnomaly) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FCntAnomaly{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Downlink:` + fmt.Sprintf("%v", this.Downlink) + `,`,
+		`FCnt:` + fmt.Sprintf("%v", this.FCnt) + `,`,
+		`PreviousFCnt:` + fmt.Sprintf("%v", this.PreviousFCnt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceStatus{`,
+		`Battery:` + fmt.Sprintf("%v", this.Battery) + `,`,
+		`Margin:` + fmt.Sprintf("%v", this.Margin) + `,`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringLorawan(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -3302,6 +3882,70 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeviceStatus == nil {
+				m.DeviceStatus = &DeviceStatus{}
+			}
+			if err := m.DeviceStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FCntAnomalies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FCntAnomalies = append(m.FCntAnomalies, &FCntAnomaly{})
+			if err := m.FCntAnomalies[len(m.FCntAnomalies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -3645,6 +4289,89 @@ func (m *ActivationMetadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SNwkSIntKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.NwkSKey
+			m.SNwkSIntKey = &v
+			if err := m.SNwkSIntKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NwkSEncKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.NwkSKey
+			m.NwkSEncKey = &v
+			if err := m.NwkSEncKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MacVersion", wireType)
+			}
+			m.MacVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MacVersion |= (MACVersion(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 11:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DrOffset", wireType)
@@ -5183,6 +5910,240 @@ func (m *CFList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FCntAnomaly) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FCntAnomaly: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FCntAnomaly: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (FCntAnomaly_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Downlink = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FCnt", wireType)
+			}
+			m.FCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FCnt |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousFCnt", wireType)
+			}
+			m.PreviousFCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousFCnt |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Battery", wireType)
+			}
+			m.Battery = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Battery |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			m.Margin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Margin |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLorawan(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5293,90 +6254,107 @@ func init() {
 }
 
 var fileDescriptorLorawan = []byte{
-	// 1354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x4f, 0x1b, 0x47,
-	0x1f, 0xf7, 0x62, 0xaf, 0x6d, 0xfe, 0xc6, 0xb0, 0x99, 0x24, 0xcf, 0xe3, 0x27, 0x89, 0x0c, 0xb2,
-	0x9e, 0x4a, 0x08, 0xb5, 0xbc, 0xd8, 0x21, 0x40, 0xab, 0x44, 0x32, 0xb6, 0x69, 0x48, 0xc0, 0x26,
-	0x03, 0x6e, 0xaa, 0x5e, 0x46, 0xc3, 0xee, 0x2c, 0x2c, 0xf6, 0xbe, 0x64, 0x3c, 0xbc, 0xb8, 0xa7,
-	0x7e, 0x84, 0x9e, 0x7a, 0xe8, 0x17, 0x68, 0xa5, 0x5e, 0x7b, 0xe8, 0x47, 0xc8, 0x31, 0x97, 0x4a,
-	0x55, 0x0e, 0x28, 0xa1, 0xd7, 0x7e, 0x88, 0x6a, 0x66, 0xd7, 0x2f, 0x40, 0x9a, 0x0a, 0xd2, 0x43,
-	0x4f, 0xfb, 0x7f, 0xfd, 0xcd, 0x7f, 0xe6, 0xff, 0x66, 0xc3, 0xea, 0x9e, 0x23, 0xf6, 0x0f, 0x77,
-	0x67, 0x4d, 0xdf, 0x9d, 0xdb, 0xd9, 0x67, 0x3b, 0xfb, 0x8e, 0xb7, 0xd7, 0xa9, 0x33, 0x71, 0xec,
-	0xf3, 0xd6, 0x9c, 0x10, 0xde, 0x1c, 0x0d, 0x9c, 0xb9, 0x80, 0xfb, 0xc2, 0x37, 0xfd, 0xf6, 0x5c,
-	0xdb, 0xe7, 0xf4, 0x98, 0x7a, 0xbd, 0xef, 0xac, 0x52, 0xa0, 0x54, 0xc4, 0xde, 0xf9, 0x64, 0x08,
-	0x6c, 0xcf, 0xdf, 0xf3, 0x43, 0xc7, 0xdd, 0x43, 0x5b, 0x71, 0x8a, 0x51, 0x54, 0xe8, 0x57, 0xf8,
-	0x43, 0x83, 0xf4, 0x26, 0x13, 0xd4, 0xa2, 0x82, 0xa2, 0x12, 0x80, 0xeb, 0x5b, 0x87, 0x6d, 0x2a,
-	0x1c, 0xdf, 0xcb, 0x65, 0xa6, 0xb4, 0xe9, 0xf1, 0xe2, 0xcd, 0xd9, 0xde, 0x41, 0x9b, 0x7d, 0x15,
-	0x1e, 0x32, 0x43, 0x77, 0x61, 0x54, 0x3a, 0x13, 0x4e, 0x05, 0xcb, 0x8d, 0x4d, 0x69, 0xd3, 0xa3,
-	0x38, 0x2d, 0x05, 0x98, 0x0a, 0x86, 0xfe, 0x07, 0xe9, 0x5d, 0x47, 0x84, 0xba, 0xec, 0x94, 0x36,
-	0x9d, 0xc5, 0xa9, 0x5d, 0x47, 0x28, 0xd5, 0x24, 0x64, 0x4c, 0xdf, 0x72, 0xbc, 0xbd, 0x50, 0x3b,
-	0xae, 0x3c, 0x21, 0x14, 0x29, 0x83, 0x9b, 0xa0, 0xdb, 0xc4, 0xf4, 0x44, 0x6e, 0x42, 0x39, 0x26,
-	0xec, 0x8a, 0x27, 0xd0, 0x43, 0x18, 0xb7, 0x39, 0x7b, 0x71, 0xc8, 0x3c, 0xb3, 0x4b, 0x82, 0x36,
-	0xf5, 0x72, 0x86, 0x0a, 0xf3, 0x3f, 0xfd, 0x30, 0xd7, 0x7a, 0xea, 0xad, 0x36, 0xf5, 0x70, 0xd6,
-	0x1e, 0x66, 0x0b, 0x3f, 0x6b, 0x30, 0xb1, 0x73, 0x52, 0xf1, 0x3d, 0xdb, 0xd9, 0x3b, 0xe4, 0xe1,
-	0x05, 0xfe, 0xfd, 0xb7, 0x2e, 0x7c, 0x9f, 0x00, 0x54, 0x36, 0x85, 0x73, 0xa4, 0x0e, 0xef, 0xe7,
-	0xab, 0x0e, 0x29, 0x1a, 0x04, 0x84, 0x1d, 0x3a, 0x39, 0x6d, 0x4a, 0x9b, 0x1e, 0x5b, 0x5d, 0x7c,
-	0x7d, 0x3a, 0xb9, 0xf0, 0x77, 0xd5, 0x64, 0xfa, 0x9c, 0xcd, 0x89, 0x6e, 0xc0, 0x3a, 0xb3, 0xe5,
-	0x20, 0xa8, 0x35, 0xd7, 0x71, 0x92, 0x06, 0x41, 0xed, 0xd0, 0x91, 0x78, 0x16, 0x3b, 0x52, 0x78,
-	0x23, 0xd7, 0xc2, 0xab, 0xb2, 0x23, 0x85, 0x67, 0xb1, 0x23, 0x89, 0xf7, 0x0c, 0xd2, 0x12, 0x8f,
-	0x5a, 0x16, 0xcf, 0xc5, 0x15, 0xe0, 0x83, 0xd7, 0xa7, 0x93, 0xc5, 0xab, 0x01, 0x96, 0x2d, 0x8b,
-	0x63, 0x19, 0x97, 0x24, 0x10, 0x86, 0x51, 0xef, 0xb8, 0x45, 0x3a, 0xa4, 0xc5, 0xba, 0xb9, 0xc4,
-	0xb5, 0x30, 0xeb, 0xc7, 0xad, 0xed, 0xa7, 0xac, 0x8b, 0x53, 0x5e, 0x48, 0xa0, 0x02, 0x64, 0xf9,
-	0xc9, 0x02, 0xb1, 0x38, 0xf1, 0x6d, 0xbb, 0xc3, 0x84, 0xaa, 0x81, 0x2c, 0xce, 0xf0, 0x93, 0x85,
-	0x2a, 0x6f, 0x28, 0x11, 0xba, 0x0d, 0x49, 0x7e, 0x52, 0x24, 0x16, 0x57, 0xc9, 0xce, 0x62, 0x9d,
-	0x9f, 0x14, 0xab, 0x5c, 0x66, 0x9a, 0x9f, 0x10, 0x8b, 0xb5, 0x69, 0xb7, 0x97, 0x69, 0x7e, 0x52,
-	0x95, 0x2c, 0x9a, 0x86, 0x94, 0x69, 0x93, 0xb6, 0xd3, 0x11, 0x2a, 0xcb, 0x99, 0xe2, 0x44, 0xbf,
-	0xa6, 0x2a, 0x6b, 0x1b, 0x4e, 0x47, 0xe0, 0xa4, 0x69, 0xcb, 0xef, 0x3b, 0x6a, 0x7a, 0xe2, 0x2a,
-	0x35, 0xfd, 0xd3, 0x08, 0xa4, 0x36, 0x59, 0xa7, 0x43, 0xf7, 0x18, 0xfa, 0x18, 0x74, 0x97, 0xec,
-	0x5b, 0x5c, 0xd5, 0x43, 0xa6, 0x98, 0x1d, 0x94, 0xf1, 0xe3, 0x2a, 0x5e, 0x4d, 0xbf, 0x3c, 0x9d,
-	0x8c, 0xbd, 0x3a, 0x9d, 0xd4, 0x70, 0xc2, 0x7d, 0x6c, 0x71, 0x64, 0x40, 0xdc, 0x75, 0xcc, 0x30,
-	0xd7, 0x58, 0x92, 0xe8, 0x01, 0x64, 0x5c, 0x6a, 0x92, 0x80, 0x76, 0xdb, 0x3e, 0xb5, 0x54, 0xd2,
-	0x32, 0xc3, 0xcd, 0x50, 0xae, 0x6c, 0x85, 0xaa, 0xc7, 0x31, 0x0c, 0x2e, 0x35, 0x23, 0x0e, 0x35,
-	0xe0, 0xd6, 0x81, 0xef, 0x78, 0x44, 0x05, 0xd6, 0x11, 0x7d, 0x80, 0x84, 0x02, 0xb8, 0xdb, 0x07,
-	0x78, 0xe2, 0x3b, 0x1e, 0x0e, 0x6d, 0x06, 0x40, 0xe8, 0xe0, 0x92, 0x14, 0x6d, 0xc0, 0x4d, 0x05,
-	0x48, 0x4d, 0x93, 0x05, 0x03, 0x3c, 0x5d, 0xe1, 0xdd, 0x39, 0x87, 0x57, 0x56, 0x26, 0x03, 0xb8,
-	0x1b, 0x07, 0x17, 0x85, 0xab, 0xa3, 0x90, 0x8a, 0xc8, 0xc2, 0x36, 0x24, 0xe4, 0x5b, 0xa0, 0x8f,
-	0x20, 0xe9, 0x12, 0x59, 0x10, 0xea, 0xa9, 0xc6, 0x8b, 0xe3, 0x83, 0x4b, 0xee, 0x74, 0x03, 0x86,
-	0x75, 0x57, 0x7e, 0xd0, 0xff, 0x41, 0x77, 0xe9, 0x81, 0xcf, 0xd5, 0x23, 0x9d, 0xb3, 0x92, 0x52,
-	0x1c, 0x2a, 0x0b, 0x1c, 0x60, 0xf0, 0x34, 0x32, 0x09, 0xf6, 0x3b, 0x93, 0xb0, 0x76, 0x21, 0x09,
-	0xb6, 0x4c, 0xc2, 0x6d, 0x48, 0xda, 0x24, 0xf0, 0xb9, 0x50, 0x47, 0xe8, 0x58, 0xb7, 0xb7, 0x7c,
-	0x2e, 0xe4, 0xa0, 0xb0, 0xb9, 0x7b, 0x2e, 0x13, 0x63, 0x18, 0x6c, 0xee, 0xf6, 0x2e, 0xf2, 0xab,
-	0x06, 0x09, 0x09, 0x88, 0x9a, 0x43, 0x5d, 0x16, 0x8e, 0x81, 0x4f, 0xe5, 0x11, 0x1f, 0xda, 0x69,
-	0x73, 0x32, 0x2e, 0x53, 0xf0, 0xb6, 0x8a, 0x2b, 0x33, 0x74, 0xf5, 0xb5, 0x8a, 0xe0, 0xed, 0xa1,
-	0x7b, 0xe8, 0xb6, 0x14, 0x0c, 0x26, 0x57, 0x7c, 0x68, 0x5e, 0xcf, 0x4b, 0x14, 0x3f, 0x10, 0x9d,
-	0x5c, 0x62, 0x2a, 0x7e, 0xb1, 0x96, 0x2a, 0xbe, 0xeb, 0x52, 0xcf, 0x5a, 0x4d, 0x48, 0x28, 0xac,
-	0xdb, 0x8d, 0x40, 0x74, 0x0a, 0xfb, 0xa0, 0xab, 0x03, 0x64, 0x75, 0xd2, 0xe8, 0x4a, 0x69, 0x2c,
-	0x49, 0x94, 0x87, 0x0c, 0xb5, 0x38, 0xa1, 0x66, 0x4b, 0x16, 0x9a, 0x8a, 0x2b, 0x8d, 0x47, 0xa9,
-	0xc5, 0xcb, 0x66, 0x0b, 0xb3, 0x17, 0xca, 0xc3, 0x6c, 0xa9, 0xf3, 0xa5, 0x87, 0xd9, 0x92, 0x63,
-	0xda, 0x26, 0x01, 0xf3, 0xe4, 0x78, 0x55, 0xc5, 0x98, 0xc6, 0x69, 0x7b, 0x2b, 0xe4, 0x0b, 0xcb,
-	0x2a, 0x6b, 0x51, 0x10, 0xd2, 0xd9, 0x74, 0x2c, 0x75, 0x5c, 0x16, 0x4b, 0x12, 0xe5, 0x20, 0xd5,
-	0x7b, 0xfe, 0xb0, 0x45, 0x7a, 0x6c, 0xe1, 0xbb, 0x11, 0x40, 0x97, 0x4b, 0x19, 0xe1, 0x8b, 0xf3,
-	0x78, 0x25, 0x4a, 0xc4, 0x07, 0xcc, 0x64, 0x7c, 0x71, 0x26, 0x5f, 0x07, 0xf3, 0xc2, 0x5c, 0xfe,
-	0x12, 0x46, 0x25, 0xa6, 0xe7, 0x7b, 0x26, 0x8b, 0x06, 0xf3, 0x67, 0x11, 0x6a, 0xe9, 0x6a, 0xa8,
-	0x75, 0x09, 0x81, 0x65, 0xfd, 0x29, 0xaa, 0xf0, 0x4b, 0x1c, 0x6e, 0x5c, 0xea, 0x49, 0x74, 0x0f,
-	0x46, 0x99, 0x67, 0xf2, 0x6e, 0x20, 0x58, 0xf8, 0xc0, 0x63, 0x78, 0x20, 0x90, 0xd1, 0xc8, 0x57,
-	0x0b, 0xa3, 0x19, 0xb9, 0x76, 0x34, 0xe5, 0x20, 0x88, 0xa2, 0xa1, 0x11, 0x85, 0x1a, 0x90, 0xf4,
-	0x98, 0x20, 0x4e, 0xd4, 0x3e, 0xab, 0xcb, 0x11, 0xec, 0xfc, 0x55, 0xb6, 0x05, 0x13, 0xeb, 0x55,
-	0xac, 0x7b, 0x4c, 0xac, 0x5b, 0xe7, 0x5a, 0x2d, 0xf1, 0xcf, 0xb5, 0xda, 0x23, 0xc8, 0x58, 0x6d,
-	0xd2, 0x61, 0x42, 0x48, 0xaf, 0x68, 0xc8, 0x0d, 0x3a, 0xa5, 0xba, 0xb1, 0x1d, 0xa9, 0x86, 0x9a,
-	0x0e, 0xac, 0x76, 0x4f, 0x7a, 0x6e, 0x0b, 0x25, 0xff, 0x72, 0x0b, 0xa5, 0xde, 0xbb, 0x85, 0x0a,
-	0x9f, 0x03, 0x0c, 0x0e, 0xba, 0xbc, 0x13, 0xb5, 0xf7, 0xed, 0xc4, 0x91, 0xa1, 0x9d, 0x58, 0xb8,
-	0x07, 0xc9, 0x10, 0x1a, 0x21, 0x48, 0xc8, 0x55, 0x95, 0xd3, 0xa6, 0xe2, 0x6a, 0x20, 0x70, 0xf6,
-	0x62, 0x66, 0x12, 0x60, 0xf0, 0x93, 0x0a, 0xa5, 0x21, 0xb1, 0xd1, 0xc0, 0x65, 0x23, 0x86, 0x52,
-	0x10, 0x5f, 0xdb, 0x7e, 0x6a, 0x68, 0x33, 0x3f, 0x68, 0x90, 0x3d, 0xb7, 0xef, 0xd0, 0x38, 0x40,
-	0xad, 0x49, 0x96, 0x1f, 0x94, 0xc8, 0xf2, 0xd2, 0xbc, 0x11, 0x93, 0x7c, 0x73, 0x9b, 0xac, 0xcc,
-	0x17, 0xc9, 0x4a, 0x71, 0xd9, 0xd0, 0x24, 0x5f, 0xa9, 0x93, 0xa5, 0xa5, 0x15, 0xb2, 0xb4, 0xbc,
-	0x64, 0x8c, 0x20, 0x80, 0x64, 0xad, 0x49, 0xee, 0x97, 0x4a, 0x46, 0x5c, 0xea, 0xca, 0x4d, 0xb2,
-	0xb2, 0xb0, 0xa8, 0x6c, 0x13, 0x91, 0xed, 0xfd, 0xa5, 0x79, 0xb2, 0xb8, 0x30, 0x6f, 0xe8, 0xd2,
-	0xb6, 0xbc, 0x4d, 0x56, 0x8a, 0x25, 0x23, 0xa9, 0x6c, 0x25, 0x3d, 0xaf, 0xf8, 0x87, 0x7d, 0xbe,
-	0x44, 0x56, 0x8a, 0x8b, 0xc6, 0x23, 0xc9, 0x3f, 0xc5, 0x7d, 0x7d, 0x6a, 0xe6, 0xbf, 0xa0, 0xab,
-	0x2d, 0x20, 0x15, 0xf2, 0x16, 0xcf, 0xcb, 0x75, 0x82, 0x17, 0x8c, 0xd8, 0xcc, 0xd7, 0xa0, 0xab,
-	0x25, 0x82, 0x0c, 0x18, 0x7b, 0xd2, 0x58, 0xaf, 0x13, 0x5c, 0x7b, 0xd6, 0xac, 0x6d, 0xef, 0x18,
-	0x31, 0x34, 0x01, 0x19, 0x25, 0x29, 0x57, 0x2a, 0xb5, 0xad, 0x1d, 0x43, 0x43, 0x08, 0xc6, 0x9b,
-	0xf5, 0x4a, 0xa3, 0xbe, 0xb6, 0x8e, 0x37, 0x6b, 0x55, 0xd2, 0xdc, 0x32, 0x46, 0xd0, 0x2d, 0x30,
-	0x86, 0x65, 0xd5, 0xc6, 0xf3, 0xba, 0x11, 0x97, 0x60, 0xe7, 0xec, 0x12, 0xd2, 0xf7, 0x82, 0x95,
-	0xbe, 0xfa, 0xc5, 0x6f, 0x6f, 0xf3, 0xb1, 0x37, 0x6f, 0xf3, 0xda, 0x37, 0x67, 0x79, 0xed, 0xc7,
-	0xb3, 0xbc, 0xf6, 0xf2, 0x2c, 0xaf, 0xbd, 0x3a, 0xcb, 0x6b, 0x6f, 0xce, 0xf2, 0xda, 0xb7, 0xbf,
-	0xe7, 0x63, 0x5f, 0xdd, 0xbf, 0xce, 0xdf, 0x8d, 0xdd, 0xa4, 0x92, 0x94, 0xfe, 0x0c, 0x00, 0x00,
-	0xff, 0xff, 0x8a, 0x79, 0xae, 0xfe, 0xad, 0x0c, 0x00, 0x00,
+	// 1628 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0x5c, 0x92, 0x7a, 0x14, 0xa5, 0xf5, 0xd8, 0x4e, 0x19, 0x27, 0xa0, 0x04, 0x22,
+	0x05, 0x04, 0xa1, 0x91, 0x44, 0xd2, 0xb6, 0xa4, 0x34, 0x09, 0x40, 0x91, 0x54, 0xac, 0x58, 0x26,
+	0x95, 0xa1, 0xe8, 0xb4, 0x45, 0x81, 0xc1, 0x68, 0x77, 0x96, 0x5a, 0x93, 0xfb, 0xc7, 0xb3, 0x43,
+	0x49, 0xec, 0xa9, 0xd7, 0xde, 0x7a, 0xea, 0x57, 0x68, 0x81, 0x5e, 0x7b, 0xe8, 0x47, 0xc8, 0x31,
+	0x3d, 0x14, 0x28, 0x72, 0x30, 0x12, 0x15, 0xe8, 0xe7, 0x28, 0x66, 0x76, 0xf9, 0xd7, 0x6e, 0x0a,
+	0xcb, 0x3d, 0xf4, 0xb4, 0xef, 0xef, 0x6f, 0xde, 0xbc, 0x79, 0xf3, 0xde, 0x2c, 0x1c, 0xf6, 0x1c,
+	0x71, 0x31, 0x3c, 0xdf, 0x36, 0x7d, 0x77, 0xe7, 0xec, 0x82, 0x9d, 0x5d, 0x38, 0x5e, 0x2f, 0x6c,
+	0x31, 0x71, 0xe5, 0xf3, 0xfe, 0x8e, 0x10, 0xde, 0x0e, 0x0d, 0x9c, 0x9d, 0x80, 0xfb, 0xc2, 0x37,
+	0xfd, 0xc1, 0xce, 0xc0, 0xe7, 0xf4, 0x8a, 0x7a, 0xe3, 0xef, 0xb6, 0x52, 0xa0, 0x4c, 0xcc, 0x3e,
+	0xf8, 0x78, 0x06, 0xac, 0xe7, 0xf7, 0xfc, 0xc8, 0xf1, 0x7c, 0x68, 0x2b, 0x4e, 0x31, 0x8a, 0x8a,
+	0xfc, 0x4a, 0xff, 0x4a, 0x40, 0xf6, 0x19, 0x13, 0xd4, 0xa2, 0x82, 0xa2, 0x2a, 0x80, 0xeb, 0x5b,
+	0xc3, 0x01, 0x15, 0x8e, 0xef, 0x15, 0x72, 0x1b, 0xda, 0xe6, 0x6a, 0xe5, 0xee, 0xf6, 0x78, 0xa1,
+	0x67, 0x13, 0x15, 0x9e, 0x31, 0x43, 0x1f, 0xc0, 0xb2, 0x74, 0x26, 0x9c, 0x0a, 0x56, 0x58, 0xd9,
+	0xd0, 0x36, 0x97, 0x71, 0x56, 0x0a, 0x30, 0x15, 0x0c, 0xbd, 0x0f, 0xd9, 0x73, 0x47, 0x44, 0xba,
+	0xfc, 0x86, 0xb6, 0x99, 0xc7, 0x99, 0x73, 0x47, 0x28, 0xd5, 0x3a, 0xe4, 0x4c, 0xdf, 0x72, 0xbc,
+	0x5e, 0xa4, 0x5d, 0x55, 0x9e, 0x10, 0x89, 0x94, 0xc1, 0x5d, 0xd0, 0x6d, 0x62, 0x7a, 0xa2, 0xb0,
+	0xa6, 0x1c, 0x53, 0x76, 0xdd, 0x13, 0xe8, 0x33, 0x58, 0xb5, 0x39, 0x7b, 0x39, 0x64, 0x9e, 0x39,
+	0x22, 0xc1, 0x80, 0x7a, 0x05, 0x43, 0x85, 0xf9, 0xde, 0x24, 0xcc, 0xa3, 0xb1, 0xfa, 0x74, 0x40,
+	0x3d, 0x9c, 0xb7, 0x67, 0x59, 0xf4, 0x09, 0xe4, 0x2d, 0x76, 0xe9, 0x98, 0x8c, 0x84, 0x82, 0x8a,
+	0x61, 0x58, 0xb8, 0xb3, 0xa1, 0x6d, 0xe6, 0x2a, 0xf7, 0x27, 0xde, 0x0d, 0xa5, 0xed, 0x28, 0x25,
+	0x5e, 0xb1, 0x66, 0x38, 0xf4, 0x29, 0xac, 0xa9, 0x78, 0x08, 0xf5, 0x7c, 0x97, 0x0e, 0x1c, 0x16,
+	0x16, 0xd0, 0x46, 0x72, 0x33, 0x57, 0xb9, 0x37, 0x5d, 0xbb, 0xee, 0x89, 0x9a, 0xd2, 0x8e, 0x70,
+	0xde, 0x9e, 0x30, 0x0e, 0x0b, 0x4b, 0x7f, 0xd1, 0x60, 0xed, 0xec, 0xba, 0xee, 0x7b, 0xb6, 0xd3,
+	0x1b, 0xf2, 0x28, 0x75, 0xff, 0xff, 0xf9, 0x2e, 0xfd, 0x2e, 0x0d, 0xa8, 0x66, 0x0a, 0xe7, 0x52,
+	0x2d, 0x3e, 0xa9, 0x94, 0x16, 0x64, 0x68, 0x10, 0x10, 0x36, 0x74, 0x0a, 0xda, 0x86, 0xb6, 0xb9,
+	0x72, 0xf8, 0xe8, 0xbb, 0x57, 0xeb, 0xe5, 0xff, 0x56, 0xc7, 0xa6, 0xcf, 0xd9, 0x8e, 0x18, 0x05,
+	0x2c, 0xdc, 0xae, 0x05, 0x41, 0xb3, 0x7b, 0x8c, 0xd3, 0x34, 0x08, 0x9a, 0x43, 0x47, 0xe2, 0x59,
+	0xec, 0x52, 0xe1, 0x25, 0x6e, 0x85, 0xd7, 0x60, 0x97, 0x0a, 0xcf, 0x62, 0x97, 0x12, 0xef, 0x2b,
+	0xc8, 0x4a, 0x3c, 0x6a, 0x59, 0xbc, 0x90, 0x54, 0x80, 0x8f, 0xbf, 0x7b, 0xb5, 0x5e, 0x79, 0x3b,
+	0xc0, 0x9a, 0x65, 0x71, 0x9c, 0xb1, 0x22, 0x02, 0x61, 0x58, 0xf6, 0xae, 0xfa, 0x24, 0x24, 0x7d,
+	0x36, 0x2a, 0xa4, 0x6e, 0x85, 0xd9, 0xba, 0xea, 0x77, 0x9e, 0xb2, 0x11, 0xce, 0x78, 0x11, 0x81,
+	0x7e, 0x0d, 0x6b, 0x21, 0x89, 0x50, 0x1d, 0x4f, 0x28, 0x64, 0xfd, 0x9d, 0x90, 0x73, 0xa1, 0xa4,
+	0x8e, 0x3d, 0x21, 0xd1, 0x7f, 0x09, 0xf9, 0x08, 0x9b, 0x79, 0xa6, 0xc2, 0x4e, 0xbf, 0x13, 0x36,
+	0xc8, 0xa8, 0x9b, 0x9e, 0x29, 0xa1, 0x1f, 0x42, 0xce, 0xa5, 0x26, 0xb9, 0x64, 0x3c, 0x94, 0xa5,
+	0x9b, 0x59, 0x2c, 0xdd, 0x5a, 0xfd, 0x79, 0xa4, 0xc2, 0xe0, 0x52, 0x33, 0xa6, 0x51, 0x09, 0xf2,
+	0xfc, 0xba, 0x4c, 0x2c, 0x4e, 0x7c, 0xdb, 0x0e, 0x99, 0x50, 0x25, 0x9f, 0xc7, 0x39, 0x7e, 0x5d,
+	0x6e, 0xf0, 0xb6, 0x12, 0xa1, 0xfb, 0x90, 0xe6, 0xd7, 0x15, 0x62, 0x71, 0x55, 0xdb, 0x79, 0xac,
+	0xf3, 0xeb, 0x4a, 0x83, 0xcb, 0xc2, 0xe6, 0xd7, 0xc4, 0x62, 0x03, 0x3a, 0x1a, 0x17, 0x36, 0xbf,
+	0x6e, 0x48, 0x16, 0x6d, 0x42, 0xc6, 0xb4, 0xc9, 0xc0, 0x09, 0x85, 0x2a, 0xea, 0x5c, 0x65, 0x6d,
+	0x12, 0x47, 0xfd, 0xe8, 0xc4, 0x09, 0x05, 0x4e, 0x9b, 0xb6, 0xfc, 0xbe, 0xa1, 0x79, 0xac, 0xbd,
+	0x45, 0xf3, 0x28, 0xfd, 0x39, 0x01, 0x99, 0x67, 0x2c, 0x0c, 0x69, 0x8f, 0xa1, 0x9f, 0x81, 0xee,
+	0x92, 0x0b, 0x8b, 0xab, 0xf2, 0xcf, 0x55, 0xf2, 0xd3, 0xad, 0x3f, 0x69, 0xe0, 0xc3, 0xec, 0x37,
+	0xaf, 0xd6, 0x97, 0xbe, 0x7d, 0xb5, 0xae, 0xe1, 0x94, 0xfb, 0xc4, 0xe2, 0xc8, 0x80, 0xa4, 0xeb,
+	0x98, 0x51, 0x69, 0x63, 0x49, 0xa2, 0xc7, 0x51, 0x02, 0x03, 0x3a, 0x1a, 0xf8, 0xd4, 0x52, 0x35,
+	0x9a, 0x9b, 0x4f, 0xe0, 0x69, 0xa4, 0x7a, 0xb2, 0xa4, 0x52, 0x18, 0x73, 0xa8, 0x0d, 0xf7, 0x5e,
+	0xf8, 0x8e, 0x47, 0x54, 0x60, 0xa1, 0x98, 0x00, 0xa4, 0x14, 0xc0, 0x07, 0x13, 0x80, 0x2f, 0x7d,
+	0xc7, 0xc3, 0x91, 0xcd, 0x14, 0x08, 0xbd, 0x78, 0x4d, 0x8a, 0x4e, 0xe0, 0xae, 0x02, 0xa4, 0xa6,
+	0xc9, 0x82, 0x29, 0x9e, 0xae, 0xf0, 0x1e, 0xcc, 0xe1, 0xd5, 0x94, 0xc9, 0x14, 0xee, 0xce, 0x8b,
+	0x45, 0xe1, 0xe1, 0x32, 0x64, 0x62, 0xb2, 0xd4, 0x81, 0x94, 0xcc, 0x05, 0xfa, 0x29, 0xa4, 0x5d,
+	0x22, 0x2b, 0x49, 0xa5, 0x6a, 0xb5, 0xb2, 0x3a, 0xdd, 0xe4, 0xd9, 0x28, 0x60, 0x58, 0x77, 0xe5,
+	0x07, 0x7d, 0x04, 0xba, 0x4b, 0x5f, 0xf8, 0xbc, 0x90, 0x58, 0xb4, 0x92, 0x52, 0x1c, 0x29, 0x4b,
+	0x1c, 0x60, 0x9a, 0x1a, 0x79, 0x08, 0xf6, 0x1b, 0x0f, 0xe1, 0x68, 0xe1, 0x10, 0x6c, 0x79, 0x08,
+	0xf7, 0x21, 0x6d, 0x93, 0xc0, 0xe7, 0x42, 0x2d, 0xa1, 0x63, 0xdd, 0x3e, 0xf5, 0xb9, 0x90, 0x7d,
+	0xd1, 0xe6, 0xee, 0xdc, 0x49, 0xac, 0x60, 0xb0, 0xb9, 0x3b, 0xde, 0xc8, 0xdf, 0x35, 0x48, 0x49,
+	0x40, 0xd4, 0x9d, 0x69, 0x2a, 0x51, 0xd7, 0xfb, 0x44, 0x2e, 0xf1, 0xae, 0x8d, 0x65, 0x47, 0xc6,
+	0x65, 0x0a, 0x3e, 0x50, 0x71, 0xe5, 0x66, 0xb6, 0x7e, 0x54, 0x17, 0x7c, 0x30, 0xb3, 0x0f, 0xdd,
+	0x96, 0x82, 0x69, 0xa3, 0x4e, 0xce, 0x0c, 0xc6, 0x5d, 0x89, 0xe2, 0x07, 0x22, 0x2c, 0xa4, 0x36,
+	0x92, 0x8b, 0xb5, 0x54, 0xf7, 0x5d, 0x97, 0x7a, 0xd6, 0x61, 0x4a, 0x42, 0x61, 0xdd, 0x6e, 0x07,
+	0x22, 0x2c, 0x5d, 0x80, 0xae, 0x16, 0x90, 0xd5, 0x49, 0xe3, 0x2d, 0x65, 0xb1, 0x24, 0x51, 0x11,
+	0x72, 0xd4, 0xe2, 0x84, 0x9a, 0x7d, 0x59, 0x68, 0x2a, 0xae, 0x2c, 0x5e, 0xa6, 0x16, 0xaf, 0x99,
+	0x7d, 0xcc, 0x5e, 0x2a, 0x0f, 0xb3, 0x5f, 0x48, 0xc6, 0x1e, 0x66, 0x5f, 0x4e, 0x25, 0x9b, 0x04,
+	0xcc, 0x93, 0xd3, 0x44, 0x15, 0x63, 0x16, 0x67, 0xed, 0xd3, 0x88, 0x2f, 0xed, 0x03, 0x4c, 0x83,
+	0x90, 0xce, 0xa6, 0x63, 0xa9, 0xe5, 0xf2, 0x58, 0x92, 0xa8, 0x00, 0x99, 0x71, 0xfa, 0xa3, 0x2b,
+	0x32, 0x66, 0x4b, 0x7f, 0x48, 0x00, 0x7a, 0xbd, 0x94, 0x11, 0x5e, 0x1c, 0x3f, 0x07, 0xf1, 0x41,
+	0xbc, 0xc3, 0x08, 0xc2, 0x8b, 0x23, 0xe8, 0x36, 0x98, 0x0b, 0x63, 0xe8, 0x17, 0xb0, 0x2c, 0x31,
+	0x3d, 0xdf, 0x33, 0x59, 0x3c, 0x87, 0x7e, 0x1e, 0xa3, 0x56, 0xdf, 0x0e, 0xb5, 0x25, 0x21, 0x70,
+	0xd6, 0x8a, 0xa9, 0xd2, 0x5f, 0x93, 0x70, 0xe7, 0xb5, 0x3b, 0x89, 0x3e, 0x84, 0x65, 0xe6, 0x99,
+	0x7c, 0x14, 0x08, 0x16, 0x25, 0x78, 0x05, 0x4f, 0x05, 0x32, 0x1a, 0x99, 0xb5, 0x28, 0x9a, 0xc4,
+	0xad, 0xa3, 0xa9, 0x05, 0x41, 0x1c, 0x0d, 0x8d, 0x29, 0xd4, 0x86, 0xb4, 0xc7, 0x04, 0x71, 0xe2,
+	0xeb, 0x73, 0xb8, 0x1f, 0xc3, 0xee, 0xbe, 0xcd, 0x98, 0x61, 0xe2, 0xb8, 0x81, 0x75, 0x8f, 0x89,
+	0x63, 0x6b, 0xee, 0xaa, 0xa5, 0xfe, 0x77, 0x57, 0xed, 0x73, 0xc8, 0x59, 0x03, 0x12, 0x32, 0x21,
+	0xa4, 0x57, 0xdc, 0xe4, 0xa6, 0x37, 0xa5, 0x71, 0xd2, 0x89, 0x55, 0x33, 0x97, 0x0e, 0xac, 0xc1,
+	0x58, 0x3a, 0x37, 0x85, 0xd2, 0xff, 0x71, 0x0a, 0x65, 0x7e, 0x74, 0x0a, 0x95, 0xbe, 0x00, 0x98,
+	0x2e, 0xf4, 0xfa, 0x4c, 0xd4, 0x7e, 0x6c, 0x26, 0x26, 0x66, 0x66, 0x62, 0xe9, 0x43, 0x48, 0x47,
+	0xd0, 0x08, 0x41, 0x4a, 0x8e, 0xaa, 0x82, 0xb6, 0x91, 0x54, 0x0d, 0x81, 0xb3, 0x97, 0xa5, 0xbf,
+	0x69, 0x90, 0x9b, 0x79, 0x8f, 0xa2, 0x8f, 0x21, 0x35, 0xd3, 0x85, 0xdf, 0x7f, 0xd3, 0x9b, 0x75,
+	0x5b, 0x35, 0x64, 0x65, 0x86, 0x1e, 0x40, 0xd6, 0xf2, 0xaf, 0xbc, 0x81, 0xe3, 0xf5, 0xe3, 0xfb,
+	0x3f, 0xe1, 0xdf, 0xdc, 0x80, 0x3e, 0x82, 0xd5, 0x80, 0xb3, 0x4b, 0xc7, 0x1f, 0x86, 0x24, 0xd2,
+	0xa6, 0x94, 0x76, 0x65, 0x2c, 0x95, 0x0b, 0x95, 0x3e, 0x85, 0x94, 0x6a, 0xf7, 0xcb, 0xa0, 0xe3,
+	0x66, 0xa7, 0x79, 0x66, 0x2c, 0xa1, 0x0c, 0x24, 0xbf, 0xa8, 0x9d, 0x1a, 0x1a, 0x5a, 0x83, 0x1c,
+	0x6e, 0x9f, 0x9c, 0xb4, 0x9f, 0x37, 0x31, 0x29, 0x3f, 0x36, 0x12, 0x73, 0x82, 0x6a, 0xc5, 0x48,
+	0x96, 0xce, 0x60, 0x65, 0xf6, 0x81, 0x2e, 0x1b, 0xc7, 0x39, 0x15, 0x82, 0xf1, 0x51, 0x9c, 0xb6,
+	0x31, 0x8b, 0xde, 0x83, 0xb4, 0x4b, 0x79, 0xcf, 0xf1, 0xe2, 0x66, 0x1f, 0x73, 0x32, 0x53, 0xc2,
+	0x71, 0xa3, 0xcb, 0x98, 0xc4, 0x8a, 0xde, 0x5a, 0x07, 0x98, 0xbe, 0xb5, 0x51, 0x16, 0x52, 0x27,
+	0x6d, 0x5c, 0x8b, 0x02, 0x3b, 0xea, 0x3c, 0x35, 0xb4, 0xad, 0x3f, 0x6a, 0x90, 0x9f, 0x7b, 0x19,
+	0xa0, 0x55, 0x80, 0x66, 0x97, 0xec, 0x3f, 0xae, 0x92, 0xfd, 0xbd, 0x5d, 0x63, 0x49, 0xf2, 0xdd,
+	0x0e, 0x39, 0xd8, 0xad, 0x90, 0x83, 0xca, 0xbe, 0xa1, 0x49, 0xbe, 0xde, 0x22, 0x7b, 0x7b, 0x07,
+	0x64, 0x6f, 0x7f, 0xcf, 0x48, 0x20, 0x80, 0x74, 0xb3, 0x4b, 0x1e, 0x56, 0xab, 0x46, 0x52, 0xea,
+	0x6a, 0x5d, 0x72, 0x50, 0x7e, 0xa4, 0x6c, 0x53, 0xb1, 0xed, 0xc3, 0xbd, 0x5d, 0xf2, 0xa8, 0xbc,
+	0x6b, 0xe8, 0xd2, 0xb6, 0xd6, 0x21, 0x07, 0x95, 0xaa, 0x91, 0x56, 0xb6, 0x92, 0xde, 0x55, 0xfc,
+	0x67, 0x13, 0xbe, 0x4a, 0x0e, 0x2a, 0x8f, 0x8c, 0xcf, 0x25, 0xff, 0x14, 0x4f, 0xf4, 0x99, 0xad,
+	0x9f, 0x80, 0xae, 0xe6, 0xa5, 0x54, 0xc8, 0x5d, 0x7c, 0x5d, 0x6b, 0x11, 0x5c, 0x36, 0x96, 0xb6,
+	0xb6, 0x55, 0x0b, 0x1e, 0x3f, 0xc4, 0xd6, 0x20, 0x37, 0xd6, 0x96, 0x89, 0x8c, 0x7f, 0x4e, 0x50,
+	0x36, 0xb4, 0xad, 0xdf, 0x80, 0xae, 0xc6, 0x33, 0x32, 0x60, 0xe5, 0xcb, 0xf6, 0x71, 0x8b, 0xe0,
+	0xe6, 0x57, 0xdd, 0x66, 0xe7, 0x2c, 0xb2, 0x55, 0x92, 0x5a, 0xbd, 0xde, 0x3c, 0x3d, 0x33, 0x34,
+	0x84, 0x60, 0xb5, 0xdb, 0xaa, 0xb7, 0x5b, 0x47, 0xc7, 0xf8, 0x59, 0xb3, 0x41, 0xba, 0xa7, 0x46,
+	0x02, 0xdd, 0x03, 0x63, 0x56, 0xd6, 0x68, 0x7f, 0xdd, 0x32, 0x92, 0x12, 0x6c, 0xce, 0x2e, 0x25,
+	0x7d, 0x17, 0xac, 0xf4, 0xc3, 0xe7, 0xff, 0xf8, 0xa1, 0xb8, 0xf4, 0xfd, 0x0f, 0x45, 0xed, 0xb7,
+	0x37, 0x45, 0xed, 0x4f, 0x37, 0x45, 0xed, 0x9b, 0x9b, 0xa2, 0xf6, 0xed, 0x4d, 0x51, 0xfb, 0xfe,
+	0xa6, 0xa8, 0xfd, 0xfe, 0x9f, 0xc5, 0xa5, 0x5f, 0x3d, 0xbc, 0xcd, 0x1f, 0xf3, 0x79, 0x5a, 0x49,
+	0xaa, 0xff, 0x1e, 0x00, 0xf4, 0x3f, 0x01, 0x33, 0x70, 0x0f, 0x00, 0x00,
 }
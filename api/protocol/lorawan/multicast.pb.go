@@ -0,0 +1,1706 @@
+// Code generated by protoc-gen-gogo.
+// source: github.com/TheThingsNetwork/ttn/api/protocol/lorawan/multicast.proto
+// DO NOT EDIT!
+
+/*
+	Package lorawan is a generated protocol buffer package.
+
+	It is generated from these files:
+		github.com/TheThingsNetwork/ttn/api/protocol/lorawan/multicast.proto
+
+	It has these top-level messages:
+		MulticastGroupIdentifier
+		MulticastGroup
+*/
+package lorawan
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import google_protobuf "github.com/golang/protobuf/ptypes/empty"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import github_com_TheThingsNetwork_ttn_core_types "github.com/TheThingsNetwork/ttn/core/types"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import strings "strings"
+import reflect "reflect"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type MulticastGroupIdentifier struct {
+	// The AppID is a unique identifier for the application the multicast group belongs to. It can contain lowercase letters, numbers, - and _.
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// The GroupID is a unique identifier for the multicast group in the application. It can contain lowercase letters, numbers, - and _.
+	GroupId string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (m *MulticastGroupIdentifier) Reset()      { *m = MulticastGroupIdentifier{} }
+func (*MulticastGroupIdentifier) ProtoMessage() {}
+func (*MulticastGroupIdentifier) Descriptor() ([]byte, []int) {
+	return fileDescriptorMulticast, []int{0}
+}
+
+func (m *MulticastGroupIdentifier) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *MulticastGroupIdentifier) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+// A MulticastGroup is a virtual device that is used to send one downlink to many Class C devices. The members of the
+// group must be provisioned with the McAddr and the multicast session keys of the group.
+type MulticastGroup struct {
+	// The AppID is a unique identifier for the application the multicast group belongs to. It can contain lowercase letters, numbers, - and _.
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// The GroupID is a unique identifier for the multicast group in the application. It can contain lowercase letters, numbers, - and _.
+	GroupId string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// The McAddr is the 4 byte address of the multicast group.
+	McAddr *github_com_TheThingsNetwork_ttn_core_types.DevAddr `protobuf:"bytes,3,opt,name=mc_addr,json=mcAddr,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.DevAddr" json:"mc_addr,omitempty"`
+	// The McNwkSKey is a 16 byte session key that is used for the MIC of multicast downlink.
+	McNwkSKey *github_com_TheThingsNetwork_ttn_core_types.NwkSKey `protobuf:"bytes,4,opt,name=mc_nwk_s_key,json=mcNwkSKey,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.NwkSKey" json:"mc_nwk_s_key,omitempty"`
+	// The McAppSKey is a 16 byte session key that is used for encrypting the payload of multicast downlink. It is only stored by the Handler.
+	McAppSKey *github_com_TheThingsNetwork_ttn_core_types.AppSKey `protobuf:"bytes,5,opt,name=mc_app_s_key,json=mcAppSKey,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.AppSKey" json:"mc_app_s_key,omitempty"`
+	// FCntDown is the downlink frame counter of the multicast group.
+	FCntDown uint32 `protobuf:"varint,6,opt,name=f_cnt_down,json=fCntDown,proto3" json:"f_cnt_down,omitempty"`
+	// The DataRate that is used for multicast downlink (for example SF12BW125). Empty uses the RX2 data rate of the frequency plan.
+	DataRate string `protobuf:"bytes,7,opt,name=data_rate,json=dataRate,proto3" json:"data_rate,omitempty"`
+	// The Frequency (in Hz) that is used for multicast downlink. Zero uses the RX2 frequency of the frequency plan.
+	Frequency uint64 `protobuf:"varint,8,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	// The GatewayIDs of the gateways that transmit multicast downlink. Empty uses the gateways that received the last uplink of the members.
+	GatewayIds []string `protobuf:"bytes,9,rep,name=gateway_ids,json=gatewayIds" json:"gateway_ids,omitempty"`
+	// The Members of the multicast group
+	Members []*MulticastGroup_Member `protobuf:"bytes,10,rep,name=members" json:"members,omitempty"`
+}
+
+func (m *MulticastGroup) Reset()                    { *m = MulticastGroup{} }
+func (*MulticastGroup) ProtoMessage()               {}
+func (*MulticastGroup) Descriptor() ([]byte, []int) { return fileDescriptorMulticast, []int{1} }
+
+func (m *MulticastGroup) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *MulticastGroup) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *MulticastGroup) GetFCntDown() uint32 {
+	if m != nil {
+		return m.FCntDown
+	}
+	return 0
+}
+
+func (m *MulticastGroup) GetDataRate() string {
+	if m != nil {
+		return m.DataRate
+	}
+	return ""
+}
+
+func (m *MulticastGroup) GetFrequency() uint64 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+func (m *MulticastGroup) GetGatewayIds() []string {
+	if m != nil {
+		return m.GatewayIds
+	}
+	return nil
+}
+
+func (m *MulticastGroup) GetMembers() []*MulticastGroup_Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type MulticastGroup_Member struct {
+	// The DevID is a unique identifier for the device. It can contain lowercase letters, numbers, - and _.
+	DevId string `protobuf:"bytes,1,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// The AppEUI of the device. It is filled in by the Handler.
+	AppEui *github_com_TheThingsNetwork_ttn_core_types.AppEUI `protobuf:"bytes,2,opt,name=app_eui,json=appEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.AppEUI" json:"app_eui,omitempty"`
+	// The DevEUI of the device. It is filled in by the Handler.
+	DevEui *github_com_TheThingsNetwork_ttn_core_types.DevEUI `protobuf:"bytes,3,opt,name=dev_eui,json=devEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.DevEUI" json:"dev_eui,omitempty"`
+}
+
+func (m *MulticastGroup_Member) Reset()      { *m = MulticastGroup_Member{} }
+func (*MulticastGroup_Member) ProtoMessage() {}
+func (*MulticastGroup_Member) Descriptor() ([]byte, []int) {
+	return fileDescriptorMulticast, []int{1, 0}
+}
+
+func (m *MulticastGroup_Member) GetDevId() string {
+	if m != nil {
+		return m.DevId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*MulticastGroupIdentifier)(nil), "lorawan.MulticastGroupIdentifier")
+	proto.RegisterType((*MulticastGroup)(nil), "lorawan.MulticastGroup")
+	proto.RegisterType((*MulticastGroup_Member)(nil), "lorawan.MulticastGroup.Member")
+}
+func (this *MulticastGroupIdentifier) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MulticastGroupIdentifier)
+	if !ok {
+		that2, ok := that.(MulticastGroupIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MulticastGroupIdentifier")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MulticastGroupIdentifier but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MulticastGroupIdentifier but is not nil && this == nil")
+	}
+	if this.AppId != that1.AppId {
+		return fmt.Errorf("AppId this(%v) Not Equal that(%v)", this.AppId, that1.AppId)
+	}
+	if this.GroupId != that1.GroupId {
+		return fmt.Errorf("GroupId this(%v) Not Equal that(%v)", this.GroupId, that1.GroupId)
+	}
+	return nil
+}
+func (this *MulticastGroupIdentifier) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*MulticastGroupIdentifier)
+	if !ok {
+		that2, ok := that.(MulticastGroupIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppId != that1.AppId {
+		return false
+	}
+	if this.GroupId != that1.GroupId {
+		return false
+	}
+	return true
+}
+func (this *MulticastGroup) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MulticastGroup)
+	if !ok {
+		that2, ok := that.(MulticastGroup)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MulticastGroup")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MulticastGroup but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MulticastGroup but is not nil && this == nil")
+	}
+	if this.AppId != that1.AppId {
+		return fmt.Errorf("AppId this(%v) Not Equal that(%v)", this.AppId, that1.AppId)
+	}
+	if this.GroupId != that1.GroupId {
+		return fmt.Errorf("GroupId this(%v) Not Equal that(%v)", this.GroupId, that1.GroupId)
+	}
+	if that1.McAddr == nil {
+		if this.McAddr != nil {
+			return fmt.Errorf("this.McAddr != nil && that1.McAddr == nil")
+		}
+	} else if !this.McAddr.Equal(*that1.McAddr) {
+		return fmt.Errorf("McAddr this(%v) Not Equal that(%v)", this.McAddr, that1.McAddr)
+	}
+	if that1.McNwkSKey == nil {
+		if this.McNwkSKey != nil {
+			return fmt.Errorf("this.McNwkSKey != nil && that1.McNwkSKey == nil")
+		}
+	} else if !this.McNwkSKey.Equal(*that1.McNwkSKey) {
+		return fmt.Errorf("McNwkSKey this(%v) Not Equal that(%v)", this.McNwkSKey, that1.McNwkSKey)
+	}
+	if that1.McAppSKey == nil {
+		if this.McAppSKey != nil {
+			return fmt.Errorf("this.McAppSKey != nil && that1.McAppSKey == nil")
+		}
+	} else if !this.McAppSKey.Equal(*that1.McAppSKey) {
+		return fmt.Errorf("McAppSKey this(%v) Not Equal that(%v)", this.McAppSKey, that1.McAppSKey)
+	}
+	if this.FCntDown != that1.FCntDown {
+		return fmt.Errorf("FCntDown this(%v) Not Equal that(%v)", this.FCntDown, that1.FCntDown)
+	}
+	if this.DataRate != that1.DataRate {
+		return fmt.Errorf("DataRate this(%v) Not Equal that(%v)", this.DataRate, that1.DataRate)
+	}
+	if this.Frequency != that1.Frequency {
+		return fmt.Errorf("Frequency this(%v) Not Equal that(%v)", this.Frequency, that1.Frequency)
+	}
+	if len(this.GatewayIds) != len(that1.GatewayIds) {
+		return fmt.Errorf("GatewayIds this(%v) Not Equal that(%v)", len(this.GatewayIds), len(that1.GatewayIds))
+	}
+	for i := range this.GatewayIds {
+		if this.GatewayIds[i] != that1.GatewayIds[i] {
+			return fmt.Errorf("GatewayIds this[%v](%v) Not Equal that[%v](%v)", i, this.GatewayIds[i], i, that1.GatewayIds[i])
+		}
+	}
+	if len(this.Members) != len(that1.Members) {
+		return fmt.Errorf("Members this(%v) Not Equal that(%v)", len(this.Members), len(that1.Members))
+	}
+	for i := range this.Members {
+		if !this.Members[i].Equal(that1.Members[i]) {
+			return fmt.Errorf("Members this[%v](%v) Not Equal that[%v](%v)", i, this.Members[i], i, that1.Members[i])
+		}
+	}
+	return nil
+}
+func (this *MulticastGroup) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*MulticastGroup)
+	if !ok {
+		that2, ok := that.(MulticastGroup)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppId != that1.AppId {
+		return false
+	}
+	if this.GroupId != that1.GroupId {
+		return false
+	}
+	if that1.McAddr == nil {
+		if this.McAddr != nil {
+			return false
+		}
+	} else if !this.McAddr.Equal(*that1.McAddr) {
+		return false
+	}
+	if that1.McNwkSKey == nil {
+		if this.McNwkSKey != nil {
+			return false
+		}
+	} else if !this.McNwkSKey.Equal(*that1.McNwkSKey) {
+		return false
+	}
+	if that1.McAppSKey == nil {
+		if this.McAppSKey != nil {
+			return false
+		}
+	} else if !this.McAppSKey.Equal(*that1.McAppSKey) {
+		return false
+	}
+	if this.FCntDown != that1.FCntDown {
+		return false
+	}
+	if this.DataRate != that1.DataRate {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
+		return false
+	}
+	if len(this.GatewayIds) != len(that1.GatewayIds) {
+		return false
+	}
+	for i := range this.GatewayIds {
+		if this.GatewayIds[i] != that1.GatewayIds[i] {
+			return false
+		}
+	}
+	if len(this.Members) != len(that1.Members) {
+		return false
+	}
+	for i := range this.Members {
+		if !this.Members[i].Equal(that1.Members[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MulticastGroup_Member) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MulticastGroup_Member)
+	if !ok {
+		that2, ok := that.(MulticastGroup_Member)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MulticastGroup_Member")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MulticastGroup_Member but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MulticastGroup_Member but is not nil && this == nil")
+	}
+	if this.DevId != that1.DevId {
+		return fmt.Errorf("DevId this(%v) Not Equal that(%v)", this.DevId, that1.DevId)
+	}
+	if that1.AppEui == nil {
+		if this.AppEui != nil {
+			return fmt.Errorf("this.AppEui != nil && that1.AppEui == nil")
+		}
+	} else if !this.AppEui.Equal(*that1.AppEui) {
+		return fmt.Errorf("AppEui this(%v) Not Equal that(%v)", this.AppEui, that1.AppEui)
+	}
+	if that1.DevEui == nil {
+		if this.DevEui != nil {
+			return fmt.Errorf("this.DevEui != nil && that1.DevEui == nil")
+		}
+	} else if !this.DevEui.Equal(*that1.DevEui) {
+		return fmt.Errorf("DevEui this(%v) Not Equal that(%v)", this.DevEui, that1.DevEui)
+	}
+	return nil
+}
+func (this *MulticastGroup_Member) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*MulticastGroup_Member)
+	if !ok {
+		that2, ok := that.(MulticastGroup_Member)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.DevId != that1.DevId {
+		return false
+	}
+	if that1.AppEui == nil {
+		if this.AppEui != nil {
+			return false
+		}
+	} else if !this.AppEui.Equal(*that1.AppEui) {
+		return false
+	}
+	if that1.DevEui == nil {
+		if this.DevEui != nil {
+			return false
+		}
+	} else if !this.DevEui.Equal(*that1.DevEui) {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for MulticastGroupManager service
+
+type MulticastGroupManagerClient interface {
+	GetMulticastGroup(ctx context.Context, in *MulticastGroupIdentifier, opts ...grpc.CallOption) (*MulticastGroup, error)
+	SetMulticastGroup(ctx context.Context, in *MulticastGroup, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	DeleteMulticastGroup(ctx context.Context, in *MulticastGroupIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+}
+
+type multicastGroupManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMulticastGroupManagerClient(cc *grpc.ClientConn) MulticastGroupManagerClient {
+	return &multicastGroupManagerClient{cc}
+}
+
+func (c *multicastGroupManagerClient) GetMulticastGroup(ctx context.Context, in *MulticastGroupIdentifier, opts ...grpc.CallOption) (*MulticastGroup, error) {
+	out := new(MulticastGroup)
+	err := grpc.Invoke(ctx, "/lorawan.MulticastGroupManager/GetMulticastGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupManagerClient) SetMulticastGroup(ctx context.Context, in *MulticastGroup, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/lorawan.MulticastGroupManager/SetMulticastGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupManagerClient) DeleteMulticastGroup(ctx context.Context, in *MulticastGroupIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/lorawan.MulticastGroupManager/DeleteMulticastGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for MulticastGroupManager service
+
+type MulticastGroupManagerServer interface {
+	GetMulticastGroup(context.Context, *MulticastGroupIdentifier) (*MulticastGroup, error)
+	SetMulticastGroup(context.Context, *MulticastGroup) (*google_protobuf.Empty, error)
+	DeleteMulticastGroup(context.Context, *MulticastGroupIdentifier) (*google_protobuf.Empty, error)
+}
+
+func RegisterMulticastGroupManagerServer(s *grpc.Server, srv MulticastGroupManagerServer) {
+	s.RegisterService(&_MulticastGroupManager_serviceDesc, srv)
+}
+
+func _MulticastGroupManager_GetMulticastGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MulticastGroupIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupManagerServer).GetMulticastGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lorawan.MulticastGroupManager/GetMulticastGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupManagerServer).GetMulticastGroup(ctx, req.(*MulticastGroupIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupManager_SetMulticastGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MulticastGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupManagerServer).SetMulticastGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lorawan.MulticastGroupManager/SetMulticastGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupManagerServer).SetMulticastGroup(ctx, req.(*MulticastGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupManager_DeleteMulticastGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MulticastGroupIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupManagerServer).DeleteMulticastGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lorawan.MulticastGroupManager/DeleteMulticastGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupManagerServer).DeleteMulticastGroup(ctx, req.(*MulticastGroupIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MulticastGroupManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lorawan.MulticastGroupManager",
+	HandlerType: (*MulticastGroupManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMulticastGroup",
+			Handler:    _MulticastGroupManager_GetMulticastGroup_Handler,
+		},
+		{
+			MethodName: "SetMulticastGroup",
+			Handler:    _MulticastGroupManager_SetMulticastGroup_Handler,
+		},
+		{
+			MethodName: "DeleteMulticastGroup",
+			Handler:    _MulticastGroupManager_DeleteMulticastGroup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/TheThingsNetwork/ttn/api/protocol/lorawan/multicast.proto",
+}
+
+func (m *MulticastGroupIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MulticastGroupIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMulticast(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.GroupId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMulticast(dAtA, i, uint64(len(m.GroupId)))
+		i += copy(dAtA[i:], m.GroupId)
+	}
+	return i, nil
+}
+
+func (m *MulticastGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MulticastGroup) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMulticast(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.GroupId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMulticast(dAtA, i, uint64(len(m.GroupId)))
+		i += copy(dAtA[i:], m.GroupId)
+	}
+	if m.McAddr != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMulticast(dAtA, i, uint64(m.McAddr.Size()))
+		n1, err := m.McAddr.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.McNwkSKey != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMulticast(dAtA, i, uint64(m.McNwkSKey.Size()))
+		n2, err := m.McNwkSKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.McAppSKey != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMulticast(dAtA, i, uint64(m.McAppSKey.Size()))
+		n3, err := m.McAppSKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.FCntDown != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintMulticast(dAtA, i, uint64(m.FCntDown))
+	}
+	if len(m.DataRate) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintMulticast(dAtA, i, uint64(len(m.DataRate)))
+		i += copy(dAtA[i:], m.DataRate)
+	}
+	if m.Frequency != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintMulticast(dAtA, i, uint64(m.Frequency))
+	}
+	if len(m.GatewayIds) > 0 {
+		for _, s := range m.GatewayIds {
+			dAtA[i] = 0x4a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Members) > 0 {
+		for _, msg := range m.Members {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintMulticast(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *MulticastGroup_Member) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MulticastGroup_Member) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMulticast(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if m.AppEui != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMulticast(dAtA, i, uint64(m.AppEui.Size()))
+		n4, err := m.AppEui.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.DevEui != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMulticast(dAtA, i, uint64(m.DevEui.Size()))
+		n5, err := m.DevEui.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+
+func encodeFixed64Multicast(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Multicast(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintMulticast(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *MulticastGroupIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovMulticast(uint64(l))
+	}
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovMulticast(uint64(l))
+	}
+	return n
+}
+
+func (m *MulticastGroup) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovMulticast(uint64(l))
+	}
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovMulticast(uint64(l))
+	}
+	if m.McAddr != nil {
+		l = m.McAddr.Size()
+		n += 1 + l + sovMulticast(uint64(l))
+	}
+	if m.McNwkSKey != nil {
+		l = m.McNwkSKey.Size()
+		n += 1 + l + sovMulticast(uint64(l))
+	}
+	if m.McAppSKey != nil {
+		l = m.McAppSKey.Size()
+		n += 1 + l + sovMulticast(uint64(l))
+	}
+	if m.FCntDown != 0 {
+		n += 1 + sovMulticast(uint64(m.FCntDown))
+	}
+	l = len(m.DataRate)
+	if l > 0 {
+		n += 1 + l + sovMulticast(uint64(l))
+	}
+	if m.Frequency != 0 {
+		n += 1 + sovMulticast(uint64(m.Frequency))
+	}
+	if len(m.GatewayIds) > 0 {
+		for _, s := range m.GatewayIds {
+			l = len(s)
+			n += 1 + l + sovMulticast(uint64(l))
+		}
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovMulticast(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MulticastGroup_Member) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovMulticast(uint64(l))
+	}
+	if m.AppEui != nil {
+		l = m.AppEui.Size()
+		n += 1 + l + sovMulticast(uint64(l))
+	}
+	if m.DevEui != nil {
+		l = m.DevEui.Size()
+		n += 1 + l + sovMulticast(uint64(l))
+	}
+	return n
+}
+
+func sovMulticast(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozMulticast(x uint64) (n int) {
+	return sovMulticast(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *MulticastGroupIdentifier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MulticastGroupIdentifier{`,
+		`AppId:` + fmt.Sprintf("%v", this.AppId) + `,`,
+		`GroupId:` + fmt.Sprintf("%v", this.GroupId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MulticastGroup) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MulticastGroup{`,
+		`AppId:` + fmt.Sprintf("%v", this.AppId) + `,`,
+		`GroupId:` + fmt.Sprintf("%v", this.GroupId) + `,`,
+		`McAddr:` + fmt.Sprintf("%v", this.McAddr) + `,`,
+		`McNwkSKey:` + fmt.Sprintf("%v", this.McNwkSKey) + `,`,
+		`McAppSKey:` + fmt.Sprintf("%v", this.McAppSKey) + `,`,
+		`FCntDown:` + fmt.Sprintf("%v", this.FCntDown) + `,`,
+		`DataRate:` + fmt.Sprintf("%v", this.DataRate) + `,`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`GatewayIds:` + fmt.Sprintf("%v", this.GatewayIds) + `,`,
+		`Members:` + strings.Replace(fmt.Sprintf("%v", this.Members), "MulticastGroup_Member", "MulticastGroup_Member", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MulticastGroup_Member) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MulticastGroup_Member{`,
+		`DevId:` + fmt.Sprintf("%v", this.DevId) + `,`,
+		`AppEui:` + fmt.Sprintf("%v", this.AppEui) + `,`,
+		`DevEui:` + fmt.Sprintf("%v", this.DevEui) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMulticast(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *MulticastGroupIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMulticast
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MulticastGroupIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MulticastGroupIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulticast
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMulticast
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulticast
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMulticast
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMulticast(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMulticast
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MulticastGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMulticast
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MulticastGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MulticastGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulticast
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMulticast
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulticast
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMulticast
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field McAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulticast
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMulticast
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.DevAddr
+			m.McAddr = &v
+			if err := m.McAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field McNwkSKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulticast
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMulticast
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.NwkSKey
+			m.McNwkSKey = &v
+			if err := m.McNwkSKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field McAppSKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulticast
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMulticast
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.AppSKey
+			m.McAppSKey = &v
+			if err := m.McAppSKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FCntDown", wireType)
+			}
+			m.FCntDown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulticast
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FCntDown |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulticast
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMulticast
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			}
+			m.Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulticast
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frequency |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulticast
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMulticast
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayIds = append(m.GatewayIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulticast
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMulticast
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &MulticastGroup_Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMulticast(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMulticast
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MulticastGroup_Member) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMulticast
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulticast
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMulticast
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulticast
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMulticast
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.AppEUI
+			m.AppEui = &v
+			if err := m.AppEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulticast
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMulticast
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.DevEUI
+			m.DevEui = &v
+			if err := m.DevEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMulticast(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMulticast
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMulticast(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMulticast
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMulticast
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMulticast
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthMulticast
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowMulticast
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipMulticast(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthMulticast = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMulticast   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() {
+	proto.RegisterFile("github.com/TheThingsNetwork/ttn/api/protocol/lorawan/multicast.proto", fileDescriptorMulticast)
+}
+
+var fileDescriptorMulticast = []byte{
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc7, 0x3b, 0x4d, 0x9b, 0xcb, 0xb4, 0xdf, 0x27, 0x75, 0x44, 0xc1, 0xa4, 0x95, 0x1b, 0xba,
+	0xf2, 0x06, 0x5b, 0x94, 0x8b, 0xd8, 0xb6, 0x38, 0xaa, 0x22, 0x68, 0x2b, 0xdc, 0x02, 0x12, 0x1b,
+	0x6b, 0xe2, 0x39, 0x71, 0xad, 0xc4, 0x1e, 0x33, 0x1e, 0xc7, 0xf2, 0x0e, 0xde, 0x80, 0xc7, 0xe0,
+	0x1d, 0x58, 0xb0, 0x65, 0xc9, 0x12, 0x75, 0x51, 0xb5, 0xe1, 0x45, 0xd0, 0xd8, 0x8e, 0xda, 0x82,
+	0x22, 0xd4, 0xac, 0x72, 0xe6, 0x9c, 0xff, 0xfc, 0x4e, 0xe6, 0x5c, 0x8c, 0x6d, 0x3f, 0x90, 0xa7,
+	0x69, 0xdf, 0xf4, 0x78, 0x68, 0x9d, 0x9c, 0xc2, 0xc9, 0x69, 0x10, 0xf9, 0xc9, 0x21, 0xc8, 0x8c,
+	0x8b, 0xa1, 0x25, 0x65, 0x64, 0xd1, 0x38, 0xb0, 0x62, 0xc1, 0x25, 0xf7, 0xf8, 0xc8, 0x1a, 0x71,
+	0x41, 0x33, 0x1a, 0x59, 0x61, 0x3a, 0x92, 0x81, 0x47, 0x13, 0x69, 0x16, 0x21, 0xd2, 0xa8, 0x02,
+	0xed, 0x0d, 0x9f, 0x73, 0x7f, 0x04, 0xe5, 0x8d, 0x7e, 0x3a, 0xb0, 0x20, 0x8c, 0x65, 0x5e, 0xaa,
+	0xda, 0x0f, 0xaf, 0xe5, 0xf2, 0xb9, 0xcf, 0xaf, 0x54, 0xea, 0x54, 0x1c, 0x0a, 0xab, 0x94, 0x6f,
+	0xbf, 0xc2, 0xda, 0xc1, 0x34, 0xcf, 0xbe, 0xe0, 0x69, 0xdc, 0x63, 0x10, 0xc9, 0x60, 0x10, 0x80,
+	0x20, 0xeb, 0xb8, 0x4e, 0xe3, 0xd8, 0x0d, 0x98, 0x86, 0x3a, 0xc8, 0x68, 0x39, 0xcb, 0x34, 0x8e,
+	0x7b, 0x8c, 0xdc, 0xc7, 0x4d, 0x5f, 0x29, 0x55, 0x60, 0xb1, 0x08, 0x34, 0xfc, 0xf2, 0xe6, 0xf6,
+	0xd7, 0x65, 0xfc, 0xff, 0x4d, 0xdc, 0xed, 0x21, 0xe4, 0x08, 0x37, 0x42, 0xcf, 0xa5, 0x8c, 0x09,
+	0xad, 0xd6, 0x41, 0xc6, 0xea, 0xde, 0xb3, 0xb3, 0xf3, 0xad, 0x9d, 0x7f, 0x95, 0xd0, 0xe3, 0x02,
+	0x2c, 0x99, 0xc7, 0x90, 0x98, 0x36, 0x8c, 0x77, 0x19, 0x13, 0x4e, 0x3d, 0xf4, 0xd4, 0x2f, 0x79,
+	0x87, 0x57, 0x43, 0xcf, 0x8d, 0xb2, 0xa1, 0x9b, 0xb8, 0x43, 0xc8, 0xb5, 0xa5, 0xb9, 0xa8, 0x87,
+	0xd9, 0xf0, 0xf8, 0x25, 0xe4, 0x4e, 0x2b, 0xf4, 0x2a, 0xb3, 0x02, 0xab, 0xe7, 0x95, 0xe0, 0xe5,
+	0xb9, 0xc0, 0xbb, 0x71, 0x3c, 0x05, 0x57, 0x26, 0xd9, 0xc4, 0x78, 0xe0, 0x7a, 0x91, 0x74, 0x19,
+	0xcf, 0x22, 0xad, 0xde, 0x41, 0xc6, 0x7f, 0x4e, 0x73, 0xf0, 0x22, 0x92, 0x36, 0xcf, 0x22, 0xb2,
+	0x81, 0x5b, 0x8c, 0x4a, 0xea, 0x0a, 0x2a, 0x41, 0x6b, 0x14, 0xc5, 0x6b, 0x2a, 0x87, 0x43, 0x25,
+	0x90, 0x4d, 0xdc, 0x1a, 0x08, 0xf8, 0x90, 0x42, 0xe4, 0xe5, 0x5a, 0xb3, 0x83, 0x8c, 0x25, 0xe7,
+	0xca, 0x41, 0xb6, 0xf0, 0x8a, 0x4f, 0x25, 0x64, 0x34, 0x77, 0x03, 0x96, 0x68, 0xad, 0x4e, 0xcd,
+	0x68, 0x39, 0xb8, 0x72, 0xf5, 0x58, 0x42, 0x9e, 0xe3, 0x46, 0x08, 0x61, 0x1f, 0x44, 0xa2, 0xe1,
+	0x4e, 0xcd, 0x58, 0xd9, 0xd1, 0xcd, 0x6a, 0xec, 0xcc, 0x9b, 0x8d, 0x35, 0x0f, 0x0a, 0x99, 0x33,
+	0x95, 0xb7, 0xbf, 0x21, 0x5c, 0x2f, 0x7d, 0xaa, 0xe7, 0x0c, 0xc6, 0xd7, 0x7a, 0xce, 0x60, 0xdc,
+	0x63, 0xe4, 0x10, 0x37, 0x54, 0xad, 0x20, 0x0d, 0x8a, 0x96, 0xaf, 0xee, 0x3d, 0x3d, 0x3b, 0xdf,
+	0x7a, 0x74, 0xbb, 0x4a, 0x75, 0xdf, 0xf4, 0x1c, 0x35, 0x50, 0xdd, 0x34, 0x50, 0x3c, 0x95, 0x46,
+	0xf1, 0x6a, 0x73, 0xf1, 0x6c, 0x18, 0x17, 0x3c, 0x06, 0xe3, 0x6e, 0x1a, 0xec, 0x7c, 0x5a, 0xc4,
+	0xeb, 0x37, 0x1f, 0x79, 0x40, 0x23, 0xea, 0x83, 0x20, 0x47, 0x78, 0x6d, 0x1f, 0xe4, 0x1f, 0x93,
+	0xfd, 0x60, 0x46, 0x65, 0xae, 0x36, 0xa8, 0x7d, 0x6f, 0x86, 0x84, 0xd8, 0x78, 0xed, 0xf8, 0x2f,
+	0xe0, 0x2c, 0x75, 0xfb, 0xae, 0x59, 0x6e, 0xbc, 0x39, 0xdd, 0x65, 0xb3, 0xab, 0x36, 0x9e, 0xbc,
+	0xc6, 0x77, 0x6c, 0x18, 0x81, 0x84, 0xdb, 0xff, 0xb3, 0x19, 0xc8, 0xbd, 0xb7, 0x3f, 0x2f, 0xf5,
+	0x85, 0x8b, 0x4b, 0x1d, 0x7d, 0x9c, 0xe8, 0xe8, 0xcb, 0x44, 0x47, 0xdf, 0x27, 0x3a, 0xfa, 0x31,
+	0xd1, 0xd1, 0xc5, 0x44, 0x47, 0x9f, 0x7f, 0xe9, 0x0b, 0xef, 0x9f, 0xcc, 0xf3, 0x31, 0xeb, 0xd7,
+	0x0b, 0xcf, 0xe3, 0xdf, 0x03, 0x00, 0x77, 0x96, 0xb9, 0xa3, 0x0b, 0x05, 0x00, 0x00,
+}
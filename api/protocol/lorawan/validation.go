@@ -34,6 +34,73 @@ func (m *Device) Validate() error {
 	if err := api.NotEmptyAndValidID(m.DevId, "DevId"); err != nil {
 		return err
 	}
+	if m.Rx1DrOffset > 7 {
+		return errors.NewErrInvalidArgument("Rx1DrOffset", "must be at most 7")
+	}
+	if m.Rx2DataRate != "" {
+		if _, err := types.ParseDataRate(m.Rx2DataRate); err != nil {
+			return errors.NewErrInvalidArgument("Rx2DataRate", err.Error())
+		}
+	}
+	if m.RxDelay > 15 {
+		return errors.NewErrInvalidArgument("RxDelay", "must be at most 15")
+	}
+	if _, ok := MACVersion_name[int32(m.MacVersion)]; !ok {
+		return errors.NewErrInvalidArgument("MacVersion", "unknown LoRaWAN version")
+	}
+	if m.DeviceProfileId != "" {
+		if err := api.NotEmptyAndValidID(m.DeviceProfileId, "DeviceProfileId"); err != nil {
+			return err
+		}
+	}
+	if m.ServiceProfileId != "" {
+		if err := api.NotEmptyAndValidID(m.ServiceProfileId, "ServiceProfileId"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *MulticastGroupIdentifier) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if err := api.NotEmptyAndValidID(m.GroupId, "GroupId"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *MulticastGroup) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if err := api.NotEmptyAndValidID(m.GroupId, "GroupId"); err != nil {
+		return err
+	}
+	if m.McAddr == nil || m.McAddr.IsEmpty() {
+		return errors.NewErrInvalidArgument("McAddr", "can not be empty")
+	}
+	if m.McNwkSKey == nil || m.McNwkSKey.IsEmpty() {
+		return errors.NewErrInvalidArgument("McNwkSKey", "can not be empty")
+	}
+	if m.DataRate != "" {
+		if _, err := types.ParseDataRate(m.DataRate); err != nil {
+			return errors.NewErrInvalidArgument("DataRate", err.Error())
+		}
+	}
+	for _, gatewayID := range m.GatewayIds {
+		if gatewayID == "" {
+			return errors.NewErrInvalidArgument("GatewayIds", "can not contain empty IDs")
+		}
+	}
+	for _, member := range m.Members {
+		if err := api.NotEmptyAndValidID(member.DevId, "Members.DevId"); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
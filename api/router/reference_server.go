@@ -230,3 +230,8 @@ func (s *ReferenceRouterServer) Subscribe(req *SubscribeRequest, stream Router_S
 func (s *ReferenceRouterServer) Activate(ctx context.Context, req *DeviceActivationRequest) (*DeviceActivationResponse, error) {
 	return nil, grpc.Errorf(codes.Unimplemented, "Not implemented")
 }
+
+// TxAck RPC
+func (s *ReferenceRouterServer) TxAck(ctx context.Context, ack *gateway.TxAcknowledgement) (*empty.Empty, error) {
+	return nil, grpc.Errorf(codes.Unimplemented, "Not implemented")
+}
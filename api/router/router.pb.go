@@ -111,8 +111,10 @@ func (m *UplinkMessage) GetTrace() *trace.Trace {
 }
 
 type DownlinkMessage struct {
-	Payload               []byte                    `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Message               *protocol.Message         `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	Payload []byte            `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Message *protocol.Message `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	// ID of the downlink message, that the gateway returns in its TxAcknowledgement
+	Id                    string                    `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
 	ProtocolConfiguration *protocol.TxConfiguration `protobuf:"bytes,11,opt,name=protocol_configuration,json=protocolConfiguration" json:"protocol_configuration,omitempty"`
 	GatewayConfiguration  *gateway.TxConfiguration  `protobuf:"bytes,12,opt,name=gateway_configuration,json=gatewayConfiguration" json:"gateway_configuration,omitempty"`
 	Trace                 *trace.Trace              `protobuf:"bytes,21,opt,name=trace" json:"trace,omitempty"`
@@ -136,6 +138,13 @@ func (m *DownlinkMessage) GetMessage() *protocol.Message {
 	return nil
 }
 
+func (m *DownlinkMessage) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 func (m *DownlinkMessage) GetProtocolConfiguration() *protocol.TxConfiguration {
 	if m != nil {
 		return m.ProtocolConfiguration
@@ -241,6 +250,8 @@ func (m *GatewayStatusRequest) GetGatewayId() string {
 type GatewayStatusResponse struct {
 	LastSeen int64           `protobuf:"varint,1,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
 	Status   *gateway.Status `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	// The duty cycle of the sub-bands of the gateway's frequency plan
+	SubBands []*GatewayStatusResponse_SubBand `protobuf:"bytes,3,rep,name=sub_bands,json=subBands" json:"sub_bands,omitempty"`
 }
 
 func (m *GatewayStatusResponse) Reset()                    { *m = GatewayStatusResponse{} }
@@ -261,6 +272,66 @@ func (m *GatewayStatusResponse) GetStatus() *gateway.Status {
 	return nil
 }
 
+func (m *GatewayStatusResponse) GetSubBands() []*GatewayStatusResponse_SubBand {
+	if m != nil {
+		return m.SubBands
+	}
+	return nil
+}
+
+type GatewayStatusResponse_SubBand struct {
+	// Frequency range in Hz, the max_frequency is exclusive
+	MinFrequency uint64 `protobuf:"varint,1,opt,name=min_frequency,json=minFrequency,proto3" json:"min_frequency,omitempty"`
+	MaxFrequency uint64 `protobuf:"varint,2,opt,name=max_frequency,json=maxFrequency,proto3" json:"max_frequency,omitempty"`
+	// Maximum fraction of time that may be used for transmissions in the sub-band
+	DutyCycle float32 `protobuf:"fixed32,3,opt,name=duty_cycle,json=dutyCycle,proto3" json:"duty_cycle,omitempty"`
+	// Time on air of the transmissions in the sub-band in the last hour, in milliseconds
+	TimeOnAir uint32 `protobuf:"varint,4,opt,name=time_on_air,json=timeOnAir,proto3" json:"time_on_air,omitempty"`
+	// Time on air that is left in the sub-band, in milliseconds
+	Remaining uint32 `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (m *GatewayStatusResponse_SubBand) Reset()      { *m = GatewayStatusResponse_SubBand{} }
+func (*GatewayStatusResponse_SubBand) ProtoMessage() {}
+func (*GatewayStatusResponse_SubBand) Descriptor() ([]byte, []int) {
+	return fileDescriptorRouter, []int{6, 0}
+}
+
+func (m *GatewayStatusResponse_SubBand) GetMinFrequency() uint64 {
+	if m != nil {
+		return m.MinFrequency
+	}
+	return 0
+}
+
+func (m *GatewayStatusResponse_SubBand) GetMaxFrequency() uint64 {
+	if m != nil {
+		return m.MaxFrequency
+	}
+	return 0
+}
+
+func (m *GatewayStatusResponse_SubBand) GetDutyCycle() float32 {
+	if m != nil {
+		return m.DutyCycle
+	}
+	return 0
+}
+
+func (m *GatewayStatusResponse_SubBand) GetTimeOnAir() uint32 {
+	if m != nil {
+		return m.TimeOnAir
+	}
+	return 0
+}
+
+func (m *GatewayStatusResponse_SubBand) GetRemaining() uint32 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
 // message StatusRequest is used to request the status of this Router
 type StatusRequest struct {
 }
@@ -350,6 +421,7 @@ func init() {
 	proto.RegisterType((*DeviceActivationResponse)(nil), "router.DeviceActivationResponse")
 	proto.RegisterType((*GatewayStatusRequest)(nil), "router.GatewayStatusRequest")
 	proto.RegisterType((*GatewayStatusResponse)(nil), "router.GatewayStatusResponse")
+	proto.RegisterType((*GatewayStatusResponse_SubBand)(nil), "router.GatewayStatusResponse.SubBand")
 	proto.RegisterType((*StatusRequest)(nil), "router.StatusRequest")
 	proto.RegisterType((*Status)(nil), "router.Status")
 }
@@ -522,6 +594,9 @@ func (this *DownlinkMessage) VerboseEqual(that interface{}) error {
 	if !this.Message.Equal(that1.Message) {
 		return fmt.Errorf("Message this(%v) Not Equal that(%v)", this.Message, that1.Message)
 	}
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
+	}
 	if !this.ProtocolConfiguration.Equal(that1.ProtocolConfiguration) {
 		return fmt.Errorf("ProtocolConfiguration this(%v) Not Equal that(%v)", this.ProtocolConfiguration, that1.ProtocolConfiguration)
 	}
@@ -564,6 +639,9 @@ func (this *DownlinkMessage) Equal(that interface{}) bool {
 	if !this.Message.Equal(that1.Message) {
 		return false
 	}
+	if this.Id != that1.Id {
+		return false
+	}
 	if !this.ProtocolConfiguration.Equal(that1.ProtocolConfiguration) {
 		return false
 	}
@@ -838,6 +916,14 @@ func (this *GatewayStatusResponse) VerboseEqual(that interface{}) error {
 	if !this.Status.Equal(that1.Status) {
 		return fmt.Errorf("Status this(%v) Not Equal that(%v)", this.Status, that1.Status)
 	}
+	if len(this.SubBands) != len(that1.SubBands) {
+		return fmt.Errorf("SubBands this(%v) Not Equal that(%v)", len(this.SubBands), len(that1.SubBands))
+	}
+	for i := range this.SubBands {
+		if !this.SubBands[i].Equal(that1.SubBands[i]) {
+			return fmt.Errorf("SubBands this[%v](%v) Not Equal that[%v](%v)", i, this.SubBands[i], i, that1.SubBands[i])
+		}
+	}
 	return nil
 }
 func (this *GatewayStatusResponse) Equal(that interface{}) bool {
@@ -871,6 +957,98 @@ func (this *GatewayStatusResponse) Equal(that interface{}) bool {
 	if !this.Status.Equal(that1.Status) {
 		return false
 	}
+	if len(this.SubBands) != len(that1.SubBands) {
+		return false
+	}
+	for i := range this.SubBands {
+		if !this.SubBands[i].Equal(that1.SubBands[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GatewayStatusResponse_SubBand) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*GatewayStatusResponse_SubBand)
+	if !ok {
+		that2, ok := that.(GatewayStatusResponse_SubBand)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *GatewayStatusResponse_SubBand")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *GatewayStatusResponse_SubBand but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *GatewayStatusResponse_SubBand but is not nil && this == nil")
+	}
+	if this.MinFrequency != that1.MinFrequency {
+		return fmt.Errorf("MinFrequency this(%v) Not Equal that(%v)", this.MinFrequency, that1.MinFrequency)
+	}
+	if this.MaxFrequency != that1.MaxFrequency {
+		return fmt.Errorf("MaxFrequency this(%v) Not Equal that(%v)", this.MaxFrequency, that1.MaxFrequency)
+	}
+	if this.DutyCycle != that1.DutyCycle {
+		return fmt.Errorf("DutyCycle this(%v) Not Equal that(%v)", this.DutyCycle, that1.DutyCycle)
+	}
+	if this.TimeOnAir != that1.TimeOnAir {
+		return fmt.Errorf("TimeOnAir this(%v) Not Equal that(%v)", this.TimeOnAir, that1.TimeOnAir)
+	}
+	if this.Remaining != that1.Remaining {
+		return fmt.Errorf("Remaining this(%v) Not Equal that(%v)", this.Remaining, that1.Remaining)
+	}
+	return nil
+}
+func (this *GatewayStatusResponse_SubBand) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*GatewayStatusResponse_SubBand)
+	if !ok {
+		that2, ok := that.(GatewayStatusResponse_SubBand)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.MinFrequency != that1.MinFrequency {
+		return false
+	}
+	if this.MaxFrequency != that1.MaxFrequency {
+		return false
+	}
+	if this.DutyCycle != that1.DutyCycle {
+		return false
+	}
+	if this.TimeOnAir != that1.TimeOnAir {
+		return false
+	}
+	if this.Remaining != that1.Remaining {
+		return false
+	}
 	return true
 }
 func (this *StatusRequest) VerboseEqual(that interface{}) error {
@@ -1051,6 +1229,8 @@ type RouterClient interface {
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Router_SubscribeClient, error)
 	// Gateway requests device activation
 	Activate(ctx context.Context, in *DeviceActivationRequest, opts ...grpc.CallOption) (*DeviceActivationResponse, error)
+	// Gateway acknowledges the transmission of a downlink message
+	TxAck(ctx context.Context, in *gateway.TxAcknowledgement, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 }
 
 type routerClient struct {
@@ -1170,6 +1350,15 @@ func (c *routerClient) Activate(ctx context.Context, in *DeviceActivationRequest
 	return out, nil
 }
 
+func (c *routerClient) TxAck(ctx context.Context, in *gateway.TxAcknowledgement, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/router.Router/TxAck", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Router service
 
 type RouterServer interface {
@@ -1183,6 +1372,8 @@ type RouterServer interface {
 	Subscribe(*SubscribeRequest, Router_SubscribeServer) error
 	// Gateway requests device activation
 	Activate(context.Context, *DeviceActivationRequest) (*DeviceActivationResponse, error)
+	// Gateway acknowledges the transmission of a downlink message
+	TxAck(context.Context, *gateway.TxAcknowledgement) (*google_protobuf.Empty, error)
 }
 
 func RegisterRouterServer(s *grpc.Server, srv RouterServer) {
@@ -1280,6 +1471,24 @@ func _Router_Activate_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Router_TxAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(gateway.TxAcknowledgement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).TxAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/router.Router/TxAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).TxAck(ctx, req.(*gateway.TxAcknowledgement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Router_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "router.Router",
 	HandlerType: (*RouterServer)(nil),
@@ -1288,6 +1497,10 @@ var _Router_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Activate",
 			Handler:    _Router_Activate_Handler,
 		},
+		{
+			MethodName: "TxAck",
+			Handler:    _Router_TxAck_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1527,6 +1740,12 @@ func (m *DownlinkMessage) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n5
 	}
+	if len(m.Id) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
 	if m.ProtocolConfiguration != nil {
 		dAtA[i] = 0x5a
 		i++
@@ -1736,6 +1955,61 @@ func (m *GatewayStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n16
 	}
+	if len(m.SubBands) > 0 {
+		for _, msg := range m.SubBands {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintRouter(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GatewayStatusResponse_SubBand) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayStatusResponse_SubBand) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MinFrequency != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(m.MinFrequency))
+	}
+	if m.MaxFrequency != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(m.MaxFrequency))
+	}
+	if m.DutyCycle != 0 {
+		dAtA[i] = 0x1d
+		i++
+		i = encodeFixed32Router(dAtA, i, uint32(math.Float32bits(float32(m.DutyCycle))))
+	}
+	if m.TimeOnAir != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(m.TimeOnAir))
+	}
+	if m.Remaining != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(m.Remaining))
+	}
 	return i, nil
 }
 
@@ -1919,6 +2193,10 @@ func (m *DownlinkMessage) Size() (n int) {
 		l = m.Message.Size()
 		n += 1 + l + sovRouter(uint64(l))
 	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovRouter(uint64(l))
+	}
 	if m.ProtocolConfiguration != nil {
 		l = m.ProtocolConfiguration.Size()
 		n += 1 + l + sovRouter(uint64(l))
@@ -1998,6 +2276,33 @@ func (m *GatewayStatusResponse) Size() (n int) {
 		l = m.Status.Size()
 		n += 1 + l + sovRouter(uint64(l))
 	}
+	if len(m.SubBands) > 0 {
+		for _, e := range m.SubBands {
+			l = e.Size()
+			n += 1 + l + sovRouter(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GatewayStatusResponse_SubBand) Size() (n int) {
+	var l int
+	_ = l
+	if m.MinFrequency != 0 {
+		n += 1 + sovRouter(uint64(m.MinFrequency))
+	}
+	if m.MaxFrequency != 0 {
+		n += 1 + sovRouter(uint64(m.MaxFrequency))
+	}
+	if m.DutyCycle != 0 {
+		n += 5
+	}
+	if m.TimeOnAir != 0 {
+		n += 1 + sovRouter(uint64(m.TimeOnAir))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovRouter(uint64(m.Remaining))
+	}
 	return n
 }
 
@@ -2086,6 +2391,7 @@ func (this *DownlinkMessage) String() string {
 	s := strings.Join([]string{`&DownlinkMessage{`,
 		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
 		`Message:` + strings.Replace(fmt.Sprintf("%v", this.Message), "Message", "protocol.Message", 1) + `,`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
 		`ProtocolConfiguration:` + strings.Replace(fmt.Sprintf("%v", this.ProtocolConfiguration), "TxConfiguration", "protocol.TxConfiguration", 1) + `,`,
 		`GatewayConfiguration:` + strings.Replace(fmt.Sprintf("%v", this.GatewayConfiguration), "TxConfiguration", "gateway.TxConfiguration", 1) + `,`,
 		`Trace:` + strings.Replace(fmt.Sprintf("%v", this.Trace), "Trace", "trace.Trace", 1) + `,`,
@@ -2136,6 +2442,21 @@ func (this *GatewayStatusResponse) String() string {
 	s := strings.Join([]string{`&GatewayStatusResponse{`,
 		`LastSeen:` + fmt.Sprintf("%v", this.LastSeen) + `,`,
 		`Status:` + strings.Replace(fmt.Sprintf("%v", this.Status), "Status", "gateway.Status", 1) + `,`,
+		`SubBands:` + strings.Replace(fmt.Sprintf("%v", this.SubBands), "GatewayStatusResponse_SubBand", "GatewayStatusResponse_SubBand", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayStatusResponse_SubBand) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayStatusResponse_SubBand{`,
+		`MinFrequency:` + fmt.Sprintf("%v", this.MinFrequency) + `,`,
+		`MaxFrequency:` + fmt.Sprintf("%v", this.MaxFrequency) + `,`,
+		`DutyCycle:` + fmt.Sprintf("%v", this.DutyCycle) + `,`,
+		`TimeOnAir:` + fmt.Sprintf("%v", this.TimeOnAir) + `,`,
+		`Remaining:` + fmt.Sprintf("%v", this.Remaining) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2530,6 +2851,35 @@ func (m *DownlinkMessage) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolConfiguration", wireType)
@@ -3170,6 +3520,177 @@ func (m *GatewayStatusResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubBands", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubBands = append(m.SubBands, &GatewayStatusResponse_SubBand{})
+			if err := m.SubBands[len(m.SubBands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRouter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayStatusResponse_SubBand) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubBand: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubBand: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFrequency", wireType)
+			}
+			m.MinFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinFrequency |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFrequency", wireType)
+			}
+			m.MaxFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFrequency |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutyCycle", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.DutyCycle = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeOnAir", wireType)
+			}
+			m.TimeOnAir = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeOnAir |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRouter(dAtA[iNdEx:])
@@ -3637,62 +4158,73 @@ func init() {
 }
 
 var fileDescriptorRouter = []byte{
-	// 909 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xee, 0xa6, 0xc2, 0x89, 0x5f, 0xec, 0xc4, 0x99, 0xc4, 0xc9, 0xd6, 0x6d, 0x9c, 0x68, 0x0f,
-	0x10, 0x51, 0xba, 0x26, 0x46, 0x15, 0x82, 0x03, 0x22, 0x69, 0xa2, 0xaa, 0x52, 0x5d, 0xa1, 0x4d,
-	0x7a, 0x41, 0x42, 0xd6, 0x78, 0xfd, 0xba, 0x59, 0xc5, 0xde, 0x59, 0x76, 0x66, 0x9d, 0xfa, 0xc6,
-	0x9f, 0x50, 0xfe, 0x00, 0xee, 0xfc, 0x29, 0x1c, 0x39, 0x22, 0x0e, 0xa8, 0x35, 0x77, 0x8e, 0xdc,
-	0x90, 0xd0, 0xce, 0x8f, 0x5d, 0xdb, 0x49, 0x20, 0xfc, 0xe8, 0x25, 0xf6, 0x7c, 0xdf, 0xf7, 0x3e,
-	0xcf, 0xbc, 0xf7, 0x66, 0x5e, 0xe0, 0xe3, 0x20, 0x14, 0x67, 0x69, 0xcf, 0xf5, 0xd9, 0xb0, 0x75,
-	0x7a, 0x86, 0xa7, 0x67, 0x61, 0x14, 0xf0, 0x67, 0x28, 0x2e, 0x58, 0x72, 0xde, 0x12, 0x22, 0x6a,
-	0xd1, 0x38, 0x6c, 0x25, 0x2c, 0x15, 0x98, 0xe8, 0x0f, 0x37, 0x4e, 0x98, 0x60, 0xa4, 0xa4, 0x56,
-	0x8d, 0xbb, 0x01, 0x63, 0xc1, 0x00, 0x5b, 0x12, 0xed, 0xa5, 0x2f, 0x5a, 0x38, 0x8c, 0xc5, 0x58,
-	0x89, 0x1a, 0x0f, 0xa6, 0xdc, 0x03, 0x16, 0xb0, 0x42, 0x95, 0xad, 0xe4, 0x42, 0x7e, 0xd3, 0xf2,
-	0x35, 0xf3, 0x83, 0x34, 0x0e, 0x35, 0xb4, 0x63, 0x20, 0xb9, 0xf4, 0xd9, 0x20, 0xff, 0xa2, 0x05,
-	0xdb, 0x46, 0x10, 0x50, 0x81, 0x17, 0x74, 0x6c, 0x3e, 0x35, 0x7d, 0xc7, 0xd0, 0x22, 0xa1, 0x3e,
-	0xaa, 0xbf, 0x8a, 0x72, 0x08, 0xd4, 0x4e, 0xd2, 0x1e, 0xf7, 0x93, 0xb0, 0x87, 0x1e, 0x7e, 0x9d,
-	0x22, 0x17, 0xce, 0x1f, 0x16, 0x54, 0x9f, 0xc7, 0x83, 0x30, 0x3a, 0xef, 0x20, 0xe7, 0x34, 0x40,
-	0x62, 0xc3, 0x62, 0x4c, 0xc7, 0x03, 0x46, 0xfb, 0xb6, 0xb5, 0x6b, 0xed, 0x55, 0x3c, 0xb3, 0x24,
-	0xf7, 0x61, 0x71, 0xa8, 0x44, 0xf6, 0xc2, 0xae, 0xb5, 0xb7, 0xdc, 0x5e, 0x73, 0xf3, 0xbd, 0xe9,
-	0x68, 0xcf, 0x28, 0xc8, 0x01, 0xac, 0x19, 0xb2, 0x3b, 0x44, 0x41, 0xfb, 0x54, 0x50, 0x7b, 0x59,
-	0x86, 0x6d, 0x14, 0x61, 0xde, 0xcb, 0x8e, 0xe6, 0xbc, 0x9a, 0x01, 0x0d, 0x42, 0x3e, 0x83, 0x9a,
-	0x3e, 0x5b, 0xe1, 0x50, 0x91, 0x0e, 0xeb, 0xae, 0x39, 0xf4, 0x94, 0xc1, 0xaa, 0xc6, 0xf2, 0x78,
-	0x07, 0xde, 0x91, 0xc7, 0xb7, 0xeb, 0x32, 0xa8, 0xe2, 0xaa, 0x64, 0x9c, 0x66, 0x7f, 0x3d, 0x45,
-	0x39, 0xdf, 0x2d, 0xc0, 0xea, 0x11, 0xbb, 0x88, 0xde, 0x42, 0x06, 0xbe, 0x80, 0xcd, 0x3c, 0x03,
-	0x3e, 0x8b, 0x5e, 0x84, 0x41, 0x9a, 0x50, 0x11, 0xb2, 0x48, 0xa7, 0xe1, 0x4e, 0x11, 0x7b, 0xfa,
-	0xf2, 0xd1, 0xb4, 0xc0, 0xab, 0x1b, 0x66, 0x06, 0x26, 0x1d, 0xa8, 0x9b, 0x84, 0xcc, 0x1a, 0xaa,
-	0xac, 0xd8, 0x79, 0x56, 0xe6, 0xfd, 0x36, 0x34, 0x31, 0x6b, 0x77, 0x93, 0xfc, 0xfc, 0x7e, 0x1b,
-	0xb6, 0x8e, 0x70, 0x14, 0xfa, 0x78, 0xe0, 0x8b, 0x70, 0xa4, 0xec, 0x54, 0xef, 0xfc, 0x5f, 0x79,
-	0x7a, 0x06, 0x8b, 0x7d, 0x1c, 0x75, 0x31, 0x0d, 0x65, 0x62, 0x2a, 0x87, 0x0f, 0x7f, 0xfe, 0x65,
-	0x67, 0xff, 0xef, 0xae, 0xa9, 0xcf, 0x12, 0x6c, 0x89, 0x71, 0x8c, 0xdc, 0x3d, 0xc2, 0xd1, 0xf1,
-	0xf3, 0x27, 0x5e, 0xa9, 0x8f, 0xa3, 0xe3, 0x34, 0xcc, 0xfc, 0x68, 0x1c, 0x4b, 0xbf, 0xca, 0xbf,
-	0xf2, 0x3b, 0x88, 0x63, 0xe9, 0x47, 0xe3, 0x38, 0xf3, 0xbb, 0xb2, 0x93, 0xeb, 0xff, 0xb9, 0x93,
-	0x37, 0xff, 0x41, 0x27, 0x77, 0x60, 0x9d, 0xe6, 0xe9, 0x2f, 0x2c, 0xb6, 0xa4, 0xc5, 0xbd, 0x62,
-	0x13, 0x45, 0x8d, 0x72, 0x2f, 0x42, 0x2f, 0x61, 0x45, 0xe1, 0x77, 0xae, 0x2f, 0x7c, 0x03, 0xec,
-	0xcb, 0x75, 0xe7, 0x31, 0x8b, 0x38, 0x3a, 0x0f, 0x61, 0xe3, 0xb1, 0xda, 0xe1, 0x89, 0xa0, 0x22,
-	0xe5, 0xa6, 0x21, 0xb6, 0x01, 0xcc, 0x31, 0x43, 0xd5, 0x13, 0x65, 0xaf, 0xac, 0x91, 0x27, 0x7d,
-	0xe7, 0x2b, 0xa8, 0xcf, 0x85, 0x29, 0x3f, 0x72, 0x17, 0xca, 0x03, 0xca, 0x45, 0x97, 0x23, 0x46,
-	0x32, 0xec, 0xb6, 0xb7, 0x94, 0x01, 0x27, 0x88, 0x11, 0x79, 0x0f, 0x4a, 0x5c, 0xca, 0x75, 0x2b,
-	0xad, 0xe6, 0x19, 0xd3, 0x2e, 0x9a, 0x76, 0x56, 0xa1, 0x3a, 0xb3, 0x1d, 0xe7, 0xb7, 0x05, 0x28,
-	0x29, 0x84, 0xec, 0x41, 0x89, 0x8f, 0xb9, 0xc0, 0xa1, 0xb4, 0x5f, 0x6e, 0xd7, 0xdc, 0xec, 0xc5,
-	0x3d, 0x91, 0x50, 0x26, 0xc9, 0x5c, 0xe4, 0x82, 0xec, 0x43, 0xd9, 0x67, 0xc3, 0x98, 0x45, 0x18,
-	0x09, 0xfd, 0x8b, 0xeb, 0x52, 0xfc, 0xc8, 0xa0, 0x4a, 0x5f, 0xa8, 0xc8, 0x3e, 0xac, 0x98, 0x63,
-	0xeb, 0x9d, 0xaa, 0x0b, 0x0e, 0x32, 0xce, 0xa3, 0x02, 0xb9, 0x57, 0x0d, 0xa6, 0x4f, 0x4e, 0x1c,
-	0x28, 0xa5, 0xf2, 0xd5, 0xd5, 0x57, 0x77, 0x5a, 0xaa, 0x19, 0xf2, 0x2e, 0x2c, 0xf5, 0xf5, 0xcb,
-	0x64, 0x57, 0x2f, 0xa9, 0x72, 0x8e, 0x7c, 0x00, 0xcb, 0x45, 0x8d, 0xb9, 0xbd, 0x72, 0x49, 0x3a,
-	0x4d, 0x93, 0x07, 0x40, 0x7c, 0x16, 0x45, 0xe8, 0x0b, 0xec, 0x77, 0xf5, 0xa6, 0xb8, 0x6c, 0xe7,
-	0xaa, 0xb7, 0x96, 0x33, 0xba, 0x4e, 0x9c, 0xdc, 0x87, 0x02, 0xec, 0xf6, 0x12, 0x76, 0x8e, 0x09,
-	0x97, 0xad, 0x5b, 0xf5, 0x6a, 0x39, 0x71, 0xa8, 0xf0, 0xf6, 0xab, 0x05, 0x28, 0x79, 0x72, 0x4a,
-	0x92, 0x4f, 0xa1, 0x3a, 0x53, 0x6b, 0x32, 0x5f, 0xb6, 0xc6, 0xa6, 0xab, 0x06, 0xa9, 0x6b, 0x46,
-	0xa4, 0x7b, 0x9c, 0x0d, 0xd2, 0x3d, 0x8b, 0x7c, 0x02, 0x25, 0x35, 0x92, 0x48, 0xdd, 0xd5, 0x23,
-	0x78, 0x66, 0x44, 0xfd, 0x45, 0xe8, 0xe7, 0x50, 0xce, 0x47, 0x1c, 0xb1, 0x4d, 0xf4, 0xfc, 0xd4,
-	0x6b, 0x6c, 0x19, 0x66, 0xee, 0xe9, 0xff, 0xd0, 0x22, 0x1d, 0x58, 0xd2, 0x1d, 0x8f, 0x64, 0x27,
-	0x97, 0x5d, 0xfd, 0x02, 0x36, 0x76, 0xaf, 0x17, 0xa8, 0xd6, 0x6e, 0x7f, 0x6b, 0x41, 0x55, 0xa5,
-	0xa4, 0x43, 0x23, 0x1a, 0x60, 0x42, 0x9e, 0xce, 0x67, 0xe6, 0x9e, 0x31, 0xb9, 0xea, 0x4e, 0x35,
-	0xb6, 0xaf, 0x61, 0xf5, 0xd5, 0x69, 0x43, 0xf9, 0x31, 0x0a, 0xed, 0x94, 0xa7, 0x6b, 0xd6, 0x62,
-	0x65, 0x16, 0x3e, 0x7c, 0xfa, 0xd3, 0x9b, 0xe6, 0xad, 0xd7, 0x6f, 0x9a, 0xd6, 0x37, 0x93, 0xa6,
-	0xf5, 0xfd, 0xa4, 0x69, 0xfd, 0x30, 0x69, 0x5a, 0x3f, 0x4e, 0x9a, 0xd6, 0xeb, 0x49, 0xd3, 0x7a,
-	0xf5, 0x6b, 0xf3, 0xd6, 0x97, 0xef, 0xdf, 0xfc, 0x9f, 0xa4, 0x5e, 0x49, 0x16, 0xe1, 0xa3, 0x3f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xac, 0xa4, 0xce, 0xe8, 0x59, 0x09, 0x00, 0x00,
+	// 1080 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6f, 0xe3, 0xc4,
+	0x1b, 0x5e, 0xa7, 0xbb, 0x69, 0xf3, 0x26, 0xe9, 0xc7, 0xb4, 0x69, 0xbd, 0xd9, 0x36, 0x8d, 0xf2,
+	0xd3, 0x0f, 0x22, 0x96, 0x75, 0x68, 0xd0, 0x0a, 0x01, 0x12, 0x22, 0xfd, 0x60, 0xb5, 0xd2, 0x66,
+	0x41, 0x6e, 0xf7, 0xc2, 0x25, 0x9a, 0xd8, 0x53, 0xd7, 0x6a, 0x3c, 0x36, 0x9e, 0x71, 0xdb, 0xdc,
+	0xf8, 0x13, 0xe0, 0xbf, 0xe0, 0x82, 0xc4, 0x9f, 0xc1, 0x05, 0x89, 0x03, 0x07, 0xc4, 0x01, 0xed,
+	0x96, 0x3b, 0x47, 0x6e, 0x48, 0xc8, 0xf3, 0x61, 0x27, 0x69, 0xbb, 0x2c, 0x5f, 0x97, 0xc4, 0xf3,
+	0xbc, 0xcf, 0xfb, 0x78, 0xe6, 0x99, 0x77, 0xe6, 0x35, 0xbc, 0xe3, 0xf9, 0xfc, 0x24, 0x19, 0x5a,
+	0x4e, 0x18, 0x74, 0x8e, 0x4e, 0xc8, 0xd1, 0x89, 0x4f, 0x3d, 0xf6, 0x94, 0xf0, 0xf3, 0x30, 0x3e,
+	0xed, 0x70, 0x4e, 0x3b, 0x38, 0xf2, 0x3b, 0x71, 0x98, 0x70, 0x12, 0xab, 0x3f, 0x2b, 0x8a, 0x43,
+	0x1e, 0xa2, 0xa2, 0x1c, 0xd5, 0xef, 0x79, 0x61, 0xe8, 0x8d, 0x48, 0x47, 0xa0, 0xc3, 0xe4, 0xb8,
+	0x43, 0x82, 0x88, 0x8f, 0x25, 0xa9, 0xfe, 0x60, 0x42, 0xdd, 0x0b, 0xbd, 0x30, 0x67, 0xa5, 0x23,
+	0x31, 0x10, 0x4f, 0x8a, 0xbe, 0xa2, 0x5f, 0x88, 0x23, 0x5f, 0x41, 0xdb, 0x1a, 0x12, 0x43, 0x27,
+	0x1c, 0x65, 0x0f, 0x8a, 0xb0, 0xa5, 0x09, 0x1e, 0xe6, 0xe4, 0x1c, 0x8f, 0xf5, 0xbf, 0x0a, 0xdf,
+	0xd5, 0x61, 0x1e, 0x63, 0x87, 0xc8, 0x5f, 0x19, 0x6a, 0x21, 0x58, 0x3e, 0x4c, 0x86, 0xcc, 0x89,
+	0xfd, 0x21, 0xb1, 0xc9, 0x67, 0x09, 0x61, 0xbc, 0xf5, 0xbb, 0x01, 0xd5, 0x67, 0xd1, 0xc8, 0xa7,
+	0xa7, 0x7d, 0xc2, 0x18, 0xf6, 0x08, 0x32, 0x61, 0x3e, 0xc2, 0xe3, 0x51, 0x88, 0x5d, 0xd3, 0x68,
+	0x1a, 0xed, 0x8a, 0xad, 0x87, 0xe8, 0x3e, 0xcc, 0x07, 0x92, 0x64, 0x16, 0x9a, 0x46, 0xbb, 0xdc,
+	0x5d, 0xb1, 0xb2, 0xb9, 0xa9, 0x6c, 0x5b, 0x33, 0x50, 0x0f, 0x56, 0x74, 0x70, 0x10, 0x10, 0x8e,
+	0x5d, 0xcc, 0xb1, 0x59, 0x16, 0x69, 0x6b, 0x79, 0x9a, 0x7d, 0xd1, 0x57, 0x31, 0x7b, 0x59, 0x83,
+	0x1a, 0x41, 0x1f, 0xc0, 0xb2, 0x5a, 0x5b, 0xae, 0x50, 0x11, 0x0a, 0xab, 0x96, 0x5e, 0xf4, 0x84,
+	0xc0, 0x92, 0xc2, 0xb2, 0xfc, 0x16, 0xdc, 0x11, 0xcb, 0x37, 0x6b, 0x22, 0xa9, 0x62, 0x89, 0x91,
+	0x75, 0x94, 0xfe, 0xda, 0x32, 0xd4, 0xfa, 0xba, 0x00, 0x4b, 0xfb, 0xe1, 0x39, 0xfd, 0x0f, 0x1c,
+	0x58, 0x84, 0x82, 0xef, 0x9a, 0x73, 0x4d, 0xa3, 0x5d, 0xb2, 0x0b, 0xbe, 0x8b, 0x3e, 0x81, 0xf5,
+	0xcc, 0x11, 0x27, 0xa4, 0xc7, 0xbe, 0x97, 0xc4, 0x98, 0xfb, 0x21, 0x55, 0xb6, 0xdc, 0xcd, 0xb5,
+	0x8e, 0x2e, 0xf6, 0x26, 0x09, 0x76, 0x4d, 0x47, 0xa6, 0x60, 0xd4, 0x87, 0x9a, 0x36, 0x68, 0x5a,
+	0x50, 0xba, 0x64, 0x66, 0x2e, 0xcd, 0xea, 0xad, 0xa9, 0xc0, 0xb4, 0xdc, 0xab, 0xf8, 0xf5, 0xdb,
+	0x1c, 0x6c, 0xec, 0x93, 0x33, 0xdf, 0x21, 0x3d, 0x87, 0xfb, 0x67, 0x52, 0x4e, 0xd6, 0xd2, 0xbf,
+	0xe5, 0xdb, 0x53, 0x98, 0x77, 0xc9, 0xd9, 0x80, 0x24, 0xbe, 0x30, 0xa6, 0xb2, 0xfb, 0xf0, 0xa7,
+	0x9f, 0xb7, 0x77, 0xfe, 0xec, 0xd8, 0x3a, 0x61, 0x4c, 0x3a, 0x7c, 0x1c, 0x11, 0x66, 0xed, 0x93,
+	0xb3, 0x83, 0x67, 0x8f, 0xed, 0xa2, 0x4b, 0xce, 0x0e, 0x12, 0x3f, 0xd5, 0xc3, 0x51, 0x24, 0xf4,
+	0x2a, 0x7f, 0x4b, 0xaf, 0x17, 0x45, 0x42, 0x0f, 0x47, 0x51, 0xaa, 0x77, 0x6d, 0x65, 0xd7, 0xfe,
+	0x71, 0x65, 0xaf, 0xff, 0x85, 0xca, 0xee, 0xc3, 0x2a, 0xce, 0xec, 0xcf, 0x25, 0x36, 0x84, 0xc4,
+	0x66, 0x3e, 0x89, 0x7c, 0x8f, 0x32, 0x2d, 0x84, 0xaf, 0x60, 0xf9, 0xc6, 0x6f, 0xdf, 0xbc, 0xf1,
+	0x75, 0x30, 0xaf, 0xee, 0x3b, 0x8b, 0x42, 0xca, 0x48, 0xeb, 0x21, 0xac, 0x3d, 0x92, 0x33, 0x3c,
+	0xe4, 0x98, 0x27, 0x4c, 0x17, 0xc4, 0x16, 0x80, 0x5e, 0xa6, 0x2f, 0x6b, 0xa2, 0x64, 0x97, 0x14,
+	0xf2, 0xd8, 0x6d, 0xfd, 0x50, 0x80, 0xda, 0x4c, 0x9e, 0x14, 0x44, 0xf7, 0xa0, 0x34, 0xc2, 0x8c,
+	0x0f, 0x18, 0x21, 0x54, 0xe4, 0xcd, 0xd9, 0x0b, 0x29, 0x70, 0x48, 0x08, 0x45, 0xaf, 0x43, 0x91,
+	0x09, 0xba, 0xaa, 0xa5, 0xa5, 0xcc, 0x32, 0xa5, 0xa2, 0xc2, 0x68, 0x17, 0x4a, 0x2c, 0x19, 0x0e,
+	0x86, 0x98, 0xba, 0xcc, 0x9c, 0x6b, 0xce, 0xb5, 0xcb, 0xdd, 0xff, 0x5b, 0xea, 0x4e, 0xbf, 0xf6,
+	0xbd, 0xd6, 0x61, 0x32, 0xdc, 0xc5, 0xd4, 0xb5, 0x17, 0x98, 0x7c, 0x60, 0xf5, 0x6f, 0x0c, 0x98,
+	0x57, 0x28, 0xfa, 0x1f, 0x54, 0x03, 0x9f, 0x0e, 0x8e, 0xe3, 0x74, 0x79, 0xd4, 0x19, 0x8b, 0x99,
+	0xdd, 0xb6, 0x2b, 0x81, 0x4f, 0x3f, 0xd2, 0x98, 0x20, 0xe1, 0x8b, 0x09, 0x52, 0x41, 0x91, 0xf0,
+	0x45, 0x4e, 0xda, 0x02, 0x70, 0x13, 0x3e, 0x1e, 0x38, 0x63, 0x67, 0x44, 0xc4, 0x15, 0x51, 0xb0,
+	0x4b, 0x29, 0xb2, 0x97, 0x02, 0xa8, 0x01, 0x65, 0xee, 0x07, 0x64, 0x10, 0xd2, 0x01, 0xf6, 0x63,
+	0xf3, 0x76, 0xd3, 0x68, 0x57, 0xed, 0x52, 0x0a, 0x7d, 0x4c, 0x7b, 0x7e, 0x8c, 0x36, 0xa1, 0x14,
+	0x93, 0x00, 0xfb, 0xd4, 0xa7, 0x9e, 0x79, 0x47, 0x46, 0x33, 0xa0, 0xb5, 0x04, 0xd5, 0xa9, 0x6d,
+	0x68, 0xfd, 0x5a, 0x80, 0xa2, 0x44, 0x50, 0x1b, 0x8a, 0x6c, 0xcc, 0x38, 0x09, 0xc4, 0xdc, 0xcb,
+	0xdd, 0x65, 0x2b, 0xed, 0x3c, 0x87, 0x02, 0x4a, 0x29, 0xa9, 0x79, 0x62, 0x80, 0x76, 0xa0, 0xe4,
+	0x84, 0x41, 0x14, 0x52, 0x42, 0xb9, 0x32, 0x7a, 0x55, 0x90, 0xf7, 0x34, 0x2a, 0xf9, 0x39, 0x0b,
+	0xed, 0xc0, 0xa2, 0xde, 0x6e, 0xb5, 0x41, 0xf2, 0x62, 0x03, 0x91, 0x67, 0x63, 0x4e, 0x98, 0x5d,
+	0xf5, 0x26, 0x8d, 0x47, 0x2d, 0x28, 0x26, 0xa2, 0xfb, 0x98, 0x95, 0x2b, 0x54, 0x15, 0x41, 0xaf,
+	0xc1, 0x82, 0xab, 0x6e, 0x68, 0xb3, 0x7a, 0x85, 0x95, 0xc5, 0xd0, 0x9b, 0x50, 0xce, 0x6b, 0x9b,
+	0x99, 0x8b, 0x57, 0xa8, 0x93, 0x61, 0xf4, 0x00, 0x90, 0x13, 0x52, 0x4a, 0x1c, 0x4e, 0xdc, 0x81,
+	0x9a, 0x14, 0x13, 0xc7, 0xb8, 0x6a, 0xaf, 0x64, 0x11, 0x55, 0x26, 0x0c, 0xdd, 0x87, 0x1c, 0x1c,
+	0x0c, 0xe3, 0xf0, 0x94, 0xc4, 0x4c, 0x1c, 0xd9, 0xaa, 0xbd, 0x9c, 0x05, 0x76, 0x25, 0xde, 0xfd,
+	0xae, 0x00, 0x45, 0x5b, 0xd4, 0x19, 0x7a, 0x0f, 0xaa, 0x53, 0xa5, 0x86, 0x66, 0xab, 0xb5, 0xbe,
+	0x6e, 0xc9, 0x0f, 0x0a, 0x4b, 0x7f, 0x2a, 0x58, 0x07, 0xe9, 0x07, 0x45, 0xdb, 0x40, 0xef, 0x42,
+	0x51, 0xb6, 0x66, 0x54, 0xd3, 0x65, 0x3b, 0xd5, 0xaa, 0x5f, 0x92, 0xfa, 0x21, 0x94, 0xb2, 0x56,
+	0x8f, 0x4c, 0x9d, 0x3d, 0xdb, 0xfd, 0xeb, 0x1b, 0x3a, 0x32, 0xd3, 0x02, 0xdf, 0x32, 0x50, 0x1f,
+	0x16, 0xd4, 0x49, 0x27, 0x68, 0x3b, 0xa3, 0x5d, 0x7f, 0xf3, 0xd7, 0x9b, 0x37, 0x13, 0xd4, 0x89,
+	0x7e, 0x1f, 0xee, 0x1c, 0x5d, 0xf4, 0x9c, 0x53, 0x54, 0x9f, 0x68, 0x4a, 0x3d, 0xe7, 0x94, 0x86,
+	0xe7, 0x23, 0xe2, 0x7a, 0x24, 0x20, 0x94, 0xdf, 0xb4, 0x9e, 0xee, 0x97, 0x06, 0x54, 0xa5, 0x9f,
+	0x7d, 0x4c, 0xb1, 0x47, 0x62, 0xf4, 0x64, 0xd6, 0xd6, 0xcd, 0x1b, 0x0e, 0xb6, 0x9c, 0xdf, 0xd6,
+	0x4b, 0x8f, 0x3d, 0xea, 0x42, 0xe9, 0x11, 0xe1, 0x4a, 0x29, 0xf3, 0x7a, 0x5a, 0x62, 0x71, 0x1a,
+	0xde, 0x7d, 0xf2, 0xe3, 0x8b, 0xc6, 0xad, 0xe7, 0x2f, 0x1a, 0xc6, 0xe7, 0x97, 0x0d, 0xe3, 0xab,
+	0xcb, 0x86, 0xf1, 0xed, 0x65, 0xc3, 0xf8, 0xfe, 0xb2, 0x61, 0x3c, 0xbf, 0x6c, 0x18, 0x5f, 0xfc,
+	0xd2, 0xb8, 0xf5, 0xe9, 0x1b, 0xaf, 0xfe, 0xa5, 0x39, 0x2c, 0x8a, 0x15, 0xbf, 0xfd, 0xc7, 0x00,
+	0x04, 0x25, 0x6d, 0xb1, 0x9e, 0x0a, 0x00, 0x00,
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -17,6 +18,8 @@ import (
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	"github.com/TheThingsNetwork/ttn/core/broker"
 	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/proxy"
+	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -32,6 +35,7 @@ var brokerCmd = &cobra.Command{
 			"Announce":           fmt.Sprintf("%s:%d", viper.GetString("broker.server-address-announce"), viper.GetInt("broker.server-port")),
 			"NetworkServer":      viper.GetString("broker.networkserver-address"),
 			"DeduplicationDelay": viper.GetString("broker.deduplication-delay"),
+			"NetID":              viper.GetString("broker.net-id"),
 		}).Info("Initializing Broker")
 	},
 	Run: func(cmd *cobra.Command, args []string) {
@@ -52,11 +56,32 @@ var brokerCmd = &cobra.Command{
 			nsCert = string(contents)
 		}
 
+		// Roaming Partners
+		netID := viper.GetInt("broker.net-id")
+		roamingPartners := make(map[types.NetID]broker.RoamingPartner)
+		roamingSecrets := viper.GetStringMapString("broker.roaming-secrets")
+		for partner, url := range viper.GetStringMapString("broker.roaming-partners") {
+			partnerNetID, err := types.ParseNetID(partner)
+			if err != nil {
+				ctx.WithError(err).Warn("Could not use roaming partner. Skipping.")
+				continue
+			}
+			secret := roamingSecrets[partner]
+			if secret == "" {
+				ctx.Warnf("No secret configured for roaming partner %s. Skipping.", partnerNetID)
+				continue
+			}
+			roamingPartners[partnerNetID] = broker.RoamingPartner{URL: url, Secret: secret}
+			ctx.Infof("Using roaming partner %s (%s)", partnerNetID, url)
+		}
+
 		// Broker
 		broker := broker.NewBroker(
 			time.Duration(viper.GetInt("broker.deduplication-delay")) * time.Millisecond,
 		)
 		broker.SetNetworkServer(viper.GetString("broker.networkserver-address"), nsCert, viper.GetString("broker.networkserver-token"))
+		broker.SetRoaming(types.NetID{byte(netID >> 16), byte(netID >> 8), byte(netID)}, roamingPartners)
+
 		err = broker.Init(component)
 		if err != nil {
 			ctx.WithError(err).Fatal("Could not initialize broker")
@@ -75,6 +100,28 @@ var brokerCmd = &cobra.Command{
 		broker.RegisterManager(grpc)
 		go grpc.Serve(lis)
 
+		if len(roamingPartners) > 0 && viper.GetString("broker.roaming-address") != "" && viper.GetInt("broker.roaming-port") != 0 {
+			roamingServer := &http.Server{
+				Addr:      fmt.Sprintf("%s:%d", viper.GetString("broker.roaming-address"), viper.GetInt("broker.roaming-port")),
+				Handler:   proxy.WithLogger(broker.RoamingHandler(), ctx),
+				TLSConfig: component.TLSConfig(),
+			}
+			if roamingServer.TLSConfig == nil {
+				ctx.Warn("TLS is not enabled, requests of roaming partners are not encrypted")
+			}
+			go func() {
+				var err error
+				if roamingServer.TLSConfig != nil {
+					err = roamingServer.ListenAndServeTLS("", "")
+				} else {
+					err = roamingServer.ListenAndServe()
+				}
+				if err != nil {
+					ctx.WithError(err).Fatal("Error in roaming server")
+				}
+			}()
+		}
+
 		sigChan := make(chan os.Signal)
 		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 		ctx.WithField("signal", <-sigChan).Info("signal received")
@@ -97,6 +144,17 @@ func init() {
 	brokerCmd.Flags().Int("deduplication-delay", 200, "Deduplication delay (in ms)")
 	viper.BindPFlag("broker.deduplication-delay", brokerCmd.Flags().Lookup("deduplication-delay"))
 
+	brokerCmd.Flags().Int("net-id", 19, "LoRaWAN NetID")
+	viper.BindPFlag("broker.net-id", brokerCmd.Flags().Lookup("net-id"))
+
+	viper.SetDefault("broker.roaming-partners", map[string]string{})
+	viper.SetDefault("broker.roaming-secrets", map[string]string{})
+
+	brokerCmd.Flags().String("roaming-address", "0.0.0.0", "The IP address where the server for roaming partners should listen")
+	brokerCmd.Flags().Int("roaming-port", 8082, "The port where the server for roaming partners should listen")
+	viper.BindPFlag("broker.roaming-address", brokerCmd.Flags().Lookup("roaming-address"))
+	viper.BindPFlag("broker.roaming-port", brokerCmd.Flags().Lookup("roaming-port"))
+
 	brokerCmd.Flags().String("server-address", "0.0.0.0", "The IP address to listen for communication")
 	brokerCmd.Flags().String("server-address-announce", "localhost", "The public IP address to announce")
 	brokerCmd.Flags().Int("server-port", 1902, "The port for communication")
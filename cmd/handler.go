@@ -4,6 +4,7 @@
 package cmd
 
 import (
+	"encoding/hex"
 	"fmt"
 	"net"
 	"net/http"
@@ -41,6 +42,7 @@ var handlerCmd = &cobra.Command{
 			"TTN Broker ID": viper.GetString("handler.broker-id"),
 			"MQTT":          viper.GetString("handler.mqtt-address"),
 			"AMQP":          viper.GetString("handler.amqp-address"),
+			"Join Server":   viper.GetString("handler.join-server-address"),
 		}).Info("Initializing Handler")
 	},
 	Run: func(cmd *cobra.Command, args []string) {
@@ -112,6 +114,24 @@ var handlerCmd = &cobra.Command{
 		} else {
 			ctx.Warn("AMQP is not enabled in your configuration")
 		}
+		if joinServerAddress := viper.GetString("handler.join-server-address"); joinServerAddress != "" {
+			kek, err := hex.DecodeString(viper.GetString("handler.join-server-kek"))
+			if err != nil {
+				ctx.WithError(err).Fatal("Could not parse join server KEK")
+			}
+			if viper.GetString("handler.join-server-kek-label") == "" || len(kek) == 0 {
+				ctx.Fatal("No join server KEK configured")
+			}
+			if viper.GetString("handler.join-server-secret") == "" {
+				ctx.Fatal("No join server secret configured")
+			}
+			handler = handler.WithJoinServer(
+				joinServerAddress,
+				viper.GetString("handler.join-server-secret"),
+				viper.GetString("handler.join-server-kek-label"),
+				kek,
+			)
+		}
 		err = handler.Init(component)
 		if err != nil {
 			ctx.WithError(err).Fatal("Could not initialize handler")
@@ -199,6 +219,15 @@ func init() {
 	viper.BindPFlag("handler.amqp-password", handlerCmd.Flags().Lookup("amqp-password"))
 	viper.BindPFlag("handler.amqp-exchange", handlerCmd.Flags().Lookup("amqp-exchange"))
 
+	handlerCmd.Flags().String("join-server-address", "", "URL of the join server. Leave empty to handle joins in the Handler")
+	handlerCmd.Flags().String("join-server-secret", "", "Secret that the Handler uses to authenticate to the join server")
+	handlerCmd.Flags().String("join-server-kek-label", "", "Label of the KEK that the join server uses to wrap session keys")
+	handlerCmd.Flags().String("join-server-kek", "", "KEK (hex) that the join server uses to wrap session keys")
+	viper.BindPFlag("handler.join-server-address", handlerCmd.Flags().Lookup("join-server-address"))
+	viper.BindPFlag("handler.join-server-secret", handlerCmd.Flags().Lookup("join-server-secret"))
+	viper.BindPFlag("handler.join-server-kek-label", handlerCmd.Flags().Lookup("join-server-kek-label"))
+	viper.BindPFlag("handler.join-server-kek", handlerCmd.Flags().Lookup("join-server-kek"))
+
 	handlerCmd.Flags().String("server-address", "0.0.0.0", "The IP address to listen for communication")
 	handlerCmd.Flags().String("server-address-announce", "localhost", "The public IP address to announce")
 	handlerCmd.Flags().Int("server-port", 1904, "The port for communication")
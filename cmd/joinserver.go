@@ -0,0 +1,114 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/core/joinserver"
+	"github.com/TheThingsNetwork/ttn/core/proxy"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/redis.v5"
+)
+
+// joinServerCmd represents the join-server command
+var joinServerCmd = &cobra.Command{
+	Use:   "join-server",
+	Short: "LoRaWAN join server",
+	Long:  `ttn join-server handles JoinRequests over the LoRaWAN Backend Interfaces`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		ctx.WithFields(ttnlog.Fields{
+			"Server":   fmt.Sprintf("%s:%d", viper.GetString("join-server.server-address"), viper.GetInt("join-server.server-port")),
+			"Database": fmt.Sprintf("%s/%d", viper.GetString("join-server.redis-address"), viper.GetInt("join-server.redis-db")),
+			"KEK":      viper.GetString("join-server.kek-label"),
+		}).Info("Initializing Join Server")
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx.Info("Starting")
+
+		client := joinServerRedisClient()
+		if err := connectRedis(client); err != nil {
+			ctx.WithError(err).Fatal("Could not initialize database connection")
+		}
+
+		kek, err := hex.DecodeString(viper.GetString("join-server.kek"))
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not parse KEK")
+		}
+		if viper.GetString("join-server.kek-label") == "" || len(kek) == 0 {
+			ctx.Fatal("No KEK configured, the join server can not send session keys to the Handler")
+		}
+
+		secrets := viper.GetStringMapString("join-server.secrets")
+		if len(secrets) == 0 {
+			ctx.Fatal("No secrets configured, the join server would not accept any JoinRequests")
+		}
+
+		tlsCert, tlsKey := viper.GetString("join-server.tls-cert"), viper.GetString("join-server.tls-key")
+		if tlsCert == "" || tlsKey == "" {
+			ctx.Fatal("No TLS certificate configured, the join server only serves over TLS")
+		}
+
+		js := joinserver.NewRedisJoinServer(ctx, client, viper.GetString("join-server.kek-label"), kek, secrets)
+
+		go func() {
+			err := http.ListenAndServeTLS(
+				fmt.Sprintf("%s:%d", viper.GetString("join-server.server-address"), viper.GetInt("join-server.server-port")),
+				tlsCert,
+				tlsKey,
+				proxy.WithLogger(js, ctx),
+			)
+			if err != nil {
+				ctx.WithError(err).Fatal("Error in join server")
+			}
+		}()
+
+		sigChan := make(chan os.Signal)
+		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+		ctx.WithField("signal", <-sigChan).Info("signal received")
+	},
+}
+
+func joinServerRedisClient() *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:     viper.GetString("join-server.redis-address"),
+		Password: viper.GetString("join-server.redis-password"),
+		DB:       viper.GetInt("join-server.redis-db"),
+	})
+}
+
+func init() {
+	RootCmd.AddCommand(joinServerCmd)
+
+	joinServerCmd.PersistentFlags().String("redis-address", "localhost:6379", "Redis host and port")
+	viper.BindPFlag("join-server.redis-address", joinServerCmd.PersistentFlags().Lookup("redis-address"))
+	joinServerCmd.PersistentFlags().String("redis-password", "", "Redis password")
+	viper.BindPFlag("join-server.redis-password", joinServerCmd.PersistentFlags().Lookup("redis-password"))
+	joinServerCmd.PersistentFlags().Int("redis-db", 0, "Redis database")
+	viper.BindPFlag("join-server.redis-db", joinServerCmd.PersistentFlags().Lookup("redis-db"))
+
+	joinServerCmd.Flags().String("kek-label", "", "Label of the KEK that is used to wrap session keys")
+	joinServerCmd.Flags().String("kek", "", "KEK (hex) that is used to wrap session keys")
+	viper.BindPFlag("join-server.kek-label", joinServerCmd.Flags().Lookup("kek-label"))
+	viper.BindPFlag("join-server.kek", joinServerCmd.Flags().Lookup("kek"))
+
+	viper.SetDefault("join-server.secrets", map[string]string{})
+
+	joinServerCmd.Flags().String("tls-cert", "", "Location of the TLS certificate of the join server")
+	joinServerCmd.Flags().String("tls-key", "", "Location of the TLS key of the join server")
+	viper.BindPFlag("join-server.tls-cert", joinServerCmd.Flags().Lookup("tls-cert"))
+	viper.BindPFlag("join-server.tls-key", joinServerCmd.Flags().Lookup("tls-key"))
+
+	joinServerCmd.Flags().String("server-address", "0.0.0.0", "The IP address to listen for JoinRequests")
+	joinServerCmd.Flags().Int("server-port", 8085, "The port to listen for JoinRequests")
+	viper.BindPFlag("join-server.server-address", joinServerCmd.Flags().Lookup("server-address"))
+	viper.BindPFlag("join-server.server-port", joinServerCmd.Flags().Lookup("server-port"))
+}
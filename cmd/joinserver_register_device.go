@@ -0,0 +1,66 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"github.com/TheThingsNetwork/ttn/core/joinserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/spf13/cobra"
+)
+
+// joinServerRegisterDeviceCmd represents the register-device command
+var joinServerRegisterDeviceCmd = &cobra.Command{
+	Use:   "register-device [AppEUI] [DevEUI] [AppKey] [NwkKey]",
+	Short: "Register a device to this join server",
+	Long: `ttn join-server register-device registers the root keys of a device to this join server.
+The NwkKey is only needed for LoRaWAN 1.1 devices.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 3 || len(args) > 4 {
+			cmd.UsageFunc()(cmd)
+			return
+		}
+
+		appEUI, err := types.ParseAppEUI(args[0])
+		if err != nil {
+			ctx.WithError(err).Fatal("Invalid AppEUI")
+		}
+		devEUI, err := types.ParseDevEUI(args[1])
+		if err != nil {
+			ctx.WithError(err).Fatal("Invalid DevEUI")
+		}
+		appKey, err := types.ParseAppKey(args[2])
+		if err != nil {
+			ctx.WithError(err).Fatal("Invalid AppKey")
+		}
+		var nwkKey types.AppKey
+		if len(args) == 4 {
+			nwkKey, err = types.ParseAppKey(args[3])
+			if err != nil {
+				ctx.WithError(err).Fatal("Invalid NwkKey")
+			}
+		}
+
+		client := joinServerRedisClient()
+		if err := connectRedis(client); err != nil {
+			ctx.WithError(err).Fatal("Could not initialize database connection")
+		}
+
+		devices := device.NewRedisDeviceStore(client, "joinserver")
+		err = devices.Set(&device.Device{
+			AppEUI: appEUI,
+			DevEUI: devEUI,
+			AppKey: appKey,
+			NwkKey: nwkKey,
+		})
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not register device")
+		}
+
+		ctx.WithField("AppEUI", appEUI).WithField("DevEUI", devEUI).Info("Registered device")
+	},
+}
+
+func init() {
+	joinServerCmd.AddCommand(joinServerRegisterDeviceCmd)
+}
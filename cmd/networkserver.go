@@ -6,17 +6,26 @@ package cmd
 import (
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb "github.com/TheThingsNetwork/ttn/api/networkserver"
+	"github.com/TheThingsNetwork/ttn/api/pool"
 	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/networkserver"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/proxy"
+	"github.com/TheThingsNetwork/ttn/core/proxy/jsonpb"
 	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
 	"google.golang.org/grpc"
 	"gopkg.in/redis.v5"
 )
@@ -28,9 +37,10 @@ var networkserverCmd = &cobra.Command{
 	Long:  ``,
 	PreRun: func(cmd *cobra.Command, args []string) {
 		ctx.WithFields(ttnlog.Fields{
-			"Server":   fmt.Sprintf("%s:%d", viper.GetString("networkserver.server-address"), viper.GetInt("networkserver.server-port")),
-			"Database": fmt.Sprintf("%s/%d", viper.GetString("networkserver.redis-address"), viper.GetInt("networkserver.redis-db")),
-			"NetID":    viper.GetString("networkserver.net-id"),
+			"Server":     fmt.Sprintf("%s:%d", viper.GetString("networkserver.server-address"), viper.GetInt("networkserver.server-port")),
+			"HTTP Proxy": fmt.Sprintf("%s:%d", viper.GetString("networkserver.http-address"), viper.GetInt("networkserver.http-port")),
+			"Database":   fmt.Sprintf("%s/%d", viper.GetString("networkserver.redis-address"), viper.GetInt("networkserver.redis-db")),
+			"NetID":      viper.GetString("networkserver.net-id"),
 		}).Info("Initializing Network Server")
 	},
 	Run: func(cmd *cobra.Command, args []string) {
@@ -53,8 +63,29 @@ var networkserverCmd = &cobra.Command{
 			ctx.WithError(err).Fatal("Could not initialize component")
 		}
 
+		// Device status interval, can be overridden per application
+		networkserver.DefaultDevStatusInterval = viper.GetDuration("networkserver.dev-status-interval")
+
 		// networkserver Server
 		networkserver := networkserver.NewRedisNetworkServer(client, viper.GetInt("networkserver.net-id"))
+		if viper.GetBool("networkserver.cache") {
+			networkserver.WithCache(device.DefaultCacheOptions)
+		}
+
+		// Device status intervals per application
+		devStatusIntervals := make(map[string]time.Duration)
+		for _, app := range viper.GetStringSlice("networkserver.app-dev-status-intervals") {
+			parts := strings.SplitN(app, "=", 2)
+			if len(parts) != 2 {
+				ctx.WithField("Application", app).Fatal("Invalid device status interval")
+			}
+			interval, err := time.ParseDuration(parts[1])
+			if err != nil {
+				ctx.WithError(err).WithField("Application", app).Fatal("Invalid device status interval")
+			}
+			devStatusIntervals[parts[0]] = interval
+		}
+		networkserver.WithDevStatusIntervals(devStatusIntervals)
 
 		// Register Prefixes
 		for prefix, usage := range viper.GetStringMapString("networkserver.prefixes") {
@@ -89,6 +120,33 @@ var networkserverCmd = &cobra.Command{
 		networkserver.RegisterManager(grpc)
 		go grpc.Serve(lis)
 
+		if viper.GetString("networkserver.http-address") != "" && viper.GetInt("networkserver.http-port") != 0 {
+			proxyConn, err := component.Identity.Dial(pool.Global)
+			if err != nil {
+				ctx.WithError(err).Fatal("Could not start client for gRPC proxy")
+			}
+			mux := runtime.NewServeMux(runtime.WithMarshalerOption("*", &jsonpb.GoGoJSONPb{
+				OrigName: true,
+			}))
+			netCtx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			pb.RegisterProfileManagerHandler(netCtx, mux, proxyConn)
+
+			prxy := proxy.WithToken(mux)
+			prxy = proxy.WithPagination(prxy)
+			prxy = proxy.WithLogger(prxy, ctx)
+
+			go func() {
+				err := http.ListenAndServe(
+					fmt.Sprintf("%s:%d", viper.GetString("networkserver.http-address"), viper.GetInt("networkserver.http-port")),
+					prxy,
+				)
+				if err != nil {
+					ctx.WithError(err).Fatal("Error in gRPC proxy")
+				}
+			}()
+		}
+
 		sigChan := make(chan os.Signal)
 		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 		ctx.WithField("signal", <-sigChan).Info("signal received")
@@ -108,9 +166,17 @@ func init() {
 	networkserverCmd.Flags().Int("redis-db", 0, "Redis database")
 	viper.BindPFlag("networkserver.redis-db", networkserverCmd.Flags().Lookup("redis-db"))
 
+	networkserverCmd.Flags().Bool("cache", false, "Add an in-memory DevAddr index in front of the database")
+	viper.BindPFlag("networkserver.cache", networkserverCmd.Flags().Lookup("cache"))
+
 	networkserverCmd.Flags().Int("net-id", 19, "LoRaWAN NetID")
 	viper.BindPFlag("networkserver.net-id", networkserverCmd.Flags().Lookup("net-id"))
 
+	networkserverCmd.Flags().Duration("dev-status-interval", 24*time.Hour, "Interval for requesting the status of devices (0 to disable)")
+	viper.BindPFlag("networkserver.dev-status-interval", networkserverCmd.Flags().Lookup("dev-status-interval"))
+	networkserverCmd.Flags().StringSlice("app-dev-status-intervals", []string{}, "Interval for requesting the status of devices per application (app-id=interval, 0 to disable)")
+	viper.BindPFlag("networkserver.app-dev-status-intervals", networkserverCmd.Flags().Lookup("app-dev-status-intervals"))
+
 	viper.SetDefault("networkserver.prefixes", map[string]string{
 		"26000000/20": "otaa,abp,world,local,private,testing",
 	})
@@ -121,4 +187,9 @@ func init() {
 	viper.BindPFlag("networkserver.server-address", networkserverCmd.Flags().Lookup("server-address"))
 	viper.BindPFlag("networkserver.server-address-announce", networkserverCmd.Flags().Lookup("server-address-announce"))
 	viper.BindPFlag("networkserver.server-port", networkserverCmd.Flags().Lookup("server-port"))
+
+	networkserverCmd.Flags().String("http-address", "0.0.0.0", "The IP address where the gRPC proxy should listen")
+	networkserverCmd.Flags().Int("http-port", 8083, "The port where the gRPC proxy should listen")
+	viper.BindPFlag("networkserver.http-address", networkserverCmd.Flags().Lookup("http-address"))
+	viper.BindPFlag("networkserver.http-port", networkserverCmd.Flags().Lookup("http-port"))
 }
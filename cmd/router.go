@@ -8,11 +8,14 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/router"
+	"github.com/TheThingsNetwork/ttn/core/router/gateway"
+	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
@@ -42,8 +45,34 @@ var routerCmd = &cobra.Command{
 			component.Identity.MqttAddress = mqttAddress
 		}
 
+		// Downlink deadline
+		gateway.Deadline = viper.GetDuration("router.downlink-deadline")
+		gateway.MinDeadline = viper.GetDuration("router.downlink-deadline-min")
+		gateway.MaxDeadline = viper.GetDuration("router.downlink-deadline-max")
+
 		// Router
 		router := router.NewRouter()
+
+		if udpAddress := viper.GetString("router.udp-address"); udpAddress != "" {
+			gateways := make(map[types.EUI64]string)
+			for _, gateway := range viper.GetStringSlice("router.udp-gateways") {
+				parts := strings.SplitN(gateway, "=", 2)
+				eui, err := types.ParseEUI64(parts[0])
+				if err != nil {
+					ctx.WithError(err).WithField("Gateway", gateway).Fatal("Invalid UDP gateway")
+				}
+				gateways[eui] = ""
+				if len(parts) == 2 {
+					gateways[eui] = parts[1]
+				}
+			}
+			router = router.WithUDP(udpAddress, gateways)
+		}
+
+		if stationAddress := viper.GetString("router.basic-station-address"); stationAddress != "" {
+			router = router.WithBasicStation(stationAddress, viper.GetString("router.basic-station-frequency-plan"))
+		}
+
 		err = router.Init(component)
 		if err != nil {
 			ctx.WithError(err).Fatal("Could not initialize router")
@@ -78,9 +107,23 @@ func init() {
 	routerCmd.Flags().Int("server-port", 1901, "The port for communication")
 	routerCmd.Flags().String("mqtt-address-announce", "", "MQTT address to announce")
 	routerCmd.Flags().Bool("skip-verify-gateway-token", false, "Skip verification of the gateway token")
+	routerCmd.Flags().String("basic-station-address", "", "The address to listen for LoRa Basics Station WebSocket connections (disabled if empty)")
+	routerCmd.Flags().String("basic-station-frequency-plan", "EU_863_870", "The frequency plan of LoRa Basics Stations that did not report their frequency plan")
+	routerCmd.Flags().String("udp-address", "", "The address to listen for Semtech UDP packet forwarders (disabled if empty)")
+	routerCmd.Flags().StringSlice("udp-gateways", []string{}, "Gateways that are allowed to connect over UDP as untrusted gateways (EUI or EUI=gateway-id); all gateways are allowed if empty")
+	routerCmd.Flags().Duration("downlink-deadline", gateway.Deadline, "The time before transmission to send downlink to gateways of which the latency is not known")
+	routerCmd.Flags().Duration("downlink-deadline-min", gateway.MinDeadline, "The minimum time before transmission to send downlink to gateways, based on their latency")
+	routerCmd.Flags().Duration("downlink-deadline-max", gateway.MaxDeadline, "The maximum time before transmission to send downlink to gateways, based on their latency")
 	viper.BindPFlag("router.server-address", routerCmd.Flags().Lookup("server-address"))
 	viper.BindPFlag("router.server-address-announce", routerCmd.Flags().Lookup("server-address-announce"))
 	viper.BindPFlag("router.server-port", routerCmd.Flags().Lookup("server-port"))
 	viper.BindPFlag("router.mqtt-address-announce", routerCmd.Flags().Lookup("mqtt-address-announce"))
 	viper.BindPFlag("router.skip-verify-gateway-token", routerCmd.Flags().Lookup("skip-verify-gateway-token"))
+	viper.BindPFlag("router.basic-station-address", routerCmd.Flags().Lookup("basic-station-address"))
+	viper.BindPFlag("router.basic-station-frequency-plan", routerCmd.Flags().Lookup("basic-station-frequency-plan"))
+	viper.BindPFlag("router.udp-address", routerCmd.Flags().Lookup("udp-address"))
+	viper.BindPFlag("router.udp-gateways", routerCmd.Flags().Lookup("udp-gateways"))
+	viper.BindPFlag("router.downlink-deadline", routerCmd.Flags().Lookup("downlink-deadline"))
+	viper.BindPFlag("router.downlink-deadline-min", routerCmd.Flags().Lookup("downlink-deadline-min"))
+	viper.BindPFlag("router.downlink-deadline-max", routerCmd.Flags().Lookup("downlink-deadline-max"))
 }
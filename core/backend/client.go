@@ -0,0 +1,127 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package backend
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// Err returns an error if the Result is not successful
+func (r Result) Err() error {
+	if r.ResultCode == ResultSuccess {
+		return nil
+	}
+	if r.Description != "" {
+		return errors.New(fmt.Sprintf("%s: %s", r.ResultCode, r.Description))
+	}
+	return errors.New(r.ResultCode)
+}
+
+// Client sends Backend Interfaces requests over HTTP
+type Client struct {
+	SenderID      string
+	HTTPClient    *http.Client
+	transactionID uint32
+}
+
+// NewClient returns a new Client that sends requests as senderID
+func NewClient(senderID string, timeout time.Duration) *Client {
+	return &Client{
+		SenderID:   senderID,
+		HTTPClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// NewHeader returns the Header for a new request to receiverID
+func (c *Client) NewHeader(receiverID string, messageType MessageType) Header {
+	return Header{
+		ProtocolVersion: ProtocolVersion,
+		SenderID:        c.SenderID,
+		ReceiverID:      receiverID,
+		TransactionID:   atomic.AddUint32(&c.transactionID, 1),
+		MessageType:     messageType,
+	}
+}
+
+// Do sends the request to the URL and decodes the answer into ans
+func (c *Client) Do(url string, req interface{}, ans interface{}) error {
+	return c.DoWithSecret(url, "", req, ans)
+}
+
+// DoWithSecret sends the request to the URL with the shared secret of the receiver in the Authorization header, and
+// decodes the answer into ans
+func (c *Client) DoWithSecret(url string, secret string, req interface{}, ans interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	httpReq, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if secret != "" {
+		httpReq.Header.Set("Authorization", authorization(secret))
+	}
+	resp, err := c.HTTPClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(fmt.Sprintf("HTTP status %d", resp.StatusCode))
+	}
+	body, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, ans)
+}
+
+// ReadRequest reads a Backend Interfaces request. It returns the Header of the request and the full request body, that
+// can be decoded into the message that corresponds with the MessageType of the Header.
+func ReadRequest(r *http.Request) (header Header, body []byte, err error) {
+	if r.Method != http.MethodPost {
+		return header, nil, errors.NewErrInvalidArgument("Method", "must be POST")
+	}
+	body, err = ioutil.ReadAll(r.Body)
+	if err != nil {
+		return header, nil, err
+	}
+	if err = json.Unmarshal(body, &header); err != nil {
+		return header, nil, errors.NewErrInvalidArgument("Request", err.Error())
+	}
+	if header.ProtocolVersion != ProtocolVersion {
+		return header, nil, errors.NewErrInvalidArgument("ProtocolVersion", "unsupported")
+	}
+	return header, body, nil
+}
+
+func authorization(secret string) string {
+	return "Bearer " + secret
+}
+
+// Authorized returns true if the Authorization header of the request contains the shared secret of the sender. Requests
+// are never authorized if the secret is empty.
+func Authorized(r *http.Request, secret string) bool {
+	if secret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(authorization(secret))) == 1
+}
+
+// WriteAnswer writes a Backend Interfaces answer
+func WriteAnswer(w http.ResponseWriter, ans interface{}) error {
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(ans)
+}
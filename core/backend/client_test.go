@@ -0,0 +1,101 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package backend
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestHEXBytes(t *testing.T) {
+	a := New(t)
+
+	data, err := json.Marshal(HEXBytes{0x01, 0xab})
+	a.So(err, ShouldBeNil)
+	a.So(string(data), ShouldEqual, `"01AB"`)
+
+	var b HEXBytes
+	a.So(json.Unmarshal([]byte(`"0x01ab"`), &b), ShouldBeNil)
+	a.So(b, ShouldResemble, HEXBytes{0x01, 0xab})
+
+	a.So(json.Unmarshal([]byte(`"xyz"`), &b), ShouldNotBeNil)
+}
+
+func TestClient(t *testing.T) {
+	a := New(t)
+
+	var received *XmitDataReqMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header, body, err := ReadRequest(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received = new(XmitDataReqMessage)
+		json.Unmarshal(body, received)
+		WriteAnswer(w, XmitDataAnsMessage{
+			Header: header.Answer(XmitDataAns),
+			Result: Result{ResultCode: ResultSuccess},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("000013", time.Second)
+
+	req := XmitDataReqMessage{
+		Header:     client.NewHeader("600001", XmitDataReq),
+		PHYPayload: HEXBytes{0x40, 0x01, 0x02, 0x03, 0x04},
+	}
+	var ans XmitDataAnsMessage
+	err := client.Do(server.URL, req, &ans)
+	a.So(err, ShouldBeNil)
+	a.So(ans.Result.Err(), ShouldBeNil)
+	a.So(ans.SenderID, ShouldEqual, "600001")
+	a.So(ans.ReceiverID, ShouldEqual, "000013")
+	a.So(ans.TransactionID, ShouldEqual, req.TransactionID)
+	a.So(ans.MessageType, ShouldEqual, XmitDataAns)
+	a.So(received.PHYPayload, ShouldResemble, req.PHYPayload)
+
+	// Next request has a new TransactionID
+	a.So(client.NewHeader("600001", XmitDataReq).TransactionID, ShouldNotEqual, req.TransactionID)
+
+	// Unsupported protocol version
+	req.ProtocolVersion = "0.9"
+	err = client.Do(server.URL, req, &ans)
+	a.So(err, ShouldNotBeNil)
+
+	a.So(Result{ResultCode: ResultXmitFailed, Description: "no gateway"}.Err(), ShouldNotBeNil)
+}
+
+func TestAuthorized(t *testing.T) {
+	a := New(t)
+
+	var authorized bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorized = Authorized(r, "secret")
+		header, _, _ := ReadRequest(r)
+		WriteAnswer(w, XmitDataAnsMessage{Header: header.Answer(XmitDataAns)})
+	}))
+	defer server.Close()
+
+	client := NewClient("000013", time.Second)
+	req := XmitDataReqMessage{Header: client.NewHeader("600001", XmitDataReq)}
+	var ans XmitDataAnsMessage
+
+	client.Do(server.URL, req, &ans)
+	a.So(authorized, ShouldBeFalse)
+
+	client.DoWithSecret(server.URL, "other", req, &ans)
+	a.So(authorized, ShouldBeFalse)
+
+	client.DoWithSecret(server.URL, "secret", req, &ans)
+	a.So(authorized, ShouldBeTrue)
+
+	a.So(Authorized(httptest.NewRequest("POST", "/", nil), ""), ShouldBeFalse)
+}
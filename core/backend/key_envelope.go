@@ -0,0 +1,36 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package backend
+
+import (
+	"fmt"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/keywrap"
+)
+
+// WrapKey returns a KeyEnvelope with the key wrapped by the KEK. Session keys are never sent in plaintext, so a
+// KEK must be given.
+func WrapKey(key []byte, kekLabel string, kek []byte) (*KeyEnvelope, error) {
+	if kekLabel == "" || len(kek) == 0 {
+		return nil, errors.NewErrInvalidArgument("KEK", "must be configured")
+	}
+	wrapped, err := keywrap.Wrap(kek, key)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyEnvelope{KEKLabel: kekLabel, AESKey: wrapped}, nil
+}
+
+// Unwrap returns the key in the KeyEnvelope. The keks contain the KEK for each KEKLabel.
+func (e *KeyEnvelope) Unwrap(keks map[string][]byte) ([]byte, error) {
+	if e.KEKLabel == "" {
+		return nil, errors.NewErrInvalidArgument("KeyEnvelope", "key is not wrapped")
+	}
+	kek, ok := keks[e.KEKLabel]
+	if !ok {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("KEK with label %s", e.KEKLabel))
+	}
+	return keywrap.Unwrap(kek, e.AESKey)
+}
@@ -0,0 +1,35 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package backend
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestKeyEnvelope(t *testing.T) {
+	a := New(t)
+
+	key := []byte{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+	kek := []byte{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1}
+
+	// Keys are not sent in plaintext
+	_, err := WrapKey(key, "", nil)
+	a.So(err, ShouldNotBeNil)
+	_, err = (&KeyEnvelope{AESKey: key}).Unwrap(nil)
+	a.So(err, ShouldNotBeNil)
+
+	envelope, err := WrapKey(key, "handler", kek)
+	a.So(err, ShouldBeNil)
+	a.So(envelope.KEKLabel, ShouldEqual, "handler")
+	a.So(envelope.AESKey, ShouldHaveLength, 24)
+
+	_, err = envelope.Unwrap(map[string][]byte{"other": kek})
+	a.So(err, ShouldNotBeNil)
+
+	unwrapped, err := envelope.Unwrap(map[string][]byte{"handler": kek})
+	a.So(err, ShouldBeNil)
+	a.So(unwrapped, ShouldResemble, key)
+}
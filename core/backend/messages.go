@@ -0,0 +1,215 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Package backend implements the messages and transport of the LoRaWAN Backend Interfaces (version 1.0)
+package backend
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+// ProtocolVersion is the version of the Backend Interfaces that we implement
+const ProtocolVersion = "1.0"
+
+// MessageType is the type of a Backend Interfaces message
+type MessageType string
+
+// Message types of the Backend Interfaces
+const (
+	PRStartReq  MessageType = "PRStartReq"
+	PRStartAns  MessageType = "PRStartAns"
+	XmitDataReq MessageType = "XmitDataReq"
+	XmitDataAns MessageType = "XmitDataAns"
+	JoinReq     MessageType = "JoinReq"
+	JoinAns     MessageType = "JoinAns"
+)
+
+// Result codes of the Backend Interfaces
+const (
+	ResultSuccess            = "Success"
+	ResultMalformedRequest   = "MalformedRequest"
+	ResultUnknownSender      = "UnknownSender"
+	ResultUnknownDevAddr     = "UnknownDevAddr"
+	ResultUnknownDevEUI      = "UnknownDevEUI"
+	ResultMICFailed          = "MICFailed"
+	ResultJoinReqFailed      = "JoinReqFailed"
+	ResultXmitFailed         = "XmitFailed"
+	ResultFrameSizeError     = "FrameSizeError"
+	ResultUnknownMessageType = "UnknownMessageType"
+	ResultOther              = "Other"
+)
+
+// HEXBytes is a byte slice that is hex-encoded in JSON
+type HEXBytes []byte
+
+// String implements the Stringer interface.
+func (b HEXBytes) String() string {
+	return strings.ToUpper(hex.EncodeToString(b))
+}
+
+// MarshalText implements the TextMarshaler interface.
+func (b HEXBytes) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements the TextUnmarshaler interface.
+func (b *HEXBytes) UnmarshalText(data []byte) error {
+	parsed, err := hex.DecodeString(strings.TrimPrefix(string(data), "0x"))
+	if err != nil {
+		return err
+	}
+	*b = parsed
+	return nil
+}
+
+// Header is the common part of all Backend Interfaces messages
+type Header struct {
+	ProtocolVersion string      `json:"ProtocolVersion"`
+	SenderID        string      `json:"SenderID"`
+	ReceiverID      string      `json:"ReceiverID"`
+	TransactionID   uint32      `json:"TransactionID"`
+	MessageType     MessageType `json:"MessageType"`
+}
+
+// Answer returns the Header for the answer to a request with this Header
+func (h Header) Answer(messageType MessageType) Header {
+	return Header{
+		ProtocolVersion: ProtocolVersion,
+		SenderID:        h.ReceiverID,
+		ReceiverID:      h.SenderID,
+		TransactionID:   h.TransactionID,
+		MessageType:     messageType,
+	}
+}
+
+// Result is the result of a request
+type Result struct {
+	ResultCode  string `json:"ResultCode"`
+	Description string `json:"Description,omitempty"`
+}
+
+// GWInfoElement contains the metadata of a gateway that received an uplink or that should transmit a downlink
+type GWInfoElement struct {
+	ID        string   `json:"ID"`
+	RFRegion  string   `json:"RFRegion,omitempty"`
+	RSSI      int      `json:"RSSI,omitempty"`
+	SNR       float32  `json:"SNR,omitempty"`
+	Lat       float32  `json:"Lat,omitempty"`
+	Lon       float32  `json:"Lon,omitempty"`
+	ULToken   HEXBytes `json:"ULToken,omitempty"`
+	DLAllowed bool     `json:"DLAllowed,omitempty"`
+}
+
+// ULMetaData is the metadata of an uplink message
+type ULMetaData struct {
+	DevAddr    *types.DevAddr  `json:"DevAddr,omitempty"`
+	DataRate   int             `json:"DataRate"`
+	ULFreq     float64         `json:"ULFreq"` // MHz
+	RecvTime   string          `json:"RecvTime"`
+	RFRegion   string          `json:"RFRegion"`
+	FNSULToken HEXBytes        `json:"FNSULToken,omitempty"`
+	GWCnt      int             `json:"GWCnt"`
+	GWInfo     []GWInfoElement `json:"GWInfo"`
+}
+
+// DLMetaData is the metadata of a downlink message
+type DLMetaData struct {
+	DevEUI         *types.DevEUI   `json:"DevEUI,omitempty"`
+	DLFreq1        float64         `json:"DLFreq1,omitempty"` // MHz
+	DLFreq2        float64         `json:"DLFreq2,omitempty"` // MHz
+	RXDelay1       int             `json:"RXDelay1,omitempty"`
+	ClassMode      string          `json:"ClassMode,omitempty"`
+	DataRate1      *int            `json:"DataRate1,omitempty"`
+	DataRate2      *int            `json:"DataRate2,omitempty"`
+	FNSULToken     HEXBytes        `json:"FNSULToken,omitempty"`
+	GWInfo         []GWInfoElement `json:"GWInfo,omitempty"`
+	HiPriorityFlag bool            `json:"HiPriorityFlag,omitempty"`
+}
+
+// PRStartReqMessage is sent by the forwarding network server to start passive roaming for an uplink message
+type PRStartReqMessage struct {
+	Header
+	PHYPayload HEXBytes   `json:"PHYPayload"`
+	ULMetaData ULMetaData `json:"ULMetaData"`
+}
+
+// PRStartAnsMessage is the answer to a PRStartReqMessage
+type PRStartAnsMessage struct {
+	Header
+	Result   Result `json:"Result"`
+	Lifetime int    `json:"Lifetime,omitempty"` // seconds
+}
+
+// XmitDataReqMessage is used to transmit an uplink (ULMetaData) or a downlink (DLMetaData) between network servers
+type XmitDataReqMessage struct {
+	Header
+	PHYPayload HEXBytes    `json:"PHYPayload"`
+	ULMetaData *ULMetaData `json:"ULMetaData,omitempty"`
+	DLMetaData *DLMetaData `json:"DLMetaData,omitempty"`
+}
+
+// XmitDataAnsMessage is the answer to a XmitDataReqMessage
+type XmitDataAnsMessage struct {
+	Header
+	Result Result `json:"Result"`
+}
+
+// KeyEnvelope contains a session key. The key is wrapped with the key encryption key (KEK) of the KEKLabel, or in
+// plaintext if the KEKLabel is empty.
+type KeyEnvelope struct {
+	KEKLabel string   `json:"KEKLabel"`
+	AESKey   HEXBytes `json:"AESKey"`
+}
+
+// JoinReqMessage is sent to the join server to handle a JoinRequest
+type JoinReqMessage struct {
+	Header
+	MACVersion string        `json:"MACVersion"`
+	PHYPayload HEXBytes      `json:"PHYPayload"`
+	DevEUI     types.DevEUI  `json:"DevEUI"`
+	DevAddr    types.DevAddr `json:"DevAddr"`
+	DLSettings HEXBytes      `json:"DLSettings"`
+	RxDelay    int           `json:"RxDelay"`
+	CFList     HEXBytes      `json:"CFList,omitempty"`
+}
+
+// JoinAnsMessage is the answer to a JoinReqMessage. The PHYPayload contains the JoinAccept.
+type JoinAnsMessage struct {
+	Header
+	PHYPayload  HEXBytes     `json:"PHYPayload,omitempty"`
+	Result      Result       `json:"Result"`
+	Lifetime    int          `json:"Lifetime,omitempty"`    // seconds
+	NwkSKey     *KeyEnvelope `json:"NwkSKey,omitempty"`     // LoRaWAN 1.0
+	FNwkSIntKey *KeyEnvelope `json:"FNwkSIntKey,omitempty"` // LoRaWAN 1.1
+	SNwkSIntKey *KeyEnvelope `json:"SNwkSIntKey,omitempty"` // LoRaWAN 1.1
+	NwkSEncKey  *KeyEnvelope `json:"NwkSEncKey,omitempty"`  // LoRaWAN 1.1
+	AppSKey     *KeyEnvelope `json:"AppSKey,omitempty"`
+}
+
+// RFRegion returns the Backend Interfaces RFRegion for a frequency plan
+func RFRegion(frequencyPlan string) string {
+	switch frequencyPlan {
+	case "EU_863_870":
+		return "EU868"
+	case "US_902_928":
+		return "US902"
+	case "CN_779_787":
+		return "CN779"
+	case "EU_433":
+		return "EU433"
+	case "AU_915_928":
+		return "AU915"
+	case "CN_470_510":
+		return "CN470"
+	case "AS_923", "AS_920_923", "AS_923_925":
+		return "AS923"
+	case "KR_920_923":
+		return "KR920"
+	case "IN_865_867":
+		return "IN865"
+	}
+	return frequencyPlan
+}
@@ -4,6 +4,8 @@
 package band
 
 import (
+	"time"
+
 	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
@@ -14,8 +16,51 @@ import (
 // FrequencyPlan includes band configuration and CFList
 type FrequencyPlan struct {
 	lora.Band
-	ADR    *ADRConfig
-	CFList *lorawan.CFList
+	ADR      *ADRConfig
+	CFList   *lorawan.CFList
+	TxParams *TxParams // nil if devices in the frequency plan do not support the TxParamSetupReq
+	SubBands []SubBand // nil if transmissions in the frequency plan are not limited by a duty cycle
+}
+
+// MaxDwellTime is the maximum time on air of a transmission when the dwell time is limited
+const MaxDwellTime = 400 * time.Millisecond
+
+// TxParams contains the transmit parameters that devices in a frequency plan should use
+type TxParams struct {
+	UplinkDwellTime   bool    // uplink transmissions are limited to MaxDwellTime
+	DownlinkDwellTime bool    // downlink transmissions are limited to MaxDwellTime
+	MaxEIRP           float32 // maximum EIRP in dBm
+}
+
+// DutyCycleWindow is the window over which the duty cycle of a sub-band is enforced
+const DutyCycleWindow = time.Hour
+
+// SubBand is a frequency range in which the time on air of transmissions is limited by a duty cycle
+type SubBand struct {
+	MinFrequency uint64  // lowest frequency in Hz (inclusive)
+	MaxFrequency uint64  // highest frequency in Hz (exclusive)
+	DutyCycle    float64 // maximum fraction of the DutyCycleWindow that may be used for transmissions
+}
+
+// Contains returns true if the frequency is in the sub-band
+func (s SubBand) Contains(frequency uint64) bool {
+	return frequency >= s.MinFrequency && frequency < s.MaxFrequency
+}
+
+// MaxTimeOnAir returns the time on air that may be used in the DutyCycleWindow
+func (s SubBand) MaxTimeOnAir() time.Duration {
+	return time.Duration(float64(DutyCycleWindow) * s.DutyCycle)
+}
+
+// GetSubBand returns the sub-band of the frequency. If the frequency plan has sub-bands and the frequency is not in
+// any of them, transmissions on the frequency are not allowed.
+func (f *FrequencyPlan) GetSubBand(frequency uint64) (SubBand, bool) {
+	for _, subBand := range f.SubBands {
+		if subBand.Contains(frequency) {
+			return subBand, true
+		}
+	}
+	return SubBand{}, false
 }
 
 func (f *FrequencyPlan) GetDataRateStringForIndex(drIdx int) (string, error) {
@@ -43,6 +88,20 @@ func (f *FrequencyPlan) GetTxPowerIndexFor(txPower int) (int, error) {
 	return 0, errors.New("core/band: the given tx-power does not exist")
 }
 
+// DefaultChannels returns the number of default channels of the frequency plan. These are the uplink channels before
+// the first channel of the CFList, which can not be changed with a NewChannelReq.
+func (f *FrequencyPlan) DefaultChannels() int {
+	if f.CFList == nil {
+		return len(f.UplinkChannels)
+	}
+	for i, ch := range f.UplinkChannels {
+		if uint32(ch.Frequency) == f.CFList[0] {
+			return i
+		}
+	}
+	return len(f.UplinkChannels)
+}
+
 // Guess the region based on frequency
 func Guess(frequency uint64) string {
 	// Join frequencies
@@ -88,20 +147,33 @@ func Get(region string) (frequencyPlan FrequencyPlan, err error) {
 		frequencyPlan.DownlinkChannels = frequencyPlan.UplinkChannels
 		frequencyPlan.CFList = &lorawan.CFList{867100000, 867300000, 867500000, 867700000, 867900000}
 		frequencyPlan.ADR = &ADRConfig{MinDataRate: 0, MaxDataRate: 5, MinTXPower: 2, MaxTXPower: 14}
+		// ETSI EN 300 220 sub-bands
+		frequencyPlan.SubBands = []SubBand{
+			{MinFrequency: 863000000, MaxFrequency: 868000000, DutyCycle: 0.01},  // g 863.0 – 868.0 MHz 1%
+			{MinFrequency: 868000000, MaxFrequency: 868600000, DutyCycle: 0.01},  // g1 868.0 – 868.6 MHz 1%
+			{MinFrequency: 868700000, MaxFrequency: 869200000, DutyCycle: 0.001}, // g2 868.7 – 869.2 MHz 0.1%
+			{MinFrequency: 869400000, MaxFrequency: 869650000, DutyCycle: 0.1},   // g3 869.4 – 869.65 MHz 10%
+			{MinFrequency: 869700000, MaxFrequency: 870000000, DutyCycle: 0.01},  // g4 869.7 – 870.0 MHz 1%
+		}
 	case pb_lorawan.FrequencyPlan_US_902_928.String():
 		frequencyPlan.Band, err = lora.GetConfig(lora.US_902_928, false, lorawan.DwellTime400ms)
 	case pb_lorawan.FrequencyPlan_CN_779_787.String():
 		frequencyPlan.Band, err = lora.GetConfig(lora.CN_779_787, false, lorawan.DwellTimeNoLimit)
+		frequencyPlan.SubBands = []SubBand{{MinFrequency: 779000000, MaxFrequency: 787000000, DutyCycle: 0.01}}
 	case pb_lorawan.FrequencyPlan_EU_433.String():
 		frequencyPlan.Band, err = lora.GetConfig(lora.EU_433, false, lorawan.DwellTimeNoLimit)
+		frequencyPlan.SubBands = []SubBand{{MinFrequency: 433050000, MaxFrequency: 434790000, DutyCycle: 0.1}} // ETSI EN 300 220 h1.4
 	case pb_lorawan.FrequencyPlan_AU_915_928.String():
 		frequencyPlan.Band, err = lora.GetConfig(lora.AU_915_928, false, lorawan.DwellTime400ms)
+		frequencyPlan.TxParams = &TxParams{UplinkDwellTime: true, MaxEIRP: 30}
 	case pb_lorawan.FrequencyPlan_CN_470_510.String():
 		frequencyPlan.Band, err = lora.GetConfig(lora.CN_470_510, false, lorawan.DwellTimeNoLimit)
 	case pb_lorawan.FrequencyPlan_AS_923.String():
 		frequencyPlan.Band, err = lora.GetConfig(lora.AS_923, false, lorawan.DwellTime400ms)
+		frequencyPlan.TxParams = &TxParams{UplinkDwellTime: true, DownlinkDwellTime: true, MaxEIRP: 16}
 	case pb_lorawan.FrequencyPlan_AS_920_923.String():
 		frequencyPlan.Band, err = lora.GetConfig(lora.AS_923, false, lorawan.DwellTime400ms)
+		frequencyPlan.TxParams = &TxParams{UplinkDwellTime: true, DownlinkDwellTime: true, MaxEIRP: 16}
 		frequencyPlan.UplinkChannels = []lora.Channel{
 			lora.Channel{Frequency: 923200000, DataRates: []int{0, 1, 2, 3, 4, 5}},
 			lora.Channel{Frequency: 923400000, DataRates: []int{0, 1, 2, 3, 4, 5}},
@@ -118,6 +190,7 @@ func Get(region string) (frequencyPlan FrequencyPlan, err error) {
 		frequencyPlan.CFList = &lorawan.CFList{922200000, 922400000, 922600000, 922800000, 923000000}
 	case pb_lorawan.FrequencyPlan_AS_923_925.String():
 		frequencyPlan.Band, err = lora.GetConfig(lora.AS_923, false, lorawan.DwellTime400ms)
+		frequencyPlan.TxParams = &TxParams{UplinkDwellTime: true, DownlinkDwellTime: true, MaxEIRP: 16}
 		frequencyPlan.UplinkChannels = []lora.Channel{
 			lora.Channel{Frequency: 923200000, DataRates: []int{0, 1, 2, 3, 4, 5}},
 			lora.Channel{Frequency: 923400000, DataRates: []int{0, 1, 2, 3, 4, 5}},
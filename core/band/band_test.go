@@ -5,6 +5,7 @@ package band
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/smartystreets/assertions"
 )
@@ -128,3 +129,53 @@ func TestGetTxPower(t *testing.T) {
 		a.So(idx, ShouldEqual, expIdx)
 	}
 }
+
+func TestDefaultChannels(t *testing.T) {
+	a := New(t)
+
+	eu, _ := Get("EU_863_870")
+	a.So(eu.DefaultChannels(), ShouldEqual, 3)
+
+	as, _ := Get("AS_920_923")
+	a.So(as.DefaultChannels(), ShouldEqual, 2)
+
+	us, _ := Get("US_902_928")
+	a.So(us.DefaultChannels(), ShouldEqual, len(us.UplinkChannels))
+}
+
+func TestTxParams(t *testing.T) {
+	a := New(t)
+
+	eu, _ := Get("EU_863_870")
+	a.So(eu.TxParams, ShouldBeNil)
+
+	as, _ := Get("AS_923")
+	a.So(as.TxParams, ShouldNotBeNil)
+	a.So(as.TxParams.DownlinkDwellTime, ShouldBeTrue)
+	a.So(as.TxParams.MaxEIRP, ShouldEqual, 16)
+
+	au, _ := Get("AU_915_928")
+	a.So(au.TxParams, ShouldNotBeNil)
+	a.So(au.TxParams.UplinkDwellTime, ShouldBeTrue)
+	a.So(au.TxParams.DownlinkDwellTime, ShouldBeFalse)
+}
+
+func TestSubBands(t *testing.T) {
+	a := New(t)
+
+	eu, _ := Get("EU_863_870")
+	subBand, ok := eu.GetSubBand(868100000)
+	a.So(ok, ShouldBeTrue)
+	a.So(subBand.DutyCycle, ShouldEqual, 0.01)
+	a.So(subBand.MaxTimeOnAir(), ShouldEqual, 36*time.Second)
+
+	subBand, ok = eu.GetSubBand(869525000)
+	a.So(ok, ShouldBeTrue)
+	a.So(subBand.DutyCycle, ShouldEqual, 0.1)
+
+	_, ok = eu.GetSubBand(868650000)
+	a.So(ok, ShouldBeFalse)
+
+	us, _ := Get("US_902_928")
+	a.So(us.SubBands, ShouldBeNil)
+}
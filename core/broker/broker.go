@@ -5,6 +5,7 @@ package broker
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
 	"sync"
 	"time"
@@ -25,10 +26,13 @@ type Broker interface {
 	component.ManagementInterface
 
 	SetNetworkServer(addr, cert, token string)
+	SetRoaming(netID types.NetID, partners map[types.NetID]RoamingPartner)
+	RoamingHandler() http.Handler
 
 	HandleUplink(uplink *pb.UplinkMessage) error
 	HandleDownlink(downlink *pb.DownlinkMessage) error
 	HandleActivation(activation *pb.DeviceActivationRequest) (*pb.DeviceActivationResponse, error)
+	HandleTxAck(ack *pb.TxAcknowledgement) error
 
 	ActivateRouter(id string) (<-chan *pb.DownlinkMessage, error)
 	DeactivateRouter(id string) error
@@ -66,6 +70,7 @@ type broker struct {
 	activationDeduplicator Deduplicator
 	status                 *status
 	monitorStream          pb_monitor.GenericStream
+	roaming                *roaming
 }
 
 func (b *broker) checkPrefixAnnouncements() error {
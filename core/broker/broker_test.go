@@ -71,6 +71,14 @@ func (s *mockNetworkServer) Downlink(ctx context.Context, message *pb.DownlinkMe
 	return message, nil
 }
 
+func (s *mockNetworkServer) MulticastDownlink(ctx context.Context, message *pb.DownlinkMessage, options ...grpc.CallOption) (*pb_networkserver.MulticastDownlinkResponse, error) {
+	res := &pb_networkserver.MulticastDownlinkResponse{Downlinks: []*pb.DownlinkMessage{message}}
+	if message.MulticastGroupId == "partiallyReachableGroupID" {
+		res.Downlinks = append(res.Downlinks, &pb.DownlinkMessage{DownlinkOption: &pb.DownlinkOption{Identifier: "nonExistentRouterID:"}})
+	}
+	return res, nil
+}
+
 func TestActivateDeactivateRouter(t *testing.T) {
 	a := New(t)
 
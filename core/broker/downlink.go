@@ -4,11 +4,13 @@
 package broker
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
 	pb "github.com/TheThingsNetwork/ttn/api/broker"
 	"github.com/TheThingsNetwork/ttn/api/fields"
+	"github.com/TheThingsNetwork/ttn/api/networkserver"
 	"github.com/TheThingsNetwork/ttn/api/trace"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 )
@@ -39,27 +41,52 @@ func (b *broker) HandleDownlink(downlink *pb.DownlinkMessage) error {
 
 	downlink.Trace = downlink.Trace.WithEvent(trace.ReceiveEvent)
 
+	if downlink.MulticastGroupId != "" {
+		var res *networkserver.MulticastDownlinkResponse
+		res, err = b.ns.MulticastDownlink(b.Component.GetContext(b.nsToken), downlink)
+		if err != nil {
+			return errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not handle multicast downlink")
+		}
+		// A gateway that can not be reached does not prevent the downlink to the other gateways
+		var forwardErrs []string
+		for _, gatewayDownlink := range res.Downlinks {
+			if _, forwardErr := b.forwardDownlink(gatewayDownlink); forwardErr != nil {
+				forwardErrs = append(forwardErrs, fmt.Sprintf("%s: %s", gatewayDownlink.DownlinkOption.GatewayId, forwardErr))
+			}
+		}
+		ctx = ctx.WithField("Gateways", len(res.Downlinks)-len(forwardErrs))
+		if len(forwardErrs) > 0 {
+			err = errors.New(fmt.Sprintf("Could not forward multicast downlink to %d of %d gateways (%s)", len(forwardErrs), len(res.Downlinks), strings.Join(forwardErrs, ", ")))
+		}
+		return err
+	}
+
 	downlink, err = b.ns.Downlink(b.Component.GetContext(b.nsToken), downlink)
 	if err != nil {
 		return errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not handle downlink")
 	}
 
-	var routerID string
+	routerID, err := b.forwardDownlink(downlink)
+	ctx = ctx.WithField("RouterID", routerID)
+	return err
+}
+
+// forwardDownlink forwards the downlink to the router in the Identifier of its DownlinkOption
+func (b *broker) forwardDownlink(downlink *pb.DownlinkMessage) (routerID string, err error) {
 	if id := strings.Split(downlink.DownlinkOption.Identifier, ":"); len(id) == 2 {
 		routerID = id[0]
 	} else {
-		return errors.NewErrInvalidArgument("DownlinkOption Identifier", "invalid format")
+		return "", errors.NewErrInvalidArgument("DownlinkOption Identifier", "invalid format")
 	}
-	ctx = ctx.WithField("RouterID", routerID)
 
 	router, err := b.getRouter(routerID)
 	if err != nil {
-		return err
+		return routerID, err
 	}
 
 	downlink.Trace = downlink.Trace.WithEvent(trace.ForwardEvent, "router", routerID)
 
 	router <- downlink
 
-	return nil
+	return routerID, nil
 }
@@ -20,7 +20,7 @@ func TestDownlink(t *testing.T) {
 	appEUI := types.AppEUI{0, 1, 2, 3, 4, 5, 6, 7}
 	devEUI := types.DevEUI{0, 1, 2, 3, 4, 5, 6, 7}
 
-	dlch := make(chan *pb.DownlinkMessage, 2)
+	dlch := make(chan *pb.DownlinkMessage, 3)
 	logger := GetLogger(t, "TestDownlink")
 	b := &broker{
 		Component: &component.Component{
@@ -61,4 +61,25 @@ func TestDownlink(t *testing.T) {
 	})
 	a.So(err, ShouldBeNil)
 	a.So(len(dlch), ShouldEqual, 1)
+
+	err = b.HandleDownlink(&pb.DownlinkMessage{
+		AppId:            "appID",
+		MulticastGroupId: "groupID",
+		DownlinkOption: &pb.DownlinkOption{
+			Identifier: "routerID:",
+		},
+	})
+	a.So(err, ShouldBeNil)
+	a.So(len(dlch), ShouldEqual, 2)
+
+	// The downlink is forwarded to the reachable routers
+	err = b.HandleDownlink(&pb.DownlinkMessage{
+		AppId:            "appID",
+		MulticastGroupId: "partiallyReachableGroupID",
+		DownlinkOption: &pb.DownlinkOption{
+			Identifier: "routerID:",
+		},
+	})
+	a.So(err, ShouldNotBeNil)
+	a.So(len(dlch), ShouldEqual, 3)
 }
@@ -22,10 +22,11 @@ import (
 )
 
 type brokerManager struct {
-	broker         *broker
-	deviceManager  pb_lorawan.DeviceManagerClient
-	devAddrManager pb_lorawan.DevAddrManagerClient
-	clientRate     *ratelimit.Registry
+	broker           *broker
+	deviceManager    pb_lorawan.DeviceManagerClient
+	devAddrManager   pb_lorawan.DevAddrManagerClient
+	multicastManager pb_lorawan.MulticastGroupManagerClient
+	clientRate       *ratelimit.Registry
 }
 
 func (b *brokerManager) validateClient(ctx context.Context) (*claims.Claims, error) {
@@ -72,6 +73,50 @@ func (b *brokerManager) DeleteDevice(ctx context.Context, in *lorawan.DeviceIden
 	return res, nil
 }
 
+func (b *brokerManager) GetDeviceStats(ctx context.Context, in *lorawan.DeviceIdentifier) (*lorawan.DeviceStats, error) {
+	if _, err := b.validateClient(ctx); err != nil {
+		return nil, err
+	}
+	res, err := b.deviceManager.GetDeviceStats(ctx, in)
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not return device stats")
+	}
+	return res, nil
+}
+
+func (b *brokerManager) GetMulticastGroup(ctx context.Context, in *lorawan.MulticastGroupIdentifier) (*lorawan.MulticastGroup, error) {
+	if _, err := b.validateClient(ctx); err != nil {
+		return nil, err
+	}
+	res, err := b.multicastManager.GetMulticastGroup(ctx, in)
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not return multicast group")
+	}
+	return res, nil
+}
+
+func (b *brokerManager) SetMulticastGroup(ctx context.Context, in *lorawan.MulticastGroup) (*empty.Empty, error) {
+	if _, err := b.validateClient(ctx); err != nil {
+		return nil, err
+	}
+	res, err := b.multicastManager.SetMulticastGroup(ctx, in)
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not set multicast group")
+	}
+	return res, nil
+}
+
+func (b *brokerManager) DeleteMulticastGroup(ctx context.Context, in *lorawan.MulticastGroupIdentifier) (*empty.Empty, error) {
+	if _, err := b.validateClient(ctx); err != nil {
+		return nil, err
+	}
+	res, err := b.multicastManager.DeleteMulticastGroup(ctx, in)
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not delete multicast group")
+	}
+	return res, nil
+}
+
 func (b *brokerManager) RegisterApplicationHandler(ctx context.Context, in *pb.ApplicationHandlerRegistration) (*empty.Empty, error) {
 	claims, err := b.broker.Component.ValidateTTNAuthContext(ctx)
 	if err != nil {
@@ -129,9 +174,10 @@ func (b *brokerManager) GetStatus(ctx context.Context, in *pb.StatusRequest) (*p
 
 func (b *broker) RegisterManager(s *grpc.Server) {
 	server := &brokerManager{
-		broker:         b,
-		deviceManager:  pb_lorawan.NewDeviceManagerClient(b.nsConn),
-		devAddrManager: pb_lorawan.NewDevAddrManagerClient(b.nsConn),
+		broker:           b,
+		deviceManager:    pb_lorawan.NewDeviceManagerClient(b.nsConn),
+		devAddrManager:   pb_lorawan.NewDevAddrManagerClient(b.nsConn),
+		multicastManager: pb_lorawan.NewMulticastGroupManagerClient(b.nsConn),
 	}
 
 	server.clientRate = ratelimit.NewRegistry(5000, time.Hour)
@@ -139,4 +185,5 @@ func (b *broker) RegisterManager(s *grpc.Server) {
 	pb.RegisterBrokerManagerServer(s, server)
 	lorawan.RegisterDeviceManagerServer(s, server)
 	lorawan.RegisterDevAddrManagerServer(s, server)
+	lorawan.RegisterMulticastGroupManagerServer(s, server)
 }
@@ -0,0 +1,371 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package broker
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb "github.com/TheThingsNetwork/ttn/api/broker"
+	"github.com/TheThingsNetwork/ttn/api/protocol"
+	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/core/backend"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// roamingTimeout is the timeout for requests to roaming partners
+var roamingTimeout = 2 * time.Second
+
+// roamingUplinkTTL is the time that the Broker keeps the DownlinkOptions of an uplink message that was forwarded to a
+// roaming partner, so that the partner can respond with a downlink message
+var roamingUplinkTTL = time.Minute
+
+// roaming contains the state of passive roaming with the network servers of other NetIDs. The Broker acts as the
+// forwarding network server: it forwards uplink messages to the roaming partner that owns the NetID of the DevAddr,
+// and transmits the downlink messages of the roaming partner on our gateways.
+type roaming struct {
+	sync.Mutex
+	netID    types.NetID
+	partners map[types.NetID]RoamingPartner
+	client   *backend.Client
+	sessions map[types.DevAddr]time.Time
+	uplinks  map[string]*roamingUplink
+}
+
+type roamingUplink struct {
+	frequencyPlan string
+	duplicates    []*pb.UplinkMessage
+	expires       time.Time
+}
+
+// RoamingPartner is a network server of another NetID that the Broker roams with
+type RoamingPartner struct {
+	URL    string // URL of the Backend Interfaces API of the roaming partner
+	Secret string // Shared secret that authenticates the requests in both directions
+}
+
+// SetRoaming configures passive roaming. Uplink messages of DevAddrs that do not belong to netID are forwarded to the
+// roaming partner of the NetID of the DevAddr.
+func (b *broker) SetRoaming(netID types.NetID, partners map[types.NetID]RoamingPartner) {
+	if len(partners) == 0 {
+		b.roaming = nil
+		return
+	}
+	b.roaming = &roaming{
+		netID:    netID,
+		partners: partners,
+		client:   backend.NewClient(netID.String(), roamingTimeout),
+		sessions: make(map[types.DevAddr]time.Time),
+		uplinks:  make(map[string]*roamingUplink),
+	}
+}
+
+// getPartner returns the NetID and roaming partner for a DevAddr that does not belong to our own NetID
+func (r *roaming) getPartner(devAddr types.DevAddr) (netID types.NetID, partner RoamingPartner, ok bool) {
+	if devAddr.HasNetID(r.netID) {
+		return
+	}
+	for netID, partner := range r.partners {
+		if devAddr.HasNetID(netID) {
+			return netID, partner, true
+		}
+	}
+	return
+}
+
+func (r *roaming) hasSession(devAddr types.DevAddr) bool {
+	r.Lock()
+	defer r.Unlock()
+	expires, ok := r.sessions[devAddr]
+	if ok && time.Now().After(expires) {
+		delete(r.sessions, devAddr)
+		return false
+	}
+	return ok
+}
+
+func (r *roaming) setSession(devAddr types.DevAddr, lifetime time.Duration) {
+	r.Lock()
+	defer r.Unlock()
+	if lifetime <= 0 {
+		delete(r.sessions, devAddr)
+		return
+	}
+	r.sessions[devAddr] = time.Now().Add(lifetime)
+}
+
+func (r *roaming) setUplink(token string, uplink *roamingUplink) {
+	r.Lock()
+	defer r.Unlock()
+	now := time.Now()
+	for token, uplink := range r.uplinks {
+		if now.After(uplink.expires) {
+			delete(r.uplinks, token)
+		}
+	}
+	uplink.expires = now.Add(roamingUplinkTTL)
+	r.uplinks[token] = uplink
+}
+
+func (r *roaming) getUplink(token string) *roamingUplink {
+	r.Lock()
+	defer r.Unlock()
+	uplink, ok := r.uplinks[token]
+	if !ok || time.Now().After(uplink.expires) {
+		return nil
+	}
+	return uplink
+}
+
+func (r *roaming) deleteUplink(token string) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.uplinks, token)
+}
+
+// forwardRoamingUplink forwards an uplink message to a roaming partner. The first uplink message of a DevAddr is sent
+// in a PRStartReq. If the roaming partner answers with a Lifetime, the next uplink messages are sent in a XmitDataReq.
+// If the XmitDataReq fails, the session ends and the uplink message is sent in a new PRStartReq.
+func (b *broker) forwardRoamingUplink(netID types.NetID, partner RoamingPartner, devAddr types.DevAddr, duplicates []*pb.UplinkMessage, uplink *pb.DeduplicatedUplinkMessage) error {
+	lorawanMetadata := uplink.ProtocolMetadata.GetLorawan()
+	fp, err := band.Get(lorawanMetadata.FrequencyPlan.String())
+	if err != nil {
+		return err
+	}
+	dataRate, err := fp.GetDataRateIndexFor(lorawanMetadata.DataRate)
+	if err != nil {
+		return err
+	}
+
+	// The FNSULToken identifies the uplink in the downlink of the roaming partner, so it should not be predictable
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return err
+	}
+
+	rfRegion := backend.RFRegion(lorawanMetadata.FrequencyPlan.String())
+	ulMetaData := backend.ULMetaData{
+		DevAddr:    &devAddr,
+		DataRate:   dataRate,
+		RecvTime:   time.Unix(0, uplink.ServerTime).UTC().Format(time.RFC3339Nano),
+		RFRegion:   rfRegion,
+		FNSULToken: backend.HEXBytes(token),
+		GWCnt:      len(duplicates),
+	}
+	for _, duplicate := range duplicates {
+		gateway := duplicate.GatewayMetadata
+		ulMetaData.ULFreq = float64(gateway.Frequency) / 1000000
+		gwInfo := backend.GWInfoElement{
+			ID:        gateway.GatewayId,
+			RFRegion:  rfRegion,
+			RSSI:      int(gateway.Rssi),
+			SNR:       gateway.Snr,
+			DLAllowed: len(duplicate.DownlinkOptions) > 0,
+		}
+		if gps := gateway.Gps; gps != nil {
+			gwInfo.Lat, gwInfo.Lon = gps.Latitude, gps.Longitude
+		}
+		ulMetaData.GWInfo = append(ulMetaData.GWInfo, gwInfo)
+	}
+
+	b.roaming.setUplink(ulMetaData.FNSULToken.String(), &roamingUplink{
+		frequencyPlan: lorawanMetadata.FrequencyPlan.String(),
+		duplicates:    duplicates,
+	})
+
+	if b.roaming.hasSession(devAddr) {
+		var ans backend.XmitDataAnsMessage
+		err = b.roaming.client.DoWithSecret(partner.URL, partner.Secret, backend.XmitDataReqMessage{
+			Header:     b.roaming.client.NewHeader(netID.String(), backend.XmitDataReq),
+			PHYPayload: uplink.Payload,
+			ULMetaData: &ulMetaData,
+		}, &ans)
+		if err == nil {
+			err = ans.Result.Err()
+		}
+		if err == nil {
+			return nil
+		}
+		b.Ctx.WithError(err).WithField("NetID", netID).Warn("Roaming session ended, starting a new session")
+		b.roaming.setSession(devAddr, 0)
+	}
+
+	var ans backend.PRStartAnsMessage
+	err = b.roaming.client.DoWithSecret(partner.URL, partner.Secret, backend.PRStartReqMessage{
+		Header:     b.roaming.client.NewHeader(netID.String(), backend.PRStartReq),
+		PHYPayload: uplink.Payload,
+		ULMetaData: ulMetaData,
+	}, &ans)
+	if err != nil {
+		return err
+	}
+	if err := ans.Result.Err(); err != nil {
+		return err
+	}
+	b.roaming.setSession(devAddr, time.Duration(ans.Lifetime)*time.Second)
+	return nil
+}
+
+// handleRoamingDownlink handles a downlink message that a roaming partner sent in response to an uplink message. The
+// downlink is transmitted by the gateway that received the uplink and has the best DownlinkOption for the RX settings
+// in the DLMetaData.
+func (b *broker) handleRoamingDownlink(req *backend.XmitDataReqMessage) (err error) {
+	ctx := b.Ctx.WithField("NetID", req.SenderID)
+	start := time.Now()
+	defer func() {
+		if err != nil {
+			ctx.WithError(err).Warn("Could not handle roaming downlink")
+		} else {
+			ctx.WithField("Duration", time.Now().Sub(start)).Info("Handled roaming downlink")
+		}
+	}()
+
+	b.status.downlink.Mark(1)
+
+	dl := req.DLMetaData
+	uplink := b.roaming.getUplink(dl.FNSULToken.String())
+	if uplink == nil {
+		return errors.NewErrNotFound(fmt.Sprintf("Uplink for FNSULToken %s", dl.FNSULToken))
+	}
+	fp, err := band.Get(uplink.frequencyPlan)
+	if err != nil {
+		return err
+	}
+	rxDelay := fp.ReceiveDelay1
+	if dl.RXDelay1 > 1 {
+		rxDelay = time.Duration(dl.RXDelay1) * time.Second
+	}
+
+	gateways := make(map[string]bool)
+	for _, gwInfo := range dl.GWInfo {
+		gateways[gwInfo.ID] = true
+	}
+
+	var options []*pb.DownlinkOption
+	for _, duplicate := range uplink.duplicates {
+		if len(gateways) > 0 && !gateways[duplicate.GatewayMetadata.GatewayId] {
+			continue
+		}
+		for _, option := range duplicate.DownlinkOptions {
+			if option.GetProtocolConfig().GetLorawan() == nil || option.GetGatewayConfig() == nil {
+				continue
+			}
+			lorawanConfig := *option.ProtocolConfig.GetLorawan()
+			gatewayConfig := *option.GatewayConfig
+
+			var frequency float64
+			var dataRate *int
+			switch time.Duration(gatewayConfig.Timestamp-duplicate.GatewayMetadata.Timestamp) * time.Microsecond {
+			case fp.ReceiveDelay1:
+				frequency, dataRate = dl.DLFreq1, dl.DataRate1
+				gatewayConfig.Timestamp = duplicate.GatewayMetadata.Timestamp + uint32(rxDelay/time.Microsecond)
+			case fp.ReceiveDelay2:
+				frequency, dataRate = dl.DLFreq2, dl.DataRate2
+				gatewayConfig.Timestamp = duplicate.GatewayMetadata.Timestamp + uint32((rxDelay+time.Second)/time.Microsecond)
+			}
+			if frequency == 0 || dataRate == nil || *dataRate < 0 || *dataRate >= len(fp.DataRates) {
+				continue
+			}
+			if err := lorawanConfig.SetDataRate(fp.DataRates[*dataRate]); err != nil {
+				continue
+			}
+			gatewayConfig.Frequency = uint64(frequency*1000000 + 0.5)
+			gatewayConfig.FrequencyDeviation = uint32(lorawanConfig.BitRate / 2)
+
+			options = append(options, &pb.DownlinkOption{
+				Identifier:     option.Identifier,
+				GatewayId:      option.GatewayId,
+				Score:          option.Score,
+				Deadline:       option.Deadline,
+				ProtocolConfig: &protocol.TxConfiguration{Protocol: &protocol.TxConfiguration_Lorawan{Lorawan: &lorawanConfig}},
+				GatewayConfig:  &gatewayConfig,
+			})
+		}
+	}
+	if len(options) == 0 {
+		return errors.NewErrNotFound("DownlinkOption for roaming downlink")
+	}
+
+	downlink := &pb.DownlinkMessage{
+		Payload:        req.PHYPayload,
+		DownlinkOption: selectBestDownlink(options),
+	}
+	downlink.Trace = downlink.Trace.WithEvent(trace.ReceiveEvent, "netid", req.SenderID)
+	ctx = ctx.WithField("GatewayID", downlink.DownlinkOption.GatewayId)
+
+	routerID, err := b.forwardDownlink(downlink)
+	ctx = ctx.WithField("RouterID", routerID)
+	if err != nil {
+		return err
+	}
+
+	// A Class A device only receives one downlink per uplink
+	b.roaming.deleteUplink(dl.FNSULToken.String())
+
+	return nil
+}
+
+// serveHTTP handles the Backend Interfaces requests of roaming partners
+func (r *roaming) serveHTTP(b *broker, w http.ResponseWriter, req *http.Request) {
+	header, body, err := backend.ReadRequest(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ctx := b.Ctx.WithFields(ttnlog.Fields{
+		"SenderID":      header.SenderID,
+		"MessageType":   header.MessageType,
+		"TransactionID": header.TransactionID,
+	})
+
+	ans := backend.XmitDataAnsMessage{
+		Header: header.Answer(backend.XmitDataAns),
+		Result: backend.Result{ResultCode: backend.ResultSuccess},
+	}
+	senderID, err := types.ParseNetID(header.SenderID)
+	partner, ok := r.partners[senderID]
+	if err == nil && ok && !backend.Authorized(req, partner.Secret) {
+		ctx.Warn("Unauthorized roaming request")
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	switch {
+	case err != nil || !ok:
+		ans.Result = backend.Result{ResultCode: backend.ResultUnknownSender}
+	case header.MessageType != backend.XmitDataReq:
+		ans.Result = backend.Result{ResultCode: backend.ResultUnknownMessageType}
+	default:
+		var xmitDataReq backend.XmitDataReqMessage
+		if err := json.Unmarshal(body, &xmitDataReq); err != nil || xmitDataReq.DLMetaData == nil || len(xmitDataReq.PHYPayload) == 0 {
+			ans.Result = backend.Result{ResultCode: backend.ResultMalformedRequest}
+			break
+		}
+		if err := b.handleRoamingDownlink(&xmitDataReq); err != nil {
+			ans.Result = backend.Result{ResultCode: backend.ResultXmitFailed, Description: err.Error()}
+		}
+	}
+	if ans.Result.ResultCode != backend.ResultSuccess {
+		ctx.WithField("Result", ans.Result.ResultCode).Warn("Could not handle roaming request")
+	}
+
+	backend.WriteAnswer(w, ans)
+}
+
+// RoamingHandler returns the HTTP handler for the Backend Interfaces requests of roaming partners
+func (b *broker) RoamingHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if b.roaming == nil {
+			http.Error(w, "Roaming is not enabled", http.StatusNotFound)
+			return
+		}
+		b.roaming.serveHTTP(b, w, req)
+	})
+}
@@ -0,0 +1,246 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package broker
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pb "github.com/TheThingsNetwork/ttn/api/broker"
+	"github.com/TheThingsNetwork/ttn/api/gateway"
+	"github.com/TheThingsNetwork/ttn/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/backend"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func roamingUplinkMessage(devAddr types.DevAddr, fCnt uint32) *pb.UplinkMessage {
+	phy := lorawan.PHYPayload{
+		MHDR: lorawan.MHDR{
+			MType: lorawan.UnconfirmedDataUp,
+			Major: lorawan.LoRaWANR1,
+		},
+		MACPayload: &lorawan.MACPayload{
+			FHDR: lorawan.FHDR{
+				DevAddr: lorawan.DevAddr(devAddr),
+				FCnt:    fCnt,
+			},
+		},
+	}
+	payload, _ := phy.MarshalBinary()
+	downlinkOption := func(id string, delay time.Duration, frequency uint64) *pb.DownlinkOption {
+		return &pb.DownlinkOption{
+			Identifier: id,
+			GatewayId:  "gtw",
+			ProtocolConfig: &protocol.TxConfiguration{Protocol: &protocol.TxConfiguration_Lorawan{Lorawan: &pb_lorawan.TxConfiguration{
+				Modulation: pb_lorawan.Modulation_LORA,
+				DataRate:   "SF7BW125",
+				CodingRate: "4/5",
+			}}},
+			GatewayConfig: &gateway.TxConfiguration{
+				Timestamp: 1000 + uint32(delay/time.Microsecond),
+				Frequency: frequency,
+			},
+		}
+	}
+	return &pb.UplinkMessage{
+		Payload: payload,
+		ProtocolMetadata: &protocol.RxMetadata{Protocol: &protocol.RxMetadata_Lorawan{Lorawan: &pb_lorawan.Metadata{
+			Modulation:    pb_lorawan.Modulation_LORA,
+			DataRate:      "SF7BW125",
+			CodingRate:    "4/5",
+			FrequencyPlan: pb_lorawan.FrequencyPlan_EU_863_870,
+		}}},
+		GatewayMetadata: &gateway.RxMetadata{
+			GatewayId: "gtw",
+			Timestamp: 1000,
+			Frequency: 868100000,
+			Rssi:      -42,
+			Snr:       7.5,
+		},
+		DownlinkOptions: []*pb.DownlinkOption{
+			downlinkOption("routerID:rx1", time.Second, 868100000),
+			downlinkOption("routerID:rx2", 2*time.Second, 869525000),
+		},
+	}
+}
+
+func TestRoaming(t *testing.T) {
+	a := New(t)
+
+	ourNetID := types.NetID{0x00, 0x00, 0x13}
+	partnerNetID := types.NetID{0x60, 0x00, 0x01}
+	partnerDevAddr := types.DevAddr{0xE0, 0x02, 0x00, 0x01}
+
+	var received []backend.Header
+	var receivedULMetaData []backend.ULMetaData
+	lifetime := 0
+	xmitDataResult := backend.ResultSuccess
+	partner := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header, body, err := backend.ReadRequest(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if !backend.Authorized(r, "secret") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		received = append(received, header)
+		switch header.MessageType {
+		case backend.PRStartReq:
+			var req backend.PRStartReqMessage
+			json.Unmarshal(body, &req)
+			receivedULMetaData = append(receivedULMetaData, req.ULMetaData)
+			backend.WriteAnswer(w, backend.PRStartAnsMessage{
+				Header:   header.Answer(backend.PRStartAns),
+				Result:   backend.Result{ResultCode: backend.ResultSuccess},
+				Lifetime: lifetime,
+			})
+		case backend.XmitDataReq:
+			var req backend.XmitDataReqMessage
+			json.Unmarshal(body, &req)
+			receivedULMetaData = append(receivedULMetaData, *req.ULMetaData)
+			backend.WriteAnswer(w, backend.XmitDataAnsMessage{
+				Header: header.Answer(backend.XmitDataAns),
+				Result: backend.Result{ResultCode: xmitDataResult},
+			})
+		}
+	}))
+	defer partner.Close()
+
+	dlch := make(chan *pb.DownlinkMessage, 1)
+	b := &broker{
+		Component: &component.Component{
+			Ctx: GetLogger(t, "TestRoaming"),
+		},
+		ns: &mockNetworkServer{},
+		routers: map[string]chan *pb.DownlinkMessage{
+			"routerID": dlch,
+		},
+		uplinkDeduplicator: NewDeduplicator(10 * time.Millisecond),
+	}
+	b.InitStatus()
+	b.SetRoaming(ourNetID, map[types.NetID]RoamingPartner{
+		partnerNetID: {URL: partner.URL, Secret: "secret"},
+	})
+
+	// Our own DevAddr is not forwarded
+	_, _, ok := b.roaming.getPartner(types.DevAddr{0x26, 0x01, 0x02, 0x03})
+	a.So(ok, ShouldBeFalse)
+
+	// DevAddr of an unknown NetID is not forwarded
+	_, _, ok = b.roaming.getPartner(types.DevAddr{0x12, 0x01, 0x02, 0x03})
+	a.So(ok, ShouldBeFalse)
+
+	// First uplink starts passive roaming
+	lifetime = 60
+	err := b.HandleUplink(roamingUplinkMessage(partnerDevAddr, 1))
+	a.So(err, ShouldBeNil)
+	a.So(received, ShouldHaveLength, 1)
+	a.So(received[0].MessageType, ShouldEqual, backend.PRStartReq)
+	a.So(received[0].SenderID, ShouldEqual, "000013")
+	a.So(received[0].ReceiverID, ShouldEqual, "600001")
+	a.So(*receivedULMetaData[0].DevAddr, ShouldEqual, partnerDevAddr)
+	a.So(receivedULMetaData[0].DataRate, ShouldEqual, 5)
+	a.So(receivedULMetaData[0].ULFreq, ShouldEqual, 868.1)
+	a.So(receivedULMetaData[0].RFRegion, ShouldEqual, "EU868")
+	a.So(receivedULMetaData[0].GWInfo, ShouldHaveLength, 1)
+	a.So(receivedULMetaData[0].GWInfo[0].ID, ShouldEqual, "gtw")
+	a.So(receivedULMetaData[0].GWInfo[0].DLAllowed, ShouldBeTrue)
+	a.So(receivedULMetaData[0].FNSULToken, ShouldNotBeEmpty)
+
+	// Next uplink is sent in the roaming session
+	err = b.HandleUplink(roamingUplinkMessage(partnerDevAddr, 2))
+	a.So(err, ShouldBeNil)
+	a.So(received, ShouldHaveLength, 2)
+	a.So(received[1].MessageType, ShouldEqual, backend.XmitDataReq)
+	a.So(receivedULMetaData[1].FNSULToken, ShouldNotResemble, receivedULMetaData[0].FNSULToken)
+
+	xmitDataReq := func(senderID, secret string, dlMetaData *backend.DLMetaData) backend.XmitDataAnsMessage {
+		body, _ := json.Marshal(backend.XmitDataReqMessage{
+			Header: backend.Header{
+				ProtocolVersion: backend.ProtocolVersion,
+				SenderID:        senderID,
+				ReceiverID:      "000013",
+				TransactionID:   42,
+				MessageType:     backend.XmitDataReq,
+			},
+			PHYPayload: backend.HEXBytes{0x60, 0x01, 0x00, 0x02, 0xE0, 0x00, 0x01, 0x00, 0x01, 0x02, 0x03, 0x04},
+			DLMetaData: dlMetaData,
+		})
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+secret)
+		b.RoamingHandler().ServeHTTP(rec, req)
+		var ans backend.XmitDataAnsMessage
+		json.Unmarshal(rec.Body.Bytes(), &ans)
+		return ans
+	}
+
+	dataRate := 5
+	dlMetaData := &backend.DLMetaData{
+		DLFreq1:    868.3,
+		DataRate1:  &dataRate,
+		RXDelay1:   1,
+		FNSULToken: receivedULMetaData[1].FNSULToken,
+	}
+
+	// Unknown sender
+	ans := xmitDataReq("600002", "secret", dlMetaData)
+	a.So(ans.Result.ResultCode, ShouldEqual, backend.ResultUnknownSender)
+
+	// Wrong secret
+	ans = xmitDataReq("600001", "other", dlMetaData)
+	a.So(ans.Result.ResultCode, ShouldBeEmpty)
+
+	// Missing DLMetaData
+	ans = xmitDataReq("600001", "secret", nil)
+	a.So(ans.Result.ResultCode, ShouldEqual, backend.ResultMalformedRequest)
+
+	// Unknown FNSULToken
+	ans = xmitDataReq("600001", "secret", &backend.DLMetaData{FNSULToken: backend.HEXBytes{0x01}})
+	a.So(ans.Result.ResultCode, ShouldEqual, backend.ResultXmitFailed)
+
+	// Downlink in RX1
+	ans = xmitDataReq("600001", "secret", dlMetaData)
+	a.So(ans.Result.ResultCode, ShouldEqual, backend.ResultSuccess)
+	a.So(ans.SenderID, ShouldEqual, "000013")
+	a.So(ans.ReceiverID, ShouldEqual, "600001")
+	a.So(ans.TransactionID, ShouldEqual, 42)
+	a.So(dlch, ShouldHaveLength, 1)
+	downlink := <-dlch
+	a.So(downlink.Payload, ShouldResemble, []byte{0x60, 0x01, 0x00, 0x02, 0xE0, 0x00, 0x01, 0x00, 0x01, 0x02, 0x03, 0x04})
+	a.So(downlink.DownlinkOption.Identifier, ShouldEqual, "routerID:rx1")
+	a.So(downlink.DownlinkOption.GatewayConfig.Frequency, ShouldEqual, 868300000)
+	a.So(downlink.DownlinkOption.GatewayConfig.Timestamp, ShouldEqual, 1001000)
+	a.So(downlink.DownlinkOption.ProtocolConfig.GetLorawan().DataRate, ShouldEqual, "SF7BW125")
+
+	// Only one downlink per uplink
+	ans = xmitDataReq("600001", "secret", dlMetaData)
+	a.So(ans.Result.ResultCode, ShouldEqual, backend.ResultXmitFailed)
+
+	// Failing XmitDataReq starts a new session
+	xmitDataResult = backend.ResultUnknownDevAddr
+	err = b.HandleUplink(roamingUplinkMessage(partnerDevAddr, 3))
+	a.So(err, ShouldBeNil)
+	a.So(received, ShouldHaveLength, 4)
+	a.So(received[2].MessageType, ShouldEqual, backend.XmitDataReq)
+	a.So(received[3].MessageType, ShouldEqual, backend.PRStartReq)
+	a.So(b.roaming.hasSession(partnerDevAddr), ShouldBeTrue)
+
+	// Failing partner ends the session
+	partner.Close()
+	err = b.HandleUplink(roamingUplinkMessage(partnerDevAddr, 4))
+	a.So(err, ShouldNotBeNil)
+	a.So(b.roaming.hasSession(partnerDevAddr), ShouldBeFalse)
+}
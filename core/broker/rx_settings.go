@@ -0,0 +1,71 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package broker
+
+import (
+	"time"
+
+	pb "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/band"
+)
+
+// applyRXSettings updates the downlink options that the router built with the defaults of the frequency plan
+// with the RX settings that were acknowledged by the device. The router validates the changed option again before it
+// schedules the downlink.
+func applyRXSettings(device *pb_lorawan.Device, uplink *pb.UplinkMessage) {
+	if device.Rx1DrOffset == 0 && device.Rx2DataRate == "" && device.Rx2Frequency == 0 && device.RxDelay <= 1 {
+		return
+	}
+
+	lorawanMetadata := uplink.GetProtocolMetadata().GetLorawan()
+	if lorawanMetadata == nil || uplink.GatewayMetadata == nil {
+		return
+	}
+	fp, err := band.Get(lorawanMetadata.FrequencyPlan.String())
+	if err != nil {
+		return
+	}
+
+	rxDelay := fp.ReceiveDelay1
+	if device.RxDelay > 1 {
+		rxDelay = time.Duration(device.RxDelay) * time.Second
+	}
+
+	for _, option := range uplink.DownlinkOptions {
+		lorawan := option.GetProtocolConfig().GetLorawan()
+		gateway := option.GetGatewayConfig()
+		if lorawan == nil || gateway == nil {
+			continue
+		}
+
+		switch time.Duration(gateway.Timestamp-uplink.GatewayMetadata.Timestamp) * time.Microsecond {
+		case fp.ReceiveDelay1:
+			gateway.Timestamp = uplink.GatewayMetadata.Timestamp + uint32(rxDelay/time.Microsecond)
+			if device.Rx1DrOffset == 0 {
+				continue
+			}
+			upDR, err := fp.GetDataRateIndexFor(lorawanMetadata.DataRate)
+			if err != nil {
+				continue
+			}
+			downDR, err := fp.GetRX1DataRate(upDR, int(device.Rx1DrOffset))
+			if err != nil {
+				continue
+			}
+			if err := lorawan.SetDataRate(fp.DataRates[downDR]); err != nil {
+				continue
+			}
+			gateway.FrequencyDeviation = uint32(lorawan.BitRate / 2)
+		case fp.ReceiveDelay2:
+			gateway.Timestamp = uplink.GatewayMetadata.Timestamp + uint32((rxDelay+time.Second)/time.Microsecond)
+			if device.Rx2DataRate != "" {
+				lorawan.DataRate = device.Rx2DataRate
+			}
+			if device.Rx2Frequency != 0 {
+				gateway.Frequency = device.Rx2Frequency
+			}
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package broker
+
+import (
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
+	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestApplyRXSettings(t *testing.T) {
+	a := New(t)
+
+	buildUplink := func() *pb.UplinkMessage {
+		buildOption := func(delay uint32, frequency uint64, dataRate string) *pb.DownlinkOption {
+			return &pb.DownlinkOption{
+				ProtocolConfig: &pb_protocol.TxConfiguration{Protocol: &pb_protocol.TxConfiguration_Lorawan{Lorawan: &pb_lorawan.TxConfiguration{
+					Modulation: pb_lorawan.Modulation_LORA,
+					DataRate:   dataRate,
+				}}},
+				GatewayConfig: &pb_gateway.TxConfiguration{
+					Timestamp: 1000 + delay,
+					Frequency: frequency,
+				},
+			}
+		}
+		return &pb.UplinkMessage{
+			ProtocolMetadata: &pb_protocol.RxMetadata{Protocol: &pb_protocol.RxMetadata_Lorawan{Lorawan: &pb_lorawan.Metadata{
+				FrequencyPlan: pb_lorawan.FrequencyPlan_EU_863_870,
+				DataRate:      "SF7BW125",
+			}}},
+			GatewayMetadata: &pb_gateway.RxMetadata{Timestamp: 1000, Frequency: 868100000},
+			DownlinkOptions: []*pb.DownlinkOption{
+				buildOption(1000000, 868100000, "SF7BW125"),
+				buildOption(2000000, 869525000, "SF9BW125"),
+			},
+		}
+	}
+
+	// Defaults
+	uplink := buildUplink()
+	applyRXSettings(&pb_lorawan.Device{}, uplink)
+	a.So(uplink.DownlinkOptions, ShouldResemble, buildUplink().DownlinkOptions)
+
+	uplink = buildUplink()
+	applyRXSettings(&pb_lorawan.Device{
+		Rx1DrOffset:  2,
+		Rx2DataRate:  "SF12BW125",
+		Rx2Frequency: 869500000,
+		RxDelay:      5,
+	}, uplink)
+
+	rx1 := uplink.DownlinkOptions[0]
+	a.So(rx1.GatewayConfig.Timestamp, ShouldEqual, 5001000)
+	a.So(rx1.GatewayConfig.Frequency, ShouldEqual, 868100000)
+	a.So(rx1.ProtocolConfig.GetLorawan().DataRate, ShouldEqual, "SF9BW125")
+
+	rx2 := uplink.DownlinkOptions[1]
+	a.So(rx2.GatewayConfig.Timestamp, ShouldEqual, 6001000)
+	a.So(rx2.GatewayConfig.Frequency, ShouldEqual, 869500000)
+	a.So(rx2.ProtocolConfig.GetLorawan().DataRate, ShouldEqual, "SF12BW125")
+}
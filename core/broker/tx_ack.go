@@ -0,0 +1,53 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package broker
+
+import (
+	"fmt"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_handler "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+func (b *broker) HandleTxAck(ack *pb.TxAcknowledgement) (err error) {
+	ctx := b.Ctx.WithFields(ttnlog.Fields{
+		"AppID":     ack.AppId,
+		"DevID":     ack.DevId,
+		"GatewayID": ack.GatewayId,
+		"Result":    ack.Result,
+	})
+	defer func() {
+		if err != nil {
+			ctx.WithError(err).Warn("Could not handle TX acknowledgement")
+		} else {
+			ctx.Debug("Handled TX acknowledgement")
+		}
+	}()
+
+	ack.Trace = ack.Trace.WithEvent(trace.ReceiveEvent)
+
+	announcements, err := b.Discovery.GetAllHandlersForAppID(ack.AppId)
+	if err != nil {
+		return err
+	}
+	if len(announcements) == 0 {
+		return errors.NewErrNotFound(fmt.Sprintf("Handler for AppID %s", ack.AppId))
+	}
+
+	ack.Trace = ack.Trace.WithEvent(trace.ForwardEvent)
+
+	for _, announcement := range announcements {
+		conn, err := b.getHandlerConn(announcement.Id)
+		if err != nil {
+			return err
+		}
+		if _, err := pb_handler.NewHandlerClient(conn).TxAck(b.Component.GetContext(""), ack); err != nil {
+			return errors.Wrap(errors.FromGRPCError(err), "Handler did not handle TX acknowledgement")
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@
 package broker
 
 import (
+	"bytes"
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
@@ -20,6 +21,7 @@ import (
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"github.com/TheThingsNetwork/ttn/utils/fcnt"
+	"github.com/TheThingsNetwork/ttn/utils/mic"
 	"github.com/brocaar/lorawan"
 )
 
@@ -89,6 +91,22 @@ func (b *broker) HandleUplink(uplink *pb.UplinkMessage) (err error) {
 		"DevAddr": devAddr,
 		"FCnt":    macPayload.FHDR.FCnt,
 	})
+
+	// Forward uplink of other NetIDs to roaming partners
+	if b.roaming != nil {
+		if netID, partner, ok := b.roaming.getPartner(devAddr); ok {
+			ctx = ctx.WithField("NetID", netID)
+			err = b.forwardRoamingUplink(netID, partner, devAddr, duplicates, deduplicatedUplink)
+			if err != nil {
+				return errors.Wrap(err, "Roaming partner did not handle uplink")
+			}
+			deduplicatedUplink.Trace = deduplicatedUplink.Trace.WithEvent(trace.ForwardEvent,
+				"netid", netID,
+			)
+			return nil
+		}
+	}
+
 	var getDevicesResp *networkserver.DevicesResponse
 	getDevicesResp, err = b.ns.GetDevices(b.Component.GetContext(b.nsToken), &networkserver.DevicesRequest{
 		DevAddr: &devAddr,
@@ -114,11 +132,9 @@ func (b *broker) HandleUplink(uplink *pb.UplinkMessage) (err error) {
 	var micChecks int
 	originalFCnt := macPayload.FHDR.FCnt
 	for _, candidate := range getDevicesResp.Results {
-		nwkSKey := lorawan.AES128Key(*candidate.NwkSKey)
-
 		// First check with the 16 bit counter
 		micChecks++
-		ok, err = phyPayload.ValidateMIC(nwkSKey)
+		ok, err = validateMIC(candidate, phyPayload, deduplicatedUplink.Payload)
 		if err != nil {
 			return err
 		}
@@ -133,7 +149,7 @@ func (b *broker) HandleUplink(uplink *pb.UplinkMessage) (err error) {
 			// Then check again with the 32 bit counter
 			if macPayload.FHDR.FCnt != originalFCnt {
 				micChecks++
-				ok, err = phyPayload.ValidateMIC(nwkSKey)
+				ok, err = validateMIC(candidate, phyPayload, deduplicatedUplink.Payload)
 				if err != nil {
 					return err
 				}
@@ -194,6 +210,7 @@ func (b *broker) HandleUplink(uplink *pb.UplinkMessage) (err error) {
 	// Collect GatewayMetadata and DownlinkOptions
 	var downlinkOptions []*pb.DownlinkOption
 	for _, duplicate := range duplicates {
+		applyRXSettings(device, duplicate)
 		deduplicatedUplink.GatewayMetadata = append(deduplicatedUplink.GatewayMetadata, duplicate.GatewayMetadata)
 		downlinkOptions = append(downlinkOptions, duplicate.DownlinkOptions...)
 	}
@@ -242,6 +259,27 @@ func (b *broker) HandleUplink(uplink *pb.UplinkMessage) (err error) {
 	return nil
 }
 
+// validateMIC validates the MIC of an uplink message for a candidate device. The Broker does not know the SNwkSIntKey
+// of LoRaWAN 1.1 devices, so it only validates the half of the MIC that is computed with the FNwkSIntKey (the NwkSKey
+// of the candidate). The NetworkServer validates the full MIC.
+func validateMIC(candidate *pb_lorawan.Device, phyPayload lorawan.PHYPayload, payload []byte) (bool, error) {
+	if candidate.MacVersion != pb_lorawan.MACVersion_LORAWAN_1_1 {
+		return phyPayload.ValidateMIC(lorawan.AES128Key(*candidate.NwkSKey))
+	}
+	if len(payload) < 4 {
+		return false, errors.NewErrInvalidArgument("Uplink", "payload too short")
+	}
+	macPayload, ok := phyPayload.MACPayload.(*lorawan.MACPayload)
+	if !ok {
+		return false, errors.NewErrInvalidArgument("Uplink", "does not contain a MAC payload")
+	}
+	legacyMIC, err := mic.LegacyUplinkMIC(*candidate.NwkSKey, types.DevAddr(macPayload.FHDR.DevAddr), macPayload.FHDR.FCnt, payload[:len(payload)-4])
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(legacyMIC[0:2], phyPayload.MIC[2:4]), nil
+}
+
 func (b *broker) deduplicateUplink(duplicate *pb.UplinkMessage) (uplinks []*pb.UplinkMessage) {
 	sum := md5.Sum(duplicate.Payload)
 	key := hex.EncodeToString(sum[:])
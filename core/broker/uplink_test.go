@@ -16,6 +16,7 @@ import (
 	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/mic"
 	"github.com/brocaar/lorawan"
 	"github.com/golang/mock/gomock"
 	. "github.com/smartystreets/assertions"
@@ -183,3 +184,42 @@ func TestDeduplicateUplink(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestValidateMIC(t *testing.T) {
+	a := New(t)
+
+	fNwkSIntKey := types.NwkSKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+	sNwkSIntKey := types.NwkSKey{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1}
+	devAddr := types.DevAddr{1, 2, 3, 4}
+
+	phy := lorawan.PHYPayload{
+		MHDR: lorawan.MHDR{MType: lorawan.UnconfirmedDataUp, Major: lorawan.LoRaWANR1},
+		MACPayload: &lorawan.MACPayload{
+			FHDR: lorawan.FHDR{DevAddr: lorawan.DevAddr(devAddr), FCnt: 1},
+		},
+	}
+	phy.SetMIC(lorawan.AES128Key(fNwkSIntKey))
+	bytes, _ := phy.MarshalBinary()
+
+	// LoRaWAN 1.0
+	ok, err := validateMIC(&pb_lorawan.Device{NwkSKey: &fNwkSIntKey}, phy, bytes)
+	a.So(err, ShouldBeNil)
+	a.So(ok, ShouldBeTrue)
+
+	ok, err = validateMIC(&pb_lorawan.Device{NwkSKey: &sNwkSIntKey}, phy, bytes)
+	a.So(err, ShouldBeNil)
+	a.So(ok, ShouldBeFalse)
+
+	// LoRaWAN 1.1
+	uplinkMIC, _ := mic.UplinkMIC(fNwkSIntKey, sNwkSIntKey, 0, 5, 2, devAddr, 1, bytes[:len(bytes)-4])
+	copy(phy.MIC[:], uplinkMIC[:])
+	copy(bytes[len(bytes)-4:], uplinkMIC[:])
+
+	ok, err = validateMIC(&pb_lorawan.Device{NwkSKey: &fNwkSIntKey, MacVersion: pb_lorawan.MACVersion_LORAWAN_1_1}, phy, bytes)
+	a.So(err, ShouldBeNil)
+	a.So(ok, ShouldBeTrue)
+
+	ok, err = validateMIC(&pb_lorawan.Device{NwkSKey: &sNwkSIntKey, MacVersion: pb_lorawan.MACVersion_LORAWAN_1_1}, phy, bytes)
+	a.So(err, ShouldBeNil)
+	a.So(ok, ShouldBeFalse)
+}
@@ -97,3 +97,8 @@ func New(ctx ttnlog.Interface, serviceName string, announcedAddress string) (*Co
 
 	return component, nil
 }
+
+// TLSConfig returns the TLS configuration of the Component, or nil if TLS is not enabled
+func (c *Component) TLSConfig() *tls.Config {
+	return c.tlsConfig
+}
@@ -15,6 +15,7 @@ import (
 	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
 	"github.com/TheThingsNetwork/ttn/api/fields"
 	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/api/trace"
 	"github.com/TheThingsNetwork/ttn/core/handler/device"
 	"github.com/TheThingsNetwork/ttn/core/types"
@@ -48,6 +49,10 @@ func (h *handler) HandleActivationChallenge(challenge *pb_broker.ActivationChall
 		err = errors.NewErrNotFound(fmt.Sprintf("AppKey for device %s", challenge.DevId))
 		return nil, err
 	}
+	if dev.Options.MACVersion == pb_lorawan.MACVersion_LORAWAN_1_1 && dev.NwkKey.IsEmpty() {
+		err = errors.NewErrNotFound(fmt.Sprintf("NwkKey for device %s", challenge.DevId))
+		return nil, err
+	}
 
 	// Unmarshal LoRaWAN
 	var reqPHY lorawan.PHYPayload
@@ -56,7 +61,7 @@ func (h *handler) HandleActivationChallenge(challenge *pb_broker.ActivationChall
 	}
 
 	// Set MIC
-	if err := reqPHY.SetMIC(lorawan.AES128Key(dev.AppKey)); err != nil {
+	if err := reqPHY.SetMIC(lorawan.AES128Key(joinKey(dev))); err != nil {
 		return nil, errors.NewErrNotFound("Could not set MIC")
 	}
 
@@ -110,8 +115,14 @@ func (h *handler) HandleActivation(activation *pb_broker.DeduplicatedDeviceActiv
 		return nil, err
 	}
 
-	if dev.AppKey.IsEmpty() {
-		return nil, errors.NewErrNotFound(fmt.Sprintf("AppKey for device %s", devID))
+	// If a join server is used, it has the root keys of the device
+	if h.joinServer == nil {
+		if dev.AppKey.IsEmpty() {
+			return nil, errors.NewErrNotFound(fmt.Sprintf("AppKey for device %s", devID))
+		}
+		if dev.Options.MACVersion == pb_lorawan.MACVersion_LORAWAN_1_1 && dev.NwkKey.IsEmpty() {
+			return nil, errors.NewErrNotFound(fmt.Sprintf("NwkKey for device %s", devID))
+		}
 	}
 
 	// Check for LoRaWAN
@@ -139,36 +150,6 @@ func (h *handler) HandleActivation(activation *pb_broker.DeduplicatedDeviceActiv
 		return nil, errors.NewErrInvalidArgument("Activation Payload", "inconsistent")
 	}
 
-	// Validate MIC
-	activation.Trace = activation.Trace.WithEvent(trace.CheckMICEvent)
-	if ok, err = reqPHY.ValidateMIC(lorawan.AES128Key(dev.AppKey)); err != nil || !ok {
-		return nil, errors.NewErrNotFound("device that validates MIC")
-	}
-
-	if dev.DevEUI.IsEmpty() {
-		activation.Trace = activation.Trace.WithEvent("registering on join")
-		dev, err = h.registerDeviceOnJoin(dev, activation)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// Validate DevNonce
-	var alreadyUsed bool
-	for _, usedNonce := range dev.UsedDevNonces {
-		if usedNonce == device.DevNonce(reqMAC.DevNonce) {
-			alreadyUsed = true
-			break
-		}
-	}
-	if alreadyUsed {
-		err = errors.NewErrInvalidArgument("Activation DevNonce", "already used")
-		return nil, err
-	}
-
-	ctx.Debug("Accepting Join Request")
-	activation.Trace = activation.Trace.WithEvent(trace.AcceptEvent)
-
 	// Prepare Device Activation Response
 	var resPHY lorawan.PHYPayload
 	if err = resPHY.UnmarshalBinary(activation.ResponseTemplate.Payload); err != nil {
@@ -185,6 +166,103 @@ func (h *handler) HandleActivation(activation *pb_broker.DeduplicatedDeviceActiv
 	}
 	resPHY.MACPayload = joinAccept
 
+	var resBytes []byte
+	var appSKey types.AppSKey
+	var nwkSKey, sNwkSIntKey, nwkSEncKey types.NwkSKey
+
+	if h.joinServer != nil {
+		// The join server validates the MIC and DevNonce, and calculates the session keys
+		activation.Trace = activation.Trace.WithEvent(trace.ForwardEvent, "join server", h.joinServer.url)
+		resBytes, appSKey, nwkSKey, sNwkSIntKey, nwkSEncKey, err = h.joinWithJoinServer(dev, activation, joinAccept)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Validate MIC
+		activation.Trace = activation.Trace.WithEvent(trace.CheckMICEvent)
+		if ok, err = reqPHY.ValidateMIC(lorawan.AES128Key(joinKey(dev))); err != nil || !ok {
+			return nil, errors.NewErrNotFound("device that validates MIC")
+		}
+	}
+
+	if dev.DevEUI.IsEmpty() {
+		activation.Trace = activation.Trace.WithEvent("registering on join")
+		dev, err = h.registerDeviceOnJoin(dev, activation)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var appNonce device.AppNonce
+	if h.joinServer == nil {
+		// Validate DevNonce
+		var alreadyUsed bool
+		for _, usedNonce := range dev.UsedDevNonces {
+			if usedNonce == device.DevNonce(reqMAC.DevNonce) {
+				alreadyUsed = true
+				break
+			}
+		}
+		if alreadyUsed {
+			err = errors.NewErrInvalidArgument("Activation DevNonce", "already used")
+			return nil, err
+		}
+
+		ctx.Debug("Accepting Join Request")
+		activation.Trace = activation.Trace.WithEvent(trace.AcceptEvent)
+
+		// Generate AppNonce
+		if dev.Options.MACVersion == pb_lorawan.MACVersion_LORAWAN_1_1 {
+			// LoRaWAN 1.1 devices only accept incrementing JoinNonces
+			appNonce = nextJoinNonce(dev.UsedAppNonces)
+		} else {
+			for {
+				// NOTE: As DevNonces are only 2 bytes, we will start rejecting those before we run out of AppNonces.
+				// It might just take some time to get one we didn't use yet...
+				alreadyUsed = false
+				random.FillBytes(appNonce[:])
+				for _, usedNonce := range dev.UsedAppNonces {
+					if usedNonce == appNonce {
+						alreadyUsed = true
+						break
+					}
+				}
+				if !alreadyUsed {
+					break
+				}
+			}
+		}
+		joinAccept.AppNonce = appNonce
+
+		// Calculate session keys
+		if dev.Options.MACVersion == pb_lorawan.MACVersion_LORAWAN_1_1 {
+			appSKey, nwkSKey, sNwkSIntKey, nwkSEncKey, err = otaa.CalculateSessionKeys11(dev.NwkKey, dev.AppKey, joinAccept.AppNonce, *activation.AppEui, reqMAC.DevNonce)
+		} else {
+			appSKey, nwkSKey, err = otaa.CalculateSessionKeys(dev.AppKey, joinAccept.AppNonce, joinAccept.NetID, reqMAC.DevNonce)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if dev.Options.MACVersion == pb_lorawan.MACVersion_LORAWAN_1_1 {
+			resBytes, err = otaa.MarshalJoinAccept11(resPHY, dev.NwkKey, dev.DevEUI, *activation.AppEui, reqMAC.DevNonce)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			if err = resPHY.SetMIC(lorawan.AES128Key(dev.AppKey)); err != nil {
+				return nil, err
+			}
+			if err = resPHY.EncryptJoinAcceptPayload(lorawan.AES128Key(dev.AppKey)); err != nil {
+				return nil, err
+			}
+			resBytes, err = resPHY.MarshalBinary()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// Publish Activation
 	mqttMetadata, _ := h.getActivationMetadata(ctx, activation, dev)
 	h.qEvent <- &types.DeviceEvent{
@@ -199,58 +277,37 @@ func (h *handler) HandleActivation(activation *pb_broker.DeduplicatedDeviceActiv
 		},
 	}
 
-	// Generate random AppNonce
-	var appNonce device.AppNonce
-	for {
-		// NOTE: As DevNonces are only 2 bytes, we will start rejecting those before we run out of AppNonces.
-		// It might just take some time to get one we didn't use yet...
-		alreadyUsed = false
-		random.FillBytes(appNonce[:])
-		for _, usedNonce := range dev.UsedAppNonces {
-			if usedNonce == appNonce {
-				alreadyUsed = true
-				break
-			}
-		}
-		if !alreadyUsed {
-			break
-		}
-	}
-	joinAccept.AppNonce = appNonce
-
-	// Calculate session keys
-	appSKey, nwkSKey, err := otaa.CalculateSessionKeys(dev.AppKey, joinAccept.AppNonce, joinAccept.NetID, reqMAC.DevNonce)
-	if err != nil {
-		return nil, err
-	}
-
-	// Update Device
+	// Update Device. A device without a session uses the new session right away. Otherwise the current session remains
+	// active until the device sends its first uplink in the new session, in case the device did not receive the
+	// JoinAccept.
 	dev.StartUpdate()
-	dev.DevAddr = types.DevAddr(joinAccept.DevAddr)
-	dev.AppSKey = appSKey
-	dev.NwkSKey = nwkSKey
-	dev.UsedAppNonces = append(dev.UsedAppNonces, appNonce)
-	dev.UsedDevNonces = append(dev.UsedDevNonces, reqMAC.DevNonce)
-	err = h.devices.Set(dev)
-	if err != nil {
-		return nil, err
+	dev.PendingSession = device.PendingSession{
+		DevAddr:     types.DevAddr(joinAccept.DevAddr),
+		NwkSKey:     nwkSKey,
+		AppSKey:     appSKey,
+		SNwkSIntKey: sNwkSIntKey,
+		NwkSEncKey:  nwkSEncKey,
 	}
-
-	if err = resPHY.SetMIC(lorawan.AES128Key(dev.AppKey)); err != nil {
-		return nil, err
+	if dev.DevAddr.IsEmpty() {
+		activatePendingSession(dev)
 	}
-	if err = resPHY.EncryptJoinAcceptPayload(lorawan.AES128Key(dev.AppKey)); err != nil {
-		return nil, err
+	if h.joinServer == nil {
+		dev.UsedAppNonces = append(dev.UsedAppNonces, appNonce)
+		dev.UsedDevNonces = append(dev.UsedDevNonces, reqMAC.DevNonce)
 	}
-
-	var resBytes []byte
-	resBytes, err = resPHY.MarshalBinary()
+	err = h.devices.Set(dev)
 	if err != nil {
 		return nil, err
 	}
 
-	metadata.NwkSKey = &dev.NwkSKey
-	metadata.DevAddr = &dev.DevAddr
+	if dev.Options.MACVersion == pb_lorawan.MACVersion_LORAWAN_1_1 {
+		metadata.SNwkSIntKey = &sNwkSIntKey
+		metadata.NwkSEncKey = &nwkSEncKey
+	}
+	metadata.NwkSKey = &nwkSKey
+	devAddr := types.DevAddr(joinAccept.DevAddr)
+	metadata.DevAddr = &devAddr
+	metadata.MacVersion = dev.Options.MACVersion
 	res = &pb.DeviceActivationResponse{
 		Payload:            resBytes,
 		DownlinkOption:     activation.ResponseTemplate.DownlinkOption,
@@ -261,6 +318,55 @@ func (h *handler) HandleActivation(activation *pb_broker.DeduplicatedDeviceActiv
 	return res, nil
 }
 
+// activatePendingSession replaces the session of the device with its pending session
+func activatePendingSession(dev *device.Device) {
+	pending := dev.PendingSession
+	dev.DevAddr = pending.DevAddr
+	dev.NwkSKey = pending.NwkSKey
+	dev.AppSKey = pending.AppSKey
+	dev.SNwkSIntKey = pending.SNwkSIntKey
+	dev.NwkSEncKey = pending.NwkSEncKey
+	dev.FCntDown = 0
+	dev.PendingSession = device.PendingSession{}
+}
+
+// usesPendingSession returns true if the uplink message was sent in the pending session of the device. If the pending
+// session has the same DevAddr as the current session, the MIC decides.
+func usesPendingSession(ttnUp *pb_broker.DeduplicatedUplinkMessage, dev *device.Device) bool {
+	pending := dev.PendingSession
+	phyPayload := ttnUp.GetMessage().GetLorawan()
+	macPayload := phyPayload.GetMacPayload()
+	if pending.DevAddr.IsEmpty() || macPayload == nil || macPayload.DevAddr != pending.DevAddr {
+		return false
+	}
+	if pending.DevAddr != dev.DevAddr {
+		return true
+	}
+	return validateMIC(ttnUp, dev.Options.MACVersion, pending.NwkSKey) == nil
+}
+
+// joinKey returns the key that is used for the MIC of JoinRequests: the AppKey for LoRaWAN 1.0 devices and the NwkKey
+// for LoRaWAN 1.1 devices
+func joinKey(dev *device.Device) types.AppKey {
+	if dev.Options.MACVersion == pb_lorawan.MACVersion_LORAWAN_1_1 {
+		return dev.NwkKey
+	}
+	return dev.AppKey
+}
+
+// nextJoinNonce returns the JoinNonce that follows the highest JoinNonce that was used for the device
+func nextJoinNonce(used []device.AppNonce) (next device.AppNonce) {
+	var highest uint32
+	for _, nonce := range used {
+		if n := uint32(nonce[0])<<16 | uint32(nonce[1])<<8 | uint32(nonce[2]); n > highest {
+			highest = n
+		}
+	}
+	highest++
+	next[0], next[1], next[2] = byte(highest>>16), byte(highest>>8), byte(highest)
+	return
+}
+
 func (h *handler) registerDeviceOnJoin(base *device.Device, activation *pb_broker.DeduplicatedDeviceActivationRequest) (*device.Device, error) {
 	clone := base.Clone()
 	clone.DevID = strings.ToLower(fmt.Sprintf("%s-%s", base.DevID, activation.DevEui.String()))
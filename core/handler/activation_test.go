@@ -259,3 +259,9 @@ func TestHandleActivation(t *testing.T) {
 	// TODO: Check DB contents
 
 }
+
+func TestNextJoinNonce(t *testing.T) {
+	a := New(t)
+	a.So(nextJoinNonce(nil), ShouldEqual, device.AppNonce{0, 0, 1})
+	a.So(nextJoinNonce([]device.AppNonce{{0, 0, 1}, {0, 1, 0xff}, {0, 0, 5}}), ShouldEqual, device.AppNonce{0, 2, 0})
+}
@@ -4,6 +4,8 @@
 package handler
 
 import (
+	"bytes"
+
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
 	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
@@ -11,6 +13,7 @@ import (
 	"github.com/TheThingsNetwork/ttn/core/handler/device"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/mic"
 )
 
 func (h *handler) ConvertFromLoRaWAN(ctx ttnlog.Interface, ttnUp *pb_broker.DeduplicatedUplinkMessage, appUp *types.UplinkMessage, dev *device.Device) (err error) {
@@ -27,9 +30,14 @@ func (h *handler) ConvertFromLoRaWAN(ctx ttnlog.Interface, ttnUp *pb_broker.Dedu
 		return errors.NewErrInvalidArgument("Uplink", "does not contain a MAC payload")
 	}
 
+	// The first uplink in the pending session of a device that rejoined discards its previous session
+	if usesPendingSession(ttnUp, dev) {
+		ttnUp.Trace = ttnUp.Trace.WithEvent("activate pending session")
+		activatePendingSession(dev)
+	}
+
 	ttnUp.Trace = ttnUp.Trace.WithEvent(trace.CheckMICEvent)
-	err = phyPayload.ValidateMIC(dev.NwkSKey)
-	if err != nil {
+	if err = validateMIC(ttnUp, dev.Options.MACVersion, dev.NwkSKey); err != nil {
 		return err
 	}
 
@@ -78,6 +86,31 @@ func (h *handler) ConvertFromLoRaWAN(ctx ttnlog.Interface, ttnUp *pb_broker.Dedu
 	return nil
 }
 
+// validateMIC validates the MIC of an uplink message with the NwkSKey (or FNwkSIntKey for LoRaWAN 1.1 devices)
+func validateMIC(ttnUp *pb_broker.DeduplicatedUplinkMessage, macVersion pb_lorawan.MACVersion, nwkSKey types.NwkSKey) error {
+	phyPayload := ttnUp.GetMessage().GetLorawan()
+	if macVersion == pb_lorawan.MACVersion_LORAWAN_1_1 {
+		macPayload := phyPayload.GetMacPayload()
+		return validateMIC11(ttnUp.Payload, nwkSKey, macPayload.DevAddr, macPayload.FCnt)
+	}
+	return phyPayload.ValidateMIC(nwkSKey)
+}
+
+// validateMIC11 validates the half of the MIC of a LoRaWAN 1.1 uplink message that is computed with the FNwkSIntKey
+func validateMIC11(payload []byte, fNwkSIntKey types.NwkSKey, devAddr types.DevAddr, fCnt uint32) error {
+	if len(payload) < 12 {
+		return errors.NewErrInvalidArgument("Uplink", "payload too short")
+	}
+	legacyMIC, err := mic.LegacyUplinkMIC(fNwkSIntKey, devAddr, fCnt, payload[:len(payload)-4])
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(legacyMIC[0:2], payload[len(payload)-2:]) {
+		return errors.NewErrInvalidArgument("Uplink", "Invalid MIC")
+	}
+	return nil
+}
+
 func (h *handler) ConvertToLoRaWAN(ctx ttnlog.Interface, appDown *types.DownlinkMessage, ttnDown *pb_broker.DownlinkMessage, dev *device.Device) (err error) {
 	if err := ttnDown.UnmarshalPayload(); err != nil {
 		return err
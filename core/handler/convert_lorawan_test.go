@@ -12,6 +12,7 @@ import (
 	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/handler/device"
 	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/mic"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	. "github.com/smartystreets/assertions"
 )
@@ -89,6 +90,44 @@ func TestConvertFromLoRaWAN(t *testing.T) {
 	wg.Wait()
 }
 
+func TestConvertFromLoRaWANPendingSession(t *testing.T) {
+	a := New(t)
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestConvertFromLoRaWANPendingSession")},
+		devices:   device.NewRedisDeviceStore(GetRedisClient(), "handler-test-convert-from-lorawan-pending-session"),
+		qEvent:    make(chan *types.DeviceEvent, 10),
+	}
+	dev := &device.Device{
+		DevID:   "devid",
+		AppID:   "appid",
+		DevAddr: types.DevAddr{1, 2, 3, 4},
+		PendingSession: device.PendingSession{
+			DevAddr: types.DevAddr{1, 2, 3, 4},
+			NwkSKey: types.NwkSKey{1},
+			AppSKey: types.AppSKey{1},
+		},
+	}
+
+	// Uplink in the current session
+	ttnUp, appUp := buildLorawanUplink([]byte{0x40, 0x04, 0x03, 0x02, 0x01, 0x20, 0x01, 0x00, 0x0A, 0x46, 0x55, 0x96, 0x42, 0x92, 0xF2})
+	err := h.ConvertFromLoRaWAN(h.Ctx, ttnUp, appUp, dev)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.PayloadRaw, ShouldResemble, []byte{0xaa, 0xbc})
+	a.So(dev.NwkSKey, ShouldEqual, types.NwkSKey{})
+	a.So(dev.PendingSession.DevAddr.IsEmpty(), ShouldBeFalse)
+
+	// Uplink in the pending session
+	ttnUp.UnmarshalPayload()
+	ttnUp.Message.GetLorawan().GetMacPayload().FCnt++
+	ttnUp.Message.GetLorawan().SetMIC(types.NwkSKey{1})
+	ttnUp.Payload = ttnUp.Message.GetLorawan().PHYPayloadBytes()
+	err = h.ConvertFromLoRaWAN(h.Ctx, ttnUp, appUp, dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.NwkSKey, ShouldEqual, types.NwkSKey{1})
+	a.So(dev.AppSKey, ShouldEqual, types.AppSKey{1})
+	a.So(dev.PendingSession.DevAddr.IsEmpty(), ShouldBeTrue)
+}
+
 func buildLorawanDownlink(payload []byte) (*types.DownlinkMessage, *pb_broker.DownlinkMessage) {
 	appDown := &types.DownlinkMessage{
 		DevID:      "devid",
@@ -139,3 +178,20 @@ func TestConvertToLoRaWAN(t *testing.T) {
 	a.So(err, ShouldBeNil)
 	a.So(ttnDown.Payload, ShouldResemble, []byte{0x60, 0x04, 0x03, 0x02, 0x01, 0x20, 0x01, 0x00, 0x94, 0xf8, 0xcf, 0x0d})
 }
+
+func TestValidateMIC11(t *testing.T) {
+	a := New(t)
+
+	fNwkSIntKey := types.NwkSKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+	sNwkSIntKey := types.NwkSKey{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1}
+	devAddr := types.DevAddr{1, 2, 3, 4}
+	payload := []byte{0x40, 0x04, 0x03, 0x02, 0x01, 0x20, 0x01, 0x00, 0x0A, 0x46, 0x55, 0, 0, 0, 0}
+
+	uplinkMIC, _ := mic.UplinkMIC(fNwkSIntKey, sNwkSIntKey, 0, 5, 0, devAddr, 1, payload[:len(payload)-4])
+	copy(payload[len(payload)-4:], uplinkMIC[:])
+
+	a.So(validateMIC11(payload, fNwkSIntKey, devAddr, 1), ShouldBeNil)
+	a.So(validateMIC11(payload, sNwkSIntKey, devAddr, 1), ShouldNotBeNil)
+	a.So(validateMIC11(payload, fNwkSIntKey, devAddr, 2), ShouldNotBeNil)
+	a.So(validateMIC11(payload[:4], fNwkSIntKey, devAddr, 1), ShouldNotBeNil)
+}
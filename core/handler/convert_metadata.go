@@ -4,6 +4,8 @@
 package handler
 
 import (
+	"strings"
+
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
 	"github.com/TheThingsNetwork/ttn/core/handler/device"
@@ -21,6 +23,26 @@ func (h *handler) ConvertMetadata(ctx ttnlog.Interface, ttnUp *pb_broker.Dedupli
 		appUp.Metadata.DataRate = lorawan.DataRate
 		appUp.Metadata.Bitrate = lorawan.BitRate
 		appUp.Metadata.CodingRate = lorawan.CodingRate
+		if status := lorawan.DeviceStatus; status != nil {
+			appUp.Metadata.DeviceStatus = &types.DeviceStatus{
+				Battery: uint8(status.Battery),
+				Margin:  int8(status.Margin),
+				Time:    types.BuildTime(status.Time),
+			}
+		}
+		for _, anomaly := range lorawan.FCntAnomalies {
+			h.qEvent <- &types.DeviceEvent{
+				AppID: appUp.AppID,
+				DevID: appUp.DevID,
+				Event: types.FCntAnomalyEvent,
+				Data: types.FCntAnomalyEventData{
+					Type:         strings.ToLower(anomaly.Type.String()),
+					Downlink:     anomaly.Downlink,
+					FCnt:         anomaly.FCnt,
+					PreviousFCnt: anomaly.PreviousFCnt,
+				},
+			}
+		}
 	}
 
 	// Transform Gateway Metadata
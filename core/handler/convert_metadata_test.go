@@ -57,6 +57,31 @@ func TestConvertMetadata(t *testing.T) {
 	err = h.ConvertMetadata(h.Ctx, ttnUp, appUp, device)
 	a.So(err, ShouldBeNil)
 	a.So(appUp.Metadata.DataRate, ShouldEqual, "SF7BW125")
+	a.So(appUp.Metadata.DeviceStatus, ShouldBeNil)
+
+	ttnUp.ProtocolMetadata.GetLorawan().DeviceStatus = &pb_lorawan.DeviceStatus{
+		Battery: 254,
+		Margin:  -5,
+	}
+
+	err = h.ConvertMetadata(h.Ctx, ttnUp, appUp, device)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.Metadata.DeviceStatus, ShouldNotBeNil)
+	a.So(appUp.Metadata.DeviceStatus.Battery, ShouldEqual, 254)
+	a.So(appUp.Metadata.DeviceStatus.Margin, ShouldEqual, -5)
+
+	h.qEvent = make(chan *types.DeviceEvent, 10)
+	ttnUp.ProtocolMetadata.GetLorawan().FCntAnomalies = []*pb_lorawan.FCntAnomaly{
+		{Type: pb_lorawan.FCntAnomaly_RESET, FCnt: 2, PreviousFCnt: 1234},
+	}
+
+	err = h.ConvertMetadata(h.Ctx, ttnUp, appUp, device)
+	a.So(err, ShouldBeNil)
+	a.So(h.qEvent, ShouldHaveLength, 1)
+	event := <-h.qEvent
+	a.So(event.Event, ShouldEqual, types.FCntAnomalyEvent)
+	a.So(event.Data, ShouldResemble, types.FCntAnomalyEventData{Type: "reset", FCnt: 2, PreviousFCnt: 1234})
+	ttnUp.ProtocolMetadata.GetLorawan().FCntAnomalies = nil
 
 	ttnUp.GatewayMetadata[0].Time = 1465831736000000000
 	ttnUp.GatewayMetadata[0].Gps = &pb_gateway.GPSMetadata{
@@ -19,9 +19,22 @@ type AppNonce [3]byte
 
 // Options for the device
 type Options struct {
-	ActivationConstraints string `json:"activation_constraints,omitempty"` // Activation Constraints (public/local/private)
-	DisableFCntCheck      bool   `json:"disable_fcnt_check,omitemtpy"`     // Disable Frame counter check (insecure)
-	Uses32BitFCnt         bool   `json:"uses_32_bit_fcnt,omitemtpy"`       // Use 32-bit Frame counters
+	ActivationConstraints string                 `json:"activation_constraints,omitempty"` // Activation Constraints (public/local/private)
+	DisableFCntCheck      bool                   `json:"disable_fcnt_check,omitemtpy"`     // Disable Frame counter check (insecure)
+	Uses32BitFCnt         bool                   `json:"uses_32_bit_fcnt,omitemtpy"`       // Use 32-bit Frame counters
+	DevStatusInterval     time.Duration          `json:"dev_status_interval,omitempty"`    // Interval for requesting the device status (0: NetworkServer default)
+	DisableDevStatus      bool                   `json:"disable_dev_status,omitempty"`     // Never request the device status
+	RX1DROffset           uint32                 `json:"rx1_dr_offset,omitempty"`          // Data rate offset in RX1 (0: frequency plan default)
+	RX2DataRate           string                 `json:"rx2_data_rate,omitempty"`          // Data rate in RX2 (empty: frequency plan default)
+	RX2Frequency          uint64                 `json:"rx2_frequency,omitempty"`          // Frequency in RX2 (0: frequency plan default)
+	RXDelay               uint32                 `json:"rx_delay,omitempty"`               // Delay of RX1 in seconds (0: default)
+	ADRStrategy           string                 `json:"adr_strategy,omitempty"`           // Name of the ADR strategy (empty: NetworkServer default)
+	ADRMargin             uint32                 `json:"adr_margin,omitempty"`             // ADR margin in dB (0: NetworkServer default)
+	Class                 pb_lorawan.DeviceClass `json:"class,omitempty"`                  // Class of the device (Class A, B or C)
+	BeaconFrequency       uint64                 `json:"beacon_frequency,omitempty"`       // Frequency of the Class B beacons (0: frequency plan default)
+	MACVersion            pb_lorawan.MACVersion  `json:"mac_version,omitempty"`            // LoRaWAN version of the device (1.0 or 1.1)
+	DeviceProfileID       string                 `json:"device_profile_id,omitempty"`      // Device profile on the NetworkServer
+	ServiceProfileID      string                 `json:"service_profile_id,omitempty"`     // Service profile on the NetworkServer
 }
 
 // Device contains the state of a device
@@ -42,13 +55,20 @@ type Device struct {
 	Options Options `redis:"options"`
 
 	AppKey        types.AppKey `redis:"app_key"`
+	NwkKey        types.AppKey `redis:"nwk_key"` // Only used by LoRaWAN 1.1 devices
 	UsedDevNonces []DevNonce   `redis:"used_dev_nonces"`
 	UsedAppNonces []AppNonce   `redis:"used_app_nonces"`
 
-	DevAddr types.DevAddr `redis:"dev_addr"`
-	NwkSKey types.NwkSKey `redis:"nwk_s_key"`
-	AppSKey types.AppSKey `redis:"app_s_key"`
-	FCntUp  uint32        `redis:"f_cnt_up"` // Only used to detect retries
+	DevAddr  types.DevAddr `redis:"dev_addr"`
+	NwkSKey  types.NwkSKey `redis:"nwk_s_key"` // FNwkSIntKey for LoRaWAN 1.1 devices
+	AppSKey  types.AppSKey `redis:"app_s_key"`
+	FCntUp   uint32        `redis:"f_cnt_up"`   // Only used to detect retries
+	FCntDown uint32        `redis:"f_cnt_down"` // Next downlink FCnt, used for downlink that is not a response to an uplink
+
+	SNwkSIntKey types.NwkSKey `redis:"s_nwk_s_int_key"` // Only used by LoRaWAN 1.1 devices
+	NwkSEncKey  types.NwkSKey `redis:"nwk_s_enc_key"`   // Only used by LoRaWAN 1.1 devices
+
+	PendingSession PendingSession `redis:"pending_session,include"`
 
 	CurrentDownlink *types.DownlinkMessage `redis:"current_downlink"`
 
@@ -56,6 +76,16 @@ type Device struct {
 	UpdatedAt time.Time `redis:"updated_at"`
 }
 
+// PendingSession contains the session of a device that rejoined. The previous session remains active until the device
+// sends its first uplink in the pending session.
+type PendingSession struct {
+	DevAddr     types.DevAddr `redis:"pending_dev_addr,omitempty"`
+	NwkSKey     types.NwkSKey `redis:"pending_nwk_s_key,omitempty"`
+	AppSKey     types.AppSKey `redis:"pending_app_s_key,omitempty"`
+	SNwkSIntKey types.NwkSKey `redis:"pending_s_nwk_s_int_key,omitempty"`
+	NwkSEncKey  types.NwkSKey `redis:"pending_nwk_s_enc_key,omitempty"`
+}
+
 // StartUpdate stores the state of the device
 func (d *Device) StartUpdate() {
 	old := *d
@@ -116,6 +146,23 @@ func (d Device) GetLoRaWAN() *pb_lorawan.Device {
 		DisableFCntCheck:      d.Options.DisableFCntCheck,
 		Uses32BitFCnt:         d.Options.Uses32BitFCnt,
 		ActivationConstraints: d.Options.ActivationConstraints,
+		DevStatusInterval:     uint32(d.Options.DevStatusInterval / time.Second),
+		DisableDevStatus:      d.Options.DisableDevStatus,
+		Rx1DrOffset:           d.Options.RX1DROffset,
+		Rx2DataRate:           d.Options.RX2DataRate,
+		Rx2Frequency:          d.Options.RX2Frequency,
+		RxDelay:               d.Options.RXDelay,
+		AdrStrategy:           d.Options.ADRStrategy,
+		AdrMargin:             d.Options.ADRMargin,
+		DeviceClass:           d.Options.Class,
+		BeaconFrequency:       d.Options.BeaconFrequency,
+		MacVersion:            d.Options.MACVersion,
+		DeviceProfileId:       d.Options.DeviceProfileID,
+		ServiceProfileId:      d.Options.ServiceProfileID,
+	}
+	if d.Options.MACVersion == pb_lorawan.MACVersion_LORAWAN_1_1 {
+		dev.SNwkSIntKey = &d.SNwkSIntKey
+		dev.NwkSEncKey = &d.NwkSEncKey
 	}
 	return dev
 }
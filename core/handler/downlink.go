@@ -8,6 +8,8 @@ import (
 
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/api/trace"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
@@ -90,6 +92,72 @@ func (h *handler) EnqueueDownlink(appDownlink *types.DownlinkMessage) (err error
 			Message: appDownlink,
 		},
 	}
+
+	// Class B and Class C devices listen for downlink without sending an uplink first
+	if dev.Options.Class == pb_lorawan.DeviceClass_CLASS_B || dev.Options.Class == pb_lorawan.DeviceClass_CLASS_C {
+		if err := h.sendDownlinkWithoutUplink(appID, devID); err != nil {
+			ctx.WithError(err).Warn("Could not send downlink without uplink, keeping it in the queue")
+		}
+	}
+
+	return nil
+}
+
+// sendDownlinkWithoutUplink sends the next downlink in the queue of a Class B or Class C device without waiting for an
+// uplink. The NetworkServer selects the ping slot (Class B) or sends it as soon as possible (Class C).
+func (h *handler) sendDownlinkWithoutUplink(appID, devID string) error {
+	dev, err := h.devices.Get(appID, devID)
+	if err != nil {
+		return err
+	}
+	if dev.CurrentDownlink != nil {
+		return nil // Waiting for an ack
+	}
+
+	queue, err := h.devices.DownlinkQueue(appID, devID)
+	if err != nil {
+		return err
+	}
+	next, err := queue.Next()
+	if err != nil {
+		return err
+	}
+	if next == nil {
+		return nil
+	}
+
+	// The NetworkServer selects the gateway and fills the DownlinkOption
+	downlink := &pb_broker.DownlinkMessage{
+		AppEui:  &dev.AppEUI,
+		DevEui:  &dev.DevEUI,
+		AppId:   appID,
+		DevId:   devID,
+		Message: new(pb_protocol.Message),
+	}
+	mac := downlink.Message.InitLoRaWAN().InitDownlink()
+	mac.DevAddr = dev.DevAddr
+	mac.FCnt = dev.FCntDown
+
+	appDownlink := *next
+	appDownlink.AppID = appID
+	appDownlink.DevID = devID
+
+	if err := h.HandleDownlink(&appDownlink, downlink); err != nil {
+		queue.PushFirst(next)
+		return err
+	}
+
+	// A confirmed downlink stays the current downlink until it is acknowledged in an uplink
+	if next.Confirmed {
+		dev, err = h.devices.Get(appID, devID)
+		if err != nil {
+			return err
+		}
+		dev.StartUpdate()
+		dev.CurrentDownlink = next
+		return h.devices.Set(dev)
+	}
+
 	return nil
 }
 
@@ -154,6 +222,10 @@ func (h *handler) HandleDownlink(appDownlink *types.DownlinkMessage, downlink *p
 		}
 	}
 
+	if mac := downlink.GetMessage().GetLorawan().GetMacPayload(); mac != nil {
+		dev.FCntDown = mac.FCnt + 1
+	}
+
 	downlink.Message = nil
 	downlink.UnmarshalPayload()
 
@@ -167,17 +239,15 @@ func (h *handler) HandleDownlink(appDownlink *types.DownlinkMessage, downlink *p
 
 	downlinkConfig := types.DownlinkEventConfigInfo{}
 
-	if downlink.DownlinkOption.ProtocolConfig != nil {
-		if lorawan := downlink.DownlinkOption.ProtocolConfig.GetLorawan(); lorawan != nil {
-			downlinkConfig.Modulation = lorawan.Modulation.String()
-			downlinkConfig.DataRate = lorawan.DataRate
-			downlinkConfig.BitRate = uint(lorawan.BitRate)
-			downlinkConfig.FCnt = uint(lorawan.FCnt)
-		}
+	if lorawan := downlink.GetDownlinkOption().GetProtocolConfig().GetLorawan(); lorawan != nil {
+		downlinkConfig.Modulation = lorawan.Modulation.String()
+		downlinkConfig.DataRate = lorawan.DataRate
+		downlinkConfig.BitRate = uint(lorawan.BitRate)
+		downlinkConfig.FCnt = uint(lorawan.FCnt)
 	}
-	if gateway := downlink.DownlinkOption.GatewayConfig; gateway != nil {
-		downlinkConfig.Frequency = uint(downlink.DownlinkOption.GatewayConfig.Frequency)
-		downlinkConfig.Power = int(downlink.DownlinkOption.GatewayConfig.Power)
+	if gateway := downlink.GetDownlinkOption().GetGatewayConfig(); gateway != nil {
+		downlinkConfig.Frequency = uint(gateway.Frequency)
+		downlinkConfig.Power = int(gateway.Power)
 	}
 
 	h.qEvent <- &types.DeviceEvent{
@@ -187,7 +257,7 @@ func (h *handler) HandleDownlink(appDownlink *types.DownlinkMessage, downlink *p
 		Data: types.DownlinkEventData{
 			Payload:   downlink.Payload,
 			Message:   appDownlink,
-			GatewayID: downlink.DownlinkOption.GatewayId,
+			GatewayID: downlink.GetDownlinkOption().GetGatewayId(),
 			Config:    downlinkConfig,
 		},
 	}
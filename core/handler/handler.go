@@ -14,6 +14,7 @@ import (
 	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/handler/application"
 	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/multicast"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/mqtt"
 	"google.golang.org/grpc"
@@ -27,11 +28,14 @@ type Handler interface {
 
 	WithMQTT(username, password string, brokers ...string) Handler
 	WithAMQP(username, password, host, exchange string) Handler
+	WithJoinServer(url, secret, kekLabel string, kek []byte) Handler
 
 	HandleUplink(uplink *pb_broker.DeduplicatedUplinkMessage) error
 	HandleActivationChallenge(challenge *pb_broker.ActivationChallengeRequest) (*pb_broker.ActivationChallengeResponse, error)
 	HandleActivation(activation *pb_broker.DeduplicatedDeviceActivationRequest) (*pb.DeviceActivationResponse, error)
+	HandleTxAck(ack *pb_broker.TxAcknowledgement) error
 	EnqueueDownlink(appDownlink *types.DownlinkMessage) error
+	EnqueueMulticastDownlink(groupID string, appDownlink *types.DownlinkMessage) error
 }
 
 // NewRedisHandler creates a new Redis-backed Handler
@@ -39,6 +43,7 @@ func NewRedisHandler(client *redis.Client, ttnBrokerID string) Handler {
 	return &handler{
 		devices:      device.NewRedisDeviceStore(client, "handler"),
 		applications: application.NewRedisApplicationStore(client, "handler"),
+		multicast:    multicast.NewRedisGroupStore(client, "handler"),
 		ttnBrokerID:  ttnBrokerID,
 		qUp:          make(chan *types.UplinkMessage),
 		qEvent:       make(chan *types.DeviceEvent),
@@ -50,12 +55,14 @@ type handler struct {
 
 	devices      device.Store
 	applications application.Store
+	multicast    multicast.Store
 
-	ttnBrokerID      string
-	ttnBrokerConn    *grpc.ClientConn
-	ttnBroker        pb_broker.BrokerClient
-	ttnBrokerManager pb_broker.BrokerManagerClient
-	ttnDeviceManager pb_lorawan.DeviceManagerClient
+	ttnBrokerID         string
+	ttnBrokerConn       *grpc.ClientConn
+	ttnBroker           pb_broker.BrokerClient
+	ttnBrokerManager    pb_broker.BrokerManagerClient
+	ttnDeviceManager    pb_lorawan.DeviceManagerClient
+	ttnMulticastManager pb_lorawan.MulticastGroupManagerClient
 
 	downlink chan *pb_broker.DownlinkMessage
 
@@ -76,6 +83,8 @@ type handler struct {
 	amqpUp       chan *types.UplinkMessage
 	amqpEvent    chan *types.DeviceEvent
 
+	joinServer *joinServer
+
 	qUp    chan *types.UplinkMessage
 	qEvent chan *types.DeviceEvent
 
@@ -192,6 +201,7 @@ func (h *handler) associateBroker() error {
 	h.ttnBroker = pb_broker.NewBrokerClient(conn)
 	h.ttnBrokerManager = pb_broker.NewBrokerManagerClient(conn)
 	h.ttnDeviceManager = pb_lorawan.NewDeviceManagerClient(conn)
+	h.ttnMulticastManager = pb_lorawan.NewMulticastGroupManagerClient(conn)
 
 	h.downlink = make(chan *pb_broker.DownlinkMessage)
 
@@ -0,0 +1,116 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/backend"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/brocaar/lorawan"
+)
+
+// joinServerTimeout is the timeout for requests to the join server. The JoinAccept still has to be sent in RX1 or RX2
+// of the JoinRequest, so this should be short.
+const joinServerTimeout = 2 * time.Second
+
+type joinServer struct {
+	url    string
+	secret string
+	client *backend.Client
+	keks   map[string][]byte
+}
+
+func (h *handler) WithJoinServer(url, secret, kekLabel string, kek []byte) Handler {
+	h.joinServer = &joinServer{
+		url:    url,
+		secret: secret,
+		client: backend.NewClient("", joinServerTimeout),
+		keks:   map[string][]byte{},
+	}
+	if kekLabel != "" {
+		h.joinServer.keks[kekLabel] = kek
+	}
+	return h
+}
+
+// joinWithJoinServer sends the JoinRequest to the join server, and returns the JoinAccept and the session keys
+func (h *handler) joinWithJoinServer(dev *device.Device, activation *pb_broker.DeduplicatedDeviceActivationRequest, joinAccept *lorawan.JoinAcceptPayload) (resBytes []byte, appSKey types.AppSKey, nwkSKey, sNwkSIntKey, nwkSEncKey types.NwkSKey, err error) {
+	dlSettings, err := joinAccept.DLSettings.MarshalBinary()
+	if err != nil {
+		return
+	}
+	var cfList []byte
+	if joinAccept.CFList != nil {
+		if cfList, err = joinAccept.CFList.MarshalBinary(); err != nil {
+			return
+		}
+	}
+	macVersion := "1.0.2"
+	if dev.Options.MACVersion == pb_lorawan.MACVersion_LORAWAN_1_1 {
+		macVersion = "1.1"
+	}
+
+	req := &backend.JoinReqMessage{
+		Header:     h.joinServer.client.NewHeader(activation.AppEui.String(), backend.JoinReq),
+		MACVersion: macVersion,
+		PHYPayload: activation.Payload,
+		DevEUI:     *activation.DevEui,
+		DevAddr:    types.DevAddr(joinAccept.DevAddr),
+		DLSettings: dlSettings,
+		RxDelay:    int(joinAccept.RXDelay),
+		CFList:     cfList,
+	}
+	req.SenderID = types.NetID(joinAccept.NetID).String()
+
+	var ans backend.JoinAnsMessage
+	if err = h.joinServer.client.DoWithSecret(h.joinServer.url, h.joinServer.secret, req, &ans); err != nil {
+		err = errors.Wrap(err, "Join server did not answer")
+		return
+	}
+	if err = ans.Result.Err(); err != nil {
+		err = errors.Wrap(err, "Join server did not accept JoinRequest")
+		return
+	}
+
+	unwrap := func(envelope *backend.KeyEnvelope, key []byte, name string) error {
+		if envelope == nil {
+			return errors.NewErrNotFound(fmt.Sprintf("%s in JoinAns", name))
+		}
+		unwrapped, err := envelope.Unwrap(h.joinServer.keks)
+		if err != nil {
+			return err
+		}
+		if len(unwrapped) != len(key) {
+			return errors.NewErrInvalidArgument(name, "has invalid length")
+		}
+		copy(key, unwrapped)
+		return nil
+	}
+	if err = unwrap(ans.AppSKey, appSKey[:], "AppSKey"); err != nil {
+		return
+	}
+	if macVersion == "1.1" {
+		if err = unwrap(ans.FNwkSIntKey, nwkSKey[:], "FNwkSIntKey"); err != nil {
+			return
+		}
+		if err = unwrap(ans.SNwkSIntKey, sNwkSIntKey[:], "SNwkSIntKey"); err != nil {
+			return
+		}
+		if err = unwrap(ans.NwkSEncKey, nwkSEncKey[:], "NwkSEncKey"); err != nil {
+			return
+		}
+	} else {
+		if err = unwrap(ans.NwkSKey, nwkSKey[:], "NwkSKey"); err != nil {
+			return
+		}
+	}
+
+	return ans.PHYPayload, appSKey, nwkSKey, sNwkSIntKey, nwkSEncKey, nil
+}
@@ -0,0 +1,108 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/joinserver"
+	js_device "github.com/TheThingsNetwork/ttn/core/joinserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestHandleActivationWithJoinServer(t *testing.T) {
+	a := New(t)
+
+	kek := []byte{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+	js := httptest.NewServer(joinserver.NewRedisJoinServer(GetLogger(t, "JoinServer"), GetRedisClient(), "handler", kek, map[string]string{"000013": "secret"}))
+	defer js.Close()
+
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestHandleActivationWithJoinServer")},
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-activation-join-server"),
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-activation-join-server"),
+		qEvent:       make(chan *types.DeviceEvent, 10),
+	}
+	h.InitStatus()
+	h.WithJoinServer(js.URL, "secret", "handler", kek)
+
+	devAddr := types.DevAddr{0x26, 0x01, 0x02, 0x03}
+	appEUI, devEUI := types.AppEUI{1, 2, 3, 4, 5, 6, 7, 9}, types.DevEUI{1, 2, 3, 4, 5, 6, 7, 9}
+	appID, devID := "join-server-app", "join-server-dev"
+	appKey := types.AppKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+
+	// The Handler does not have the AppKey
+	h.devices.Set(&device.Device{AppID: appID, DevID: devID, AppEUI: appEUI, DevEUI: devEUI})
+	defer func() { h.devices.Delete(appID, devID) }()
+
+	req := &pb_broker.DeduplicatedDeviceActivationRequest{
+		AppId:  appID,
+		DevId:  devID,
+		AppEui: &appEUI,
+		DevEui: &devEUI,
+		ActivationMetadata: &pb_protocol.ActivationMetadata{Protocol: &pb_protocol.ActivationMetadata_Lorawan{Lorawan: &pb_lorawan.ActivationMetadata{
+			AppEui:  &appEUI,
+			DevEui:  &devEUI,
+			DevAddr: &devAddr,
+		}}},
+	}
+	{
+		req.ResponseTemplate = new(pb_broker.DeviceActivationResponse)
+		req.ResponseTemplate.Message = new(pb_protocol.Message)
+		msg := req.ResponseTemplate.Message.InitLoRaWAN()
+		msg.MType = pb_lorawan.MType_JOIN_ACCEPT
+		msg.Payload = &pb_lorawan.Message_JoinAcceptPayload{JoinAcceptPayload: &pb_lorawan.JoinAcceptPayload{
+			NetId:   types.NetID{0x00, 0x00, 0x13},
+			DevAddr: devAddr,
+			RxDelay: 1,
+		}}
+		req.ResponseTemplate.Payload = msg.PHYPayloadBytes()
+		req.ResponseTemplate.DownlinkOption = new(pb_broker.DownlinkOption)
+	}
+	{
+		req.Message = new(pb_protocol.Message)
+		msg := req.Message.InitLoRaWAN()
+		msg.MType = pb_lorawan.MType_JOIN_REQUEST
+		msg.Payload = &pb_lorawan.Message_JoinRequestPayload{JoinRequestPayload: &pb_lorawan.JoinRequestPayload{
+			AppEui: appEUI,
+			DevEui: devEUI,
+		}}
+		phy := msg.PHYPayload()
+		phy.SetMIC(lorawan.AES128Key(appKey))
+		req.Payload, _ = phy.MarshalBinary()
+	}
+
+	// Device not known to the join server
+	_, err := h.HandleActivation(req)
+	a.So(err, ShouldNotBeNil)
+
+	jsDevices := js_device.NewRedisDeviceStore(GetRedisClient(), "joinserver")
+	jsDevices.Set(&js_device.Device{AppEUI: appEUI, DevEUI: devEUI, AppKey: appKey})
+	defer func() { jsDevices.Delete(appEUI, devEUI) }()
+
+	// Valid join
+	res, err := h.HandleActivation(req)
+	a.So(err, ShouldBeNil)
+	a.So(res.Payload, ShouldHaveLength, 17)
+
+	dev, _ := h.devices.Get(appID, devID)
+	a.So(dev.DevAddr, ShouldEqual, devAddr)
+	a.So(dev.AppSKey.IsEmpty(), ShouldBeFalse)
+	a.So(dev.NwkSKey.IsEmpty(), ShouldBeFalse)
+	a.So(dev.AppKey.IsEmpty(), ShouldBeTrue)
+
+	// DevNonce re-use is rejected by the join server
+	_, err = h.HandleActivation(req)
+	a.So(err, ShouldNotBeNil)
+}
@@ -109,14 +109,32 @@ func (h *handlerManager) GetDevice(ctx context.Context, in *pb.DeviceIdentifier)
 			NwkSKey:               &dev.NwkSKey,
 			AppSKey:               &dev.AppSKey,
 			AppKey:                &dev.AppKey,
+			NwkKey:                &dev.NwkKey,
 			DisableFCntCheck:      dev.Options.DisableFCntCheck,
 			Uses32BitFCnt:         dev.Options.Uses32BitFCnt,
 			ActivationConstraints: dev.Options.ActivationConstraints,
+			DevStatusInterval:     uint32(dev.Options.DevStatusInterval / time.Second),
+			DisableDevStatus:      dev.Options.DisableDevStatus,
+			Rx1DrOffset:           dev.Options.RX1DROffset,
+			Rx2DataRate:           dev.Options.RX2DataRate,
+			Rx2Frequency:          dev.Options.RX2Frequency,
+			RxDelay:               dev.Options.RXDelay,
+			AdrStrategy:           dev.Options.ADRStrategy,
+			AdrMargin:             dev.Options.ADRMargin,
+			DeviceClass:           dev.Options.Class,
+			BeaconFrequency:       dev.Options.BeaconFrequency,
+			MacVersion:            dev.Options.MACVersion,
+			DeviceProfileId:       dev.Options.DeviceProfileID,
+			ServiceProfileId:      dev.Options.ServiceProfileID,
 		}},
 		Latitude:  dev.Latitude,
 		Longitude: dev.Longitude,
 		Altitude:  dev.Altitude,
 	}
+	if dev.Options.MACVersion == pb_lorawan.MACVersion_LORAWAN_1_1 {
+		pbDev.GetLorawanDevice().SNwkSIntKey = &dev.SNwkSIntKey
+		pbDev.GetLorawanDevice().NwkSEncKey = &dev.NwkSEncKey
+	}
 
 	nsDev, err := h.handler.ttnDeviceManager.GetDevice(ctx, &pb_lorawan.DeviceIdentifier{
 		AppEui: &dev.AppEUI,
@@ -142,6 +160,10 @@ func (h *handlerManager) GetDevice(ctx context.Context, in *pb.DeviceIdentifier)
 	pbDev.GetLorawanDevice().FCntUp = nsDev.FCntUp
 	pbDev.GetLorawanDevice().FCntDown = nsDev.FCntDown
 	pbDev.GetLorawanDevice().LastSeen = nsDev.LastSeen
+	pbDev.GetLorawanDevice().Battery = nsDev.Battery
+	pbDev.GetLorawanDevice().Margin = nsDev.Margin
+	pbDev.GetLorawanDevice().LastStatus = nsDev.LastStatus
+	pbDev.GetLorawanDevice().PingSlotPeriodicity = nsDev.PingSlotPeriodicity
 
 	return pbDev, nil
 }
@@ -213,11 +235,30 @@ func (h *handlerManager) SetDevice(ctx context.Context, in *pb.Device) (*empty.E
 		DisableFCntCheck:      lorawan.DisableFCntCheck,
 		Uses32BitFCnt:         lorawan.Uses32BitFCnt,
 		ActivationConstraints: lorawan.ActivationConstraints,
+		DevStatusInterval:     time.Duration(lorawan.DevStatusInterval) * time.Second,
+		DisableDevStatus:      lorawan.DisableDevStatus,
+		RX1DROffset:           lorawan.Rx1DrOffset,
+		RX2DataRate:           lorawan.Rx2DataRate,
+		RX2Frequency:          lorawan.Rx2Frequency,
+		RXDelay:               lorawan.RxDelay,
+		ADRStrategy:           lorawan.AdrStrategy,
+		ADRMargin:             lorawan.AdrMargin,
+		Class:                 lorawan.DeviceClass,
+		BeaconFrequency:       lorawan.BeaconFrequency,
+		MACVersion:            lorawan.MacVersion,
+		DeviceProfileID:       lorawan.DeviceProfileId,
+		ServiceProfileID:      lorawan.ServiceProfileId,
 	}
 	if dev.Options.ActivationConstraints == "" {
 		dev.Options.ActivationConstraints = "local"
 	}
 
+	// A session that is changed by hand replaces the pending session
+	if (lorawan.DevAddr != nil && *lorawan.DevAddr != dev.DevAddr) ||
+		(lorawan.NwkSKey != nil && *lorawan.NwkSKey != dev.NwkSKey) ||
+		(lorawan.AppSKey != nil && *lorawan.AppSKey != dev.AppSKey) {
+		dev.PendingSession = device.PendingSession{}
+	}
 	if lorawan.DevAddr != nil {
 		dev.DevAddr = *lorawan.DevAddr
 	}
@@ -227,6 +268,12 @@ func (h *handlerManager) SetDevice(ctx context.Context, in *pb.Device) (*empty.E
 	if lorawan.AppSKey != nil {
 		dev.AppSKey = *lorawan.AppSKey
 	}
+	if lorawan.SNwkSIntKey != nil {
+		dev.SNwkSIntKey = *lorawan.SNwkSIntKey
+	}
+	if lorawan.NwkSEncKey != nil {
+		dev.NwkSEncKey = *lorawan.NwkSEncKey
+	}
 
 	if lorawan.AppKey != nil {
 		if dev.AppKey != *lorawan.AppKey { // When the AppKey of an existing device is changed
@@ -235,10 +282,18 @@ func (h *handlerManager) SetDevice(ctx context.Context, in *pb.Device) (*empty.E
 		}
 		dev.AppKey = *lorawan.AppKey
 	}
+	if lorawan.NwkKey != nil {
+		if dev.NwkKey != *lorawan.NwkKey { // When the NwkKey of an existing device is changed
+			dev.UsedAppNonces = []device.AppNonce{}
+			dev.UsedDevNonces = []device.DevNonce{}
+		}
+		dev.NwkKey = *lorawan.NwkKey
+	}
 
 	dev.Latitude = in.Latitude
 	dev.Longitude = in.Longitude
 	dev.Altitude = in.Altitude
+	dev.FCntDown = lorawan.FCntDown
 
 	// Update the device in the Broker (NetworkServer)
 	nsUpdated := dev.GetLoRaWAN()
@@ -302,6 +357,39 @@ func (h *handlerManager) DeleteDevice(ctx context.Context, in *pb.DeviceIdentifi
 	return &empty.Empty{}, nil
 }
 
+func (h *handlerManager) GetDeviceStats(ctx context.Context, in *pb.DeviceIdentifier) (*pb_lorawan.DeviceStats, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Identifier")
+	}
+
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.Devices)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.handler.applications.Get(in.AppId); err != nil {
+		return nil, errors.Wrap(err, "Application not registered to this Handler")
+	}
+
+	dev, err := h.handler.devices.Get(in.AppId, in.DevId)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := h.handler.ttnDeviceManager.GetDeviceStats(ctx, &pb_lorawan.DeviceIdentifier{
+		AppEui: &dev.AppEUI,
+		DevEui: &dev.DevEUI,
+	})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Broker did not return device stats")
+	}
+	return stats, nil
+}
+
 func (h *handlerManager) GetDevicesForApplication(ctx context.Context, in *pb.ApplicationIdentifier) (*pb.DeviceList, error) {
 	if err := in.Validate(); err != nil {
 		return nil, errors.Wrap(err, "Invalid Application Identifier")
@@ -347,6 +435,7 @@ func (h *handlerManager) GetDevicesForApplication(ctx context.Context, in *pb.Ap
 				NwkSKey: &dev.NwkSKey,
 				AppSKey: &dev.AppSKey,
 				AppKey:  &dev.AppKey,
+				NwkKey:  &dev.NwkKey,
 			}},
 			Latitude:  dev.Latitude,
 			Longitude: dev.Longitude,
@@ -0,0 +1,89 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// EnqueueMulticastDownlink sends a downlink to all members of a multicast group. Multicast downlink is not queued,
+// the NetworkServer sends it as soon as possible on the gateways of the group.
+func (h *handler) EnqueueMulticastDownlink(groupID string, appDownlink *types.DownlinkMessage) (err error) {
+	appID := appDownlink.AppID
+	ctx := h.Ctx.WithFields(ttnlog.Fields{
+		"AppID":   appID,
+		"GroupID": groupID,
+	})
+
+	start := time.Now()
+	defer func() {
+		if err != nil {
+			ctx.WithError(err).Warn("Could not send multicast downlink")
+		} else {
+			ctx.WithField("Duration", time.Now().Sub(start)).Debug("Sent multicast downlink")
+		}
+	}()
+
+	if appDownlink.Confirmed {
+		return errors.NewErrInvalidArgument("Downlink", "can not be confirmed for multicast group")
+	}
+
+	group, err := h.multicast.Get(appID, groupID)
+	if err != nil {
+		return err
+	}
+
+	downlink := &pb_broker.DownlinkMessage{
+		AppId:            appID,
+		MulticastGroupId: groupID,
+		Message:          new(pb_protocol.Message),
+	}
+
+	err = h.ConvertFieldsDown(ctx, appDownlink, downlink, nil)
+	if err != nil {
+		return err
+	}
+	if len(appDownlink.PayloadRaw) == 0 {
+		return errors.NewErrInvalidArgument("Downlink", "does not contain a payload")
+	}
+
+	phyPayload := downlink.Message.InitLoRaWAN()
+	macPayload := phyPayload.InitDownlink()
+	macPayload.DevAddr = group.McAddr
+	macPayload.FCnt = group.FCntDown
+	macPayload.FPort = int32(appDownlink.FPort)
+	if macPayload.FPort <= 0 {
+		macPayload.FPort = 1
+	}
+	macPayload.FrmPayload = appDownlink.PayloadRaw
+	err = phyPayload.EncryptFRMPayload(group.McAppSKey)
+	if err != nil {
+		return err
+	}
+
+	// The NetworkServer sets the MIC and fills the DownlinkOption for every gateway
+	downlink.Payload = phyPayload.PHYPayloadBytes()
+	downlink.Message = nil
+	downlink.UnmarshalPayload()
+
+	group.FCntDown++
+	if err := h.multicast.Set(group, "f_cnt_down"); err != nil {
+		return err
+	}
+
+	h.status.downlink.Mark(1)
+
+	downlink.Trace = downlink.Trace.WithEvent(trace.ForwardEvent, "broker", h.ttnBrokerID)
+
+	h.downlink <- downlink
+
+	return nil
+}
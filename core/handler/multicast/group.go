@@ -0,0 +1,31 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package multicast
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+// Group contains the state of a multicast group
+type Group struct {
+	AppID   string `redis:"app_id"`
+	GroupID string `redis:"group_id"`
+
+	McAddr    types.DevAddr `redis:"mc_addr"`
+	McNwkSKey types.NwkSKey `redis:"mc_nwk_s_key"`
+	McAppSKey types.AppSKey `redis:"mc_app_s_key"`
+	FCntDown  uint32        `redis:"f_cnt_down"`
+
+	DataRate   string   `redis:"data_rate"`
+	Frequency  uint64   `redis:"frequency"`
+	GatewayIDs []string `redis:"gateway_ids"`
+
+	// Members contains the DevIDs of the devices in the group
+	Members []string `redis:"members"`
+
+	CreatedAt time.Time `redis:"created_at"`
+	UpdatedAt time.Time `redis:"updated_at"`
+}
@@ -0,0 +1,73 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package multicast
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestGroupStore(t *testing.T) {
+	a := New(t)
+
+	NewRedisGroupStore(GetRedisClient(), "")
+
+	s := NewRedisGroupStore(GetRedisClient(), "handler-test-group-store")
+
+	// Get non-existing
+	group, err := s.Get("test", "lights")
+	a.So(err, ShouldNotBeNil)
+	a.So(group, ShouldBeNil)
+
+	groups, err := s.ListForApp("test", nil)
+	a.So(err, ShouldBeNil)
+	a.So(groups, ShouldHaveLength, 0)
+
+	// Create
+	err = s.Set(&Group{
+		AppID:      "test",
+		GroupID:    "lights",
+		McAddr:     types.DevAddr{1, 2, 3, 4},
+		McAppSKey:  types.AppSKey{1, 2, 3, 4},
+		GatewayIDs: []string{"gtw"},
+		Members:    []string{"lamp"},
+	})
+	defer func() {
+		s.Delete("test", "lights")
+	}()
+	a.So(err, ShouldBeNil)
+
+	// Get existing
+	group, err = s.Get("test", "lights")
+	a.So(err, ShouldBeNil)
+	a.So(group, ShouldNotBeNil)
+	a.So(group.McAddr, ShouldEqual, types.DevAddr{1, 2, 3, 4})
+	a.So(group.GatewayIDs, ShouldResemble, []string{"gtw"})
+	a.So(group.Members, ShouldHaveLength, 1)
+	a.So(group.Members[0], ShouldEqual, "lamp")
+	a.So(group.McAppSKey, ShouldEqual, types.AppSKey{1, 2, 3, 4})
+	a.So(group.CreatedAt.IsZero(), ShouldBeFalse)
+
+	groups, err = s.ListForApp("test", nil)
+	a.So(err, ShouldBeNil)
+	a.So(groups, ShouldHaveLength, 1)
+
+	// Update a single property
+	group.FCntDown = 42
+	err = s.Set(group, "f_cnt_down")
+	a.So(err, ShouldBeNil)
+	group, _ = s.Get("test", "lights")
+	a.So(group.FCntDown, ShouldEqual, 42)
+	a.So(group.Members, ShouldHaveLength, 1)
+
+	// Delete
+	err = s.Delete("test", "lights")
+	a.So(err, ShouldBeNil)
+	group, err = s.Get("test", "lights")
+	a.So(err, ShouldNotBeNil)
+	a.So(group, ShouldBeNil)
+}
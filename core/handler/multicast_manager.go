@@ -0,0 +1,207 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	"github.com/TheThingsNetwork/ttn/api"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/handler/multicast"
+	"github.com/TheThingsNetwork/ttn/core/storage"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+func multicastGroupToPb(in *multicast.Group) *pb_lorawan.MulticastGroup {
+	out := &pb_lorawan.MulticastGroup{
+		AppId:      in.AppID,
+		GroupId:    in.GroupID,
+		McAddr:     &in.McAddr,
+		McNwkSKey:  &in.McNwkSKey,
+		McAppSKey:  &in.McAppSKey,
+		FCntDown:   in.FCntDown,
+		DataRate:   in.DataRate,
+		Frequency:  in.Frequency,
+		GatewayIds: in.GatewayIDs,
+	}
+	for _, devID := range in.Members {
+		out.Members = append(out.Members, &pb_lorawan.MulticastGroup_Member{DevId: devID})
+	}
+	return out
+}
+
+// validateMulticastAppContext checks that the context has device rights to the application, and that the application
+// is registered to this Handler
+func (h *handlerManager) validateMulticastAppContext(ctx context.Context, appID string) (context.Context, error) {
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, appID)
+	if err != nil {
+		return ctx, err
+	}
+	err = checkAppRights(claims, appID, rights.Devices)
+	if err != nil {
+		return ctx, err
+	}
+	if _, err := h.handler.applications.Get(appID); err != nil {
+		return ctx, errors.Wrap(err, "Application not registered to this Handler")
+	}
+	return ctx, nil
+}
+
+func (h *handlerManager) GetMulticastGroup(ctx context.Context, in *pb_lorawan.MulticastGroupIdentifier) (*pb_lorawan.MulticastGroup, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Multicast Group Identifier")
+	}
+	if _, err := h.validateMulticastAppContext(ctx, in.AppId); err != nil {
+		return nil, err
+	}
+	group, err := h.handler.multicast.Get(in.AppId, in.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	return multicastGroupToPb(group), nil
+}
+
+func (h *handlerManager) SetMulticastGroup(ctx context.Context, in *pb_lorawan.MulticastGroup) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Multicast Group")
+	}
+	if in.McAppSKey == nil || in.McAppSKey.IsEmpty() {
+		return nil, errors.NewErrInvalidArgument("McAppSKey", "can not be empty")
+	}
+	ctx, err := h.validateMulticastAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+
+	group := &multicast.Group{
+		AppID:      in.AppId,
+		GroupID:    in.GroupId,
+		McAddr:     *in.McAddr,
+		McNwkSKey:  *in.McNwkSKey,
+		McAppSKey:  *in.McAppSKey,
+		FCntDown:   in.FCntDown,
+		DataRate:   in.DataRate,
+		Frequency:  in.Frequency,
+		GatewayIDs: in.GatewayIds,
+	}
+
+	// The NetworkServer does not get the McAppSKey, and identifies the members by their EUIs
+	nsGroup := &pb_lorawan.MulticastGroup{
+		AppId:      in.AppId,
+		GroupId:    in.GroupId,
+		McAddr:     in.McAddr,
+		McNwkSKey:  in.McNwkSKey,
+		FCntDown:   in.FCntDown,
+		DataRate:   in.DataRate,
+		Frequency:  in.Frequency,
+		GatewayIds: in.GatewayIds,
+	}
+	for _, member := range in.Members {
+		dev, err := h.handler.devices.Get(in.AppId, member.DevId)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Could not get member %s", member.DevId)
+		}
+		if dev.Options.Class != pb_lorawan.DeviceClass_CLASS_C {
+			return nil, errors.NewErrInvalidArgument("Members", "must be Class C devices")
+		}
+		group.Members = append(group.Members, dev.DevID)
+		nsGroup.Members = append(nsGroup.Members, &pb_lorawan.MulticastGroup_Member{
+			DevId:  dev.DevID,
+			AppEui: &dev.AppEUI,
+			DevEui: &dev.DevEUI,
+		})
+	}
+
+	_, err = h.handler.ttnMulticastManager.SetMulticastGroup(ctx, nsGroup)
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Broker did not set multicast group")
+	}
+
+	err = h.handler.multicast.Set(group)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (h *handlerManager) DeleteMulticastGroup(ctx context.Context, in *pb_lorawan.MulticastGroupIdentifier) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Multicast Group Identifier")
+	}
+	ctx, err := h.validateMulticastAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.handler.multicast.Get(in.AppId, in.GroupId); err != nil {
+		return nil, err
+	}
+	_, err = h.handler.ttnMulticastManager.DeleteMulticastGroup(ctx, in)
+	if err != nil && errors.GetErrType(errors.FromGRPCError(err)) != errors.NotFound {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Broker did not delete multicast group")
+	}
+	err = h.handler.multicast.Delete(in.AppId, in.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (h *handlerManager) GetMulticastGroupsForApplication(ctx context.Context, in *pb.ApplicationIdentifier) (*pb.MulticastGroupList, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	ctx, err := h.validateMulticastAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, offset, err := api.LimitAndOffsetFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := h.handler.multicast.ListForApp(in.AppId, &storage.ListOptions{Limit: limit, Offset: offset})
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.MulticastGroupList{Groups: []*pb_lorawan.MulticastGroup{}}
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+		res.Groups = append(res.Groups, multicastGroupToPb(group))
+	}
+	return res, nil
+}
+
+func (h *handlerManager) SendMulticastDownlink(ctx context.Context, in *pb.MulticastDownlinkMessage) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Multicast Downlink")
+	}
+	if _, err := h.validateMulticastAppContext(ctx, in.AppId); err != nil {
+		return nil, err
+	}
+
+	appDownlink := &types.DownlinkMessage{
+		AppID:      in.AppId,
+		FPort:      uint8(in.Port),
+		PayloadRaw: in.Payload,
+	}
+	if in.Fields != "" {
+		if err := json.Unmarshal([]byte(in.Fields), &appDownlink.PayloadFields); err != nil {
+			return nil, errors.NewErrInvalidArgument("Fields", err.Error())
+		}
+	}
+
+	if err := h.handler.EnqueueMulticastDownlink(in.GroupId, appDownlink); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
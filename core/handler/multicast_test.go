@@ -0,0 +1,75 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/multicast"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestEnqueueMulticastDownlink(t *testing.T) {
+	a := New(t)
+	var wg WaitGroup
+	appID := "app-multicast"
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestEnqueueMulticastDownlink")},
+		multicast: multicast.NewRedisGroupStore(GetRedisClient(), "handler-test-enqueue-multicast-downlink"),
+		downlink:  make(chan *pb_broker.DownlinkMessage),
+	}
+	h.InitStatus()
+
+	// Group Not Found
+	err := h.EnqueueMulticastDownlink("lights", &types.DownlinkMessage{AppID: appID, PayloadRaw: []byte{0xAA}})
+	a.So(err, ShouldNotBeNil)
+
+	h.multicast.Set(&multicast.Group{
+		AppID:     appID,
+		GroupID:   "lights",
+		McAddr:    types.DevAddr{1, 2, 3, 4},
+		McAppSKey: types.AppSKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+		FCntDown:  42,
+	})
+	defer func() {
+		h.multicast.Delete(appID, "lights")
+	}()
+
+	// Confirmed
+	err = h.EnqueueMulticastDownlink("lights", &types.DownlinkMessage{AppID: appID, PayloadRaw: []byte{0xAA}, Confirmed: true})
+	a.So(err, ShouldNotBeNil)
+
+	// No Payload
+	err = h.EnqueueMulticastDownlink("lights", &types.DownlinkMessage{AppID: appID})
+	a.So(err, ShouldNotBeNil)
+
+	wg.Add(1)
+	go func() {
+		dl := <-h.downlink
+		a.So(dl.AppId, ShouldEqual, appID)
+		a.So(dl.MulticastGroupId, ShouldEqual, "lights")
+		var phy lorawan.PHYPayload
+		a.So(phy.UnmarshalBinary(dl.Payload), ShouldBeNil)
+		a.So(phy.MHDR.MType, ShouldEqual, lorawan.UnconfirmedDataDown)
+		mac := phy.MACPayload.(*lorawan.MACPayload)
+		a.So(mac.FHDR.DevAddr, ShouldEqual, lorawan.DevAddr{1, 2, 3, 4})
+		a.So(mac.FHDR.FCnt, ShouldEqual, 42)
+		a.So(*mac.FPort, ShouldEqual, 3)
+		a.So(mac.FRMPayload, ShouldHaveLength, 1)
+		wg.Done()
+	}()
+
+	err = h.EnqueueMulticastDownlink("lights", &types.DownlinkMessage{AppID: appID, FPort: 3, PayloadRaw: []byte{0xAA}})
+	a.So(err, ShouldBeNil)
+	a.So(wg.WaitFor(100*time.Millisecond), ShouldBeNil)
+
+	group, _ := h.multicast.Get(appID, "lights")
+	a.So(group.FCntDown, ShouldEqual, 43)
+}
@@ -0,0 +1,40 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+// HandleTxAck publishes an event for the acknowledgement of a downlink message by the gateway
+func (h *handler) HandleTxAck(ack *pb_broker.TxAcknowledgement) error {
+	ctx := h.Ctx.WithFields(ttnlog.Fields{
+		"AppID":     ack.AppId,
+		"DevID":     ack.DevId,
+		"GatewayID": ack.GatewayId,
+		"Result":    ack.Result,
+	})
+
+	event := &types.DeviceEvent{
+		AppID: ack.AppId,
+		DevID: ack.DevId,
+		Event: types.DownlinkTransmittedEvent,
+		Data:  types.DownlinkTxAckEventData{GatewayID: ack.GatewayId},
+	}
+	if ack.Result != pb_gateway.TxAcknowledgement_SUCCESS {
+		event.Event = types.DownlinkFailedEvent
+		event.Data = types.DownlinkTxAckEventData{
+			ErrorEventData: types.ErrorEventData{Error: ack.Result.String()},
+			GatewayID:      ack.GatewayId,
+		}
+		ctx.Debug("Gateway did not transmit downlink")
+	} else {
+		ctx.Debug("Gateway transmitted downlink")
+	}
+	h.qEvent <- event
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestHandleTxAck(t *testing.T) {
+	a := New(t)
+
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestHandleTxAck")},
+		qEvent:    make(chan *types.DeviceEvent, 10),
+	}
+
+	err := h.HandleTxAck(&pb_broker.TxAcknowledgement{
+		AppId:     "app",
+		DevId:     "dev",
+		GatewayId: "gateway",
+	})
+	a.So(err, ShouldBeNil)
+	event := <-h.qEvent
+	a.So(event.AppID, ShouldEqual, "app")
+	a.So(event.DevID, ShouldEqual, "dev")
+	a.So(event.Event, ShouldEqual, types.DownlinkTransmittedEvent)
+	a.So(event.Data, ShouldResemble, types.DownlinkTxAckEventData{GatewayID: "gateway"})
+
+	err = h.HandleTxAck(&pb_broker.TxAcknowledgement{
+		AppId:     "app",
+		DevId:     "dev",
+		GatewayId: "gateway",
+		Result:    pb_gateway.TxAcknowledgement_COLLISION_PACKET,
+	})
+	a.So(err, ShouldBeNil)
+	event = <-h.qEvent
+	a.So(event.Event, ShouldEqual, types.DownlinkFailedEvent)
+	a.So(event.Data, ShouldResemble, types.DownlinkTxAckEventData{
+		ErrorEventData: types.ErrorEventData{Error: "COLLISION_PACKET"},
+		GatewayID:      "gateway",
+	})
+}
@@ -0,0 +1,32 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+// DevNonce is the nonce that a device sends in its JoinRequest
+type DevNonce [2]byte
+
+// JoinNonce is the nonce that the join server sends in the JoinAccept (AppNonce in LoRaWAN 1.0)
+type JoinNonce [3]byte
+
+// Device contains the root keys and the join state of a device
+type Device struct {
+	AppEUI types.AppEUI `redis:"app_eui"` // JoinEUI for LoRaWAN 1.1 devices
+	DevEUI types.DevEUI `redis:"dev_eui"`
+
+	AppKey types.AppKey `redis:"app_key"`
+	NwkKey types.AppKey `redis:"nwk_key"` // Only used by LoRaWAN 1.1 devices
+
+	UsedDevNonces  []DevNonce  `redis:"used_dev_nonces"` // Only used by LoRaWAN 1.0 devices
+	NextDevNonce   uint32      `redis:"next_dev_nonce"`  // Lowest DevNonce that a LoRaWAN 1.1 device can use in its next JoinRequest
+	UsedJoinNonces []JoinNonce `redis:"used_join_nonces"`
+
+	CreatedAt time.Time `redis:"created_at"`
+	UpdatedAt time.Time `redis:"updated_at"`
+}
@@ -0,0 +1,117 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TheThingsNetwork/go-utils/encoding"
+	"github.com/TheThingsNetwork/ttn/core/storage"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"gopkg.in/redis.v5"
+)
+
+// Store interface for Devices
+type Store interface {
+	Get(appEUI types.AppEUI, devEUI types.DevEUI) (*Device, error)
+	Set(new *Device, properties ...string) error
+	Update(appEUI types.AppEUI, devEUI types.DevEUI, update func(dev *Device) error, properties ...string) error
+	Delete(appEUI types.AppEUI, devEUI types.DevEUI) error
+}
+
+const defaultRedisPrefix = "joinserver"
+
+const redisDevicePrefix = "device"
+
+// updateRetries is the number of times that an update is retried if the device was changed concurrently
+const updateRetries = 10
+
+// NewRedisDeviceStore creates a new Redis-based Device store
+func NewRedisDeviceStore(client *redis.Client, prefix string) Store {
+	if prefix == "" {
+		prefix = defaultRedisPrefix
+	}
+	store := storage.NewRedisMapStore(client, prefix+":"+redisDevicePrefix)
+	store.SetBase(Device{}, "")
+	return &RedisDeviceStore{
+		client: client,
+		prefix: prefix + ":" + redisDevicePrefix + ":",
+		store:  store,
+	}
+}
+
+// RedisDeviceStore stores Devices in Redis.
+// - Devices are stored as a Hash
+type RedisDeviceStore struct {
+	client *redis.Client
+	prefix string
+	store  *storage.RedisMapStore
+}
+
+// Get a specific Device
+func (s *RedisDeviceStore) Get(appEUI types.AppEUI, devEUI types.DevEUI) (*Device, error) {
+	deviceI, err := s.store.Get(fmt.Sprintf("%s:%s", appEUI, devEUI))
+	if err != nil {
+		return nil, err
+	}
+	if device, ok := deviceI.(Device); ok {
+		return &device, nil
+	}
+	return nil, errors.New("Database did not return a Device")
+}
+
+// Set a new Device or update an existing one. If properties are given, only those are updated.
+func (s *RedisDeviceStore) Set(new *Device, properties ...string) error {
+	now := time.Now()
+	new.UpdatedAt = now
+	key := fmt.Sprintf("%s:%s", new.AppEUI, new.DevEUI)
+	if len(properties) == 0 {
+		if existing, err := s.Get(new.AppEUI, new.DevEUI); err == nil {
+			new.CreatedAt = existing.CreatedAt
+		} else {
+			new.CreatedAt = now
+		}
+		return s.store.Set(key, *new)
+	}
+	return s.store.Set(key, *new, append(properties, "updated_at")...)
+}
+
+// Update the given properties of an existing Device in a transaction. The update function is called with the current
+// Device, and nothing is stored if it returns an error. The transaction is retried if the Device is changed
+// concurrently, so the update function may be called more than once.
+func (s *RedisDeviceStore) Update(appEUI types.AppEUI, devEUI types.DevEUI, update func(dev *Device) error, properties ...string) (err error) {
+	key := fmt.Sprintf("%s%s:%s", s.prefix, appEUI, devEUI)
+	for i := 0; i < updateRetries; i++ {
+		err = s.client.Watch(func(tx *redis.Tx) error {
+			dev, err := s.Get(appEUI, devEUI)
+			if err != nil {
+				return err
+			}
+			if err := update(dev); err != nil {
+				return err
+			}
+			dev.UpdatedAt = time.Now()
+			vmap, err := encoding.ToStringStringMap("redis", *dev, append(properties, "updated_at")...)
+			if err != nil {
+				return err
+			}
+			_, err = tx.Pipelined(func(pipe *redis.Pipeline) error {
+				pipe.HMSet(key, vmap)
+				return nil
+			})
+			return err
+		}, key)
+		if err != redis.TxFailedErr {
+			return err
+		}
+	}
+	return err
+}
+
+// Delete a Device
+func (s *RedisDeviceStore) Delete(appEUI types.AppEUI, devEUI types.DevEUI) error {
+	return s.store.Delete(fmt.Sprintf("%s:%s", appEUI, devEUI))
+}
@@ -0,0 +1,82 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDeviceStore(t *testing.T) {
+	a := New(t)
+
+	NewRedisDeviceStore(GetRedisClient(), "")
+
+	s := NewRedisDeviceStore(GetRedisClient(), "joinserver-test-device-store")
+
+	appEUI := types.AppEUI{1, 2, 3, 4, 5, 6, 7, 8}
+	devEUI := types.DevEUI{8, 7, 6, 5, 4, 3, 2, 1}
+
+	// Get non-existing
+	dev, err := s.Get(appEUI, devEUI)
+	a.So(err, ShouldNotBeNil)
+	a.So(dev, ShouldBeNil)
+
+	// Create
+	err = s.Set(&Device{
+		AppEUI:        appEUI,
+		DevEUI:        devEUI,
+		AppKey:        types.AppKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+		UsedDevNonces: []DevNonce{{0, 0}, {1, 2}},
+	})
+	defer func() {
+		s.Delete(appEUI, devEUI)
+	}()
+	a.So(err, ShouldBeNil)
+
+	// Get existing
+	dev, err = s.Get(appEUI, devEUI)
+	a.So(err, ShouldBeNil)
+	a.So(dev, ShouldNotBeNil)
+	a.So(dev.AppKey, ShouldEqual, types.AppKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8})
+	a.So(dev.UsedDevNonces, ShouldResemble, []DevNonce{{0, 0}, {1, 2}})
+	a.So(dev.CreatedAt.IsZero(), ShouldBeFalse)
+
+	// Update a single property
+	dev.UsedJoinNonces = []JoinNonce{{0, 0, 1}}
+	err = s.Set(dev, "used_join_nonces")
+	a.So(err, ShouldBeNil)
+	dev, _ = s.Get(appEUI, devEUI)
+	a.So(dev.UsedJoinNonces, ShouldResemble, []JoinNonce{{0, 0, 1}})
+	a.So(dev.UsedDevNonces, ShouldHaveLength, 2)
+
+	// Update in a transaction
+	err = s.Update(appEUI, devEUI, func(dev *Device) error {
+		dev.UsedDevNonces = append(dev.UsedDevNonces, DevNonce{2, 3})
+		return nil
+	}, "used_dev_nonces")
+	a.So(err, ShouldBeNil)
+	dev, _ = s.Get(appEUI, devEUI)
+	a.So(dev.UsedDevNonces, ShouldResemble, []DevNonce{{0, 0}, {1, 2}, {2, 3}})
+
+	// Nothing is stored if the update fails
+	err = s.Update(appEUI, devEUI, func(dev *Device) error {
+		dev.UsedDevNonces = nil
+		return errors.New("failed")
+	}, "used_dev_nonces")
+	a.So(err, ShouldNotBeNil)
+	dev, _ = s.Get(appEUI, devEUI)
+	a.So(dev.UsedDevNonces, ShouldHaveLength, 3)
+
+	// Delete
+	err = s.Delete(appEUI, devEUI)
+	a.So(err, ShouldBeNil)
+	dev, err = s.Get(appEUI, devEUI)
+	a.So(err, ShouldNotBeNil)
+	a.So(dev, ShouldBeNil)
+}
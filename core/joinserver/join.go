@@ -0,0 +1,218 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package joinserver
+
+import (
+	"strings"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/go-utils/random"
+	"github.com/TheThingsNetwork/ttn/core/backend"
+	"github.com/TheThingsNetwork/ttn/core/joinserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/otaa"
+	"github.com/brocaar/lorawan"
+)
+
+func (j *joinServer) HandleJoinRequest(req *backend.JoinReqMessage) (ans *backend.JoinAnsMessage) {
+	ctx := j.Ctx.WithFields(ttnlog.Fields{
+		"SenderID":      req.SenderID,
+		"TransactionID": req.TransactionID,
+		"DevEUI":        req.DevEUI,
+		"DevAddr":       req.DevAddr,
+	})
+	start := time.Now()
+	ans = &backend.JoinAnsMessage{
+		Header: req.Answer(backend.JoinAns),
+		Result: backend.Result{ResultCode: backend.ResultSuccess},
+	}
+	var err error
+	fail := func(resultCode string, cause error) *backend.JoinAnsMessage {
+		err = cause
+		return &backend.JoinAnsMessage{
+			Header: ans.Header,
+			Result: backend.Result{ResultCode: resultCode, Description: cause.Error()},
+		}
+	}
+	defer func() {
+		if err != nil {
+			ctx.WithError(err).WithField("Result", ans.Result.ResultCode).Warn("Could not handle JoinRequest")
+		} else {
+			ctx.WithField("Duration", time.Now().Sub(start)).Info("Handled JoinRequest")
+		}
+	}()
+
+	// Unmarshal LoRaWAN
+	var reqPHY lorawan.PHYPayload
+	if err := reqPHY.UnmarshalBinary(req.PHYPayload); err != nil {
+		return fail(backend.ResultMalformedRequest, err)
+	}
+	reqMAC, ok := reqPHY.MACPayload.(*lorawan.JoinRequestPayload)
+	if !ok {
+		return fail(backend.ResultMalformedRequest, errors.NewErrInvalidArgument("PHYPayload", "does not contain a JoinRequestPayload"))
+	}
+	appEUI, devEUI := types.AppEUI(reqMAC.AppEUI), types.DevEUI(reqMAC.DevEUI)
+	if devEUI != req.DevEUI {
+		return fail(backend.ResultMalformedRequest, errors.NewErrInvalidArgument("DevEUI", "does not match PHYPayload"))
+	}
+	ctx = ctx.WithField("AppEUI", appEUI)
+	netID, err := types.ParseNetID(req.SenderID)
+	if err != nil {
+		return fail(backend.ResultMalformedRequest, errors.NewErrInvalidArgument("SenderID", "must be a NetID"))
+	}
+
+	// Find Device
+	dev, err := j.devices.Get(appEUI, devEUI)
+	if err != nil {
+		return fail(backend.ResultUnknownDevEUI, err)
+	}
+	isLoRaWAN11 := strings.HasPrefix(req.MACVersion, "1.1")
+	joinKey := dev.AppKey
+	if isLoRaWAN11 {
+		if dev.NwkKey.IsEmpty() {
+			return fail(backend.ResultJoinReqFailed, errors.NewErrNotFound("NwkKey"))
+		}
+		joinKey = dev.NwkKey
+	}
+	if joinKey.IsEmpty() {
+		return fail(backend.ResultJoinReqFailed, errors.NewErrNotFound("AppKey"))
+	}
+
+	// Validate MIC
+	if ok, err := reqPHY.ValidateMIC(lorawan.AES128Key(joinKey)); err != nil || !ok {
+		return fail(backend.ResultMICFailed, errors.NewErrInvalidArgument("MIC", "does not validate"))
+	}
+
+	// Use DevNonce and generate JoinNonce. This is done in a transaction, so that concurrent JoinRequests with the same
+	// DevNonce can not both be accepted.
+	var joinNonce device.JoinNonce
+	err = j.devices.Update(appEUI, devEUI, func(latest *device.Device) error {
+		if isLoRaWAN11 {
+			// LoRaWAN 1.1 devices use a DevNonce counter and only accept incrementing JoinNonces
+			devNonce := uint32(reqMAC.DevNonce[0])<<8 | uint32(reqMAC.DevNonce[1])
+			if devNonce < latest.NextDevNonce {
+				return errors.NewErrInvalidArgument("DevNonce", "must be greater than the last DevNonce")
+			}
+			latest.NextDevNonce = devNonce + 1
+			joinNonce = nextJoinNonce(latest.UsedJoinNonces)
+		} else {
+			for _, usedNonce := range latest.UsedDevNonces {
+				if usedNonce == device.DevNonce(reqMAC.DevNonce) {
+					return errors.NewErrInvalidArgument("DevNonce", "already used")
+				}
+			}
+			latest.UsedDevNonces = append(latest.UsedDevNonces, device.DevNonce(reqMAC.DevNonce))
+			joinNonce = randomJoinNonce(latest.UsedJoinNonces)
+		}
+		latest.UsedJoinNonces = append(latest.UsedJoinNonces, joinNonce)
+		return nil
+	}, "used_dev_nonces", "next_dev_nonce", "used_join_nonces")
+	if err != nil {
+		return fail(backend.ResultJoinReqFailed, err)
+	}
+
+	// Build JoinAccept
+	joinAccept := &lorawan.JoinAcceptPayload{
+		DevAddr: lorawan.DevAddr(req.DevAddr),
+		RXDelay: uint8(req.RxDelay),
+	}
+	copy(joinAccept.AppNonce[:], joinNonce[:])
+	copy(joinAccept.NetID[:], netID[:])
+	if err := joinAccept.DLSettings.UnmarshalBinary(req.DLSettings); err != nil {
+		return fail(backend.ResultMalformedRequest, errors.NewErrInvalidArgument("DLSettings", err.Error()))
+	}
+	if len(req.CFList) > 0 {
+		joinAccept.CFList = new(lorawan.CFList)
+		if err := joinAccept.CFList.UnmarshalBinary(req.CFList); err != nil {
+			return fail(backend.ResultMalformedRequest, errors.NewErrInvalidArgument("CFList", err.Error()))
+		}
+	}
+	resPHY := lorawan.PHYPayload{
+		MHDR:       lorawan.MHDR{MType: lorawan.JoinAccept, Major: lorawan.LoRaWANR1},
+		MACPayload: joinAccept,
+	}
+
+	// Calculate session keys and marshal JoinAccept
+	var resBytes []byte
+	var appSKey types.AppSKey
+	if isLoRaWAN11 {
+		var fNwkSIntKey, sNwkSIntKey, nwkSEncKey types.NwkSKey
+		appSKey, fNwkSIntKey, sNwkSIntKey, nwkSEncKey, err = otaa.CalculateSessionKeys11(dev.NwkKey, dev.AppKey, joinNonce, appEUI, reqMAC.DevNonce)
+		if err != nil {
+			return fail(backend.ResultJoinReqFailed, err)
+		}
+		resBytes, err = otaa.MarshalJoinAccept11(resPHY, dev.NwkKey, devEUI, appEUI, reqMAC.DevNonce)
+		if err != nil {
+			return fail(backend.ResultJoinReqFailed, err)
+		}
+		if ans.FNwkSIntKey, err = backend.WrapKey(fNwkSIntKey[:], j.kekLabel, j.kek); err != nil {
+			return fail(backend.ResultJoinReqFailed, err)
+		}
+		if ans.SNwkSIntKey, err = backend.WrapKey(sNwkSIntKey[:], j.kekLabel, j.kek); err != nil {
+			return fail(backend.ResultJoinReqFailed, err)
+		}
+		if ans.NwkSEncKey, err = backend.WrapKey(nwkSEncKey[:], j.kekLabel, j.kek); err != nil {
+			return fail(backend.ResultJoinReqFailed, err)
+		}
+	} else {
+		var nwkSKey types.NwkSKey
+		appSKey, nwkSKey, err = otaa.CalculateSessionKeys(dev.AppKey, joinNonce, netID, reqMAC.DevNonce)
+		if err != nil {
+			return fail(backend.ResultJoinReqFailed, err)
+		}
+		if err = resPHY.SetMIC(lorawan.AES128Key(dev.AppKey)); err != nil {
+			return fail(backend.ResultJoinReqFailed, err)
+		}
+		if err = resPHY.EncryptJoinAcceptPayload(lorawan.AES128Key(dev.AppKey)); err != nil {
+			return fail(backend.ResultJoinReqFailed, err)
+		}
+		resBytes, err = resPHY.MarshalBinary()
+		if err != nil {
+			return fail(backend.ResultJoinReqFailed, err)
+		}
+		if ans.NwkSKey, err = backend.WrapKey(nwkSKey[:], j.kekLabel, j.kek); err != nil {
+			return fail(backend.ResultJoinReqFailed, err)
+		}
+	}
+	ans.AppSKey, err = backend.WrapKey(appSKey[:], j.kekLabel, j.kek)
+	if err != nil {
+		return fail(backend.ResultJoinReqFailed, err)
+	}
+	ans.PHYPayload = resBytes
+
+	return ans
+}
+
+// nextJoinNonce returns the JoinNonce that follows the highest JoinNonce that was used for the device
+func nextJoinNonce(used []device.JoinNonce) (next device.JoinNonce) {
+	var highest uint32
+	for _, nonce := range used {
+		if n := uint32(nonce[0])<<16 | uint32(nonce[1])<<8 | uint32(nonce[2]); n > highest {
+			highest = n
+		}
+	}
+	highest++
+	next[0], next[1], next[2] = byte(highest>>16), byte(highest>>8), byte(highest)
+	return
+}
+
+// randomJoinNonce returns a random JoinNonce that was not used for the device
+func randomJoinNonce(used []device.JoinNonce) (nonce device.JoinNonce) {
+	for {
+		// NOTE: As DevNonces are only 2 bytes, we will start rejecting those before we run out of JoinNonces.
+		random.FillBytes(nonce[:])
+		alreadyUsed := false
+		for _, usedNonce := range used {
+			if usedNonce == nonce {
+				alreadyUsed = true
+				break
+			}
+		}
+		if !alreadyUsed {
+			return
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package joinserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/backend"
+	"github.com/TheThingsNetwork/ttn/core/joinserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/otaa"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestHandleJoinRequest(t *testing.T) {
+	a := New(t)
+
+	kek := []byte{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+	j := &joinServer{
+		Ctx:      GetLogger(t, "TestHandleJoinRequest"),
+		devices:  device.NewRedisDeviceStore(GetRedisClient(), "joinserver-test-handle-join-request"),
+		kekLabel: "handler",
+		kek:      kek,
+		secrets:  map[string]string{"000013": "secret"},
+	}
+
+	appEUI, devEUI := types.AppEUI{1, 2, 3, 4, 5, 6, 7, 8}, types.DevEUI{1, 2, 3, 4, 5, 6, 7, 8}
+	appKey := types.AppKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+
+	joinReq := func(devNonce [2]byte, key types.AppKey) *backend.JoinReqMessage {
+		phy := lorawan.PHYPayload{
+			MHDR: lorawan.MHDR{MType: lorawan.JoinRequest, Major: lorawan.LoRaWANR1},
+			MACPayload: &lorawan.JoinRequestPayload{
+				AppEUI:   lorawan.EUI64(appEUI),
+				DevEUI:   lorawan.EUI64(devEUI),
+				DevNonce: devNonce,
+			},
+		}
+		phy.SetMIC(lorawan.AES128Key(key))
+		payload, _ := phy.MarshalBinary()
+		return &backend.JoinReqMessage{
+			Header: backend.Header{
+				ProtocolVersion: backend.ProtocolVersion,
+				SenderID:        "000013",
+				ReceiverID:      appEUI.String(),
+				TransactionID:   1,
+				MessageType:     backend.JoinReq,
+			},
+			MACVersion: "1.0.2",
+			PHYPayload: payload,
+			DevEUI:     devEUI,
+			DevAddr:    types.DevAddr{0x26, 0x01, 0x02, 0x03},
+			DLSettings: backend.HEXBytes{0x00},
+			RxDelay:    1,
+		}
+	}
+
+	// Unknown device
+	ans := j.HandleJoinRequest(joinReq([2]byte{1, 2}, appKey))
+	a.So(ans.Result.ResultCode, ShouldEqual, backend.ResultUnknownDevEUI)
+
+	j.devices.Set(&device.Device{AppEUI: appEUI, DevEUI: devEUI, AppKey: appKey})
+	defer func() { j.devices.Delete(appEUI, devEUI) }()
+
+	// Invalid MIC
+	ans = j.HandleJoinRequest(joinReq([2]byte{1, 2}, types.AppKey{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1}))
+	a.So(ans.Result.ResultCode, ShouldEqual, backend.ResultMICFailed)
+
+	// Valid JoinRequest
+	ans = j.HandleJoinRequest(joinReq([2]byte{1, 2}, appKey))
+	a.So(ans.Result.ResultCode, ShouldEqual, backend.ResultSuccess)
+	a.So(ans.SenderID, ShouldEqual, appEUI.String())
+	a.So(ans.ReceiverID, ShouldEqual, "000013")
+	a.So(ans.PHYPayload, ShouldHaveLength, 17)
+	a.So(ans.NwkSKey, ShouldNotBeNil)
+	a.So(ans.NwkSKey.KEKLabel, ShouldEqual, "handler")
+	a.So(ans.AppSKey, ShouldNotBeNil)
+	a.So(ans.AppSKey.KEKLabel, ShouldEqual, "handler")
+
+	// The session keys can only be unwrapped with the KEK
+	_, err := ans.AppSKey.Unwrap(map[string][]byte{})
+	a.So(err, ShouldNotBeNil)
+	appSKey, err := ans.AppSKey.Unwrap(map[string][]byte{"handler": kek})
+	a.So(err, ShouldBeNil)
+	nwkSKey, err := ans.NwkSKey.Unwrap(map[string][]byte{"handler": kek})
+	a.So(err, ShouldBeNil)
+
+	// The session keys match the JoinAccept
+	var resPHY lorawan.PHYPayload
+	a.So(resPHY.UnmarshalBinary(ans.PHYPayload), ShouldBeNil)
+	a.So(resPHY.DecryptJoinAcceptPayload(lorawan.AES128Key(appKey)), ShouldBeNil)
+	joinAccept := resPHY.MACPayload.(*lorawan.JoinAcceptPayload)
+	a.So(joinAccept.DevAddr, ShouldEqual, lorawan.DevAddr{0x26, 0x01, 0x02, 0x03})
+	expectedAppSKey, expectedNwkSKey, _ := otaa.CalculateSessionKeys(appKey, joinAccept.AppNonce, [3]byte{0x00, 0x00, 0x13}, [2]byte{1, 2})
+	a.So(appSKey, ShouldResemble, expectedAppSKey[:])
+	a.So(nwkSKey, ShouldResemble, expectedNwkSKey[:])
+
+	// DevNonce can not be reused
+	ans = j.HandleJoinRequest(joinReq([2]byte{1, 2}, appKey))
+	a.So(ans.Result.ResultCode, ShouldEqual, backend.ResultJoinReqFailed)
+
+	// Concurrent JoinRequests with the same DevNonce
+	var wg sync.WaitGroup
+	results := make(chan string, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results <- j.HandleJoinRequest(joinReq([2]byte{3, 4}, appKey)).Result.ResultCode
+		}()
+	}
+	wg.Wait()
+	close(results)
+	var accepted int
+	for resultCode := range results {
+		if resultCode == backend.ResultSuccess {
+			accepted++
+		}
+	}
+	a.So(accepted, ShouldEqual, 1)
+
+	// LoRaWAN 1.1 devices use a DevNonce counter instead of the used DevNonces
+	nwkKey := types.AppKey{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1}
+	dev, _ := j.devices.Get(appEUI, devEUI)
+	dev.NwkKey = nwkKey
+	j.devices.Set(dev)
+	joinReq11 := func(devNonce [2]byte) *backend.JoinReqMessage {
+		req := joinReq(devNonce, nwkKey)
+		req.MACVersion = "1.1"
+		return req
+	}
+	ans = j.HandleJoinRequest(joinReq11([2]byte{1, 2}))
+	a.So(ans.Result.ResultCode, ShouldEqual, backend.ResultSuccess)
+	dev, _ = j.devices.Get(appEUI, devEUI)
+	a.So(dev.NextDevNonce, ShouldEqual, 0x0103)
+	a.So(dev.UsedDevNonces, ShouldHaveLength, 2)
+
+	// The DevNonce of LoRaWAN 1.1 devices must increase
+	ans = j.HandleJoinRequest(joinReq11([2]byte{1, 2}))
+	a.So(ans.Result.ResultCode, ShouldEqual, backend.ResultJoinReqFailed)
+	ans = j.HandleJoinRequest(joinReq11([2]byte{1, 1}))
+	a.So(ans.Result.ResultCode, ShouldEqual, backend.ResultJoinReqFailed)
+	ans = j.HandleJoinRequest(joinReq11([2]byte{1, 4}))
+	a.So(ans.Result.ResultCode, ShouldEqual, backend.ResultSuccess)
+
+	// Over HTTP without secret
+	body, _ := json.Marshal(joinReq([2]byte{2, 3}, appKey))
+	rec := httptest.NewRecorder()
+	j.ServeHTTP(rec, httptest.NewRequest("POST", "/", bytes.NewReader(body)))
+	a.So(rec.Code, ShouldEqual, http.StatusUnauthorized)
+
+	// Over HTTP with wrong secret
+	rec = httptest.NewRecorder()
+	httpReq := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	httpReq.Header.Set("Authorization", "Bearer wrong")
+	j.ServeHTTP(rec, httpReq)
+	a.So(rec.Code, ShouldEqual, http.StatusUnauthorized)
+
+	// Over HTTP
+	rec = httptest.NewRecorder()
+	httpReq = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	httpReq.Header.Set("Authorization", "Bearer secret")
+	j.ServeHTTP(rec, httpReq)
+	var httpAns backend.JoinAnsMessage
+	json.Unmarshal(rec.Body.Bytes(), &httpAns)
+	a.So(httpAns.Result.ResultCode, ShouldEqual, backend.ResultSuccess)
+	a.So(httpAns.MessageType, ShouldEqual, backend.JoinAns)
+}
+
+func TestNextJoinNonce(t *testing.T) {
+	a := New(t)
+	a.So(nextJoinNonce(nil), ShouldEqual, device.JoinNonce{0, 0, 1})
+	a.So(nextJoinNonce([]device.JoinNonce{{0, 0, 1}, {0, 1, 0xFF}, {0, 0, 3}}), ShouldEqual, device.JoinNonce{0, 2, 0})
+}
@@ -0,0 +1,73 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Package joinserver implements a join server that handles the JoinRequests of devices over the LoRaWAN Backend
+// Interfaces. The join server owns the root keys (AppKey and NwkKey) of the devices and keeps track of the used
+// DevNonces and JoinNonces. The Handler only gets the session keys, which are wrapped by a KEK.
+package joinserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/core/backend"
+	"github.com/TheThingsNetwork/ttn/core/joinserver/device"
+	"gopkg.in/redis.v5"
+)
+
+// JoinServer handles JoinReq messages. It implements http.Handler for the Backend Interfaces API.
+type JoinServer interface {
+	http.Handler
+	HandleJoinRequest(req *backend.JoinReqMessage) *backend.JoinAnsMessage
+}
+
+// NewRedisJoinServer creates a new Redis-backed join server. The session keys in JoinAns messages are wrapped with
+// the KEK with the given label. The secrets contain the shared secret for each SenderID that is allowed to send
+// JoinRequests.
+func NewRedisJoinServer(ctx ttnlog.Interface, client *redis.Client, kekLabel string, kek []byte, secrets map[string]string) JoinServer {
+	return &joinServer{
+		Ctx:      ctx,
+		devices:  device.NewRedisDeviceStore(client, "joinserver"),
+		kekLabel: kekLabel,
+		kek:      kek,
+		secrets:  secrets,
+	}
+}
+
+type joinServer struct {
+	Ctx      ttnlog.Interface
+	devices  device.Store
+	kekLabel string
+	kek      []byte
+	secrets  map[string]string
+}
+
+func (j *joinServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	header, body, err := backend.ReadRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !backend.Authorized(r, j.secrets[header.SenderID]) {
+		j.Ctx.WithField("SenderID", header.SenderID).Warn("Unauthorized JoinRequest")
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if header.MessageType != backend.JoinReq {
+		backend.WriteAnswer(w, backend.JoinAnsMessage{
+			Header: header.Answer(backend.JoinAns),
+			Result: backend.Result{ResultCode: backend.ResultUnknownMessageType},
+		})
+		return
+	}
+	var req backend.JoinReqMessage
+	if err := json.Unmarshal(body, &req); err != nil {
+		backend.WriteAnswer(w, backend.JoinAnsMessage{
+			Header: header.Answer(backend.JoinAns),
+			Result: backend.Result{ResultCode: backend.ResultMalformedRequest, Description: err.Error()},
+		})
+		return
+	}
+	backend.WriteAnswer(w, j.HandleJoinRequest(&req))
+}
@@ -4,6 +4,7 @@
 package networkserver
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
 	"time"
@@ -11,31 +12,77 @@ import (
 	"github.com/TheThingsNetwork/go-utils/pseudorandom"
 	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
 	pb_handler "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/core/band"
 	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/mic"
 	"github.com/brocaar/lorawan"
 )
 
 var emptyDevEUI = types.DevEUI{}
 
-func (n *networkServer) getDevAddr(constraints ...string) (types.DevAddr, error) {
-	// Generate random DevAddr bytes
-	var devAddr types.DevAddr
-	pseudorandom.FillBytes(devAddr[:])
+// devAddrCandidates is the number of random DevAddrs that are considered when a DevAddr is shared because the prefix
+// is full
+const devAddrCandidates = 8
+
+// devAddrReservation is the duration that an allocated DevAddr is reserved for the device that joins
+const devAddrReservation = 10 * time.Minute
+
+// prefixSize returns the number of DevAddrs in the prefix
+func prefixSize(prefix types.DevAddrPrefix) uint64 {
+	return uint64(1) << uint(32-prefix.Length)
+}
 
-	// Get a random prefix that matches the constraints
+// getDevAddr allocates a free DevAddr in the least utilized prefix that matches the constraints. Only if all DevAddrs
+// in the prefix are taken, the one with the fewest devices of a number of random candidates is shared.
+func (n *networkServer) getDevAddr(constraints ...string) (types.DevAddr, error) {
+	// Get the prefixes that match the constraints
 	prefixes := n.GetPrefixesFor(constraints...)
 	if len(prefixes) == 0 {
 		return types.DevAddr{}, errors.NewErrNotFound(fmt.Sprintf("DevAddr prefix with constraints %v", constraints))
 	}
 
-	// Select a prefix
-	prefix := prefixes[pseudorandom.Intn(len(prefixes))]
+	// Select the prefix with the lowest utilization
+	var prefix types.DevAddrPrefix
+	lowest := 2.0
+	for _, candidate := range prefixes {
+		used, err := n.devices.CountAddresses(candidate)
+		if err != nil {
+			return types.DevAddr{}, err
+		}
+		if utilization := float64(used) / float64(prefixSize(candidate)); utilization < lowest {
+			prefix, lowest = candidate, utilization
+		}
+	}
 
-	// Apply the prefix
-	devAddr = devAddr.WithPrefix(prefix)
+	// Reserve a free DevAddr in the prefix
+	devAddr, reserved, err := n.devices.AllocateAddress(prefix, devAddrReservation)
+	if err != nil {
+		return types.DevAddr{}, err
+	}
+	if reserved {
+		return devAddr, nil
+	}
+
+	// Share the least loaded DevAddr of random candidates
+	candidates := make([]types.DevAddr, devAddrCandidates)
+	for i := range candidates {
+		pseudorandom.FillBytes(candidates[i][:])
+		candidates[i] = candidates[i].WithPrefix(prefix)
+	}
+	fewest := -1
+	for _, candidate := range candidates {
+		count, err := n.devices.CountForAddress(candidate)
+		if err != nil {
+			return types.DevAddr{}, err
+		}
+		if fewest < 0 || count < fewest {
+			devAddr, fewest = candidate, count
+		}
+	}
 
 	return devAddr, nil
 }
@@ -54,6 +101,13 @@ func (n *networkServer) HandlePrepareActivation(activation *pb_broker.Deduplicat
 	if err != nil {
 		return nil, err
 	}
+	deviceProfile, err := n.applyProfiles(dev)
+	if err != nil {
+		return nil, err
+	}
+	if deviceProfile != nil && !deviceProfile.SupportsJoin {
+		return nil, errors.NewErrPermissionDenied("Device profile does not support OTAA")
+	}
 	activation.AppId = dev.AppID
 	activation.DevId = dev.DevID
 
@@ -137,14 +191,35 @@ func (n *networkServer) HandleActivate(activation *pb_handler.DeviceActivationRe
 
 	dev.LastSeen = time.Now()
 	dev.UpdatedAt = time.Now()
-	dev.DevAddr = *lorawan.DevAddr
-	dev.NwkSKey = *lorawan.NwkSKey
-	dev.FCntUp = 0
-	dev.FCntDown = 0
-	dev.ADR = device.ADRSettings{Band: dev.ADR.Band, Margin: dev.ADR.Margin}
+	dev.PendingSession = device.PendingSession{
+		DevAddr:    *lorawan.DevAddr,
+		NwkSKey:    *lorawan.NwkSKey,
+		MACVersion: lorawan.MacVersion,
+		Band:       dev.ADR.Band,
+	}
+	if lorawan.MacVersion == pb_lorawan.MACVersion_LORAWAN_1_1 {
+		if lorawan.SNwkSIntKey == nil || lorawan.NwkSEncKey == nil {
+			return nil, errors.NewErrInvalidArgument("Activation", "missing LoRaWAN 1.1 session keys")
+		}
+		dev.PendingSession.SNwkSIntKey = *lorawan.SNwkSIntKey
+		dev.PendingSession.NwkSEncKey = *lorawan.NwkSEncKey
+	}
 
 	if band := meta.GetLorawan().GetFrequencyPlan().String(); band != "" {
-		dev.ADR.Band = band
+		dev.PendingSession.Band = band
+	}
+
+	if fp, err := band.Get(dev.PendingSession.Band); err == nil && fp.CFList != nil {
+		dev.PendingSession.Channels = activationChannels(fp, lorawan.CfList)
+	}
+
+	// A device without a session uses the new session right away. Otherwise the current session remains active until the
+	// device sends its first uplink in the new session, in case the device did not receive the JoinAccept.
+	if dev.DevAddr.IsEmpty() {
+		err = n.activatePendingSession(dev)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	err = n.devices.Set(dev)
@@ -152,14 +227,81 @@ func (n *networkServer) HandleActivate(activation *pb_handler.DeviceActivationRe
 		return nil, err
 	}
 
+	return activation, nil
+}
+
+// activatePendingSession replaces the session of the device with its pending session
+func (n *networkServer) activatePendingSession(dev *device.Device) error {
+	pending := dev.PendingSession
+	dev.DevAddr = pending.DevAddr
+	dev.NwkSKey = pending.NwkSKey
+	dev.FCntUp = 0
+	dev.FCntDown = 0
+	dev.Options.MACVersion = pending.MACVersion
+	dev.Session = device.SessionKeys{
+		SNwkSIntKey: pending.SNwkSIntKey,
+		NwkSEncKey:  pending.NwkSEncKey,
+	}
+	dev.ADR.Band = pending.Band
+	resetMACSettings(dev)
+	dev.Channels = pending.Channels
+	dev.PendingSession = device.PendingSession{}
+
 	frames, err := n.devices.Frames(dev.AppEUI, dev.DevEUI)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	err = frames.Clear()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return activation, nil
+	macCommands, err := n.devices.MACCommands(dev.AppEUI, dev.DevEUI)
+	if err != nil {
+		return err
+	}
+	return macCommands.Clear()
+}
+
+// usesPendingSession returns true if the uplink message was sent in the pending session of the device. If the pending
+// session has the same DevAddr as the current session, the MIC decides.
+func usesPendingSession(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device) bool {
+	pending := dev.PendingSession
+	lorawanUplinkMac := message.GetMessage().GetLorawan().GetMacPayload()
+	if pending.DevAddr.IsEmpty() || lorawanUplinkMac == nil || lorawanUplinkMac.DevAddr != pending.DevAddr {
+		return false
+	}
+	if pending.DevAddr != dev.DevAddr {
+		return true
+	}
+	payload := message.GetPayload()
+	if len(payload) < 4 {
+		return false
+	}
+	legacyMIC, err := mic.LegacyUplinkMIC(pending.NwkSKey, pending.DevAddr, lorawanUplinkMac.FCnt, payload[:len(payload)-4])
+	if err != nil {
+		return false
+	}
+	if pending.MACVersion == pb_lorawan.MACVersion_LORAWAN_1_1 {
+		return bytes.Equal(legacyMIC[0:2], payload[len(payload)-2:])
+	}
+	return bytes.Equal(legacyMIC[:], payload[len(payload)-4:])
+}
+
+// resetMACSettings resets the MAC settings of a device that joined or reset. The device uses the default settings of the
+// frequency plan, so the NetworkServer should configure the desired settings again.
+func resetMACSettings(dev *device.Device) {
+	dev.ADR = device.ADRSettings{Band: dev.ADR.Band, Margin: dev.ADR.Margin, Strategy: dev.ADR.Strategy}
+	dev.RX = device.RXSettings{
+		SendReq:      true,
+		RX1DROffset:  dev.RX.RX1DROffset,
+		RX2DataRate:  dev.RX.RX2DataRate,
+		RX2Frequency: dev.RX.RX2Frequency,
+		RXDelay:      dev.RX.RXDelay,
+	}
+	dev.ClassB = device.ClassBSettings{ // The device resends its ping slot info
+		SendReq:         true,
+		BeaconFrequency: dev.ClassB.BeaconFrequency,
+	}
+	dev.TxParams = device.TxParamSettings{SendReq: true, MaxEIRP: dev.TxParams.MaxEIRP}
 }
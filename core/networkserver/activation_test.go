@@ -17,6 +17,38 @@ import (
 	. "github.com/smartystreets/assertions"
 )
 
+func TestGetDevAddr(t *testing.T) {
+	a := New(t)
+	full := types.DevAddrPrefix{DevAddr: [4]byte{0x26, 0x01, 0x00, 0x00}, Length: 31}
+	empty := types.DevAddrPrefix{DevAddr: [4]byte{0x26, 0x01, 0x00, 0x02}, Length: 31}
+	ns := &networkServer{
+		prefixes: map[types.DevAddrPrefix][]string{
+			full:  []string{"otaa"},
+			empty: []string{"otaa"},
+		},
+		devices: device.NewRedisDeviceStore(GetRedisClient(), "test-get-dev-addr"),
+	}
+
+	_, err := ns.getDevAddr("abp")
+	a.So(err, ShouldNotBeNil)
+
+	for i, devAddr := range []types.DevAddr{getDevAddr(0x26, 0x01, 0x00, 0x00), getDevAddr(0x26, 0x01, 0x00, 0x01)} {
+		appEUI := types.AppEUI(getEUI(0, 0, 0, 0, 0, 0, 4, 1))
+		devEUI := types.DevEUI(getEUI(0, 0, 0, 0, 0, 0, 4, byte(i)))
+		a.So(ns.devices.Set(&device.Device{AppEUI: appEUI, DevEUI: devEUI, DevAddr: devAddr}), ShouldBeNil)
+		defer func() {
+			ns.devices.Delete(appEUI, devEUI)
+		}()
+	}
+
+	// The prefix without devices is preferred
+	for i := 0; i < 10; i++ {
+		devAddr, err := ns.getDevAddr("otaa")
+		a.So(err, ShouldBeNil)
+		a.So(devAddr.HasPrefix(empty), ShouldBeTrue)
+	}
+}
+
 func TestHandlePrepareActivation(t *testing.T) {
 	a := New(t)
 	ns := &networkServer{
@@ -155,4 +187,48 @@ func TestHandleActivate(t *testing.T) {
 		}},
 	})
 	a.So(err, ShouldBeNil)
+
+	// A device without a session uses the new session right away
+	dev, _ = ns.devices.Get(appEUI, devEUI)
+	a.So(dev.DevAddr, ShouldEqual, devAddr)
+	a.So(dev.PendingSession.DevAddr.IsEmpty(), ShouldBeTrue)
+
+	// Rejoin
+	newDevAddr := getDevAddr(0, 0, 3, 2)
+	_, err = ns.HandleActivate(&pb_handler.DeviceActivationResponse{
+		ActivationMetadata: &pb_protocol.ActivationMetadata{Protocol: &pb_protocol.ActivationMetadata_Lorawan{
+			Lorawan: &pb_lorawan.ActivationMetadata{
+				AppEui:  &appEUI,
+				DevEui:  &devEUI,
+				DevAddr: &newDevAddr,
+				NwkSKey: &nwkSKey,
+			},
+		}},
+	})
+	a.So(err, ShouldBeNil)
+
+	// The previous session remains active
+	dev, _ = ns.devices.Get(appEUI, devEUI)
+	a.So(dev.DevAddr, ShouldEqual, devAddr)
+	a.So(dev.PendingSession.DevAddr, ShouldEqual, newDevAddr)
+	count, _ := ns.devices.CountForAddress(newDevAddr)
+	a.So(count, ShouldEqual, 1)
+
+	// Uplink in the previous session
+	message := &pb_broker.DeduplicatedUplinkMessage{Message: new(pb_protocol.Message)}
+	message.Message.InitLoRaWAN().InitUplink().DevAddr = devAddr
+	a.So(usesPendingSession(message, dev), ShouldBeFalse)
+
+	// Uplink in the pending session
+	message.Message.GetLorawan().GetMacPayload().DevAddr = newDevAddr
+	a.So(usesPendingSession(message, dev), ShouldBeTrue)
+
+	dev.StartUpdate()
+	a.So(ns.activatePendingSession(dev), ShouldBeNil)
+	a.So(ns.devices.Set(dev), ShouldBeNil)
+	dev, _ = ns.devices.Get(appEUI, devEUI)
+	a.So(dev.DevAddr, ShouldEqual, newDevAddr)
+	a.So(dev.PendingSession.DevAddr.IsEmpty(), ShouldBeTrue)
+	count, _ = ns.devices.CountForAddress(devAddr)
+	a.So(count, ShouldEqual, 0)
 }
@@ -38,6 +38,116 @@ func lossPercentage(frames []*device.Frame) int {
 	return int(math.Floor((float64(loss) / float64(sentPackets) * 100) + .5))
 }
 
+// adrFrames returns the frames that were received since the device enabled ADR
+func adrFrames(frames []*device.Frame) []*device.Frame {
+	for i, frame := range frames {
+		if frame.NoADR {
+			return frames[:i]
+		}
+	}
+	return frames
+}
+
+// usesSubBands returns true if the uplink channels of the band are grouped in sub-bands of eight 125kHz channels and
+// one 500kHz channel
+func usesSubBands(bandName string) bool {
+	switch bandName {
+	case pb_lorawan.FrequencyPlan_US_902_928.String(), pb_lorawan.FrequencyPlan_AU_915_928.String():
+		return true
+	}
+	return false
+}
+
+// subBand returns the sub-band (1-8) of the uplink channel with the given frequency, or 0 if there is no such channel
+func subBand(fp band.FrequencyPlan, frequency uint64) int {
+	for i, ch := range fp.UplinkChannels {
+		if uint64(ch.Frequency) != frequency {
+			continue
+		}
+		switch {
+		case i < 64:
+			return i/8 + 1
+		case i < 72:
+			return i - 64 + 1
+		}
+	}
+	return 0
+}
+
+// updateSubBand sets the sub-band of the device to the sub-band of the uplink frequency. The sub-band of the device
+// only changes if it did not have one yet, or if the device does not use the sub-band it acknowledged anymore.
+func updateSubBand(fp band.FrequencyPlan, dev *device.Device, frequency uint64) {
+	uplinkSubBand := subBand(fp, frequency)
+	if uplinkSubBand == 0 {
+		return
+	}
+	if dev.ADR.AckedSubBand != 0 && dev.ADR.AckedSubBand != uplinkSubBand {
+		dev.ADR.SubBand, dev.ADR.AckedSubBand = 0, 0
+	}
+	if dev.ADR.SubBand == 0 {
+		dev.ADR.SubBand = uplinkSubBand
+	}
+	if dev.ADR.SubBand != dev.ADR.AckedSubBand {
+		dev.ADR.SendReq = true // schedule a LinkADRReq
+	}
+}
+
+// linkADRReqPayloads returns the block of LinkADRReq payloads for the given settings. If a sub-band is given, the
+// block disables all channels except the 125kHz channels and the 500kHz channel of that sub-band.
+func linkADRReqPayloads(fp band.FrequencyPlan, subBand int, drIdx int, powerIdx int, nbTrans int) []lorawan.LinkADRReqPayload {
+	if subBand == 0 {
+		payload := lorawan.LinkADRReqPayload{
+			DataRate:   uint8(drIdx),
+			TXPower:    uint8(powerIdx),
+			Redundancy: lorawan.Redundancy{ChMaskCntl: 0, NbRep: uint8(nbTrans)},
+		}
+		for i, ch := range fp.UplinkChannels {
+			if i >= len(payload.ChMask) {
+				break
+			}
+			for _, dr := range ch.DataRates {
+				if dr == drIdx {
+					payload.ChMask[i] = true
+				}
+			}
+		}
+		return []lorawan.LinkADRReqPayload{payload}
+	}
+
+	// ChMaskCntl 7 disables all 125kHz channels, the ChMask applies to the 500kHz channels
+	channels500kHz := lorawan.LinkADRReqPayload{
+		DataRate:   uint8(drIdx),
+		TXPower:    uint8(powerIdx),
+		Redundancy: lorawan.Redundancy{ChMaskCntl: 7, NbRep: uint8(nbTrans)},
+	}
+	channels500kHz.ChMask[subBand-1] = true
+
+	// ChMaskCntl 0-3 applies the ChMask to 125kHz channels 0-15, 16-31, 32-47 and 48-63
+	channels125kHz := lorawan.LinkADRReqPayload{
+		DataRate:   uint8(drIdx),
+		TXPower:    uint8(powerIdx),
+		Redundancy: lorawan.Redundancy{ChMaskCntl: uint8((subBand - 1) / 2), NbRep: uint8(nbTrans)},
+	}
+	for i := 0; i < 8; i++ {
+		channels125kHz.ChMask[(subBand-1)%2*8+i] = true
+	}
+
+	return []lorawan.LinkADRReqPayload{channels500kHz, channels125kHz}
+}
+
+// handleUplinkLinkADR handles the LinkADRAns answers to a block of LinkADRReqs
+func handleUplinkLinkADR(dev *device.Device, answers []lorawan.LinkADRAnsPayload) {
+	for _, answer := range answers {
+		if !answer.DataRateACK || !answer.PowerACK || !answer.ChannelMaskACK {
+			dev.ADR.Failed++
+			return
+		}
+	}
+	dev.ADR.Failed = 0
+	dev.ADR.SendReq = false
+	dev.ADR.AckedSubBand = dev.ADR.SubBand
+}
+
 func (n *networkServer) handleUplinkADR(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device) error {
 	lorawanUplinkMac := message.GetMessage().GetLorawan().GetMacPayload()
 	lorawanDownlinkMac := message.GetResponseTemplate().GetMessage().GetLorawan().GetMacPayload()
@@ -47,17 +157,34 @@ func (n *networkServer) handleUplinkADR(message *pb_broker.DeduplicatedUplinkMes
 		return err
 	}
 
+	// The history is also used for link quality statistics, so frames are always collected
+	frame := &device.Frame{
+		FCnt:         lorawanUplinkMac.FCnt,
+		SNR:          bestSNR(message.GetGatewayMetadata()),
+		RSSI:         bestRSSI(message.GetGatewayMetadata()),
+		GatewayCount: uint32(len(message.GatewayMetadata)),
+		DataRate:     message.GetProtocolMetadata().GetLorawan().GetDataRate(),
+		NoADR:        !lorawanUplinkMac.Adr,
+	}
+	for _, md := range message.GetGatewayMetadata() {
+		frame.GatewayIDs = append(frame.GatewayIDs, md.GatewayId)
+	}
+	if len(message.GatewayMetadata) > 0 {
+		frame.Frequency = message.GatewayMetadata[0].Frequency
+	}
+	if err := history.Push(frame); err != nil {
+		n.Ctx.WithError(err).Error("Could not push frame for device")
+	}
+
 	if lorawanUplinkMac.Adr {
-		if err := history.Push(&device.Frame{
-			FCnt:         lorawanUplinkMac.FCnt,
-			SNR:          bestSNR(message.GetGatewayMetadata()),
-			GatewayCount: uint32(len(message.GatewayMetadata)),
-		}); err != nil {
-			n.Ctx.WithError(err).Error("Could not push frame for device")
-		}
 		if dev.ADR.Band == "" {
 			dev.ADR.Band = message.GetProtocolMetadata().GetLorawan().GetFrequencyPlan().String()
 		}
+		if usesSubBands(dev.ADR.Band) && len(message.GatewayMetadata) > 0 {
+			if fp, err := band.Get(dev.ADR.Band); err == nil {
+				updateSubBand(fp, dev, message.GatewayMetadata[0].Frequency)
+			}
+		}
 
 		dataRate := message.GetProtocolMetadata().GetLorawan().GetDataRate()
 		if dev.ADR.DataRate != dataRate {
@@ -69,10 +196,7 @@ func (n *networkServer) handleUplinkADR(message *pb_broker.DeduplicatedUplinkMes
 			lorawanDownlinkMac.Ack = true // force a downlink
 		}
 	} else {
-		// Clear history and reset settings
-		if err := history.Clear(); err != nil {
-			return err
-		}
+		// Reset settings; frames without ADR are not used for ADR
 		dev.ADR.SendReq = false
 		dev.ADR.DataRate = ""
 		dev.ADR.TxPower = 0
@@ -97,21 +221,28 @@ func (n *networkServer) handleDownlinkADR(message *pb_broker.DownlinkMessage, de
 	if err != nil {
 		return err
 	}
-	if len(frames) < device.FramesHistorySize {
-		return nil
-	}
+	frames = adrFrames(frames)
 
-	frames = frames[:device.FramesHistorySize]
 	// Check settings
 	if dev.ADR.DataRate == "" {
 		return nil
 	}
-	if dev.ADR.Margin == 0 {
-		dev.ADR.Margin = DefaultADRMargin
-	}
 	if dev.ADR.Band == "" {
 		return nil
 	}
+
+	// Devices in bands with sub-bands should be confined to the sub-band of the gateway, even before there is enough
+	// history to calculate ADR settings
+	var subBand int
+	if usesSubBands(dev.ADR.Band) {
+		subBand = dev.ADR.SubBand
+	}
+	sendSubBand := subBand != 0 && subBand != dev.ADR.AckedSubBand
+
+	if len(frames) < device.FramesHistorySize && !sendSubBand {
+		return nil
+	}
+
 	fp, err := band.Get(dev.ADR.Band)
 	if err != nil {
 		return err
@@ -123,14 +254,41 @@ func (n *networkServer) handleDownlinkADR(message *pb_broker.DownlinkMessage, de
 		dev.ADR.NbTrans = 1
 	}
 
-	// Calculate ADR settings
-	dataRate, txPower, err := fp.ADRSettings(dev.ADR.DataRate, dev.ADR.TxPower, maxSNR(frames), float32(dev.ADR.Margin))
-	if err == band.ErrADRUnavailable {
-		return nil
-	}
-	if err != nil {
-		return err
+	dataRate, txPower, nbTrans := dev.ADR.DataRate, dev.ADR.TxPower, dev.ADR.NbTrans
+	if len(frames) >= device.FramesHistorySize {
+		frames = frames[:device.FramesHistorySize]
+
+		strategy, err := getADRStrategy(dev.ADR.Strategy)
+		if err != nil {
+			return err
+		}
+		settings := dev.ADR
+		if settings.Margin == 0 {
+			settings.Margin = DefaultADRMargin
+		}
+
+		// Calculate ADR settings
+		dataRate, txPower, nbTrans, err = strategy.ADRSettings(fp, settings, frames)
+		if err == band.ErrADRUnavailable && !sendSubBand {
+			return nil
+		}
+		if err == band.ErrADRUnavailable {
+			dataRate, txPower, nbTrans = dev.ADR.DataRate, dev.ADR.TxPower, dev.ADR.NbTrans
+		} else if err != nil {
+			return err
+		}
+
+		if dev.Options.DisableFCntCheck {
+			nbTrans = dev.ADR.NbTrans // frame loss can not be determined
+		}
+		if nbTrans < 1 {
+			nbTrans = 1
+		}
+		if nbTrans > 15 {
+			nbTrans = 15
+		}
 	}
+
 	drIdx, err := fp.GetDataRateIndexFor(dataRate)
 	if err != nil {
 		return err
@@ -140,62 +298,28 @@ func (n *networkServer) handleDownlinkADR(message *pb_broker.DownlinkMessage, de
 		powerIdx, _ = fp.GetTxPowerIndexFor(fp.DefaultTXPower)
 	}
 
-	var nbTrans = dev.ADR.NbTrans
-	if dev.ADR.DataRate == dataRate && dev.ADR.TxPower == txPower && !dev.Options.DisableFCntCheck {
-		lossPercentage := lossPercentage(frames)
-		switch {
-		case lossPercentage <= 5:
-			nbTrans--
-		case lossPercentage <= 10:
-			// don't change
-		case lossPercentage <= 30:
-			nbTrans++
-		default:
-			nbTrans += 2
-		}
-		if nbTrans < 1 {
-			nbTrans = 1
-		}
-		if nbTrans > 3 {
-			nbTrans = 3
-		}
-	}
-
-	if dev.ADR.DataRate == dataRate && dev.ADR.TxPower == txPower && dev.ADR.NbTrans == nbTrans {
+	if dev.ADR.DataRate == dataRate && dev.ADR.TxPower == txPower && dev.ADR.NbTrans == nbTrans && !sendSubBand {
 		return nil
 	}
 	dev.ADR.DataRate, dev.ADR.TxPower, dev.ADR.NbTrans = dataRate, txPower, nbTrans
 
-	// Set MAC command
+	// Set MAC commands
 	lorawanDownlinkMac := message.GetMessage().GetLorawan().GetMacPayload()
-	response := &lorawan.LinkADRReqPayload{
-		DataRate: uint8(drIdx),
-		TXPower:  uint8(powerIdx),
-		Redundancy: lorawan.Redundancy{
-			ChMaskCntl: 0, // Different for US/AU
-			NbRep:      uint8(dev.ADR.NbTrans),
-		},
-	}
-	for i, ch := range fp.UplinkChannels { // Different for US/AU
-		for _, dr := range ch.DataRates {
-			if dr == drIdx {
-				response.ChMask[i] = true
-			}
-		}
-	}
-	responsePayload, _ := response.MarshalBinary()
 
-	// Remove LinkADRReq if already added
-	fOpts := make([]pb_lorawan.MACCommand, 0, len(lorawanDownlinkMac.FOpts)+1)
+	// Remove LinkADRReqs if already added
+	fOpts := make([]pb_lorawan.MACCommand, 0, len(lorawanDownlinkMac.FOpts)+2)
 	for _, existing := range lorawanDownlinkMac.FOpts {
 		if existing.Cid != uint32(lorawan.LinkADRReq) {
 			fOpts = append(fOpts, existing)
 		}
 	}
-	fOpts = append(fOpts, pb_lorawan.MACCommand{
-		Cid:     uint32(lorawan.LinkADRReq),
-		Payload: responsePayload,
-	})
+	for _, payload := range linkADRReqPayloads(fp, subBand, drIdx, powerIdx, dev.ADR.NbTrans) {
+		responsePayload, _ := payload.MarshalBinary()
+		fOpts = append(fOpts, pb_lorawan.MACCommand{
+			Cid:     uint32(lorawan.LinkADRReq),
+			Payload: responsePayload,
+		})
+	}
 
 	lorawanDownlinkMac.FOpts = fOpts
 
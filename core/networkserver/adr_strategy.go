@@ -0,0 +1,130 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"fmt"
+
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// ADRStrategy calculates the ADR settings for a device
+type ADRStrategy interface {
+	// ADRSettings returns the desired data rate, TX power and NbTrans for a device, based on its current ADR settings
+	// and its frame history (newest first). It returns band.ErrADRUnavailable if the settings should not be changed.
+	ADRSettings(fp band.FrequencyPlan, settings device.ADRSettings, frames []*device.Frame) (dataRate string, txPower int, nbTrans int, err error)
+}
+
+// ADRStrategyFunc is a function that implements ADRStrategy
+type ADRStrategyFunc func(fp band.FrequencyPlan, settings device.ADRSettings, frames []*device.Frame) (dataRate string, txPower int, nbTrans int, err error)
+
+// ADRSettings implements ADRStrategy
+func (f ADRStrategyFunc) ADRSettings(fp band.FrequencyPlan, settings device.ADRSettings, frames []*device.Frame) (dataRate string, txPower int, nbTrans int, err error) {
+	return f(fp, settings, frames)
+}
+
+// DefaultADRStrategy is the name of the ADR strategy for devices that did not select one
+var DefaultADRStrategy = "default"
+
+// ConservativeADRMargin is the extra SNR margin of the conservative ADR strategy
+var ConservativeADRMargin = 5
+
+var adrStrategies = map[string]ADRStrategy{
+	"default":      ADRStrategyFunc(defaultADR),
+	"conservative": ADRStrategyFunc(conservativeADR),
+	"mobile":       ADRStrategyFunc(mobileADR),
+	"disabled":     ADRStrategyFunc(disabledADR),
+}
+
+// RegisterADRStrategy registers an ADR strategy that devices can select by name. This should be done before the
+// NetworkServer is started.
+func RegisterADRStrategy(name string, strategy ADRStrategy) {
+	adrStrategies[name] = strategy
+}
+
+func getADRStrategy(name string) (ADRStrategy, error) {
+	if name == "" {
+		name = DefaultADRStrategy
+	}
+	strategy, ok := adrStrategies[name]
+	if !ok {
+		return nil, errors.NewErrInvalidArgument("ADR Strategy", fmt.Sprintf("%s is not a known strategy", name))
+	}
+	return strategy, nil
+}
+
+func minSNR(frames []*device.Frame) float32 {
+	if len(frames) == 0 {
+		return 0
+	}
+	min := frames[0].SNR
+	for _, frame := range frames {
+		if frame.SNR < min {
+			min = frame.SNR
+		}
+	}
+	return min
+}
+
+// nbTransForLoss returns the NbTrans for the given frame loss percentage
+func nbTransForLoss(nbTrans int, lossPercentage int) int {
+	switch {
+	case lossPercentage <= 5:
+		nbTrans--
+	case lossPercentage <= 10:
+		// don't change
+	case lossPercentage <= 30:
+		nbTrans++
+	default:
+		nbTrans += 2
+	}
+	if nbTrans < 1 {
+		nbTrans = 1
+	}
+	if nbTrans > 3 {
+		nbTrans = 3
+	}
+	return nbTrans
+}
+
+// defaultADR uses the best SNR of the frame history to calculate the data rate and TX power. NbTrans is only changed
+// if the data rate and TX power stay the same, based on the frame loss.
+func defaultADR(fp band.FrequencyPlan, settings device.ADRSettings, frames []*device.Frame) (dataRate string, txPower int, nbTrans int, err error) {
+	dataRate, txPower, err = fp.ADRSettings(settings.DataRate, settings.TxPower, maxSNR(frames), float32(settings.Margin))
+	if err != nil {
+		return settings.DataRate, settings.TxPower, settings.NbTrans, err
+	}
+	nbTrans = settings.NbTrans
+	if dataRate == settings.DataRate && txPower == settings.TxPower {
+		nbTrans = nbTransForLoss(nbTrans, lossPercentage(frames))
+	}
+	return dataRate, txPower, nbTrans, nil
+}
+
+// conservativeADR uses the worst SNR of the frame history and an extra margin. This suits static devices that should
+// keep a stable link, even if the link quality varies over time.
+func conservativeADR(fp band.FrequencyPlan, settings device.ADRSettings, frames []*device.Frame) (dataRate string, txPower int, nbTrans int, err error) {
+	dataRate, txPower, err = fp.ADRSettings(settings.DataRate, settings.TxPower, minSNR(frames), float32(settings.Margin+ConservativeADRMargin))
+	if err != nil {
+		return settings.DataRate, settings.TxPower, settings.NbTrans, err
+	}
+	nbTrans = settings.NbTrans
+	if dataRate == settings.DataRate && txPower == settings.TxPower {
+		nbTrans = nbTransForLoss(nbTrans, lossPercentage(frames))
+	}
+	return dataRate, txPower, nbTrans, nil
+}
+
+// mobileADR does not change the data rate and TX power, as the frame history of a moving device does not predict its
+// future link quality. Only NbTrans is changed, based on the frame loss.
+func mobileADR(fp band.FrequencyPlan, settings device.ADRSettings, frames []*device.Frame) (dataRate string, txPower int, nbTrans int, err error) {
+	return settings.DataRate, settings.TxPower, nbTransForLoss(settings.NbTrans, lossPercentage(frames)), nil
+}
+
+// disabledADR never changes the ADR settings
+func disabledADR(fp band.FrequencyPlan, settings device.ADRSettings, frames []*device.Frame) (dataRate string, txPower int, nbTrans int, err error) {
+	return settings.DataRate, settings.TxPower, settings.NbTrans, band.ErrADRUnavailable
+}
@@ -0,0 +1,80 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestGetADRStrategy(t *testing.T) {
+	a := New(t)
+
+	for _, name := range []string{"", "default", "conservative", "mobile", "disabled"} {
+		strategy, err := getADRStrategy(name)
+		a.So(err, ShouldBeNil)
+		a.So(strategy, ShouldNotBeNil)
+	}
+
+	_, err := getADRStrategy("unknown")
+	a.So(err, ShouldNotBeNil)
+
+	RegisterADRStrategy("test", ADRStrategyFunc(disabledADR))
+	defer delete(adrStrategies, "test")
+	_, err = getADRStrategy("test")
+	a.So(err, ShouldBeNil)
+}
+
+func TestNbTransForLoss(t *testing.T) {
+	a := New(t)
+	a.So(nbTransForLoss(1, 0), ShouldEqual, 1)
+	a.So(nbTransForLoss(2, 0), ShouldEqual, 1)
+	a.So(nbTransForLoss(2, 10), ShouldEqual, 2)
+	a.So(nbTransForLoss(2, 20), ShouldEqual, 3)
+	a.So(nbTransForLoss(1, 50), ShouldEqual, 3)
+}
+
+func TestADRStrategies(t *testing.T) {
+	a := New(t)
+	fp, _ := band.Get("EU_863_870")
+
+	settings := device.ADRSettings{DataRate: "SF10BW125", TxPower: 14, NbTrans: 1, Margin: 15}
+	frames := make([]*device.Frame, 0, device.FramesHistorySize)
+	for i := device.FramesHistorySize; i > 0; i-- {
+		snr := float32(10)
+		if i == 5 {
+			snr = -5
+		}
+		frames = append(frames, &device.Frame{FCnt: uint32(i), SNR: snr, GatewayCount: 1})
+	}
+
+	// The default strategy uses the best SNR
+	dataRate, txPower, nbTrans, err := defaultADR(fp, settings, frames)
+	a.So(err, ShouldBeNil)
+	a.So(dataRate, ShouldEqual, "SF7BW125")
+	a.So(txPower, ShouldEqual, 14)
+	a.So(nbTrans, ShouldEqual, 1)
+
+	// The conservative strategy uses the worst SNR and an extra margin
+	dataRate, txPower, nbTrans, err = conservativeADR(fp, settings, frames)
+	a.So(err, ShouldBeNil)
+	a.So(dataRate, ShouldEqual, "SF10BW125")
+	a.So(txPower, ShouldEqual, 14)
+	a.So(nbTrans, ShouldEqual, 1)
+
+	// The mobile strategy only changes NbTrans
+	lossyFrames := append([]*device.Frame{&device.Frame{FCnt: 30, SNR: 10}}, frames...)
+	dataRate, txPower, nbTrans, err = mobileADR(fp, settings, lossyFrames)
+	a.So(err, ShouldBeNil)
+	a.So(dataRate, ShouldEqual, "SF10BW125")
+	a.So(txPower, ShouldEqual, 14)
+	a.So(nbTrans, ShouldEqual, 2)
+
+	// The disabled strategy does not change anything
+	_, _, _, err = disabledADR(fp, settings, frames)
+	a.So(err, ShouldEqual, band.ErrADRUnavailable)
+}
@@ -13,6 +13,7 @@ import (
 	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
 	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
 	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/band"
 	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
@@ -79,6 +80,66 @@ func TestLossPercentage(t *testing.T) {
 	a.So(lossPercentage(buildFrames(1, 2, 3, 6, 7, 8, 9, 12, 13, 14)), ShouldEqual, 29) // 4/14 missing
 }
 
+func TestSubBand(t *testing.T) {
+	a := New(t)
+	us, _ := band.Get("US_902_928")
+	a.So(subBand(us, 902300000), ShouldEqual, 1)
+	a.So(subBand(us, 903900000), ShouldEqual, 2)
+	a.So(subBand(us, 905300000), ShouldEqual, 2)
+	a.So(subBand(us, 904600000), ShouldEqual, 2) // 500kHz channel
+	a.So(subBand(us, 914900000), ShouldEqual, 8)
+	a.So(subBand(us, 868100000), ShouldEqual, 0)
+}
+
+func TestLinkADRReqPayloads(t *testing.T) {
+	a := New(t)
+
+	eu, _ := band.Get("EU_863_870")
+	payloads := linkADRReqPayloads(eu, 0, 5, 1, 1)
+	a.So(payloads, ShouldHaveLength, 1)
+	a.So(payloads[0].Redundancy.ChMaskCntl, ShouldEqual, 0)
+
+	us, _ := band.Get("US_902_928")
+	payloads = linkADRReqPayloads(us, 2, 3, 5, 1)
+	a.So(payloads, ShouldHaveLength, 2)
+	for _, payload := range payloads {
+		a.So(payload.DataRate, ShouldEqual, 3)
+		a.So(payload.TXPower, ShouldEqual, 5)
+		a.So(payload.Redundancy.NbRep, ShouldEqual, 1)
+	}
+	a.So(payloads[0].Redundancy.ChMaskCntl, ShouldEqual, 7)
+	a.So(payloads[0].ChMask, ShouldResemble, lorawan.ChMask{false, true})
+	a.So(payloads[1].Redundancy.ChMaskCntl, ShouldEqual, 0)
+	a.So(payloads[1].ChMask, ShouldResemble, lorawan.ChMask{false, false, false, false, false, false, false, false, true, true, true, true, true, true, true, true})
+
+	payloads = linkADRReqPayloads(us, 7, 3, 5, 1)
+	a.So(payloads[0].ChMask[6], ShouldBeTrue)
+	a.So(payloads[1].Redundancy.ChMaskCntl, ShouldEqual, 3)
+	a.So(payloads[1].ChMask[0], ShouldBeTrue)
+	a.So(payloads[1].ChMask[8], ShouldBeFalse)
+}
+
+func TestHandleUplinkLinkADR(t *testing.T) {
+	a := New(t)
+	dev := &device.Device{ADR: device.ADRSettings{SendReq: true, SubBand: 2}}
+
+	handleUplinkLinkADR(dev, []lorawan.LinkADRAnsPayload{
+		{ChannelMaskACK: true, DataRateACK: true, PowerACK: true},
+		{ChannelMaskACK: false, DataRateACK: true, PowerACK: true},
+	})
+	a.So(dev.ADR.Failed, ShouldEqual, 1)
+	a.So(dev.ADR.SendReq, ShouldBeTrue)
+	a.So(dev.ADR.AckedSubBand, ShouldEqual, 0)
+
+	handleUplinkLinkADR(dev, []lorawan.LinkADRAnsPayload{
+		{ChannelMaskACK: true, DataRateACK: true, PowerACK: true},
+		{ChannelMaskACK: true, DataRateACK: true, PowerACK: true},
+	})
+	a.So(dev.ADR.Failed, ShouldEqual, 0)
+	a.So(dev.ADR.SendReq, ShouldBeFalse)
+	a.So(dev.ADR.AckedSubBand, ShouldEqual, 2)
+}
+
 func TestHandleUplinkADR(t *testing.T) {
 	a := New(t)
 	ns := &networkServer{
@@ -109,14 +170,16 @@ func TestHandleUplinkADR(t *testing.T) {
 		a.So(dev.ADR.DataRate, ShouldEqual, "SF8BW125")
 	}
 
-	// Resetting ADR to false should empty the frames
+	// Resetting ADR to false should keep collecting frames, but not for ADR
 	{
 		dev := &device.Device{AppEUI: appEUI, DevEUI: devEUI}
 		message := adrInitUplinkMessage()
 		err := ns.handleUplinkADR(message, dev)
 		a.So(err, ShouldBeNil)
 		frames, _ := history.Get()
-		a.So(frames, ShouldBeEmpty)
+		a.So(frames, ShouldHaveLength, 2)
+		a.So(frames[0].NoADR, ShouldBeTrue)
+		a.So(adrFrames(frames), ShouldBeEmpty)
 	}
 
 	// Setting ADRAckReq to true should set the ACK and schedule a LinkADRReq
@@ -131,6 +194,35 @@ func TestHandleUplinkADR(t *testing.T) {
 		a.So(resMac.Ack, ShouldBeTrue)
 		a.So(dev.ADR.SendReq, ShouldBeTrue)
 	}
+
+	// Uplinks in US/AU bands should set the sub-band of the device
+	{
+		dev := &device.Device{AppEUI: appEUI, DevEUI: devEUI}
+		message := adrInitUplinkMessage()
+		message.Message.GetLorawan().GetMacPayload().Adr = true
+		message.ProtocolMetadata.GetLorawan().FrequencyPlan = pb_lorawan.FrequencyPlan_US_902_928
+		message.ProtocolMetadata.GetLorawan().DataRate = "SF10BW125"
+		message.GatewayMetadata[0].Frequency = 904300000
+		err := ns.handleUplinkADR(message, dev)
+		a.So(err, ShouldBeNil)
+		a.So(dev.ADR.SubBand, ShouldEqual, 2)
+		a.So(dev.ADR.SendReq, ShouldBeTrue)
+
+		// Acknowledged sub-band
+		dev.ADR.SendReq = false
+		dev.ADR.AckedSubBand = 2
+		err = ns.handleUplinkADR(message, dev)
+		a.So(err, ShouldBeNil)
+		a.So(dev.ADR.SendReq, ShouldBeFalse)
+
+		// Device does not use the acknowledged sub-band anymore
+		message.GatewayMetadata[0].Frequency = 906300000
+		err = ns.handleUplinkADR(message, dev)
+		a.So(err, ShouldBeNil)
+		a.So(dev.ADR.SubBand, ShouldEqual, 3)
+		a.So(dev.ADR.AckedSubBand, ShouldEqual, 0)
+		a.So(dev.ADR.SendReq, ShouldBeTrue)
+	}
 }
 
 func TestHandleDownlinkADR(t *testing.T) {
@@ -256,7 +348,47 @@ func TestHandleDownlinkADR(t *testing.T) {
 		}
 	}
 
+	// Strategies
+	resetFrames(dev.AppEUI, dev.DevEUI)
+	dev.ADR.Strategy = "disabled"
+	nothingShouldHappen()
+	dev.ADR.Strategy = "unknown"
+	shouldReturnError()
+	dev.ADR.Strategy = ""
+
+	// Sub-band without enough history
+	usDev := &device.Device{AppEUI: appEUI, DevEUI: devEUI, ADR: device.ADRSettings{
+		SendReq:  true,
+		Band:     "US_902_928",
+		DataRate: "SF10BW125",
+		SubBand:  2,
+	}}
+	history.Clear()
+	message = adrInitDownlinkMessage()
+	err = ns.handleDownlinkADR(message, usDev)
+	a.So(err, ShouldBeNil)
+	fOpts = message.Message.GetLorawan().GetMacPayload().FOpts
+	a.So(fOpts, ShouldHaveLength, 3)
+	a.So(fOpts[1].Cid, ShouldEqual, lorawan.LinkADRReq)
+	a.So(fOpts[2].Cid, ShouldEqual, lorawan.LinkADRReq)
+	payload = new(lorawan.LinkADRReqPayload)
+	payload.UnmarshalBinary(fOpts[1].Payload)
+	a.So(payload.DataRate, ShouldEqual, 0) // SF10BW125
+	a.So(payload.Redundancy.ChMaskCntl, ShouldEqual, 7)
+	a.So(payload.ChMask[1], ShouldBeTrue)
+	payload.UnmarshalBinary(fOpts[2].Payload)
+	a.So(payload.Redundancy.ChMaskCntl, ShouldEqual, 0)
+	a.So(payload.ChMask[8], ShouldBeTrue)
+
+	// Acknowledged sub-band
+	usDev.ADR.AckedSubBand = 2
+	message = adrInitDownlinkMessage()
+	err = ns.handleDownlinkADR(message, usDev)
+	a.So(err, ShouldBeNil)
+	a.So(message.Message.GetLorawan().GetMacPayload().FOpts, ShouldHaveLength, 1)
+
 	// Invalid case
+	resetFrames(dev.AppEUI, dev.DevEUI)
 	message = adrInitDownlinkMessage()
 	dev.ADR.DataRate = "INVALID"
 	shouldReturnError()
@@ -0,0 +1,296 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"fmt"
+
+	"github.com/TheThingsNetwork/go-utils/log"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/brocaar/lorawan"
+	lora "github.com/brocaar/lorawan/band"
+)
+
+// dlChannelReq is the CID of the DlChannelReq and DlChannelAns MAC commands (LoRaWAN 1.0.2)
+const dlChannelReq = lorawan.CID(0x0A)
+
+// maxChannels is the maximum number of channels in the channel table of a device
+const maxChannels = 16
+
+// frequencyPlanChannels returns the channel table of the frequency plan
+func frequencyPlanChannels(fp band.FrequencyPlan) []device.Channel {
+	channels := make([]device.Channel, len(fp.UplinkChannels))
+	for i, ch := range fp.UplinkChannels {
+		channels[i].Frequency = uint64(ch.Frequency)
+		for j, dr := range ch.DataRates {
+			if j == 0 || dr < channels[i].MinDataRate {
+				channels[i].MinDataRate = dr
+			}
+			if j == 0 || dr > channels[i].MaxDataRate {
+				channels[i].MaxDataRate = dr
+			}
+		}
+		if i < len(fp.DownlinkChannels) && fp.DownlinkChannels[i].Frequency != ch.Frequency {
+			channels[i].DownlinkFrequency = uint64(fp.DownlinkChannels[i].Frequency)
+		}
+	}
+	return channels
+}
+
+// loraChannels returns the channels that can be used with LoRa modulation. The FSK channels of a frequency plan can
+// not be configured with a NewChannelReq.
+func loraChannels(fp band.FrequencyPlan, channels []device.Channel) []device.Channel {
+	out := make([]device.Channel, 0, len(channels))
+	for _, ch := range channels {
+		if ch.MinDataRate < 0 || ch.MaxDataRate >= len(fp.DataRates) {
+			continue
+		}
+		for dr := ch.MinDataRate; dr <= ch.MaxDataRate; dr++ {
+			if fp.DataRates[dr].Modulation == lora.LoRaModulation {
+				out = append(out, ch)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// deviceChannels returns the channel table of the device. Devices without a channel table only know the default channels
+func deviceChannels(fp band.FrequencyPlan, dev *device.Device) []device.Channel {
+	if len(dev.Channels) != 0 {
+		return dev.Channels
+	}
+	return frequencyPlanChannels(fp)[:fp.DefaultChannels()]
+}
+
+// activationChannels returns the channel table of a device that received the given CFList in its JoinAccept
+func activationChannels(fp band.FrequencyPlan, cfList *pb_lorawan.CFList) []device.Channel {
+	channels := frequencyPlanChannels(fp)[:fp.DefaultChannels()]
+	if cfList == nil || len(channels) == 0 {
+		return channels
+	}
+	for _, freq := range cfList.Freq {
+		channels = append(channels, device.Channel{
+			Frequency:   uint64(freq),
+			MinDataRate: channels[0].MinDataRate,
+			MaxDataRate: channels[0].MaxDataRate,
+		})
+	}
+	return channels
+}
+
+func sameChannel(a, b device.Channel) bool {
+	return a.Frequency == b.Frequency && a.MinDataRate == b.MinDataRate && a.MaxDataRate == b.MaxDataRate
+}
+
+func marshalDlChannelReq(chIndex uint8, freq uint32) []byte {
+	freq = freq / 100
+	return []byte{chIndex, byte(freq), byte(freq >> 8), byte(freq >> 16)}
+}
+
+func unmarshalDlChannelReq(payload []byte) (chIndex uint8, freq uint32, err error) {
+	if len(payload) != 4 {
+		return 0, 0, fmt.Errorf("DlChannelReq payload should be 4 bytes, not %d", len(payload))
+	}
+	freq = uint32(payload[1]) | uint32(payload[2])<<8 | uint32(payload[3])<<16
+	return payload[0], freq * 100, nil
+}
+
+// handleUplinkChannels queues a NewChannelReq or DlChannelReq for the first channel in the channel table of the device
+// that does not match the frequency plan. Channels are configured one at a time, until the channel tables match.
+func (n *networkServer) handleUplinkChannels(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device) error {
+	fp, err := band.Get(message.GetProtocolMetadata().GetLorawan().GetFrequencyPlan().String())
+	if err != nil {
+		return err
+	}
+	if fp.CFList == nil {
+		return nil // The channels of this frequency plan can not be changed
+	}
+
+	for _, cid := range []lorawan.CID{lorawan.NewChannelReq, dlChannelReq} {
+		pending, err := n.pendingMACCommand(dev, cid)
+		if err != nil || pending != nil {
+			return err
+		}
+	}
+
+	queue, err := n.devices.MACCommands(dev.AppEUI, dev.DevEUI)
+	if err != nil {
+		return err
+	}
+
+	desired := loraChannels(fp, frequencyPlanChannels(fp))
+	known := deviceChannels(fp, dev)
+	for i := fp.DefaultChannels(); i < maxChannels && (i < len(desired) || i < len(known)); i++ {
+		var want, have device.Channel
+		if i < len(desired) {
+			want = desired[i]
+		}
+		if i < len(known) {
+			have = known[i]
+		}
+		if !sameChannel(want, have) {
+			payload, err := (&lorawan.NewChannelReqPayload{
+				ChIndex: uint8(i),
+				Freq:    uint32(want.Frequency),
+				MinDR:   uint8(want.MinDataRate),
+				MaxDR:   uint8(want.MaxDataRate),
+			}).MarshalBinary()
+			if err != nil {
+				return err
+			}
+			return queue.Push(&device.MACCommand{CID: uint32(lorawan.NewChannelReq), Payload: payload})
+		}
+		if have.Rejected {
+			continue
+		}
+		if want.Frequency != 0 && want.DownlinkFrequency != have.DownlinkFrequency {
+			freq := want.DownlinkFrequency
+			if freq == 0 {
+				freq = want.Frequency
+			}
+			return queue.Push(&device.MACCommand{CID: uint32(dlChannelReq), Payload: marshalDlChannelReq(uint8(i), uint32(freq))})
+		}
+	}
+
+	return nil
+}
+
+// rejectChannel marks the channel of a NewChannelReq or DlChannelReq that the device did not answer as rejected, so
+// that it is not requested again
+func rejectChannel(dev *device.Device, cmd *device.MACCommand) {
+	fp, err := band.Get(dev.Downlink.FrequencyPlan)
+	if err != nil {
+		return
+	}
+	switch lorawan.CID(cmd.CID) {
+	case lorawan.NewChannelReq:
+		var params lorawan.NewChannelReqPayload
+		if err := params.UnmarshalBinary(cmd.Payload); err != nil {
+			return
+		}
+		dev.Channels = setChannel(fp, dev, int(params.ChIndex), device.Channel{
+			Frequency:   uint64(params.Freq),
+			MinDataRate: int(params.MinDR),
+			MaxDataRate: int(params.MaxDR),
+			Rejected:    true,
+		})
+	case dlChannelReq:
+		chIndex, _, err := unmarshalDlChannelReq(cmd.Payload)
+		if err != nil {
+			return
+		}
+		channels := deviceChannels(fp, dev)
+		if int(chIndex) >= len(channels) {
+			return
+		}
+		channel := channels[chIndex]
+		channel.Rejected = true
+		dev.Channels = setChannel(fp, dev, int(chIndex), channel)
+	}
+}
+
+// setChannel returns the channel table of the device with the given channel set
+func setChannel(fp band.FrequencyPlan, dev *device.Device, index int, channel device.Channel) []device.Channel {
+	channels := append([]device.Channel{}, deviceChannels(fp, dev)...)
+	for len(channels) <= index {
+		channels = append(channels, device.Channel{})
+	}
+	channels[index] = channel
+	return channels
+}
+
+func (n *networkServer) handleUplinkNewChannel(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device, cmd pb_lorawan.MACCommand) error {
+	var answer lorawan.NewChannelAnsPayload
+	if err := answer.UnmarshalBinary(cmd.Payload); err != nil {
+		return nil
+	}
+	message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "new-channel",
+		"frequency-ack", answer.ChannelFrequencyOK,
+		"data-rate-ack", answer.DataRateRangeOK,
+	)
+
+	req, err := n.pendingMACCommand(dev, lorawan.NewChannelReq)
+	if err != nil || req == nil {
+		return err
+	}
+	var params lorawan.NewChannelReqPayload
+	if err := params.UnmarshalBinary(req.Payload); err != nil {
+		return err
+	}
+	fp, err := band.Get(message.GetProtocolMetadata().GetLorawan().GetFrequencyPlan().String())
+	if err != nil {
+		return err
+	}
+
+	channel := device.Channel{
+		Frequency:   uint64(params.Freq),
+		MinDataRate: int(params.MinDR),
+		MaxDataRate: int(params.MaxDR),
+	}
+	if !answer.ChannelFrequencyOK || !answer.DataRateRangeOK {
+		channel.Rejected = true
+		n.Ctx.WithFields(log.Fields{
+			"AppEUI":  dev.AppEUI,
+			"DevEUI":  dev.DevEUI,
+			"Channel": params.ChIndex,
+			"Answer":  fmt.Sprintf("%v/%v", answer.ChannelFrequencyOK, answer.DataRateRangeOK),
+		}).Warn("Negative NewChannelAns")
+	}
+	dev.Channels = setChannel(fp, dev, int(params.ChIndex), channel)
+
+	return nil
+}
+
+func (n *networkServer) handleUplinkDlChannel(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device, cmd pb_lorawan.MACCommand) error {
+	if len(cmd.Payload) != 1 {
+		return nil
+	}
+	frequencyOK := cmd.Payload[0]&0x01 != 0
+	uplinkFrequencyOK := cmd.Payload[0]&0x02 != 0
+	message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "dl-channel",
+		"frequency-ack", frequencyOK,
+		"uplink-frequency-ack", uplinkFrequencyOK,
+	)
+
+	req, err := n.pendingMACCommand(dev, dlChannelReq)
+	if err != nil || req == nil {
+		return err
+	}
+	chIndex, freq, err := unmarshalDlChannelReq(req.Payload)
+	if err != nil {
+		return err
+	}
+	fp, err := band.Get(message.GetProtocolMetadata().GetLorawan().GetFrequencyPlan().String())
+	if err != nil {
+		return err
+	}
+
+	channels := deviceChannels(fp, dev)
+	if int(chIndex) >= len(channels) {
+		return nil
+	}
+	channel := channels[chIndex]
+	if frequencyOK && uplinkFrequencyOK {
+		channel.DownlinkFrequency = uint64(freq)
+		if channel.DownlinkFrequency == channel.Frequency {
+			channel.DownlinkFrequency = 0
+		}
+	} else {
+		channel.Rejected = true
+		n.Ctx.WithFields(log.Fields{
+			"AppEUI":  dev.AppEUI,
+			"DevEUI":  dev.DevEUI,
+			"Channel": chIndex,
+			"Answer":  fmt.Sprintf("%v/%v", frequencyOK, uplinkFrequencyOK),
+		}).Warn("Negative DlChannelAns")
+	}
+	dev.Channels = setChannel(fp, dev, int(chIndex), channel)
+
+	return nil
+}
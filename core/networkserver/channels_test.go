@@ -0,0 +1,144 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestFrequencyPlanChannels(t *testing.T) {
+	a := New(t)
+	fp, _ := band.Get("EU_863_870")
+
+	channels := frequencyPlanChannels(fp)
+	a.So(channels, ShouldHaveLength, 9)
+	a.So(channels[1], ShouldResemble, device.Channel{Frequency: 868300000, MinDataRate: 0, MaxDataRate: 6})
+	a.So(channels[8], ShouldResemble, device.Channel{Frequency: 868800000, MinDataRate: 7, MaxDataRate: 7})
+	a.So(loraChannels(fp, channels), ShouldHaveLength, 8) // Without the FSK channel
+
+	a.So(deviceChannels(fp, &device.Device{}), ShouldHaveLength, 3)
+
+	channels = activationChannels(fp, &pb_lorawan.CFList{Freq: []uint32{867100000, 867300000, 867500000, 867700000, 867900000}})
+	a.So(channels, ShouldHaveLength, 8)
+	a.So(channels[3], ShouldResemble, device.Channel{Frequency: 867100000, MinDataRate: 0, MaxDataRate: 5})
+}
+
+func TestDlChannelReq(t *testing.T) {
+	a := New(t)
+	payload := marshalDlChannelReq(3, 869525000)
+	a.So(payload, ShouldResemble, []byte{3, 0xd2, 0xad, 0x84})
+	chIndex, freq, err := unmarshalDlChannelReq(payload)
+	a.So(err, ShouldBeNil)
+	a.So(chIndex, ShouldEqual, 3)
+	a.So(freq, ShouldEqual, 869525000)
+	_, _, err = unmarshalDlChannelReq(payload[:2])
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestHandleChannels(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		Component: &component.Component{
+			Ctx: GetLogger(t, "TestHandleChannels"),
+		},
+		devices: device.NewRedisDeviceStore(GetRedisClient(), "ns-test-handle-channels"),
+	}
+
+	defer func() {
+		keys, _ := GetRedisClient().Keys("*ns-test-handle-channels*").Result()
+		for _, key := range keys {
+			GetRedisClient().Del(key).Result()
+		}
+	}()
+
+	dev := &device.Device{AppEUI: types.AppEUI([8]byte{1}), DevEUI: types.DevEUI([8]byte{1})}
+	queue, _ := ns.devices.MACCommands(dev.AppEUI, dev.DevEUI)
+
+	answer := func(ans lorawan.NewChannelAnsPayload) {
+		payload, _ := ans.MarshalBinary()
+		err := ns.handleUplinkNewChannel(adrInitUplinkMessage(), dev, pb_lorawan.MACCommand{Cid: uint32(lorawan.NewChannelAns), Payload: payload})
+		a.So(err, ShouldBeNil)
+		queue.Remove(uint32(lorawan.NewChannelReq))
+	}
+
+	// ABP device only knows the default channels
+	err := ns.handleUplinkChannels(adrInitUplinkMessage(), dev)
+	a.So(err, ShouldBeNil)
+	pending, _ := queue.Get()
+	a.So(pending, ShouldHaveLength, 1)
+	a.So(pending[0].CID, ShouldEqual, lorawan.NewChannelReq)
+	var req lorawan.NewChannelReqPayload
+	req.UnmarshalBinary(pending[0].Payload)
+	a.So(req.ChIndex, ShouldEqual, 3)
+	a.So(req.Freq, ShouldEqual, 867100000)
+
+	// Only one channel at a time
+	err = ns.handleUplinkChannels(adrInitUplinkMessage(), dev)
+	a.So(err, ShouldBeNil)
+	pending, _ = queue.Get()
+	a.So(pending, ShouldHaveLength, 1)
+
+	answer(lorawan.NewChannelAnsPayload{ChannelFrequencyOK: true, DataRateRangeOK: true})
+	a.So(dev.Channels, ShouldHaveLength, 4)
+	a.So(dev.Channels[3].Frequency, ShouldEqual, 867100000)
+
+	// Next channel
+	err = ns.handleUplinkChannels(adrInitUplinkMessage(), dev)
+	a.So(err, ShouldBeNil)
+	pending, _ = queue.Get()
+	req.UnmarshalBinary(pending[0].Payload)
+	a.So(req.ChIndex, ShouldEqual, 4)
+
+	// Rejected channels are not sent again, and the FSK channel is not sent at all
+	fp, _ := band.Get("EU_863_870")
+	dev.Channels = activationChannels(fp, &pb_lorawan.CFList{Freq: []uint32{867100000, 867300000, 867500000, 867700000}})
+	queue.Clear()
+	err = ns.handleUplinkChannels(adrInitUplinkMessage(), dev)
+	a.So(err, ShouldBeNil)
+	pending, _ = queue.Get()
+	req.UnmarshalBinary(pending[0].Payload)
+	a.So(req.ChIndex, ShouldEqual, 7)
+	answer(lorawan.NewChannelAnsPayload{ChannelFrequencyOK: true})
+	a.So(dev.Channels[7].Rejected, ShouldBeTrue)
+
+	err = ns.handleUplinkChannels(adrInitUplinkMessage(), dev)
+	a.So(err, ShouldBeNil)
+	pending, _ = queue.Get()
+	a.So(pending, ShouldBeEmpty)
+
+	// Unanswered channels are not sent again
+	dev.Channels = activationChannels(fp, &pb_lorawan.CFList{Freq: []uint32{867100000, 867300000, 867500000, 867700000}})
+	dev.Downlink.FrequencyPlan = "EU_863_870"
+	err = ns.handleUplinkChannels(adrInitUplinkMessage(), dev)
+	a.So(err, ShouldBeNil)
+	pending, _ = queue.Get()
+	a.So(pending, ShouldHaveLength, 1)
+	pending[0].Attempts = MaxMACCommandAttempts
+	queue.Set(pending...)
+	err = ns.handleDownlinkPendingMAC(adrInitDownlinkMessage(), dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.Channels[7].Rejected, ShouldBeTrue)
+
+	err = ns.handleUplinkChannels(adrInitUplinkMessage(), dev)
+	a.So(err, ShouldBeNil)
+	pending, _ = queue.Get()
+	a.So(pending, ShouldBeEmpty)
+
+	// Fixed channel plans
+	message := adrInitUplinkMessage()
+	message.ProtocolMetadata.GetLorawan().FrequencyPlan = pb_lorawan.FrequencyPlan_US_902_928
+	err = ns.handleUplinkChannels(message, &device.Device{AppEUI: dev.AppEUI, DevEUI: dev.DevEUI})
+	a.So(err, ShouldBeNil)
+	pending, _ = queue.Get()
+	a.So(pending, ShouldBeEmpty)
+}
@@ -0,0 +1,197 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"crypto/aes"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/TheThingsNetwork/go-utils/log"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/gpstime"
+	"github.com/brocaar/lorawan"
+)
+
+// CIDs of the Class B MAC commands (LoRaWAN 1.0.2)
+const (
+	pingSlotInfoReq = lorawan.CID(0x10)
+	beaconFreqReq   = lorawan.CID(0x13)
+)
+
+const (
+	beaconPeriod   = 128 * time.Second
+	beaconReserved = 2120 * time.Millisecond // time after the start of the beacon period in which there are no ping slots
+	pingSlotLength = 30 * time.Millisecond
+	pingSlotCount  = 4096 // number of ping slots in a beacon period
+)
+
+// ClassBMinDelay is the minimum time between handling a Class B downlink and the ping slot that it is scheduled in. It
+// should leave enough time to send the downlink to the gateway.
+var ClassBMinDelay = 2 * time.Second
+
+// pingOffset returns the pseudo-random offset (in ping slots) of the ping slots of a device in the beacon period that
+// starts at beaconTime (in seconds since the GPS epoch)
+func pingOffset(beaconTime uint32, devAddr types.DevAddr, pingPeriod int) int {
+	var block [16]byte
+	binary.LittleEndian.PutUint32(block[0:4], beaconTime)
+	binary.LittleEndian.PutUint32(block[4:8], binary.BigEndian.Uint32(devAddr[:]))
+	cipher, _ := aes.NewCipher(make([]byte, 16)) // A key of 16 bytes never results in an error
+	cipher.Encrypt(block[:], block[:])
+	return (int(block[0]) + int(block[1])*256) % pingPeriod
+}
+
+// nextPingSlot returns the GPS time of the first ping slot of a device with the given periodicity that starts at or
+// after the given GPS time
+func nextPingSlot(devAddr types.DevAddr, periodicity int, after time.Duration) time.Duration {
+	pingNb := 1 << uint(7-periodicity)
+	pingPeriod := pingSlotCount / pingNb
+	beaconTime := after - after%beaconPeriod
+	for {
+		offset := pingOffset(uint32(beaconTime/time.Second), devAddr, pingPeriod)
+		for n := 0; n < pingNb; n++ {
+			slot := beaconTime + beaconReserved + time.Duration(offset+n*pingPeriod)*pingSlotLength
+			if slot >= after {
+				return slot
+			}
+		}
+		beaconTime += beaconPeriod
+	}
+}
+
+// pingSlotChannel returns the frequency and data rate of the ping slots of a device in the beacon period that starts at
+// beaconTime (GPS time)
+func pingSlotChannel(dev *device.Device, beaconTime time.Duration) (frequency uint64, dataRate string, err error) {
+	switch dev.Downlink.FrequencyPlan {
+	case pb_lorawan.FrequencyPlan_EU_863_870.String():
+		return 869525000, "SF9BW125", nil
+	case pb_lorawan.FrequencyPlan_US_902_928.String(), pb_lorawan.FrequencyPlan_AU_915_928.String():
+		// The ping slots hop over the 8 downlink channels
+		channel := (uint64(beaconTime/beaconPeriod) + uint64(binary.BigEndian.Uint32(dev.DevAddr[:]))) % 8
+		return 923300000 + channel*600000, "SF12BW500", nil
+	}
+	fp, err := downlinkFrequencyPlan(dev)
+	if err != nil {
+		return 0, "", err
+	}
+	params, err := rxParams(fp, 0, dev.RX.AckedRX2DataRate, dev.RX.AckedRX2Frequency)
+	if err != nil {
+		return 0, "", err
+	}
+	dataRate, err = fp.GetDataRateStringForIndex(int(params.DLSettings.RX2DataRate))
+	if err != nil {
+		return 0, "", err
+	}
+	return uint64(params.Frequency), dataRate, nil
+}
+
+// classBDownlinkOption builds a DownlinkOption for a Class B device, that is transmitted in the first ping slot of the
+// device that is at least ClassBMinDelay after now. The GatewayConfig contains the GPS time of the ping slot, the
+// router schedules it on the gateway.
+func classBDownlinkOption(dev *device.Device, now time.Time) (*pb_broker.DownlinkOption, error) {
+	if dev.Options.Class != pb_lorawan.DeviceClass_CLASS_B {
+		return nil, errors.NewErrInvalidArgument("Downlink", "does not have a DownlinkOption")
+	}
+	if !dev.ClassB.PingSlotInfo {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("Ping slot periodicity of %s", dev.DevEUI))
+	}
+	fp, err := downlinkFrequencyPlan(dev)
+	if err != nil {
+		return nil, err
+	}
+	slot := nextPingSlot(dev.DevAddr, dev.ClassB.PingSlotPeriodicity, gpstime.ToGPS(now.Add(ClassBMinDelay)))
+	frequency, dataRate, err := pingSlotChannel(dev, slot-slot%beaconPeriod)
+	if err != nil {
+		return nil, err
+	}
+	option := downlinkOptionWithoutUplink(dev.Downlink, fp, dataRate, frequency)
+	option.GatewayConfig.GpsTime = uint64(slot / time.Microsecond)
+	return option, nil
+}
+
+func marshalBeaconFreqReq(freq uint32) []byte {
+	freq = freq / 100
+	return []byte{byte(freq), byte(freq >> 8), byte(freq >> 16)}
+}
+
+func unmarshalBeaconFreqReq(payload []byte) (freq uint32, err error) {
+	if len(payload) != 3 {
+		return 0, fmt.Errorf("BeaconFreqReq payload should be 3 bytes, not %d", len(payload))
+	}
+	freq = uint32(payload[0]) | uint32(payload[1])<<8 | uint32(payload[2])<<16
+	return freq * 100, nil
+}
+
+// handleUplinkPingSlotInfo stores the ping slot periodicity of the device and answers with a PingSlotInfoAns
+func (n *networkServer) handleUplinkPingSlotInfo(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device, cmd pb_lorawan.MACCommand) {
+	if len(cmd.Payload) != 1 {
+		return
+	}
+	periodicity := int(cmd.Payload[0] & 0x07)
+	message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "ping-slot-info",
+		"periodicity", periodicity,
+	)
+	dev.ClassB.PingSlotInfo = true
+	dev.ClassB.PingSlotPeriodicity = periodicity
+
+	if lorawanDownlinkMac := message.GetResponseTemplate().GetMessage().GetLorawan().GetMacPayload(); lorawanDownlinkMac != nil {
+		lorawanDownlinkMac.FOpts = append(lorawanDownlinkMac.FOpts, pb_lorawan.MACCommand{
+			Cid: uint32(pingSlotInfoReq),
+		})
+	}
+}
+
+func (n *networkServer) handleUplinkBeaconFreq(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device, cmd pb_lorawan.MACCommand) error {
+	if len(cmd.Payload) != 1 {
+		return nil
+	}
+	frequencyOK := cmd.Payload[0]&0x01 != 0
+	message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "beacon-freq",
+		"frequency-ack", frequencyOK,
+	)
+	if !frequencyOK {
+		n.Ctx.WithFields(log.Fields{
+			"AppEUI": dev.AppEUI,
+			"DevEUI": dev.DevEUI,
+		}).Warn("Negative BeaconFreqAns")
+		return nil
+	}
+
+	req, err := n.pendingMACCommand(dev, beaconFreqReq)
+	if err != nil || req == nil {
+		return err
+	}
+	freq, err := unmarshalBeaconFreqReq(req.Payload)
+	if err != nil {
+		return err
+	}
+	dev.ClassB.AckedBeaconFrequency = uint64(freq)
+
+	return nil
+}
+
+// handleUplinkClassB queues a BeaconFreqReq if the desired beacon frequency of a Class B device differs from the
+// beacon frequency that was acknowledged by the device
+func (n *networkServer) handleUplinkClassB(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device) error {
+	if dev.Options.Class != pb_lorawan.DeviceClass_CLASS_B || !dev.ClassB.SendReq {
+		return nil
+	}
+	if dev.ClassB.BeaconFrequency != dev.ClassB.AckedBeaconFrequency {
+		queue, err := n.devices.MACCommands(dev.AppEUI, dev.DevEUI)
+		if err != nil {
+			return err
+		}
+		if err := queue.Push(&device.MACCommand{CID: uint32(beaconFreqReq), Payload: marshalBeaconFreqReq(uint32(dev.ClassB.BeaconFrequency))}); err != nil {
+			return err
+		}
+	}
+	dev.ClassB.SendReq = false
+	return nil
+}
@@ -0,0 +1,163 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/gpstime"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestNextPingSlot(t *testing.T) {
+	a := New(t)
+	devAddr := types.DevAddr([4]byte{0x26, 0x01, 0x23, 0x45})
+
+	// Ping offsets are pseudo-random, but within the ping period
+	for _, beaconTime := range []uint32{0, 128, 1167264000} {
+		a.So(pingOffset(beaconTime, devAddr, 32), ShouldBeBetweenOrEqual, 0, 31)
+	}
+	a.So(pingOffset(128, devAddr, 4096), ShouldEqual, pingOffset(128, devAddr, 4096))
+
+	after := 1167264000*time.Second + 10*time.Second
+	beaconTime := after - after%beaconPeriod
+
+	// Periodicity 0: every second
+	slot := nextPingSlot(devAddr, 0, after)
+	a.So(slot, ShouldBeGreaterThanOrEqualTo, after)
+	a.So(slot, ShouldBeLessThan, after+time.Second)
+	a.So((slot-beaconTime-beaconReserved)%pingSlotLength, ShouldEqual, 0)
+	a.So(nextPingSlot(devAddr, 0, slot+1), ShouldEqual, slot+32*pingSlotLength)
+
+	// Periodicity 7: every 128 seconds
+	slot = nextPingSlot(devAddr, 7, after)
+	a.So(slot, ShouldBeGreaterThanOrEqualTo, after)
+	a.So(slot, ShouldBeLessThan, beaconTime+2*beaconPeriod)
+	next := nextPingSlot(devAddr, 7, slot+1)
+	a.So(next-next%beaconPeriod, ShouldEqual, slot-slot%beaconPeriod+beaconPeriod)
+}
+
+func TestPingSlotChannel(t *testing.T) {
+	a := New(t)
+
+	dev := &device.Device{Downlink: device.DownlinkPath{RouterID: "router", GatewayID: "gateway", FrequencyPlan: "EU_863_870"}}
+	frequency, dataRate, err := pingSlotChannel(dev, 0)
+	a.So(err, ShouldBeNil)
+	a.So(frequency, ShouldEqual, 869525000)
+	a.So(dataRate, ShouldEqual, "SF9BW125")
+
+	dev.Downlink.FrequencyPlan = "US_902_928"
+	dev.DevAddr = types.DevAddr([4]byte{0, 0, 0, 3})
+	frequency, dataRate, err = pingSlotChannel(dev, 0)
+	a.So(err, ShouldBeNil)
+	a.So(frequency, ShouldEqual, 925100000)
+	a.So(dataRate, ShouldEqual, "SF12BW500")
+	frequency, _, _ = pingSlotChannel(dev, 5*beaconPeriod)
+	a.So(frequency, ShouldEqual, 923300000)
+
+	dev.Downlink.FrequencyPlan = "AS_923"
+	frequency, _, err = pingSlotChannel(dev, 0)
+	a.So(err, ShouldBeNil)
+	a.So(frequency, ShouldEqual, 923200000)
+}
+
+func TestClassBDownlinkOption(t *testing.T) {
+	a := New(t)
+
+	dev := &device.Device{Options: device.Options{Class: pb_lorawan.DeviceClass_CLASS_C}}
+	_, err := classBDownlinkOption(dev, time.Now())
+	a.So(err, ShouldNotBeNil)
+
+	dev.Options.Class = pb_lorawan.DeviceClass_CLASS_B
+	dev.Downlink = device.DownlinkPath{RouterID: "router", GatewayID: "gateway", FrequencyPlan: "EU_863_870"}
+	_, err = classBDownlinkOption(dev, time.Now())
+	a.So(err, ShouldNotBeNil)
+
+	dev.ClassB.PingSlotInfo = true
+	dev.ClassB.PingSlotPeriodicity = 2
+	now := time.Now()
+	option, err := classBDownlinkOption(dev, now)
+	a.So(err, ShouldBeNil)
+	a.So(option.Identifier, ShouldEqual, "router:")
+	a.So(option.GetProtocolConfig().GetLorawan().DataRate, ShouldEqual, "SF9BW125")
+	a.So(option.GetGatewayConfig().Frequency, ShouldEqual, 869525000)
+	slot := gpstime.FromGPS(time.Duration(option.GetGatewayConfig().GpsTime) * time.Microsecond)
+	a.So(slot.After(now.Add(ClassBMinDelay-time.Microsecond)), ShouldBeTrue)
+	a.So(slot.Before(now.Add(ClassBMinDelay+10*time.Second)), ShouldBeTrue)
+}
+
+func TestBeaconFreqReq(t *testing.T) {
+	a := New(t)
+	payload := marshalBeaconFreqReq(869525000)
+	a.So(payload, ShouldResemble, []byte{0xd2, 0xad, 0x84})
+	freq, err := unmarshalBeaconFreqReq(payload)
+	a.So(err, ShouldBeNil)
+	a.So(freq, ShouldEqual, 869525000)
+	_, err = unmarshalBeaconFreqReq(payload[:2])
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestHandleClassB(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		Component: &component.Component{
+			Ctx: GetLogger(t, "TestHandleClassB"),
+		},
+		devices: device.NewRedisDeviceStore(GetRedisClient(), "ns-test-handle-class-b"),
+	}
+
+	defer func() {
+		keys, _ := GetRedisClient().Keys("*ns-test-handle-class-b*").Result()
+		for _, key := range keys {
+			GetRedisClient().Del(key).Result()
+		}
+	}()
+
+	dev := &device.Device{
+		AppEUI:  types.AppEUI([8]byte{1}),
+		DevEUI:  types.DevEUI([8]byte{1}),
+		Options: device.Options{Class: pb_lorawan.DeviceClass_CLASS_B},
+	}
+	queue, _ := ns.devices.MACCommands(dev.AppEUI, dev.DevEUI)
+
+	// PingSlotInfoReq is answered with PingSlotInfoAns
+	message := adrInitUplinkMessage()
+	ns.handleUplinkPingSlotInfo(message, dev, pb_lorawan.MACCommand{Cid: uint32(pingSlotInfoReq), Payload: []byte{3}})
+	a.So(dev.ClassB.PingSlotInfo, ShouldBeTrue)
+	a.So(dev.ClassB.PingSlotPeriodicity, ShouldEqual, 3)
+	a.So(message.GetResponseTemplate().GetMessage().GetLorawan().GetMacPayload().FOpts, ShouldContain, pb_lorawan.MACCommand{Cid: uint32(pingSlotInfoReq)})
+
+	// Nothing to send if the beacon frequency is the default
+	dev.ClassB.SendReq = true
+	err := ns.handleUplinkClassB(adrInitUplinkMessage(), dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.ClassB.SendReq, ShouldBeFalse)
+	pending, _ := queue.Get()
+	a.So(pending, ShouldBeEmpty)
+
+	// BeaconFreqReq for a different beacon frequency
+	dev.ClassB.SendReq = true
+	dev.ClassB.BeaconFrequency = 869525000
+	err = ns.handleUplinkClassB(adrInitUplinkMessage(), dev)
+	a.So(err, ShouldBeNil)
+	pending, _ = queue.Get()
+	a.So(pending, ShouldHaveLength, 1)
+	a.So(pending[0].CID, ShouldEqual, beaconFreqReq)
+
+	// Negative answer
+	err = ns.handleUplinkBeaconFreq(adrInitUplinkMessage(), dev, pb_lorawan.MACCommand{Cid: uint32(beaconFreqReq), Payload: []byte{0}})
+	a.So(err, ShouldBeNil)
+	a.So(dev.ClassB.AckedBeaconFrequency, ShouldEqual, 0)
+
+	// Positive answer
+	err = ns.handleUplinkBeaconFreq(adrInitUplinkMessage(), dev, pb_lorawan.MACCommand{Cid: uint32(beaconFreqReq), Payload: []byte{1}})
+	a.So(err, ShouldBeNil)
+	a.So(dev.ClassB.AckedBeaconFrequency, ShouldEqual, 869525000)
+}
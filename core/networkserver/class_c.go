@@ -0,0 +1,96 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"fmt"
+	"strings"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
+	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// downlinkPath returns the DownlinkPath for the given DownlinkOption that was selected for an uplink
+func downlinkPath(option *pb_broker.DownlinkOption, frequencyPlan string) device.DownlinkPath {
+	var routerID string
+	if id := strings.Split(option.Identifier, ":"); len(id) == 2 {
+		routerID = id[0]
+	}
+	return device.DownlinkPath{
+		RouterID:      routerID,
+		GatewayID:     option.GatewayId,
+		FrequencyPlan: frequencyPlan,
+	}
+}
+
+// classCDownlinkOption builds a DownlinkOption for a Class C device, that is transmitted as soon as possible on the
+// RX2 parameters of the device. The Identifier only contains the ID of the router, the router selects the transmission
+// slot.
+func classCDownlinkOption(dev *device.Device) (*pb_broker.DownlinkOption, error) {
+	if dev.Options.Class != pb_lorawan.DeviceClass_CLASS_C {
+		return nil, errors.NewErrInvalidArgument("Downlink", "does not have a DownlinkOption")
+	}
+	fp, err := downlinkFrequencyPlan(dev)
+	if err != nil {
+		return nil, err
+	}
+	params, err := rxParams(fp, 0, dev.RX.AckedRX2DataRate, dev.RX.AckedRX2Frequency)
+	if err != nil {
+		return nil, err
+	}
+	dataRate, err := fp.GetDataRateStringForIndex(int(params.DLSettings.RX2DataRate))
+	if err != nil {
+		return nil, err
+	}
+	return downlinkOptionWithoutUplink(dev.Downlink, fp, dataRate, uint64(params.Frequency)), nil
+}
+
+// downlinkFrequencyPlan returns the frequency plan of the gateway that is used for downlink that is not a response to
+// an uplink
+func downlinkFrequencyPlan(dev *device.Device) (band.FrequencyPlan, error) {
+	if dev.Downlink.RouterID == "" || dev.Downlink.GatewayID == "" {
+		return band.FrequencyPlan{}, errors.NewErrNotFound(fmt.Sprintf("Gateway for downlink to %s", dev.DevEUI))
+	}
+	return band.Get(dev.Downlink.FrequencyPlan)
+}
+
+// downlinkOptionWithoutUplink builds a DownlinkOption for the gateway of the given DownlinkPath, usually the gateway
+// that received the last uplink of the device best. The FCnt is not set, as the FCnt of the payload is the FCnt that
+// the Handler used for encryption.
+func downlinkOptionWithoutUplink(path device.DownlinkPath, fp band.FrequencyPlan, dataRate string, frequency uint64) *pb_broker.DownlinkOption {
+	power := int32(fp.DefaultTXPower)
+	if path.FrequencyPlan == pb_lorawan.FrequencyPlan_EU_863_870.String() && frequency == 869525000 {
+		power = 27 // The EU RX2 frequency allows up to 27dBm
+	}
+	return &pb_broker.DownlinkOption{
+		Identifier: fmt.Sprintf("%s:", path.RouterID),
+		GatewayId:  path.GatewayID,
+		ProtocolConfig: &pb_protocol.TxConfiguration{Protocol: &pb_protocol.TxConfiguration_Lorawan{Lorawan: &pb_lorawan.TxConfiguration{
+			Modulation: pb_lorawan.Modulation_LORA,
+			DataRate:   dataRate,
+			CodingRate: "4/5",
+		}}},
+		GatewayConfig: &pb_gateway.TxConfiguration{
+			RfChain:               0,
+			PolarizationInversion: true,
+			Frequency:             frequency,
+			Power:                 power,
+		},
+	}
+}
+
+// handlerFCnt returns the full FCnt for the 16 lsb of the FCnt in a downlink from the Handler that is not a response
+// to an uplink
+func handlerFCnt(dev *device.Device, fCnt uint32) (uint32, error) {
+	full := dev.FCntDown&0xffff0000 | fCnt&0xffff
+	if full < dev.FCntDown {
+		return 0, errors.NewErrInvalidArgument("Downlink", "FCnt was already used")
+	}
+	return full, nil
+}
@@ -0,0 +1,76 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDownlinkPath(t *testing.T) {
+	a := New(t)
+
+	path := downlinkPath(&pb_broker.DownlinkOption{Identifier: "router:abc", GatewayId: "gateway"}, "EU_863_870")
+	a.So(path.RouterID, ShouldEqual, "router")
+	a.So(path.GatewayID, ShouldEqual, "gateway")
+	a.So(path.FrequencyPlan, ShouldEqual, "EU_863_870")
+
+	path = downlinkPath(&pb_broker.DownlinkOption{Identifier: "abc", GatewayId: "gateway"}, "EU_863_870")
+	a.So(path.RouterID, ShouldBeEmpty)
+}
+
+func TestClassCDownlinkOption(t *testing.T) {
+	a := New(t)
+
+	dev := &device.Device{}
+	_, err := classCDownlinkOption(dev)
+	a.So(err, ShouldNotBeNil)
+
+	dev.Options.Class = pb_lorawan.DeviceClass_CLASS_C
+	_, err = classCDownlinkOption(dev)
+	a.So(err, ShouldNotBeNil)
+
+	dev.Downlink = device.DownlinkPath{RouterID: "router", GatewayID: "gateway", FrequencyPlan: "EU_863_870"}
+	option, err := classCDownlinkOption(dev)
+	a.So(err, ShouldBeNil)
+	a.So(option.Identifier, ShouldEqual, "router:")
+	a.So(option.GatewayId, ShouldEqual, "gateway")
+	a.So(option.GetProtocolConfig().GetLorawan().DataRate, ShouldEqual, "SF12BW125")
+	a.So(option.GetProtocolConfig().GetLorawan().FCnt, ShouldEqual, 0)
+	a.So(option.GetGatewayConfig().Frequency, ShouldEqual, 869525000)
+	a.So(option.GetGatewayConfig().Power, ShouldEqual, 27)
+
+	dev.RX.AckedRX2DataRate = "SF9BW125"
+	dev.RX.AckedRX2Frequency = 868500000
+	option, err = classCDownlinkOption(dev)
+	a.So(err, ShouldBeNil)
+	a.So(option.GetProtocolConfig().GetLorawan().DataRate, ShouldEqual, "SF9BW125")
+	a.So(option.GetGatewayConfig().Frequency, ShouldEqual, 868500000)
+	a.So(option.GetGatewayConfig().Power, ShouldEqual, 14)
+}
+
+func TestHandlerFCnt(t *testing.T) {
+	a := New(t)
+
+	dev := &device.Device{FCntDown: 10}
+	fCnt, err := handlerFCnt(dev, 10)
+	a.So(err, ShouldBeNil)
+	a.So(fCnt, ShouldEqual, 10)
+
+	fCnt, err = handlerFCnt(dev, 12)
+	a.So(err, ShouldBeNil)
+	a.So(fCnt, ShouldEqual, 12)
+
+	_, err = handlerFCnt(dev, 9)
+	a.So(err, ShouldNotBeNil)
+
+	dev.FCntDown = 0x10005
+	fCnt, err = handlerFCnt(dev, 0x0006)
+	a.So(err, ShouldBeNil)
+	a.So(fCnt, ShouldEqual, 0x10006)
+}
@@ -0,0 +1,88 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"time"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/brocaar/lorawan"
+)
+
+// DefaultDevStatusInterval is the default interval in which the NetworkServer requests the status of a device. The
+// NetworkServer does not request the status of devices if it is 0.
+var DefaultDevStatusInterval = 24 * time.Hour
+
+func (n *networkServer) WithDevStatusIntervals(intervals map[string]time.Duration) {
+	n.devStatusIntervals = intervals
+}
+
+// devStatusInterval returns the interval in which the status of the device is requested, which is taken from the
+// device (or its service profile), from its application or from the NetworkServer default. The status of the device
+// is not requested if it is disabled for the device or if the interval is not positive.
+func (n *networkServer) devStatusInterval(dev *device.Device) time.Duration {
+	if dev.Options.DisableDevStatus {
+		return 0
+	}
+	if dev.Options.DevStatusInterval != 0 {
+		return dev.Options.DevStatusInterval
+	}
+	if interval, ok := n.devStatusIntervals[dev.AppID]; ok {
+		return interval
+	}
+	return DefaultDevStatusInterval
+}
+
+func (n *networkServer) handleUplinkDevStatus(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device, cmd pb_lorawan.MACCommand) {
+	var answer lorawan.DevStatusAnsPayload
+	if err := answer.UnmarshalBinary(cmd.Payload); err != nil {
+		return
+	}
+	message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "dev-status",
+		"battery", answer.Battery,
+		"margin", answer.Margin,
+	)
+	dev.Status.Battery = int(answer.Battery)
+	dev.Status.Margin = int(answer.Margin)
+	dev.Status.Time = time.Now()
+}
+
+// handleDownlinkDevStatus adds a DevStatusReq to the pending MAC commands when the interval has passed. It is sent
+// with the pending MAC commands, and retried in later downlinks until the device answers it.
+func (n *networkServer) handleDownlinkDevStatus(message *pb_broker.DownlinkMessage, dev *device.Device) error {
+	interval := n.devStatusInterval(dev)
+	if interval <= 0 || time.Since(dev.Status.LastReq) < interval {
+		return nil
+	}
+
+	if message.GetMessage().GetLorawan().GetMacPayload() == nil {
+		return nil
+	}
+
+	queue, err := n.devices.MACCommands(dev.AppEUI, dev.DevEUI)
+	if err != nil {
+		return err
+	}
+	if err := queue.Push(&device.MACCommand{CID: uint32(lorawan.DevStatusReq)}); err != nil {
+		return err
+	}
+	dev.Status.LastReq = time.Now()
+
+	return nil
+}
+
+// deviceStatus returns the last reported status of the device, or nil if the device never reported its status
+func deviceStatus(dev *device.Device) *pb_lorawan.DeviceStatus {
+	if dev.Status.Time.IsZero() {
+		return nil
+	}
+	return &pb_lorawan.DeviceStatus{
+		Battery: uint32(dev.Status.Battery),
+		Margin:  int32(dev.Status.Margin),
+		Time:    dev.Status.Time.UnixNano(),
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestHandleUplinkDevStatus(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		Component: &component.Component{
+			Ctx: GetLogger(t, "TestHandleUplinkDevStatus"),
+		},
+	}
+
+	dev := &device.Device{}
+	message := adrInitUplinkMessage()
+
+	// Invalid payload
+	ns.handleUplinkDevStatus(message, dev, pb_lorawan.MACCommand{Cid: uint32(lorawan.DevStatusAns)})
+	a.So(dev.Status.Time.IsZero(), ShouldBeTrue)
+	a.So(deviceStatus(dev), ShouldBeNil)
+
+	payload, _ := (&lorawan.DevStatusAnsPayload{Battery: 42, Margin: -3}).MarshalBinary()
+	ns.handleUplinkDevStatus(message, dev, pb_lorawan.MACCommand{Cid: uint32(lorawan.DevStatusAns), Payload: payload})
+	a.So(dev.Status.Battery, ShouldEqual, 42)
+	a.So(dev.Status.Margin, ShouldEqual, -3)
+	a.So(dev.Status.Time, ShouldHappenWithin, time.Second, time.Now())
+
+	status := deviceStatus(dev)
+	a.So(status, ShouldNotBeNil)
+	a.So(status.Battery, ShouldEqual, 42)
+	a.So(status.Margin, ShouldEqual, -3)
+}
+
+func TestHandleDownlinkDevStatus(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		Component: &component.Component{
+			Ctx: GetLogger(t, "TestHandleDownlinkDevStatus"),
+		},
+		devices: device.NewRedisDeviceStore(GetRedisClient(), "ns-test-handle-downlink-dev-status"),
+	}
+
+	defer func() {
+		keys, _ := GetRedisClient().Keys("*ns-test-handle-downlink-dev-status*").Result()
+		for _, key := range keys {
+			GetRedisClient().Del(key).Result()
+		}
+	}()
+
+	dev := &device.Device{AppEUI: types.AppEUI([8]byte{1}), DevEUI: types.DevEUI([8]byte{1})}
+	queue, _ := ns.devices.MACCommands(dev.AppEUI, dev.DevEUI)
+
+	// hasDevStatusReq returns whether a DevStatusReq is pending, and clears the pending MAC commands
+	hasDevStatusReq := func() bool {
+		defer queue.Clear()
+		pending, _ := queue.Get()
+		for _, cmd := range pending {
+			if cmd.CID == uint32(lorawan.DevStatusReq) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Never requested before
+	message := adrInitDownlinkMessage()
+	err := ns.handleDownlinkDevStatus(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(hasMACCommand(message.Message.GetLorawan().GetMacPayload().FOpts, uint32(lorawan.DevStatusReq)), ShouldBeFalse)
+	a.So(hasDevStatusReq(), ShouldBeTrue)
+	a.So(dev.Status.LastReq, ShouldHappenWithin, time.Second, time.Now())
+
+	// Requested recently
+	message = adrInitDownlinkMessage()
+	err = ns.handleDownlinkDevStatus(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(hasDevStatusReq(), ShouldBeFalse)
+
+	// Sent and retried with the pending MAC commands until the device answers
+	dev.Status.LastReq = time.Time{}
+	ns.handleDownlinkDevStatus(adrInitDownlinkMessage(), dev)
+	for i := 0; i < 2; i++ {
+		message = adrInitDownlinkMessage()
+		err = ns.handleDownlinkPendingMAC(message, dev)
+		a.So(err, ShouldBeNil)
+		a.So(hasMACCommand(message.Message.GetLorawan().GetMacPayload().FOpts, uint32(lorawan.DevStatusReq)), ShouldBeTrue)
+	}
+	uplink := adrInitUplinkMessage()
+	uplink.Message.GetLorawan().GetMacPayload().FOpts = []pb_lorawan.MACCommand{{Cid: uint32(lorawan.DevStatusAns), Payload: []byte{42, 3}}}
+	err = ns.handleUplinkPendingMAC(uplink, dev)
+	a.So(err, ShouldBeNil)
+	a.So(hasDevStatusReq(), ShouldBeFalse)
+
+	// Device-specific interval
+	dev.Options.DevStatusInterval = time.Minute
+	dev.Status.LastReq = time.Now().Add(-2 * time.Minute)
+	message = adrInitDownlinkMessage()
+	err = ns.handleDownlinkDevStatus(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(hasDevStatusReq(), ShouldBeTrue)
+
+	// Disabled for device
+	dev.Options.DisableDevStatus = true
+	dev.Status.LastReq = time.Time{}
+	message = adrInitDownlinkMessage()
+	err = ns.handleDownlinkDevStatus(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(hasDevStatusReq(), ShouldBeFalse)
+
+	// Negative interval for device
+	dev.Options.DisableDevStatus = false
+	dev.Options.DevStatusInterval = -1
+	message = adrInitDownlinkMessage()
+	err = ns.handleDownlinkDevStatus(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(hasDevStatusReq(), ShouldBeFalse)
+
+	// Application-specific interval
+	dev = &device.Device{AppEUI: dev.AppEUI, DevEUI: dev.DevEUI, AppID: "app"}
+	ns.WithDevStatusIntervals(map[string]time.Duration{"app": time.Minute})
+	dev.Status.LastReq = time.Now().Add(-2 * time.Minute)
+	message = adrInitDownlinkMessage()
+	err = ns.handleDownlinkDevStatus(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(hasDevStatusReq(), ShouldBeTrue)
+
+	// Disabled for application
+	ns.WithDevStatusIntervals(map[string]time.Duration{"app": 0})
+	dev.Status.LastReq = time.Time{}
+	message = adrInitDownlinkMessage()
+	err = ns.handleDownlinkDevStatus(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(hasDevStatusReq(), ShouldBeFalse)
+
+	// Disabled by default
+	ns.WithDevStatusIntervals(nil)
+	defaultInterval := DefaultDevStatusInterval
+	defer func() { DefaultDevStatusInterval = defaultInterval }()
+	DefaultDevStatusInterval = 0
+	message = adrInitDownlinkMessage()
+	err = ns.handleDownlinkDevStatus(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(hasDevStatusReq(), ShouldBeFalse)
+}
@@ -0,0 +1,383 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TheThingsNetwork/go-utils/random"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"gopkg.in/redis.v5"
+)
+
+const redisDevAddrInvalidateChannel = "dev_addr_invalidate"
+
+// CacheOptions used for the cache
+type CacheOptions struct {
+	Size       int           // Maximum number of DevAddrs in the cache
+	Expiration time.Duration // Time after which DevAddrs are loaded from the backing store again
+}
+
+// DefaultCacheOptions are the default CacheOptions
+var DefaultCacheOptions = CacheOptions{
+	Size:       100000,           // A DevAddr entry only contains a few small devices
+	Expiration: 10 * time.Minute, // Entries are invalidated on changes, so this only limits the damage of missed invalidations
+}
+
+// deviceID identifies a device in a DevAddr entry
+type deviceID struct {
+	AppEUI types.AppEUI
+	DevEUI types.DevEUI
+}
+
+// devAddrEntry contains the devices of a DevAddr. Entries are never modified, updates replace the entry.
+type devAddrEntry struct {
+	devices map[deviceID]Device
+	expires time.Time
+}
+
+func (e *devAddrEntry) with(id deviceID, dev *Device) *devAddrEntry {
+	n := &devAddrEntry{devices: make(map[deviceID]Device, len(e.devices)+1), expires: e.expires}
+	for k, v := range e.devices {
+		n.devices[k] = v
+	}
+	if dev != nil {
+		n.devices[id] = indexed(dev)
+	} else {
+		delete(n.devices, id)
+	}
+	return n
+}
+
+func (e *devAddrEntry) list() []*Device {
+	devices := make([]*Device, 0, len(e.devices))
+	for _, dev := range e.devices {
+		dev := dev
+		devices = append(devices, &dev)
+	}
+	return devices
+}
+
+// indexChanged returns true if the fields that are announced to other instances changed. These are the fields in the
+// index, except for the FCntUp, which changes on every uplink message and is loaded on every lookup instead.
+func indexChanged(dev *Device) bool {
+	if dev.old == nil {
+		return true
+	}
+	old, new := indexed(dev.old), indexed(dev)
+	old.FCntUp, new.FCntUp = 0, 0
+	return !reflect.DeepEqual(old, new)
+}
+
+// indexed returns a copy of the device with only the fields that are needed to find the devices for an uplink message
+func indexed(dev *Device) Device {
+	return Device{
+		DevEUI:  dev.DevEUI,
+		AppEUI:  dev.AppEUI,
+		AppID:   dev.AppID,
+		DevID:   dev.DevID,
+		DevAddr: dev.DevAddr,
+		NwkSKey: dev.NwkSKey,
+		FCntUp:  dev.FCntUp,
+		Options: dev.Options,
+		RX:      dev.RX,
+		PendingSession: PendingSession{
+			DevAddr:    dev.PendingSession.DevAddr,
+			NwkSKey:    dev.PendingSession.NwkSKey,
+			MACVersion: dev.PendingSession.MACVersion,
+		},
+	}
+}
+
+// NewCachedDeviceStore returns a wrapper around the existing store that keeps an in-memory index of devices by DevAddr.
+// The index is updated when devices are changed through this store, and changes of the addresses, keys, options or RX
+// settings are announced over Redis pub/sub, so that the indexes of other instances that use the same prefix are
+// invalidated. The FCntUp is not announced, but loaded from Redis on every lookup.
+//
+// Devices returned by ListForAddress only contain the fields that are needed to find the devices for an uplink
+// message (identifiers, DevAddr, NwkSKey, FCntUp, Options, RX settings and the pending session).
+func NewCachedDeviceStore(store Store, client *redis.Client, prefix string, options CacheOptions) Store {
+	if prefix == "" {
+		prefix = defaultRedisPrefix
+	}
+	s := &cachedDeviceStore{
+		Store:      store,
+		client:     client,
+		prefix:     prefix,
+		channel:    prefix + ":" + redisDevAddrInvalidateChannel,
+		instanceID: random.String(16),
+		options:    options,
+		entries:    make(map[types.DevAddr]*devAddrEntry),
+		loading:    make(map[types.DevAddr]*devAddrVersion),
+	}
+	go s.subscribe()
+	return s
+}
+
+// devAddrVersion is the version of a DevAddr that is being loaded from the backing store. The version is incremented
+// on every change of the DevAddr, so that concurrent loads do not overwrite newer entries.
+type devAddrVersion struct {
+	version uint64
+	loads   int
+}
+
+type cachedDeviceStore struct {
+	Store
+	client     *redis.Client
+	prefix     string
+	channel    string
+	instanceID string
+	options    CacheOptions
+
+	mu      sync.RWMutex
+	entries map[types.DevAddr]*devAddrEntry
+	loading map[types.DevAddr]*devAddrVersion // only contains the DevAddrs that are being loaded
+}
+
+// ListForAddress lists all devices for a specific DevAddr
+func (s *cachedDeviceStore) ListForAddress(devAddr types.DevAddr) ([]*Device, error) {
+	s.mu.RLock()
+	entry, ok := s.entries[devAddr]
+	s.mu.RUnlock()
+	if ok && time.Now().Before(entry.expires) {
+		return s.withFCntUp(entry.list())
+	}
+
+	s.mu.Lock()
+	loading, ok := s.loading[devAddr]
+	if !ok {
+		loading = &devAddrVersion{}
+		s.loading[devAddr] = loading
+	}
+	loading.loads++
+	version := loading.version
+	s.mu.Unlock()
+
+	devices, err := s.Store.ListForAddress(devAddr)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	loading.loads--
+	if loading.loads == 0 {
+		delete(s.loading, devAddr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	entry = &devAddrEntry{devices: make(map[deviceID]Device, len(devices)), expires: time.Now().Add(s.options.Expiration)}
+	for _, dev := range devices {
+		if dev == nil {
+			continue
+		}
+		entry.devices[deviceID{dev.AppEUI, dev.DevEUI}] = indexed(dev)
+	}
+	if loading.version == version {
+		s.evict()
+		s.entries[devAddr] = entry
+	}
+
+	return entry.list(), nil
+}
+
+// withFCntUp loads the current FCntUp of the devices in one round-trip. Changes of the FCntUp are not announced to
+// other instances, but the FCntUp protects against replayed uplink messages, so it can not be taken from the cache.
+func (s *cachedDeviceStore) withFCntUp(devices []*Device) ([]*Device, error) {
+	if len(devices) == 0 {
+		return devices, nil
+	}
+	cmds := make([]*redis.StringCmd, len(devices))
+	_, err := s.client.Pipelined(func(pipe *redis.Pipeline) error {
+		for i, dev := range devices {
+			cmds[i] = pipe.HGet(fmt.Sprintf("%s:%s:%s:%s", s.prefix, redisDevicePrefix, dev.AppEUI, dev.DevEUI), "f_cnt_up")
+		}
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+	for i, cmd := range cmds {
+		val, err := cmd.Result()
+		if err == redis.Nil {
+			devices[i].FCntUp = 0
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		fCntUp, err := strconv.ParseUint(val, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		devices[i].FCntUp = uint32(fCntUp)
+	}
+	return devices, nil
+}
+
+// changed increments the versions of DevAddrs that are being loaded. Without DevAddrs, it increments all versions.
+// The caller must hold the lock.
+func (s *cachedDeviceStore) changed(devAddrs ...types.DevAddr) {
+	if len(devAddrs) == 0 {
+		for _, loading := range s.loading {
+			loading.version++
+		}
+		return
+	}
+	for _, devAddr := range devAddrs {
+		if loading, ok := s.loading[devAddr]; ok {
+			loading.version++
+		}
+	}
+}
+
+// evict removes expired entries if the cache is full. If that is not enough, it removes an arbitrary entry.
+func (s *cachedDeviceStore) evict() {
+	if len(s.entries) < s.options.Size {
+		return
+	}
+	now := time.Now()
+	for devAddr, entry := range s.entries {
+		if now.After(entry.expires) {
+			delete(s.entries, devAddr)
+		}
+	}
+	for devAddr := range s.entries {
+		if len(s.entries) < s.options.Size {
+			break
+		}
+		delete(s.entries, devAddr)
+	}
+}
+
+// Set a new Device or update an existing one
+func (s *cachedDeviceStore) Set(new *Device, properties ...string) error {
+	var oldID deviceID
+	var oldAddresses []types.DevAddr
+	if new.old != nil {
+		oldID = deviceID{new.old.AppEUI, new.old.DevEUI}
+		oldAddresses = new.old.addresses()
+	}
+	newID := deviceID{new.AppEUI, new.DevEUI}
+	newAddresses := new.addresses()
+	changed := append(append([]types.DevAddr{}, oldAddresses...), newAddresses...)
+	announce := indexChanged(new)
+
+	if err := s.Store.Set(new, properties...); err != nil {
+		// We don't know what was written, so we invalidate
+		if len(changed) > 0 {
+			s.invalidate(changed...)
+		}
+		s.publish(changed...)
+		return err
+	}
+
+	s.mu.Lock()
+	s.changed(changed...)
+	for _, devAddr := range oldAddresses {
+		if entry, ok := s.entries[devAddr]; ok {
+			s.entries[devAddr] = entry.with(oldID, nil)
+		}
+	}
+	for _, devAddr := range newAddresses {
+		if entry, ok := s.entries[devAddr]; ok {
+			s.entries[devAddr] = entry.with(newID, new)
+		}
+	}
+	s.mu.Unlock()
+
+	if announce {
+		s.publish(changed...)
+	}
+	return nil
+}
+
+// Delete a Device
+func (s *cachedDeviceStore) Delete(appEUI types.AppEUI, devEUI types.DevEUI) error {
+	dev, err := s.Store.Get(appEUI, devEUI)
+	if err != nil {
+		return err
+	}
+	if err := s.Store.Delete(appEUI, devEUI); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.changed(dev.addresses()...)
+	for _, devAddr := range dev.addresses() {
+		if entry, ok := s.entries[devAddr]; ok {
+			s.entries[devAddr] = entry.with(deviceID{appEUI, devEUI}, nil)
+		}
+	}
+	s.mu.Unlock()
+
+	s.publish(dev.addresses()...)
+	return nil
+}
+
+// invalidate removes DevAddrs from the cache. Without DevAddrs, it clears the entire cache.
+func (s *cachedDeviceStore) invalidate(devAddrs ...types.DevAddr) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.changed(devAddrs...)
+	if len(devAddrs) == 0 {
+		s.entries = make(map[types.DevAddr]*devAddrEntry)
+		return
+	}
+	for _, devAddr := range devAddrs {
+		delete(s.entries, devAddr)
+	}
+}
+
+// publish announces changes of DevAddrs to other instances. Messages contain the ID of this instance, followed by the
+// DevAddrs that changed.
+func (s *cachedDeviceStore) publish(devAddrs ...types.DevAddr) {
+	if len(devAddrs) == 0 {
+		return
+	}
+	fields := make([]string, 0, len(devAddrs)+1)
+	fields = append(fields, s.instanceID)
+	for _, devAddr := range devAddrs {
+		fields = append(fields, devAddr.String())
+	}
+	// If publishing fails, other instances load the DevAddrs again when their entries expire
+	s.client.Publish(s.channel, strings.Join(fields, " "))
+}
+
+// subscribe invalidates DevAddrs that were changed by other instances. When the subscription fails, invalidations may
+// have been missed, so the entire cache is cleared.
+func (s *cachedDeviceStore) subscribe() {
+	for {
+		pubsub, err := s.client.Subscribe(s.channel)
+		if err != nil {
+			s.invalidate()
+			time.Sleep(time.Second)
+			continue
+		}
+		for {
+			msg, err := pubsub.ReceiveMessage()
+			if err != nil {
+				s.invalidate()
+				break
+			}
+			fields := strings.Fields(msg.Payload)
+			if len(fields) < 2 || fields[0] == s.instanceID {
+				continue
+			}
+			devAddrs := make([]types.DevAddr, 0, len(fields)-1)
+			for _, field := range fields[1:] {
+				if devAddr, err := types.ParseDevAddr(field); err == nil {
+					devAddrs = append(devAddrs, devAddr)
+				}
+			}
+			if len(devAddrs) > 0 {
+				s.invalidate(devAddrs...)
+			}
+		}
+		pubsub.Close()
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestCachedDeviceStore(t *testing.T) {
+	a := New(t)
+
+	prefix := "networkserver-test-cached-device-store"
+	backing := NewRedisDeviceStore(GetRedisClient(), prefix)
+	s := NewCachedDeviceStore(backing, GetRedisClient(), prefix, DefaultCacheOptions)
+	other := NewCachedDeviceStore(backing, GetRedisClient(), prefix, DefaultCacheOptions)
+
+	time.Sleep(50 * time.Millisecond) // Wait for the subscriptions
+
+	appEUI, devEUI := types.AppEUI{0, 0, 0, 0, 0, 0, 0, 1}, types.DevEUI{0, 0, 0, 0, 0, 0, 0, 1}
+	devAddr := types.DevAddr{0, 0, 0, 1}
+
+	// Unknown DevAddr
+	res, err := s.ListForAddress(devAddr)
+	a.So(err, ShouldBeNil)
+	a.So(res, ShouldBeEmpty)
+
+	// New device is added to the index
+	err = s.Set(&Device{
+		DevAddr: devAddr,
+		DevEUI:  devEUI,
+		AppEUI:  appEUI,
+		NwkSKey: types.NwkSKey{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 1},
+	})
+	a.So(err, ShouldBeNil)
+	defer func() {
+		s.Delete(appEUI, devEUI)
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	res, err = s.ListForAddress(devAddr)
+	a.So(err, ShouldBeNil)
+	a.So(res, ShouldHaveLength, 1)
+	res, err = other.ListForAddress(devAddr)
+	a.So(err, ShouldBeNil)
+	a.So(res, ShouldHaveLength, 1)
+
+	// Updates are written through
+	dev, _ := s.Get(appEUI, devEUI)
+	dev.StartUpdate()
+	dev.FCntUp = 42
+	dev.ADR.Band = "EU_863_870"
+	err = s.Set(dev)
+	a.So(err, ShouldBeNil)
+	res, _ = s.ListForAddress(devAddr)
+	a.So(res, ShouldHaveLength, 1)
+	a.So(res[0].FCntUp, ShouldEqual, 42)
+	a.So(res[0].ADR.Band, ShouldBeEmpty) // Not in the index
+
+	// Other instances are not invalidated when only the FCntUp changes, but still get the latest FCntUp
+	time.Sleep(50 * time.Millisecond)
+	otherCache := other.(*cachedDeviceStore)
+	otherCache.mu.RLock()
+	_, cached := otherCache.entries[devAddr]
+	otherCache.mu.RUnlock()
+	a.So(cached, ShouldBeTrue)
+	res, _ = other.ListForAddress(devAddr)
+	a.So(res, ShouldHaveLength, 1)
+	a.So(res[0].FCntUp, ShouldEqual, 42)
+
+	// Other instances are invalidated when the options change
+	dev, _ = s.Get(appEUI, devEUI)
+	dev.StartUpdate()
+	dev.Options.DisableFCntCheck = true
+	err = s.Set(dev)
+	a.So(err, ShouldBeNil)
+	time.Sleep(50 * time.Millisecond)
+	otherCache.mu.RLock()
+	_, cached = otherCache.entries[devAddr]
+	otherCache.mu.RUnlock()
+	a.So(cached, ShouldBeFalse)
+	res, _ = other.ListForAddress(devAddr)
+	a.So(res, ShouldHaveLength, 1)
+	a.So(res[0].Options.DisableFCntCheck, ShouldBeTrue)
+
+	// Changing the DevAddr moves the device
+	dev, _ = s.Get(appEUI, devEUI)
+	dev.StartUpdate()
+	dev.DevAddr = types.DevAddr{0, 0, 0, 2}
+	err = s.Set(dev)
+	a.So(err, ShouldBeNil)
+	res, _ = s.ListForAddress(devAddr)
+	a.So(res, ShouldBeEmpty)
+	res, _ = s.ListForAddress(types.DevAddr{0, 0, 0, 2})
+	a.So(res, ShouldHaveLength, 1)
+
+	// Deleted devices are removed from the index
+	time.Sleep(50 * time.Millisecond)
+	other.ListForAddress(types.DevAddr{0, 0, 0, 2})
+	err = s.Delete(appEUI, devEUI)
+	a.So(err, ShouldBeNil)
+	res, _ = s.ListForAddress(types.DevAddr{0, 0, 0, 2})
+	a.So(res, ShouldBeEmpty)
+	time.Sleep(50 * time.Millisecond)
+	res, _ = other.ListForAddress(types.DevAddr{0, 0, 0, 2})
+	a.So(res, ShouldBeEmpty)
+}
@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"time"
 
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/fatih/structs"
 )
@@ -15,35 +16,79 @@ const currentDBVersion = "2.4.1"
 
 // Options for the specified device
 type Options struct {
-	ActivationConstraints string `json:"activation_constraints,omitempty"` // Activation Constraints (public/local/private)
-	DisableFCntCheck      bool   `json:"disable_fcnt_check,omitemtpy"`     // Disable Frame counter check (insecure)
-	Uses32BitFCnt         bool   `json:"uses_32_bit_fcnt,omitemtpy"`       // Use 32-bit Frame counters
+	ActivationConstraints      string                 `json:"activation_constraints,omitempty"`       // Activation Constraints (public/local/private)
+	DisableFCntCheck           bool                   `json:"disable_fcnt_check,omitemtpy"`           // Disable Frame counter check (insecure)
+	Uses32BitFCnt              bool                   `json:"uses_32_bit_fcnt,omitemtpy"`             // Use 32-bit Frame counters
+	DevStatusInterval          time.Duration          `json:"dev_status_interval,omitempty"`          // Interval for requesting the device status (0: application or NetworkServer default)
+	DisableDevStatus           bool                   `json:"disable_dev_status,omitempty"`           // Never request the device status
+	Class                      pb_lorawan.DeviceClass `json:"class,omitempty"`                        // Class of the device (Class A, B or C)
+	MACVersion                 pb_lorawan.MACVersion  `json:"mac_version,omitempty"`                  // LoRaWAN version of the device (1.0 or 1.1)
+	RegionalParametersRevision string                 `json:"regional_parameters_revision,omitempty"` // Revision of the LoRaWAN Regional Parameters of the device
+	DeviceProfileID            string                 `json:"device_profile_id,omitempty"`            // Device profile that overrides the capabilities of the device
+	ServiceProfileID           string                 `json:"service_profile_id,omitempty"`           // Service profile that overrides the network service settings
 }
 
 // Device contains the state of a device
 type Device struct {
 	old *Device
 
-	DevEUI   types.DevEUI  `redis:"dev_eui"`
-	AppEUI   types.AppEUI  `redis:"app_eui"`
-	AppID    string        `redis:"app_id"`
-	DevID    string        `redis:"dev_id"`
-	DevAddr  types.DevAddr `redis:"dev_addr"`
-	NwkSKey  types.NwkSKey `redis:"nwk_s_key"`
-	FCntUp   uint32        `redis:"f_cnt_up"`
-	FCntDown uint32        `redis:"f_cnt_down"`
-	LastSeen time.Time     `redis:"last_seen"`
-	Options  Options       `redis:"options"`
-	ADR      ADRSettings   `redis:"adr,include"`
+	DevEUI   types.DevEUI    `redis:"dev_eui"`
+	AppEUI   types.AppEUI    `redis:"app_eui"`
+	AppID    string          `redis:"app_id"`
+	DevID    string          `redis:"dev_id"`
+	DevAddr  types.DevAddr   `redis:"dev_addr"`
+	NwkSKey  types.NwkSKey   `redis:"nwk_s_key"` // FNwkSIntKey for LoRaWAN 1.1 devices
+	FCntUp   uint32          `redis:"f_cnt_up"`
+	FCntDown uint32          `redis:"f_cnt_down"`
+	LastSeen time.Time       `redis:"last_seen"`
+	Options  Options         `redis:"options"`
+	ADR      ADRSettings     `redis:"adr,include"`
+	RX       RXSettings      `redis:"rx,include"`
+	TxParams TxParamSettings `redis:"tx_params,include"`
+	Status   DeviceStatus    `redis:"status,include"`
+	Channels []Channel       `redis:"channels"`
+	Downlink DownlinkPath    `redis:"downlink,include"`
+	ClassB   ClassBSettings  `redis:"class_b,include"`
+	Session  SessionKeys     `redis:"session,include"`
+
+	PendingSession PendingSession `redis:"pending_session,include"`
+
+	// Anomalies of the downlink frame counter that are reported with the next uplink message
+	FCntAnomalies []pb_lorawan.FCntAnomaly `redis:"f_cnt_anomalies,omitempty"`
 
 	CreatedAt time.Time `redis:"created_at"`
 	UpdatedAt time.Time `redis:"updated_at"`
 }
 
+// SessionKeys contains the additional network session keys and the confirmed frame counters of a LoRaWAN 1.1 device
+type SessionKeys struct {
+	SNwkSIntKey types.NwkSKey `redis:"s_nwk_s_int_key,omitempty"`
+	NwkSEncKey  types.NwkSKey `redis:"nwk_s_enc_key,omitempty"`
+
+	// FCnt of the last confirmed uplink and confirmed downlink, used for the MIC of the acknowledgement
+	LastConfirmedFCntUp   uint32 `redis:"last_confirmed_f_cnt_up,omitempty"`
+	LastConfirmedFCntDown uint32 `redis:"last_confirmed_f_cnt_down,omitempty"`
+}
+
+// PendingSession contains the session of a device that rejoined. The previous session remains active until the device
+// sends its first uplink in the pending session, so that a device that missed the JoinAccept can still communicate.
+type PendingSession struct {
+	DevAddr     types.DevAddr         `redis:"pending_dev_addr,omitempty"`
+	NwkSKey     types.NwkSKey         `redis:"pending_nwk_s_key,omitempty"`
+	SNwkSIntKey types.NwkSKey         `redis:"pending_s_nwk_s_int_key,omitempty"`
+	NwkSEncKey  types.NwkSKey         `redis:"pending_nwk_s_enc_key,omitempty"`
+	MACVersion  pb_lorawan.MACVersion `redis:"pending_mac_version,omitempty"`
+
+	// Frequency plan and channels of the device after the join
+	Band     string    `redis:"pending_band,omitempty"`
+	Channels []Channel `redis:"pending_channels,omitempty"`
+}
+
 // ADRSettings contains the (desired) settings for a device that uses ADR
 type ADRSettings struct {
-	Band   string `redis:"band"`
-	Margin int    `redis:"margin"`
+	Band     string `redis:"band"`
+	Margin   int    `redis:"margin"`
+	Strategy string `redis:"strategy,omitempty"` // name of the ADR strategy (empty: NetworkServer default)
 
 	// Indicates whether the NetworkServer should send a LinkADRReq when possible
 	SendReq bool `redis:"send_req,omitempty"`
@@ -53,6 +98,86 @@ type ADRSettings struct {
 	DataRate string `redis:"data_rate,omitempty"`
 	TxPower  int    `redis:"tx_power,omitempty"`
 	NbTrans  int    `redis:"nb_trans,omitempty"`
+
+	// Sub-band (1-8) that devices in frequency plans with fixed channels (US/AU) should be confined to, 0 for all channels
+	SubBand      int `redis:"sub_band,omitempty"`
+	AckedSubBand int `redis:"acked_sub_band,omitempty"` // sub-band that was acknowledged by the device
+}
+
+// RXSettings contains the (desired) receive window settings for a device. Zero values indicate the defaults of the frequency plan.
+type RXSettings struct {
+	// Indicates whether the NetworkServer should send a RXParamSetupReq and RXTimingSetupReq when possible
+	SendReq bool `redis:"rx_send_req,omitempty"`
+
+	// Desired Settings:
+	RX1DROffset  int    `redis:"rx1_dr_offset,omitempty"`
+	RX2DataRate  string `redis:"rx2_data_rate,omitempty"`
+	RX2Frequency uint64 `redis:"rx2_frequency,omitempty"`
+	RXDelay      int    `redis:"rx_delay,omitempty"` // delay of RX1 in seconds
+
+	// Settings acknowledged by the device (zero values if the device uses the defaults):
+	AckedRX1DROffset  int    `redis:"rx_acked_rx1_dr_offset,omitempty"`
+	AckedRX2DataRate  string `redis:"rx_acked_rx2_data_rate,omitempty"`
+	AckedRX2Frequency uint64 `redis:"rx_acked_rx2_frequency,omitempty"`
+	AckedRXDelay      int    `redis:"rx_acked_rx_delay,omitempty"`
+}
+
+// TxParamSettings contains the transmit parameters that were acknowledged by a device. The desired transmit parameters
+// are those of the frequency plan, with the max EIRP limited to that of the device.
+type TxParamSettings struct {
+	// Indicates whether the NetworkServer should send a TxParamSetupReq when possible
+	SendReq bool `redis:"tx_param_send_req,omitempty"`
+
+	// Desired settings:
+	MaxEIRP float32 `redis:"tx_param_max_eirp,omitempty"` // in dBm, 0: frequency plan default
+
+	// Settings acknowledged by the device (zero values if the device uses the defaults):
+	AckedUplinkDwellTime   bool    `redis:"tx_param_acked_uplink_dwell_time,omitempty"`
+	AckedDownlinkDwellTime bool    `redis:"tx_param_acked_downlink_dwell_time,omitempty"`
+	AckedMaxEIRP           float32 `redis:"tx_param_acked_max_eirp,omitempty"` // in dBm
+}
+
+// Channel in the channel table of a device. The index in the table is the channel index.
+type Channel struct {
+	Frequency         uint64 `json:"frequency"`                    // 0: disabled
+	DownlinkFrequency uint64 `json:"downlink_frequency,omitempty"` // 0: same as Frequency
+	MinDataRate       int    `json:"min_data_rate"`
+	MaxDataRate       int    `json:"max_data_rate"`
+
+	// Indicates that the device rejected this channel
+	Rejected bool `json:"rejected,omitempty"`
+}
+
+// ClassBSettings contains the ping slot and beacon settings of a Class B device
+type ClassBSettings struct {
+	// Indicates whether the device sent its ping slot periodicity in a PingSlotInfoReq
+	PingSlotInfo        bool `redis:"class_b_ping_slot_info,omitempty"`
+	PingSlotPeriodicity int  `redis:"class_b_ping_slot_periodicity,omitempty"` // the device opens a ping slot every 2^periodicity seconds
+
+	// Indicates whether the NetworkServer should send a BeaconFreqReq when possible
+	SendReq bool `redis:"class_b_send_req,omitempty"`
+
+	// Desired beacon frequency (0: frequency plan default) and the beacon frequency that was acknowledged by the device
+	BeaconFrequency      uint64 `redis:"class_b_beacon_frequency,omitempty"`
+	AckedBeaconFrequency uint64 `redis:"class_b_acked_beacon_frequency,omitempty"`
+}
+
+// DownlinkPath contains the router and gateway that received the last uplink of the device best. It is used for
+// downlink that is not a response to an uplink.
+type DownlinkPath struct {
+	RouterID      string `redis:"downlink_router_id,omitempty"`
+	GatewayID     string `redis:"downlink_gateway_id,omitempty"`
+	FrequencyPlan string `redis:"downlink_frequency_plan,omitempty"`
+}
+
+// DeviceStatus contains the status of a device, as reported in its last DevStatusAns
+type DeviceStatus struct {
+	Battery int       `redis:"status_battery"` // 0: external power source, 1..254: battery level, 255: unable to measure
+	Margin  int       `redis:"status_margin"`  // demodulation margin in dB
+	Time    time.Time `redis:"status_time"`    // when the last DevStatusAns was received
+
+	// Indicates when the NetworkServer last added a DevStatusReq to the pending MAC commands
+	LastReq time.Time `redis:"status_last_req"`
 }
 
 // StartUpdate stores the state of the device
@@ -25,14 +25,19 @@ type RedisFrameHistory struct {
 	store  *storage.RedisQueueStore
 }
 
-// FramesHistorySize for ADR
+// FramesHistorySize for ADR and link quality statistics
 const FramesHistorySize = 20
 
-// Frame collected for ADR
+// Frame collected for ADR and link quality statistics
 type Frame struct {
-	FCnt         uint32  `json:"f_cnt"`
-	SNR          float32 `json:"snr"`
-	GatewayCount uint32  `json:"gw_cnt"`
+	FCnt         uint32   `json:"f_cnt"`
+	SNR          float32  `json:"snr"`
+	RSSI         float32  `json:"rssi,omitempty"`
+	GatewayCount uint32   `json:"gw_cnt"`
+	GatewayIDs   []string `json:"gw_ids,omitempty"`
+	DataRate     string   `json:"data_rate,omitempty"`
+	Frequency    uint64   `json:"freq,omitempty"`
+	NoADR        bool     `json:"no_adr,omitempty"` // The ADR bit was not set in the uplink
 }
 
 func (s *RedisFrameHistory) key() string {
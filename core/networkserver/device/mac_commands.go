@@ -0,0 +1,191 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/TheThingsNetwork/ttn/core/storage"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"gopkg.in/redis.v5"
+)
+
+// MACCommandQueue contains the MAC commands that are pending for a device
+type MACCommandQueue interface {
+	Push(cmd *MACCommand) error
+	Get() ([]*MACCommand, error)
+	Set(cmds ...*MACCommand) error
+	Remove(cids ...uint32) error
+	Attempt(maxAttempts int, selectCIDs func(pending []*MACCommand) (sent []uint32)) (expired []*MACCommand, err error)
+	Clear() error
+}
+
+// RedisMACCommandQueue implements the MAC command queue in Redis
+type RedisMACCommandQueue struct {
+	appEUI types.AppEUI
+	devEUI types.DevEUI
+	client *redis.Client
+	prefix string
+	store  *storage.RedisQueueStore
+}
+
+// macCommandQueueRetries is the number of times that a change to the queue is retried if the queue was changed
+// concurrently
+const macCommandQueueRetries = 10
+
+// MACCommand that is pending for a device. It remains pending until the device answers it or until it is sent too often.
+type MACCommand struct {
+	CID      uint32 `json:"cid"`
+	Payload  []byte `json:"payload,omitempty"`
+	Attempts int    `json:"attempts,omitempty"`
+}
+
+func (s *RedisMACCommandQueue) key() string {
+	return fmt.Sprintf("%s:%s", s.appEUI, s.devEUI)
+}
+
+// update replaces the pending MAC commands with the result of the update function in a transaction. The transaction
+// is retried if the queue was changed concurrently. The queue is not changed if the update function returns false.
+func (s *RedisMACCommandQueue) update(update func(cmds []*MACCommand) ([]*MACCommand, bool)) (err error) {
+	key := s.prefix + s.key()
+	for i := 0; i < macCommandQueueRetries; i++ {
+		err = s.client.Watch(func(tx *redis.Tx) error {
+			values, err := tx.LRange(key, 0, -1).Result()
+			if err != nil {
+				return err
+			}
+			cmds, err := decodeMACCommands(values)
+			if err != nil {
+				return err
+			}
+			cmds, changed := update(cmds)
+			if !changed {
+				return nil
+			}
+			updated := make([]interface{}, 0, len(cmds))
+			for _, cmd := range cmds {
+				cmdBytes, err := json.Marshal(cmd)
+				if err != nil {
+					return err
+				}
+				updated = append(updated, string(cmdBytes))
+			}
+			_, err = tx.Pipelined(func(pipe *redis.Pipeline) error {
+				pipe.Del(key)
+				if len(updated) > 0 {
+					pipe.RPush(key, updated...)
+				}
+				return nil
+			})
+			return err
+		}, key)
+		if err != redis.TxFailedErr {
+			return err
+		}
+	}
+	return err
+}
+
+func decodeMACCommands(values []string) ([]*MACCommand, error) {
+	cmds := make([]*MACCommand, 0, len(values))
+	for _, cmdStr := range values {
+		cmd := new(MACCommand)
+		if err := json.Unmarshal([]byte(cmdStr), cmd); err != nil {
+			return nil, err
+		}
+		cmds = append(cmds, cmd)
+	}
+	return cmds, nil
+}
+
+// Push a MACCommand to the end of the queue. If a command with the same CID is already pending, it is replaced.
+func (s *RedisMACCommandQueue) Push(cmd *MACCommand) error {
+	return s.update(func(cmds []*MACCommand) ([]*MACCommand, bool) {
+		for i, existing := range cmds {
+			if existing.CID == cmd.CID {
+				cmds = append(cmds[:i], cmds[i+1:]...)
+				break
+			}
+		}
+		return append(cmds, cmd), true
+	})
+}
+
+// Get all pending MAC commands, in the order in which they were added
+func (s *RedisMACCommandQueue) Get() ([]*MACCommand, error) {
+	values, err := s.store.GetFront(s.key(), 0)
+	if err != nil {
+		return nil, err
+	}
+	cmds, err := decodeMACCommands(values)
+	if err != nil || len(cmds) == 0 {
+		return nil, err
+	}
+	return cmds, nil
+}
+
+// Set replaces all pending MAC commands
+func (s *RedisMACCommandQueue) Set(cmds ...*MACCommand) error {
+	return s.update(func([]*MACCommand) ([]*MACCommand, bool) {
+		return cmds, true
+	})
+}
+
+// Remove the pending MAC commands with the given CIDs
+func (s *RedisMACCommandQueue) Remove(cids ...uint32) error {
+	return s.update(func(cmds []*MACCommand) ([]*MACCommand, bool) {
+		remaining := make([]*MACCommand, 0, len(cmds))
+		for _, cmd := range cmds {
+			var remove bool
+			for _, cid := range cids {
+				if cmd.CID == cid {
+					remove = true
+					break
+				}
+			}
+			if !remove {
+				remaining = append(remaining, cmd)
+			}
+		}
+		return remaining, len(remaining) != len(cmds)
+	})
+}
+
+// Attempt sends pending MAC commands in a downlink, in a transaction. The MAC commands that were already sent in
+// maxAttempts downlinks are removed from the queue and returned. The selectCIDs function is called with the other MAC
+// commands, and returns the CIDs of the commands that are sent in the downlink. The attempts of those commands are
+// incremented. The selectCIDs function may be called more than once if the queue is changed concurrently.
+func (s *RedisMACCommandQueue) Attempt(maxAttempts int, selectCIDs func(pending []*MACCommand) (sent []uint32)) (expired []*MACCommand, err error) {
+	err = s.update(func(cmds []*MACCommand) ([]*MACCommand, bool) {
+		expired = nil
+		remaining := make([]*MACCommand, 0, len(cmds))
+		for _, cmd := range cmds {
+			if cmd.Attempts >= maxAttempts {
+				expired = append(expired, cmd)
+				continue
+			}
+			remaining = append(remaining, cmd)
+		}
+		sent := selectCIDs(remaining)
+		for _, cmd := range remaining {
+			for _, cid := range sent {
+				if cmd.CID == cid {
+					cmd.Attempts++
+					break
+				}
+			}
+		}
+		return remaining, len(expired) > 0 || len(sent) > 0
+	})
+	if err != nil {
+		return nil, err
+	}
+	return expired, nil
+}
+
+// Clear all pending MAC commands
+func (s *RedisMACCommandQueue) Clear() error {
+	return s.store.Delete(s.key())
+}
@@ -0,0 +1,138 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestMACCommandQueue(t *testing.T) {
+	a := New(t)
+	store := NewRedisDeviceStore(GetRedisClient(), "networkserver-test-mac-command-queue")
+
+	appEUI := types.AppEUI{0, 0, 0, 0, 0, 0, 0, 1}
+	devEUI := types.DevEUI{0, 0, 0, 0, 0, 0, 0, 1}
+
+	q, err := store.MACCommands(appEUI, devEUI)
+	a.So(err, ShouldBeNil)
+
+	defer q.Clear()
+
+	{
+		cmds, err := q.Get()
+		a.So(err, ShouldBeNil)
+		a.So(cmds, ShouldBeEmpty)
+	}
+
+	{
+		err := q.Push(&MACCommand{CID: 0x05, Payload: []byte{1, 2, 3, 4}})
+		a.So(err, ShouldBeNil)
+		err = q.Push(&MACCommand{CID: 0x08, Payload: []byte{1}})
+		a.So(err, ShouldBeNil)
+	}
+
+	{
+		cmds, err := q.Get()
+		a.So(err, ShouldBeNil)
+		a.So(cmds, ShouldHaveLength, 2)
+		a.So(cmds[0].CID, ShouldEqual, 0x05)
+		a.So(cmds[0].Payload, ShouldResemble, []byte{1, 2, 3, 4})
+		a.So(cmds[1].CID, ShouldEqual, 0x08)
+	}
+
+	// Pushing a command with the same CID replaces it
+	{
+		err := q.Push(&MACCommand{CID: 0x05, Payload: []byte{4, 3, 2, 1}})
+		a.So(err, ShouldBeNil)
+		cmds, _ := q.Get()
+		a.So(cmds, ShouldHaveLength, 2)
+		a.So(cmds[0].CID, ShouldEqual, 0x08)
+		a.So(cmds[1].CID, ShouldEqual, 0x05)
+		a.So(cmds[1].Payload, ShouldResemble, []byte{4, 3, 2, 1})
+	}
+
+	{
+		cmds, _ := q.Get()
+		cmds[0].Attempts = 2
+		err := q.Set(cmds...)
+		a.So(err, ShouldBeNil)
+		cmds, _ = q.Get()
+		a.So(cmds[0].Attempts, ShouldEqual, 2)
+	}
+
+	// Attempts are incremented for the sent commands, and expired commands are removed
+	{
+		expired, err := q.Attempt(3, func(pending []*MACCommand) []uint32 {
+			return []uint32{0x05}
+		})
+		a.So(err, ShouldBeNil)
+		a.So(expired, ShouldBeEmpty)
+		cmds, _ := q.Get()
+		a.So(cmds, ShouldHaveLength, 2)
+		a.So(cmds[0].Attempts, ShouldEqual, 2)
+		a.So(cmds[1].Attempts, ShouldEqual, 1)
+
+		q.Push(&MACCommand{CID: 0x08, Payload: []byte{1}, Attempts: 3})
+		expired, err = q.Attempt(3, func(pending []*MACCommand) []uint32 {
+			a.So(pending, ShouldHaveLength, 1)
+			return nil
+		})
+		a.So(err, ShouldBeNil)
+		a.So(expired, ShouldHaveLength, 1)
+		a.So(expired[0].CID, ShouldEqual, 0x08)
+		cmds, _ = q.Get()
+		a.So(cmds, ShouldHaveLength, 1)
+		a.So(cmds[0].CID, ShouldEqual, 0x05)
+		q.Push(&MACCommand{CID: 0x08, Payload: []byte{1}})
+	}
+
+	{
+		err := q.Remove(0x08)
+		a.So(err, ShouldBeNil)
+		cmds, _ := q.Get()
+		a.So(cmds, ShouldHaveLength, 1)
+		a.So(cmds[0].CID, ShouldEqual, 0x05)
+	}
+
+	{
+		err := q.Clear()
+		a.So(err, ShouldBeNil)
+		cmds, err := q.Get()
+		a.So(err, ShouldBeNil)
+		a.So(cmds, ShouldBeEmpty)
+	}
+
+	// Concurrent changes are not lost
+	{
+		var wg sync.WaitGroup
+		for cid := uint32(0x02); cid < 0x0a; cid++ {
+			wg.Add(1)
+			go func(cid uint32) {
+				defer wg.Done()
+				q.Push(&MACCommand{CID: cid})
+			}(cid)
+		}
+		wg.Wait()
+		cmds, err := q.Get()
+		a.So(err, ShouldBeNil)
+		a.So(cmds, ShouldHaveLength, 8)
+
+		for cid := uint32(0x02); cid < 0x0a; cid++ {
+			wg.Add(1)
+			go func(cid uint32) {
+				defer wg.Done()
+				q.Remove(cid)
+			}(cid)
+		}
+		wg.Wait()
+		cmds, err = q.Get()
+		a.So(err, ShouldBeNil)
+		a.So(cmds, ShouldBeEmpty)
+	}
+}
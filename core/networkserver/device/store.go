@@ -4,9 +4,14 @@
 package device
 
 import (
+	"encoding/binary"
 	"fmt"
+	"math"
+	"strconv"
+	"sync"
 	"time"
 
+	"github.com/TheThingsNetwork/go-utils/pseudorandom"
 	"github.com/TheThingsNetwork/ttn/core/networkserver/device/migrate"
 	"github.com/TheThingsNetwork/ttn/core/storage"
 	"github.com/TheThingsNetwork/ttn/core/types"
@@ -20,17 +25,33 @@ type Store interface {
 	List(opts *storage.ListOptions) ([]*Device, error)
 	CountForAddress(devAddr types.DevAddr) (int, error)
 	ListForAddress(devAddr types.DevAddr) ([]*Device, error)
+	CountAddresses(prefix types.DevAddrPrefix) (int, error)
+	ReserveAddress(devAddr types.DevAddr, duration time.Duration) (bool, error)
+	AllocateAddress(prefix types.DevAddrPrefix, duration time.Duration) (types.DevAddr, bool, error)
 	Get(appEUI types.AppEUI, devEUI types.DevEUI) (*Device, error)
+	GetAll(ids []Identifier) ([]*Device, error)
 	Set(new *Device, properties ...string) (err error)
 	Delete(appEUI types.AppEUI, devEUI types.DevEUI) error
+	ReserveFCntDown(dev *Device) error
 	Frames(appEUI types.AppEUI, devEUI types.DevEUI) (FrameHistory, error)
+	MACCommands(appEUI types.AppEUI, devEUI types.DevEUI) (MACCommandQueue, error)
 }
 
 const defaultRedisPrefix = "ns"
 
 const redisDevicePrefix = "device"
 const redisDevAddrPrefix = "dev_addr"
+const redisDevAddrPoolPrefix = "dev_addr_pool"
+const redisDevAddrPoolMigratedSuffix = "migrated"
+const redisDevAddrReservationPrefix = "reserved"
+
+// devAddrPoolRetries is the number of times that a DevAddr is released if its DevAddr index changes concurrently
+const devAddrPoolRetries = 10
+
+// devAddrPoolBatchSize is the number of assigned DevAddrs that are read at once when searching for a free DevAddr
+const devAddrPoolBatchSize = 1000
 const redisFramesPrefix = "frames"
+const redisMACCommandsPrefix = "mac_commands"
 
 // NewRedisDeviceStore creates a new Redis-based status store
 func NewRedisDeviceStore(client *redis.Client, prefix string) Store {
@@ -43,24 +64,34 @@ func NewRedisDeviceStore(client *redis.Client, prefix string) Store {
 		store.AddMigration(v, f)
 	}
 	frameStore := storage.NewRedisQueueStore(client, prefix+":"+redisFramesPrefix)
+	macCommandStore := storage.NewRedisQueueStore(client, prefix+":"+redisMACCommandsPrefix)
 	return &RedisDeviceStore{
-		client:       client,
-		prefix:       prefix,
-		store:        store,
-		frameStore:   frameStore,
-		devAddrIndex: storage.NewRedisSetStore(client, prefix+":"+redisDevAddrPrefix),
+		client:          client,
+		prefix:          prefix,
+		store:           store,
+		frameStore:      frameStore,
+		macCommandStore: macCommandStore,
+		devAddrIndex:    storage.NewRedisSetStore(client, prefix+":"+redisDevAddrPrefix),
+		devAddrPool:     prefix + ":" + redisDevAddrPoolPrefix,
 	}
 }
 
 // RedisDeviceStore stores Devices in Redis.
 // - Devices are stored as a Hash
 // - DevAddr mappings are indexed in a Set
+// - DevAddrs that are assigned to devices are stored in a Sorted Set (scored by DevAddr)
+// - Pending MAC commands are stored in a List
 type RedisDeviceStore struct {
-	client       *redis.Client
-	prefix       string
-	store        *storage.RedisMapStore
-	frameStore   *storage.RedisQueueStore
-	devAddrIndex *storage.RedisSetStore
+	client          *redis.Client
+	prefix          string
+	store           *storage.RedisMapStore
+	frameStore      *storage.RedisQueueStore
+	macCommandStore *storage.RedisQueueStore
+	devAddrIndex    *storage.RedisSetStore
+	devAddrPool     string
+
+	devAddrPoolMu    sync.Mutex
+	devAddrPoolReady bool
 }
 
 func (s *RedisDeviceStore) key(appEUI types.AppEUI, devEUI types.DevEUI) string {
@@ -117,6 +148,177 @@ func (s *RedisDeviceStore) ListForAddress(devAddr types.DevAddr) ([]*Device, err
 	return devices, nil
 }
 
+// CountAddresses counts the DevAddrs in the prefix that are assigned to one or more devices
+func (s *RedisDeviceStore) CountAddresses(prefix types.DevAddrPrefix) (int, error) {
+	if err := s.initDevAddrPool(); err != nil {
+		return 0, err
+	}
+	first := prefix.DevAddr.Mask(prefix.Length)
+	last := types.DevAddr{0xff, 0xff, 0xff, 0xff}.WithPrefix(prefix)
+	count, err := s.client.ZCount(s.devAddrPool, fmt.Sprint(poolScore(first)), fmt.Sprint(poolScore(last))).Result()
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// poolMember returns the DevAddr as it is stored in the DevAddr pool. The score is the DevAddr as a number, so that the
+// DevAddrs in a prefix are a range of scores.
+func poolMember(devAddr types.DevAddr) redis.Z {
+	return redis.Z{Score: float64(poolScore(devAddr)), Member: devAddr.String()}
+}
+
+func poolScore(devAddr types.DevAddr) uint32 {
+	return binary.BigEndian.Uint32(devAddr[:])
+}
+
+func poolDevAddr(score uint32) (devAddr types.DevAddr) {
+	binary.BigEndian.PutUint32(devAddr[:], score)
+	return
+}
+
+// initDevAddrPool adds the DevAddrs of devices that were stored before the DevAddr pool was introduced. Because Set
+// also adds DevAddrs to the pool, a separate key records that the migration was done. If the migration fails, it is
+// retried on the next call.
+func (s *RedisDeviceStore) initDevAddrPool() error {
+	s.devAddrPoolMu.Lock()
+	defer s.devAddrPoolMu.Unlock()
+	if s.devAddrPoolReady {
+		return nil
+	}
+	migrated, err := s.client.Exists(s.devAddrPool + ":" + redisDevAddrPoolMigratedSuffix).Result()
+	if err != nil {
+		return err
+	}
+	if !migrated {
+		index, err := s.devAddrIndex.List("", nil)
+		if err != nil {
+			return err
+		}
+		members := make([]redis.Z, 0, len(index))
+		for key, devices := range index {
+			devAddr, err := types.ParseDevAddr(key)
+			if err != nil || len(devices) == 0 {
+				continue
+			}
+			members = append(members, poolMember(devAddr))
+		}
+		if len(members) > 0 {
+			if err := s.client.ZAdd(s.devAddrPool, members...).Err(); err != nil {
+				return err
+			}
+		}
+		if err := s.client.Set(s.devAddrPool+":"+redisDevAddrPoolMigratedSuffix, time.Now().UTC().Format(time.RFC3339), 0).Err(); err != nil {
+			return err
+		}
+	}
+	s.devAddrPoolReady = true
+	return nil
+}
+
+// ReserveAddress reserves a DevAddr that is not assigned to any device for the given duration, so that it is not
+// allocated twice before the device that it was allocated to is stored. It returns false if the DevAddr is already
+// assigned or reserved.
+func (s *RedisDeviceStore) ReserveAddress(devAddr types.DevAddr, duration time.Duration) (bool, error) {
+	if err := s.initDevAddrPool(); err != nil {
+		return false, err
+	}
+	_, err := s.client.ZScore(s.devAddrPool, devAddr.String()).Result()
+	if err == nil {
+		return false, nil
+	}
+	if err != redis.Nil {
+		return false, err
+	}
+	return s.client.SetNX(s.devAddrPool+":"+redisDevAddrReservationPrefix+":"+devAddr.String(), "", duration).Result()
+}
+
+// AllocateAddress reserves a DevAddr in the prefix that is not assigned to any device for the given duration. The
+// search starts at a random DevAddr in the prefix and walks the gaps between the assigned DevAddrs, wrapping around to
+// the start of the prefix. It returns false if all DevAddrs in the prefix are assigned or reserved.
+func (s *RedisDeviceStore) AllocateAddress(prefix types.DevAddrPrefix, duration time.Duration) (types.DevAddr, bool, error) {
+	if err := s.initDevAddrPool(); err != nil {
+		return types.DevAddr{}, false, err
+	}
+	first := poolScore(prefix.DevAddr.Mask(prefix.Length))
+	last := poolScore(types.DevAddr{0xff, 0xff, 0xff, 0xff}.WithPrefix(prefix))
+
+	var start types.DevAddr
+	pseudorandom.FillBytes(start[:])
+	from := poolScore(start.WithPrefix(prefix))
+
+	devAddr, reserved, err := s.allocateAddressBetween(from, last, duration)
+	if err != nil || reserved || from == first {
+		return devAddr, reserved, err
+	}
+	return s.allocateAddressBetween(first, from-1, duration)
+}
+
+// allocateAddressBetween reserves the first DevAddr between min and max (inclusive) that is not in the DevAddr pool
+func (s *RedisDeviceStore) allocateAddressBetween(min, max uint32, duration time.Duration) (types.DevAddr, bool, error) {
+	candidate := uint64(min)
+	reserve := func(until uint64) (types.DevAddr, bool, error) {
+		for ; candidate < until; candidate++ {
+			devAddr := poolDevAddr(uint32(candidate))
+			reserved, err := s.ReserveAddress(devAddr, duration)
+			if err != nil || reserved {
+				return devAddr, reserved, err
+			}
+		}
+		return types.DevAddr{}, false, nil
+	}
+	for candidate <= uint64(max) {
+		assigned, err := s.client.ZRangeByScoreWithScores(s.devAddrPool, redis.ZRangeBy{
+			Min:   fmt.Sprint(candidate),
+			Max:   fmt.Sprint(max),
+			Count: devAddrPoolBatchSize,
+		}).Result()
+		if err != nil {
+			return types.DevAddr{}, false, err
+		}
+		for _, member := range assigned {
+			score := uint64(member.Score)
+			if devAddr, reserved, err := reserve(score); err != nil || reserved {
+				return devAddr, reserved, err
+			}
+			candidate = score + 1
+		}
+		if len(assigned) < devAddrPoolBatchSize {
+			break
+		}
+	}
+	// The DevAddrs after the last assigned DevAddr are free
+	return reserve(uint64(max) + 1)
+}
+
+// assignAddress adds the DevAddr to the DevAddr pool
+func (s *RedisDeviceStore) assignAddress(devAddr types.DevAddr) error {
+	return s.client.ZAdd(s.devAddrPool, poolMember(devAddr)).Err()
+}
+
+// releaseAddress removes the DevAddr from the DevAddr pool if it is no longer assigned to any device. The DevAddr index
+// is watched, so that the DevAddr is not removed if it is assigned to a device in the meantime.
+func (s *RedisDeviceStore) releaseAddress(devAddr types.DevAddr) (err error) {
+	indexKey := s.prefix + ":" + redisDevAddrPrefix + ":" + devAddr.String()
+	for i := 0; i < devAddrPoolRetries; i++ {
+		err = s.client.Watch(func(tx *redis.Tx) error {
+			count, err := tx.SCard(indexKey).Result()
+			if err != nil || count > 0 {
+				return err
+			}
+			_, err = tx.Pipelined(func(pipe *redis.Pipeline) error {
+				pipe.ZRem(s.devAddrPool, devAddr.String())
+				return nil
+			})
+			return err
+		}, indexKey)
+		if err != redis.TxFailedErr {
+			return err
+		}
+	}
+	return err
+}
+
 // Get a specific Device
 func (s *RedisDeviceStore) Get(appEUI types.AppEUI, devEUI types.DevEUI) (*Device, error) {
 	deviceI, err := s.store.Get(s.key(appEUI, devEUI))
@@ -129,15 +331,68 @@ func (s *RedisDeviceStore) Get(appEUI types.AppEUI, devEUI types.DevEUI) (*Devic
 	return nil, errors.New("Database did not return a Device")
 }
 
+// Identifier of a device
+type Identifier struct {
+	AppEUI types.AppEUI
+	DevEUI types.DevEUI
+}
+
+// GetAll gets the devices with the given identifiers in a single round-trip. Devices that do not exist are omitted.
+func (s *RedisDeviceStore) GetAll(ids []Identifier) ([]*Device, error) {
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = s.key(id.AppEUI, id.DevEUI)
+	}
+	devicesI, err := s.store.GetAll(keys, nil)
+	if err != nil {
+		return nil, err
+	}
+	devices := make([]*Device, 0, len(devicesI))
+	for _, deviceI := range devicesI {
+		if device, ok := deviceI.(Device); ok && !device.AppEUI.IsEmpty() {
+			devices = append(devices, &device)
+		}
+	}
+	return devices, nil
+}
+
+// addresses returns the DevAddrs of the active and pending session of the device
+func (d *Device) addresses() (addresses []types.DevAddr) {
+	for _, devAddr := range []types.DevAddr{d.DevAddr, d.PendingSession.DevAddr} {
+		if !devAddr.IsEmpty() && !containsAddress(addresses, devAddr) {
+			addresses = append(addresses, devAddr)
+		}
+	}
+	return
+}
+
+func containsAddress(addresses []types.DevAddr, devAddr types.DevAddr) bool {
+	for _, address := range addresses {
+		if address == devAddr {
+			return true
+		}
+	}
+	return false
+}
+
 // Set a new Device or update an existing one
 func (s *RedisDeviceStore) Set(new *Device, properties ...string) (err error) {
-	// If this is an update, check if AppEUI, DevEUI and DevAddr are still the same
+	// If this is an update, check if AppEUI, DevEUI and DevAddrs are still the same
 	old := new.old
-	var addrChanged bool
+	newAddresses := new.addresses()
+	var oldAddresses []types.DevAddr
+	var keyChanged bool
 	if old != nil {
-		addrChanged = new.DevAddr != old.DevAddr || new.DevEUI != old.DevEUI || new.AppEUI != old.AppEUI
-		if addrChanged {
-			if err := s.devAddrIndex.Remove(old.DevAddr.String(), s.key(old.AppEUI, old.DevEUI)); err != nil {
+		oldAddresses = old.addresses()
+		keyChanged = new.DevEUI != old.DevEUI || new.AppEUI != old.AppEUI
+		for _, devAddr := range oldAddresses {
+			if !keyChanged && containsAddress(newAddresses, devAddr) {
+				continue
+			}
+			if err := s.devAddrIndex.Remove(devAddr.String(), s.key(old.AppEUI, old.DevEUI)); err != nil {
+				return err
+			}
+			if err := s.releaseAddress(devAddr); err != nil {
 				return err
 			}
 		}
@@ -154,8 +409,14 @@ func (s *RedisDeviceStore) Set(new *Device, properties ...string) (err error) {
 		return
 	}
 
-	if (new.old == nil || addrChanged) && !new.DevAddr.IsEmpty() {
-		if err := s.devAddrIndex.Add(new.DevAddr.String(), key); err != nil {
+	for _, devAddr := range newAddresses {
+		if old != nil && !keyChanged && containsAddress(oldAddresses, devAddr) {
+			continue
+		}
+		if err := s.devAddrIndex.Add(devAddr.String(), key); err != nil {
+			return err
+		}
+		if err := s.assignAddress(devAddr); err != nil {
 			return err
 		}
 	}
@@ -167,7 +428,7 @@ func (s *RedisDeviceStore) Set(new *Device, properties ...string) (err error) {
 func (s *RedisDeviceStore) Delete(appEUI types.AppEUI, devEUI types.DevEUI) error {
 	key := s.key(appEUI, devEUI)
 
-	deviceI, err := s.store.GetFields(key, "dev_addr")
+	deviceI, err := s.store.GetFields(key, "dev_addr", "pending_dev_addr")
 	if err != nil {
 		return err
 	}
@@ -177,8 +438,11 @@ func (s *RedisDeviceStore) Delete(appEUI types.AppEUI, devEUI types.DevEUI) erro
 		errors.New("Database did not return a Device")
 	}
 
-	if !device.DevAddr.IsEmpty() {
-		if err := s.devAddrIndex.Remove(device.DevAddr.String(), key); err != nil {
+	for _, devAddr := range device.addresses() {
+		if err := s.devAddrIndex.Remove(devAddr.String(), key); err != nil {
+			return err
+		}
+		if err := s.releaseAddress(devAddr); err != nil {
 			return err
 		}
 	}
@@ -186,6 +450,54 @@ func (s *RedisDeviceStore) Delete(appEUI types.AppEUI, devEUI types.DevEUI) erro
 	return s.store.Delete(key)
 }
 
+// ReserveFCntDown reserves the FCntDown of the device for a downlink message, and increments it. The incremented
+// FCntDown is stored immediately, so that the FCntDown is never used twice, even if the device can not be stored after
+// the downlink message. An error is returned if the FCntDown was already used.
+func (s *RedisDeviceStore) ReserveFCntDown(dev *Device) error {
+	if dev.FCntDown == math.MaxUint32 {
+		return errors.NewErrInvalidArgument("FCntDown", "can not be incremented, the device should rejoin")
+	}
+	key := s.prefix + ":" + redisDevicePrefix + ":" + s.key(dev.AppEUI, dev.DevEUI)
+	next := dev.FCntDown + 1
+	err := s.client.Watch(func(tx *redis.Tx) error {
+		exists, err := tx.Exists(key).Result()
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.NewErrNotFound(key)
+		}
+		stored, err := tx.HGet(key, "f_cnt_down").Result()
+		if err != nil && err != redis.Nil {
+			return err
+		}
+		if stored != "" {
+			storedFCntDown, err := strconv.ParseUint(stored, 10, 32)
+			if err != nil {
+				return err
+			}
+			if uint64(dev.FCntDown) < storedFCntDown {
+				return errors.NewErrInvalidArgument("FCntDown", fmt.Sprintf("%d was already used", dev.FCntDown))
+			}
+		}
+		_, err = tx.Pipelined(func(pipe *redis.Pipeline) error {
+			pipe.HSet(key, "f_cnt_down", strconv.FormatUint(uint64(next), 10))
+			return nil
+		})
+		return err
+	}, key)
+	if err != nil {
+		return err
+	}
+
+	// The FCntDown is already stored, the next Set should not overwrite a newer reservation
+	dev.FCntDown = next
+	if dev.old != nil {
+		dev.old.FCntDown = next
+	}
+	return nil
+}
+
 // Frames history for a specific Device
 func (s *RedisDeviceStore) Frames(appEUI types.AppEUI, devEUI types.DevEUI) (FrameHistory, error) {
 	return &RedisFrameHistory{
@@ -194,3 +506,14 @@ func (s *RedisDeviceStore) Frames(appEUI types.AppEUI, devEUI types.DevEUI) (Fra
 		store:  s.frameStore,
 	}, nil
 }
+
+// MACCommands that are pending for a specific Device
+func (s *RedisDeviceStore) MACCommands(appEUI types.AppEUI, devEUI types.DevEUI) (MACCommandQueue, error) {
+	return &RedisMACCommandQueue{
+		appEUI: appEUI,
+		devEUI: devEUI,
+		client: s.client,
+		prefix: s.prefix + ":" + redisMACCommandsPrefix + ":",
+		store:  s.macCommandStore,
+	}, nil
+}
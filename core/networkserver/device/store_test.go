@@ -5,6 +5,7 @@ package device
 
 import (
 	"testing"
+	"time"
 
 	"github.com/TheThingsNetwork/ttn/core/types"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
@@ -14,6 +15,8 @@ import (
 func TestDeviceStore(t *testing.T) {
 	a := New(t)
 
+	prefix := types.DevAddrPrefix{DevAddr: types.DevAddr{0, 0, 0, 0}, Length: 30}
+
 	NewRedisDeviceStore(GetRedisClient(), "")
 
 	s := NewRedisDeviceStore(GetRedisClient(), "networkserver-test-device-store")
@@ -66,6 +69,10 @@ func TestDeviceStore(t *testing.T) {
 	a.So(err, ShouldBeNil)
 	a.So(res, ShouldHaveLength, 0)
 
+	count, err = s.CountAddresses(prefix)
+	a.So(err, ShouldBeNil)
+	a.So(count, ShouldEqual, 1)
+
 	// Existing Device, New DevAddr
 	err = s.Set(&Device{
 		old: &Device{
@@ -94,6 +101,10 @@ func TestDeviceStore(t *testing.T) {
 	a.So(err, ShouldBeNil)
 	a.So(res, ShouldHaveLength, 1)
 
+	count, err = s.CountAddresses(prefix)
+	a.So(err, ShouldBeNil)
+	a.So(count, ShouldEqual, 2)
+
 	s.Set(&Device{
 		old: &Device{
 			DevAddr: types.DevAddr{0, 0, 0, 1},
@@ -113,6 +124,11 @@ func TestDeviceStore(t *testing.T) {
 	a.So(err, ShouldBeNil)
 	a.So(res, ShouldHaveLength, 2)
 
+	// DevAddr 0.0.0.1 is released
+	count, err = s.CountAddresses(prefix)
+	a.So(err, ShouldBeNil)
+	a.So(count, ShouldEqual, 1)
+
 	dev, err = s.Get(types.AppEUI{0, 0, 0, 0, 0, 0, 0, 2}, types.DevEUI{0, 0, 0, 0, 0, 0, 0, 2})
 	a.So(err, ShouldNotBeNil)
 	a.So(dev, ShouldBeNil)
@@ -137,6 +153,11 @@ func TestDeviceStore(t *testing.T) {
 	a.So(err, ShouldBeNil)
 	a.So(res, ShouldHaveLength, 1)
 
+	// DevAddr 0.0.0.3 is still assigned to the other device
+	count, err = s.CountAddresses(prefix)
+	a.So(err, ShouldBeNil)
+	a.So(count, ShouldEqual, 1)
+
 	// Empty DevEUI
 	defer func() {
 		s.Delete(types.AppEUI{0, 0, 0, 0, 0, 0, 0, 3}, types.DevEUI{})
@@ -149,3 +170,127 @@ func TestDeviceStore(t *testing.T) {
 	})
 	a.So(err, ShouldBeNil)
 }
+
+func TestReserveFCntDown(t *testing.T) {
+	a := New(t)
+
+	s := NewRedisDeviceStore(GetRedisClient(), "networkserver-test-reserve-f-cnt-down")
+
+	appEUI, devEUI := types.AppEUI{0, 0, 0, 0, 0, 0, 0, 1}, types.DevEUI{0, 0, 0, 0, 0, 0, 0, 1}
+
+	// Unknown device
+	err := s.ReserveFCntDown(&Device{AppEUI: appEUI, DevEUI: devEUI})
+	a.So(err, ShouldNotBeNil)
+
+	s.Set(&Device{AppEUI: appEUI, DevEUI: devEUI, FCntDown: 5})
+	defer func() {
+		s.Delete(appEUI, devEUI)
+	}()
+
+	dev, _ := s.Get(appEUI, devEUI)
+	other, _ := s.Get(appEUI, devEUI)
+	dev.StartUpdate()
+	other.StartUpdate()
+
+	// The FCntDown is stored immediately
+	err = s.ReserveFCntDown(dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.FCntDown, ShouldEqual, 6)
+	stored, _ := s.Get(appEUI, devEUI)
+	a.So(stored.FCntDown, ShouldEqual, 6)
+
+	// The FCntDown can not be reserved twice
+	err = s.ReserveFCntDown(other)
+	a.So(err, ShouldNotBeNil)
+	a.So(other.FCntDown, ShouldEqual, 5)
+
+	// Storing the device does not overwrite a newer reservation
+	err = s.ReserveFCntDown(stored)
+	a.So(err, ShouldBeNil)
+	dev.FCntUp = 1
+	s.Set(dev)
+	stored, _ = s.Get(appEUI, devEUI)
+	a.So(stored.FCntDown, ShouldEqual, 7)
+
+	// The FCntDown can not roll over
+	stored.FCntDown = 0xffffffff
+	err = s.ReserveFCntDown(stored)
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestReserveAddress(t *testing.T) {
+	a := New(t)
+	client := GetRedisClient()
+	s := NewRedisDeviceStore(client, "networkserver-test-reserve-address")
+
+	appEUI := types.AppEUI{0, 0, 0, 0, 0, 0, 0, 1}
+	devEUI := types.DevEUI{0, 0, 0, 0, 0, 0, 0, 1}
+	assigned := types.DevAddr{0, 0, 1, 1}
+	free := types.DevAddr{0, 0, 1, 2}
+	defer func() {
+		s.Delete(appEUI, devEUI)
+		client.Del("networkserver-test-reserve-address:dev_addr_pool:reserved:" + free.String())
+	}()
+	err := s.Set(&Device{AppEUI: appEUI, DevEUI: devEUI, DevAddr: assigned})
+	a.So(err, ShouldBeNil)
+
+	// A DevAddr that is assigned to a device can not be reserved
+	reserved, err := s.ReserveAddress(assigned, time.Minute)
+	a.So(err, ShouldBeNil)
+	a.So(reserved, ShouldBeFalse)
+
+	// A DevAddr can only be reserved once
+	reserved, err = s.ReserveAddress(free, time.Minute)
+	a.So(err, ShouldBeNil)
+	a.So(reserved, ShouldBeTrue)
+	reserved, err = s.ReserveAddress(free, time.Minute)
+	a.So(err, ShouldBeNil)
+	a.So(reserved, ShouldBeFalse)
+}
+
+func TestAllocateAddress(t *testing.T) {
+	a := New(t)
+	client := GetRedisClient()
+	s := NewRedisDeviceStore(client, "networkserver-test-allocate-address")
+
+	prefix := types.DevAddrPrefix{DevAddr: types.DevAddr{0, 0, 4, 0}, Length: 30}
+	free := types.DevAddr{0, 0, 4, 2}
+	appEUI := types.AppEUI{0, 0, 0, 0, 0, 0, 0, 1}
+	for i, devAddr := range []types.DevAddr{{0, 0, 4, 0}, {0, 0, 4, 1}, {0, 0, 4, 3}} {
+		devEUI := types.DevEUI{0, 0, 0, 0, 0, 0, 4, byte(i)}
+		a.So(s.Set(&Device{AppEUI: appEUI, DevEUI: devEUI, DevAddr: devAddr}), ShouldBeNil)
+		defer s.Delete(appEUI, devEUI)
+	}
+	defer client.Del("networkserver-test-allocate-address:dev_addr_pool:reserved:" + free.String())
+
+	// The only DevAddr that is not assigned is allocated
+	devAddr, reserved, err := s.AllocateAddress(prefix, time.Minute)
+	a.So(err, ShouldBeNil)
+	a.So(reserved, ShouldBeTrue)
+	a.So(devAddr, ShouldEqual, free)
+
+	// The prefix is full
+	_, reserved, err = s.AllocateAddress(prefix, time.Minute)
+	a.So(err, ShouldBeNil)
+	a.So(reserved, ShouldBeFalse)
+}
+
+func TestGetAll(t *testing.T) {
+	a := New(t)
+	s := NewRedisDeviceStore(GetRedisClient(), "networkserver-test-get-all")
+
+	appEUI := types.AppEUI{0, 0, 0, 0, 0, 0, 0, 1}
+	for i := byte(1); i <= 2; i++ {
+		devEUI := types.DevEUI{0, 0, 0, 0, 0, 0, 0, i}
+		s.Set(&Device{AppEUI: appEUI, DevEUI: devEUI, DevAddr: types.DevAddr{0, 0, 2, i}})
+		defer s.Delete(appEUI, devEUI)
+	}
+
+	devices, err := s.GetAll([]Identifier{
+		{AppEUI: appEUI, DevEUI: types.DevEUI{0, 0, 0, 0, 0, 0, 0, 1}},
+		{AppEUI: appEUI, DevEUI: types.DevEUI{0, 0, 0, 0, 0, 0, 0, 2}},
+		{AppEUI: appEUI, DevEUI: types.DevEUI{0, 0, 0, 0, 0, 0, 0, 3}},
+	})
+	a.So(err, ShouldBeNil)
+	a.So(devices, ShouldHaveLength, 2)
+}
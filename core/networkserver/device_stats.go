@@ -0,0 +1,111 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"sort"
+
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+)
+
+// percentiles returns the min, 10th percentile, median, 90th percentile and max of the values (nearest rank)
+func percentiles(values []float32) *pb_lorawan.DeviceStats_Percentiles {
+	if len(values) == 0 {
+		return nil
+	}
+	sorted := make([]float64, len(values))
+	for i, value := range values {
+		sorted[i] = float64(value)
+	}
+	sort.Float64s(sorted)
+	rank := func(p int) float32 {
+		idx := (p*len(sorted)+99)/100 - 1
+		if idx < 0 {
+			idx = 0
+		}
+		return float32(sorted[idx])
+	}
+	return &pb_lorawan.DeviceStats_Percentiles{
+		Min:    float32(sorted[0]),
+		P10:    rank(10),
+		Median: rank(50),
+		P90:    rank(90),
+		Max:    float32(sorted[len(sorted)-1]),
+	}
+}
+
+// deviceStats aggregates the frame history (newest first) of a device into link quality statistics
+func deviceStats(frames []*device.Frame) *pb_lorawan.DeviceStats {
+	stats := new(pb_lorawan.DeviceStats)
+	if len(frames) == 0 {
+		return stats
+	}
+
+	stats.Frames = uint32(len(frames))
+	stats.FirstFCnt = frames[len(frames)-1].FCnt
+	stats.LastFCnt = frames[0].FCnt
+
+	// Packet loss can only be determined if the frame counter did not reset within the history
+	if stats.LastFCnt >= stats.FirstFCnt {
+		received := make(map[uint32]bool)
+		for _, frame := range frames {
+			received[frame.FCnt] = true
+		}
+		sent := stats.LastFCnt - stats.FirstFCnt + 1
+		if lost := int64(sent) - int64(len(received)); lost > 0 {
+			stats.PacketLoss = float32(lost) / float32(sent)
+		}
+	}
+
+	snr := make([]float32, 0, len(frames))
+	rssi := make([]float32, 0, len(frames))
+	var gatewayCount uint32
+	gateways := make(map[string]uint32)
+	dataRates := make(map[string]uint32)
+	frequencies := make(map[uint64]uint32)
+	for _, frame := range frames {
+		snr = append(snr, frame.SNR)
+		if frame.RSSI != 0 {
+			rssi = append(rssi, frame.RSSI)
+		}
+		gatewayCount += frame.GatewayCount
+		for _, gatewayID := range frame.GatewayIDs {
+			gateways[gatewayID]++
+		}
+		if frame.DataRate != "" {
+			dataRates[frame.DataRate]++
+		}
+		if frame.Frequency != 0 {
+			frequencies[frame.Frequency]++
+		}
+	}
+	stats.Snr = percentiles(snr)
+	stats.Rssi = percentiles(rssi)
+	stats.AverageGateways = float32(gatewayCount) / float32(len(frames))
+
+	for gatewayID, count := range gateways {
+		stats.Gateways = append(stats.Gateways, &pb_lorawan.DeviceStats_Gateway{GatewayId: gatewayID, Frames: count})
+	}
+	sort.Slice(stats.Gateways, func(i, j int) bool {
+		if stats.Gateways[i].Frames != stats.Gateways[j].Frames {
+			return stats.Gateways[i].Frames > stats.Gateways[j].Frames
+		}
+		return stats.Gateways[i].GatewayId < stats.Gateways[j].GatewayId
+	})
+	for dataRate, count := range dataRates {
+		stats.DataRates = append(stats.DataRates, &pb_lorawan.DeviceStats_DataRate{DataRate: dataRate, Frames: count})
+	}
+	sort.Slice(stats.DataRates, func(i, j int) bool {
+		return stats.DataRates[i].DataRate < stats.DataRates[j].DataRate
+	})
+	for frequency, count := range frequencies {
+		stats.Frequencies = append(stats.Frequencies, &pb_lorawan.DeviceStats_Frequency{Frequency: frequency, Frames: count})
+	}
+	sort.Slice(stats.Frequencies, func(i, j int) bool {
+		return stats.Frequencies[i].Frequency < stats.Frequencies[j].Frequency
+	})
+
+	return stats
+}
@@ -0,0 +1,48 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDeviceStats(t *testing.T) {
+	a := New(t)
+
+	a.So(deviceStats(nil).Frames, ShouldEqual, 0)
+
+	frames := []*device.Frame{
+		{FCnt: 10, SNR: 5, RSSI: -80, GatewayCount: 2, GatewayIDs: []string{"gw-a", "gw-b"}, DataRate: "SF7BW125", Frequency: 868100000},
+		{FCnt: 10, SNR: 3, RSSI: -90, GatewayCount: 1, GatewayIDs: []string{"gw-a"}, DataRate: "SF7BW125", Frequency: 868100000},
+		{FCnt: 8, SNR: -2, RSSI: -110, GatewayCount: 1, GatewayIDs: []string{"gw-a"}, DataRate: "SF9BW125", Frequency: 868300000},
+		{FCnt: 7, SNR: 1, GatewayCount: 2, GatewayIDs: []string{"gw-b", "gw-c"}, DataRate: "SF9BW125", Frequency: 868500000},
+	}
+
+	stats := deviceStats(frames)
+	a.So(stats.Frames, ShouldEqual, 4)
+	a.So(stats.FirstFCnt, ShouldEqual, 7)
+	a.So(stats.LastFCnt, ShouldEqual, 10)
+	a.So(stats.PacketLoss, ShouldEqual, 0.25) // FCnt 9 is missing, the duplicate of FCnt 10 does not count
+	a.So(stats.Snr, ShouldResemble, &pb_lorawan.DeviceStats_Percentiles{Min: -2, P10: -2, Median: 1, P90: 5, Max: 5})
+	a.So(stats.Rssi.Min, ShouldEqual, -110) // Frames without RSSI are ignored
+	a.So(stats.Rssi.Max, ShouldEqual, -80)
+	a.So(stats.AverageGateways, ShouldEqual, 1.5)
+	a.So(stats.Gateways, ShouldResemble, []*pb_lorawan.DeviceStats_Gateway{
+		{GatewayId: "gw-a", Frames: 3},
+		{GatewayId: "gw-b", Frames: 2},
+		{GatewayId: "gw-c", Frames: 1},
+	})
+	a.So(stats.DataRates, ShouldHaveLength, 2)
+	a.So(stats.Frequencies, ShouldHaveLength, 3)
+	a.So(stats.Frequencies[0].Frequency, ShouldEqual, 868100000)
+	a.So(stats.Frequencies[0].Frames, ShouldEqual, 2)
+
+	// Frame counter reset
+	stats = deviceStats([]*device.Frame{{FCnt: 1}, {FCnt: 100}})
+	a.So(stats.PacketLoss, ShouldEqual, 0)
+}
@@ -0,0 +1,71 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"time"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/utils/gpstime"
+	"github.com/brocaar/lorawan"
+)
+
+// deviceTimeReq is the CID of the DeviceTimeReq and DeviceTimeAns MAC commands (LoRaWAN 1.0.3)
+const deviceTimeReq = lorawan.CID(0x0D)
+
+// Sources of the time in a DeviceTimeAns
+const (
+	deviceTimeSourceGPS     = "gps"     // GPS time of a trusted gateway
+	deviceTimeSourceGateway = "gateway" // Time of a trusted gateway
+	deviceTimeSourceServer  = "server"  // Time at which the Broker received the uplink
+)
+
+// uplinkTime returns the time at which the uplink was received and the source of that time. The GPS time of trusted
+// gateways is preferred over the time of trusted gateways, which is preferred over the server time.
+func uplinkTime(message *pb_broker.DeduplicatedUplinkMessage) (time.Time, string) {
+	var gatewayTime int64
+	for _, gateway := range message.GetGatewayMetadata() {
+		if !gateway.GatewayTrusted {
+			continue
+		}
+		if gpsTime := gateway.GetGps().GetTime(); gpsTime != 0 {
+			return time.Unix(0, gpsTime), deviceTimeSourceGPS
+		}
+		if gatewayTime == 0 {
+			gatewayTime = gateway.Time
+		}
+	}
+	if gatewayTime != 0 {
+		return time.Unix(0, gatewayTime), deviceTimeSourceGateway
+	}
+	if message.ServerTime != 0 {
+		return time.Unix(0, message.ServerTime), deviceTimeSourceServer
+	}
+	return time.Now(), deviceTimeSourceServer
+}
+
+// marshalDeviceTimeAns returns the payload of a DeviceTimeAns: the seconds since the GPS epoch and the fractional
+// second in 1/256 seconds
+func marshalDeviceTimeAns(gps time.Duration) []byte {
+	seconds := uint32(gps / time.Second)
+	fraction := byte((gps % time.Second) * 256 / time.Second)
+	return []byte{byte(seconds), byte(seconds >> 8), byte(seconds >> 16), byte(seconds >> 24), fraction}
+}
+
+// handleUplinkDeviceTime answers a DeviceTimeReq with the GPS time at which the uplink was received
+func (n *networkServer) handleUplinkDeviceTime(message *pb_broker.DeduplicatedUplinkMessage) {
+	t, source := uplinkTime(message)
+	message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "device-time",
+		"source", source,
+		"time", t,
+	)
+	if lorawanDownlinkMac := message.GetResponseTemplate().GetMessage().GetLorawan().GetMacPayload(); lorawanDownlinkMac != nil {
+		lorawanDownlinkMac.FOpts = append(lorawanDownlinkMac.FOpts, pb_lorawan.MACCommand{
+			Cid:     uint32(deviceTimeReq),
+			Payload: marshalDeviceTimeAns(gpstime.ToGPS(t)),
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/utils/gpstime"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestUplinkTime(t *testing.T) {
+	a := New(t)
+
+	gpsTime := time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+	gatewayTime := gpsTime.Add(time.Millisecond)
+	serverTime := gpsTime.Add(time.Second)
+
+	message := adrInitUplinkMessage()
+	message.ServerTime = serverTime.UnixNano()
+	message.GatewayMetadata = []*pb_gateway.RxMetadata{
+		&pb_gateway.RxMetadata{Time: gatewayTime.UnixNano(), Gps: &pb_gateway.GPSMetadata{Time: gpsTime.UnixNano()}},
+	}
+
+	// Untrusted gateways are ignored
+	tm, source := uplinkTime(message)
+	a.So(tm, ShouldHappenOnOrBetween, serverTime, serverTime)
+	a.So(source, ShouldEqual, deviceTimeSourceServer)
+
+	message.GatewayMetadata = append(message.GatewayMetadata, &pb_gateway.RxMetadata{GatewayTrusted: true, Time: gatewayTime.UnixNano()})
+	tm, source = uplinkTime(message)
+	a.So(tm, ShouldHappenOnOrBetween, gatewayTime, gatewayTime)
+	a.So(source, ShouldEqual, deviceTimeSourceGateway)
+
+	message.GatewayMetadata[0].GatewayTrusted = true
+	tm, source = uplinkTime(message)
+	a.So(tm, ShouldHappenOnOrBetween, gpsTime, gpsTime)
+	a.So(source, ShouldEqual, deviceTimeSourceGPS)
+}
+
+func TestMarshalDeviceTimeAns(t *testing.T) {
+	a := New(t)
+	a.So(marshalDeviceTimeAns(0x01020304*time.Second+500*time.Millisecond), ShouldResemble, []byte{0x04, 0x03, 0x02, 0x01, 0x80})
+	a.So(marshalDeviceTimeAns(10*time.Second+time.Millisecond), ShouldResemble, []byte{0x0a, 0, 0, 0, 0})
+}
+
+func TestHandleDeviceTime(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		Component: &component.Component{
+			Ctx: GetLogger(t, "TestHandleDeviceTime"),
+		},
+	}
+
+	serverTime := time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+	message := adrInitUplinkMessage()
+	message.ServerTime = serverTime.UnixNano()
+	ns.handleUplinkDeviceTime(message)
+	fOpts := message.GetResponseTemplate().GetMessage().GetLorawan().GetMacPayload().FOpts
+	a.So(fOpts, ShouldHaveLength, 1)
+	a.So(fOpts[0], ShouldResemble, pb_lorawan.MACCommand{
+		Cid:     uint32(deviceTimeReq),
+		Payload: marshalDeviceTimeAns(gpstime.ToGPS(serverTime)),
+	})
+}
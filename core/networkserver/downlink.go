@@ -4,20 +4,19 @@
 package networkserver
 
 import (
+	"time"
+
+	"github.com/TheThingsNetwork/go-utils/log"
 	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/api/trace"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"github.com/brocaar/lorawan"
 )
 
 func (n *networkServer) HandleDownlink(message *pb_broker.DownlinkMessage) (*pb_broker.DownlinkMessage, error) {
-	err := message.UnmarshalPayload()
-	if err != nil {
-		return nil, err
-	}
-	lorawanDownlinkMac := message.Message.GetLorawan().GetMacPayload()
-	if lorawanDownlinkMac == nil {
-		return nil, errors.NewErrInvalidArgument("Downlink", "does not contain a MAC payload")
+	if message.AppEui == nil || message.DevEui == nil {
+		return nil, errors.NewErrInvalidArgument("Downlink", "does not contain AppEUI and DevEUI")
 	}
 
 	n.status.downlink.Mark(1)
@@ -32,6 +31,33 @@ func (n *networkServer) HandleDownlink(message *pb_broker.DownlinkMessage) (*pb_
 		return nil, errors.NewErrInvalidArgument("Downlink", "AppID and DevID do not match AppEUI and DevEUI")
 	}
 
+	_, err = n.applyProfiles(dev)
+	if err != nil {
+		return nil, err
+	}
+
+	// Downlink that is not a response to an uplink
+	withoutUplink := message.DownlinkOption == nil
+	if withoutUplink {
+		if dev.Options.Class == pb_lorawan.DeviceClass_CLASS_B {
+			message.DownlinkOption, err = classBDownlinkOption(dev, time.Now())
+		} else {
+			message.DownlinkOption, err = classCDownlinkOption(dev)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = message.UnmarshalPayload()
+	if err != nil {
+		return nil, err
+	}
+	lorawanDownlinkMac := message.Message.GetLorawan().GetMacPayload()
+	if lorawanDownlinkMac == nil {
+		return nil, errors.NewErrInvalidArgument("Downlink", "does not contain a MAC payload")
+	}
+
 	message.Trace = message.Trace.WithEvent(trace.UpdateStateEvent)
 
 	dev.StartUpdate()
@@ -49,13 +75,59 @@ func (n *networkServer) HandleDownlink(message *pb_broker.DownlinkMessage) (*pb_
 		return nil, errors.NewErrInvalidArgument("Downlink", "DevAddr does not match device")
 	}
 
+	// The Handler encrypted the payload of a downlink that is not a response to an uplink with the FCnt that it expected
+	nextFCntDown := dev.FCntDown
+	if withoutUplink {
+		dev.FCntDown, err = handlerFCnt(dev, lorawanDownlinkMac.FCnt)
+		if err != nil {
+			return nil, err
+		}
+		message.DownlinkOption.ProtocolConfig.GetLorawan().FCnt = dev.FCntDown
+	}
+
 	err = n.handleDownlinkMAC(message, dev)
 	if err != nil {
 		return nil, err
 	}
 
+	if anomaly := downlinkFCntAnomaly(dev, nextFCntDown, dev.FCntDown); anomaly != nil {
+		n.Ctx.WithFields(log.Fields{
+			"AppEUI":  dev.AppEUI,
+			"DevEUI":  dev.DevEUI,
+			"Anomaly": anomaly.Type,
+			"FCnt":    anomaly.FCnt,
+		}).Warn("Downlink frame counter anomaly")
+		dev.FCntAnomalies = append(dev.FCntAnomalies, *anomaly)
+	}
+
 	lorawanDownlinkMac.FCnt = dev.FCntDown // Use full 32-bit FCnt for setting MIC
-	dev.FCntDown++                         // TODO: For confirmed downlink, FCntDown should be incremented AFTER ACK
+
+	// The FCntDown is stored before the downlink leaves, so that it is never used twice, even if the device state can
+	// not be stored. TODO: For confirmed downlink, FCntDown should be incremented AFTER ACK
+	err = n.devices.ReserveFCntDown(dev)
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not reserve FCntDown")
+	}
+
+	// MAC commands on FPort 0 are encrypted with the NwkSKey (NwkSEncKey for LoRaWAN 1.1)
+	if lorawanDownlinkMac.FPort == 0 && len(lorawanDownlinkMac.FrmPayload) != 0 {
+		encKey := dev.NwkSKey
+		if dev.Options.MACVersion == pb_lorawan.MACVersion_LORAWAN_1_1 {
+			encKey = dev.Session.NwkSEncKey
+		}
+		lorawanDownlinkMac.FrmPayload, err = lorawan.EncryptFRMPayload(lorawan.AES128Key(encKey), false, lorawan.DevAddr(dev.DevAddr), lorawanDownlinkMac.FCnt, lorawanDownlinkMac.FrmPayload)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if dev.Options.MACVersion == pb_lorawan.MACVersion_LORAWAN_1_1 {
+		message.Payload, err = handleDownlinkSecurity(message, dev)
+		if err != nil {
+			return nil, err
+		}
+		return message, nil
+	}
 
 	phyPayload := message.Message.GetLorawan().PHYPayload()
 	phyPayload.SetMIC(lorawan.AES128Key(dev.NwkSKey))
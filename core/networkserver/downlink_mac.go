@@ -12,5 +12,11 @@ func (n *networkServer) handleDownlinkMAC(message *pb_broker.DownlinkMessage, de
 	if err := n.handleDownlinkADR(message, dev); err != nil {
 		return err
 	}
+	if err := n.handleDownlinkDevStatus(message, dev); err != nil {
+		return err
+	}
+	if err := n.handleDownlinkPendingMAC(message, dev); err != nil {
+		return err
+	}
 	return nil
 }
@@ -0,0 +1,74 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"math"
+
+	"github.com/TheThingsNetwork/go-utils/log"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+)
+
+// fCntGapAnomaly is the number of frames that a frame counter can skip before it is reported as an anomaly
+const fCntGapAnomaly = 1000
+
+// uplinkFCntAnomaly returns the anomaly of the uplink frame counter of the device, or nil if there is none
+func uplinkFCntAnomaly(dev *device.Device, fCnt uint32) *pb_lorawan.FCntAnomaly {
+	previous := dev.FCntUp
+	if previous == 0 || fCnt == previous {
+		return nil // New session or retransmission
+	}
+	anomaly := &pb_lorawan.FCntAnomaly{FCnt: fCnt, PreviousFCnt: previous}
+	switch {
+	case fCnt < previous && dev.Options.Uses32BitFCnt && previous > math.MaxUint32-fCntGapAnomaly && fCnt < fCntGapAnomaly:
+		anomaly.Type = pb_lorawan.FCntAnomaly_ROLLOVER_32
+	case fCnt < previous && !dev.Options.Uses32BitFCnt && previous > math.MaxUint16-fCntGapAnomaly && fCnt < fCntGapAnomaly:
+		anomaly.Type = pb_lorawan.FCntAnomaly_ROLLOVER_16
+	case fCnt < previous:
+		anomaly.Type = pb_lorawan.FCntAnomaly_RESET
+	case fCnt-previous > fCntGapAnomaly:
+		anomaly.Type = pb_lorawan.FCntAnomaly_GAP
+	default:
+		return nil
+	}
+	return anomaly
+}
+
+// downlinkFCntAnomaly returns the anomaly of the downlink frame counter of the device, or nil if there is none. The
+// next is the FCntDown that was stored for the device, the fCnt is the FCntDown of the downlink message.
+func downlinkFCntAnomaly(dev *device.Device, next uint32, fCnt uint32) *pb_lorawan.FCntAnomaly {
+	if !dev.Options.Uses32BitFCnt && next <= math.MaxUint16 && fCnt > math.MaxUint16 {
+		// The device only knows the 16 lsb of the FCntDown, so it will not accept downlink messages anymore
+		return &pb_lorawan.FCntAnomaly{Type: pb_lorawan.FCntAnomaly_ROLLOVER_16, Downlink: true, FCnt: fCnt, PreviousFCnt: next}
+	}
+	if fCnt-next > fCntGapAnomaly {
+		return &pb_lorawan.FCntAnomaly{Type: pb_lorawan.FCntAnomaly_GAP, Downlink: true, FCnt: fCnt, PreviousFCnt: next}
+	}
+	return nil
+}
+
+// handleUplinkFCnt adds the anomalies of the uplink frame counter, and the anomalies of the downlink frame counter since
+// the previous uplink, to the metadata of the uplink message, so that the Handler can report them to the application
+func (n *networkServer) handleUplinkFCnt(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device) {
+	lorawanUplinkMac := message.GetMessage().GetLorawan().GetMacPayload()
+	lorawanMeta := message.GetProtocolMetadata().GetLorawan()
+	if lorawanUplinkMac == nil || lorawanMeta == nil {
+		return
+	}
+	if anomaly := uplinkFCntAnomaly(dev, lorawanUplinkMac.FCnt); anomaly != nil {
+		n.Ctx.WithFields(log.Fields{
+			"AppEUI":  dev.AppEUI,
+			"DevEUI":  dev.DevEUI,
+			"Anomaly": anomaly.Type,
+			"FCnt":    anomaly.FCnt,
+		}).Warn("Uplink frame counter anomaly")
+		lorawanMeta.FCntAnomalies = append(lorawanMeta.FCntAnomalies, anomaly)
+	}
+	for i := range dev.FCntAnomalies {
+		lorawanMeta.FCntAnomalies = append(lorawanMeta.FCntAnomalies, &dev.FCntAnomalies[i])
+	}
+	dev.FCntAnomalies = nil
+}
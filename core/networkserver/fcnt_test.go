@@ -0,0 +1,88 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestUplinkFCntAnomaly(t *testing.T) {
+	a := New(t)
+
+	dev := &device.Device{}
+	a.So(uplinkFCntAnomaly(dev, 5), ShouldBeNil) // New session
+
+	dev.FCntUp = 5
+	a.So(uplinkFCntAnomaly(dev, 5), ShouldBeNil) // Retransmission
+	a.So(uplinkFCntAnomaly(dev, 6), ShouldBeNil)
+	a.So(uplinkFCntAnomaly(dev, 100), ShouldBeNil)
+	a.So(uplinkFCntAnomaly(dev, 2000).Type, ShouldEqual, pb_lorawan.FCntAnomaly_GAP)
+	a.So(uplinkFCntAnomaly(dev, 1).Type, ShouldEqual, pb_lorawan.FCntAnomaly_RESET)
+
+	dev.FCntUp = 0xfff0
+	a.So(uplinkFCntAnomaly(dev, 2).Type, ShouldEqual, pb_lorawan.FCntAnomaly_ROLLOVER_16)
+
+	dev.Options.Uses32BitFCnt = true
+	a.So(uplinkFCntAnomaly(dev, 2).Type, ShouldEqual, pb_lorawan.FCntAnomaly_RESET)
+	a.So(uplinkFCntAnomaly(dev, 0x10002), ShouldBeNil)
+
+	dev.FCntUp = 0xfffffff0
+	anomaly := uplinkFCntAnomaly(dev, 2)
+	a.So(anomaly.Type, ShouldEqual, pb_lorawan.FCntAnomaly_ROLLOVER_32)
+	a.So(anomaly.FCnt, ShouldEqual, 2)
+	a.So(anomaly.PreviousFCnt, ShouldEqual, 0xfffffff0)
+	a.So(anomaly.Downlink, ShouldBeFalse)
+}
+
+func TestDownlinkFCntAnomaly(t *testing.T) {
+	a := New(t)
+
+	dev := &device.Device{}
+	a.So(downlinkFCntAnomaly(dev, 5, 5), ShouldBeNil)
+	a.So(downlinkFCntAnomaly(dev, 5, 10), ShouldBeNil)
+	a.So(downlinkFCntAnomaly(dev, 5, 2000).Type, ShouldEqual, pb_lorawan.FCntAnomaly_GAP)
+
+	anomaly := downlinkFCntAnomaly(dev, 0xffff, 0x10000)
+	a.So(anomaly.Type, ShouldEqual, pb_lorawan.FCntAnomaly_ROLLOVER_16)
+	a.So(anomaly.Downlink, ShouldBeTrue)
+	a.So(downlinkFCntAnomaly(dev, 0x10000, 0x10001), ShouldBeNil) // Only reported once
+
+	dev.Options.Uses32BitFCnt = true
+	a.So(downlinkFCntAnomaly(dev, 0xffff, 0x10000), ShouldBeNil)
+}
+
+func TestHandleUplinkFCnt(t *testing.T) {
+	a := New(t)
+
+	ns := &networkServer{
+		Component: &component.Component{Ctx: GetLogger(t, "TestHandleUplinkFCnt")},
+	}
+
+	message := adrInitUplinkMessage()
+	message.Message.GetLorawan().GetMacPayload().FCnt = 1
+
+	dev := &device.Device{FCntUp: 1234}
+	dev.FCntAnomalies = []pb_lorawan.FCntAnomaly{
+		{Type: pb_lorawan.FCntAnomaly_ROLLOVER_16, Downlink: true, FCnt: 0x10000, PreviousFCnt: 0xffff},
+	}
+	ns.handleUplinkFCnt(message, dev)
+
+	anomalies := message.ProtocolMetadata.GetLorawan().FCntAnomalies
+	a.So(anomalies, ShouldHaveLength, 2)
+	a.So(anomalies[0].Type, ShouldEqual, pb_lorawan.FCntAnomaly_RESET)
+	a.So(anomalies[1].Downlink, ShouldBeTrue)
+	a.So(dev.FCntAnomalies, ShouldBeEmpty)
+
+	// Without anomalies
+	message = adrInitUplinkMessage()
+	message.Message.GetLorawan().GetMacPayload().FCnt = 1235
+	ns.handleUplinkFCnt(message, dev)
+	a.So(message.ProtocolMetadata.GetLorawan().FCntAnomalies, ShouldBeEmpty)
+}
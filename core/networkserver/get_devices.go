@@ -4,6 +4,7 @@
 package networkserver
 
 import (
+	"github.com/TheThingsNetwork/go-utils/log"
 	pb "github.com/TheThingsNetwork/ttn/api/networkserver"
 	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/utils/fcnt"
@@ -25,6 +26,33 @@ func (n *networkServer) HandleGetDevices(req *pb.DevicesRequest) (*pb.DevicesRes
 		if device == nil {
 			continue
 		}
+
+		// A device with an invalid profile does not prevent the other devices with the DevAddr from being found
+		if _, err := n.applyProfiles(device); err != nil {
+			n.Ctx.WithError(err).WithFields(log.Fields{
+				"AppEUI": device.AppEUI,
+				"DevEUI": device.DevEUI,
+			}).Warn("Could not apply profiles to device")
+			continue
+		}
+
+		// A device that rejoined can send uplink in its current session and its pending session
+		if device.PendingSession.DevAddr == *req.DevAddr {
+			res.Results = append(res.Results, &pb_lorawan.Device{
+				AppEui:           &device.AppEUI,
+				AppId:            device.AppID,
+				DevEui:           &device.DevEUI,
+				DevId:            device.DevID,
+				NwkSKey:          &device.PendingSession.NwkSKey,
+				Uses32BitFCnt:    device.Options.Uses32BitFCnt,
+				DisableFCntCheck: device.Options.DisableFCntCheck,
+				MacVersion:       device.PendingSession.MACVersion,
+			})
+		}
+		if device.DevAddr != *req.DevAddr {
+			continue
+		}
+
 		fullFCnt := fcnt.GetFull(device.FCntUp, uint16(req.FCnt))
 		dev := &pb_lorawan.Device{
 			AppEui:           &device.AppEUI,
@@ -35,6 +63,11 @@ func (n *networkServer) HandleGetDevices(req *pb.DevicesRequest) (*pb.DevicesRes
 			FCntUp:           device.FCntUp,
 			Uses32BitFCnt:    device.Options.Uses32BitFCnt,
 			DisableFCntCheck: device.Options.DisableFCntCheck,
+			Rx1DrOffset:      uint32(device.RX.AckedRX1DROffset),
+			Rx2DataRate:      device.RX.AckedRX2DataRate,
+			Rx2Frequency:     device.RX.AckedRX2Frequency,
+			RxDelay:          uint32(device.RX.AckedRXDelay),
+			MacVersion:       device.Options.MACVersion,
 		}
 		if device.Options.DisableFCntCheck {
 			res.Results = append(res.Results, dev)
@@ -7,7 +7,9 @@ import (
 	"testing"
 
 	pb "github.com/TheThingsNetwork/ttn/api/networkserver"
+	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/profile"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	. "github.com/smartystreets/assertions"
@@ -17,7 +19,9 @@ func TestHandleGetDevices(t *testing.T) {
 	a := New(t)
 
 	ns := &networkServer{
-		devices: device.NewRedisDeviceStore(GetRedisClient(), "ns-test-handle-get-devices"),
+		Component: &component.Component{Ctx: GetLogger(t, "TestHandleGetDevices")},
+		devices:   device.NewRedisDeviceStore(GetRedisClient(), "ns-test-handle-get-devices"),
+		profiles:  profile.NewRedisProfileStore(GetRedisClient(), "ns-test-handle-get-devices"),
 	}
 
 	nwkSKey := types.NwkSKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
@@ -132,4 +136,56 @@ func TestHandleGetDevices(t *testing.T) {
 	a.So(err, ShouldBeNil)
 	a.So(res.Results, ShouldHaveLength, 1)
 
+	// Pending Session
+	ns.devices.Set(&device.Device{
+		DevAddr: getDevAddr(2, 2, 3, 6),
+		AppEUI:  types.AppEUI(getEUI(2, 2, 3, 4, 5, 4, 7, 8)),
+		DevEUI:  types.DevEUI(getEUI(2, 2, 3, 4, 5, 4, 7, 8)),
+		NwkSKey: nwkSKey,
+		FCntUp:  42,
+		PendingSession: device.PendingSession{
+			DevAddr: getDevAddr(2, 2, 3, 7),
+			NwkSKey: types.NwkSKey{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1},
+		},
+	})
+	defer func() {
+		ns.devices.Delete(types.AppEUI(getEUI(2, 2, 3, 4, 5, 4, 7, 8)), types.DevEUI(getEUI(2, 2, 3, 4, 5, 4, 7, 8)))
+	}()
+	devAddr5 := getDevAddr(2, 2, 3, 6)
+	res, err = ns.HandleGetDevices(&pb.DevicesRequest{
+		DevAddr: &devAddr5,
+		FCnt:    43,
+	})
+	a.So(err, ShouldBeNil)
+	a.So(res.Results, ShouldHaveLength, 1)
+	a.So(*res.Results[0].NwkSKey, ShouldEqual, nwkSKey)
+	devAddr6 := getDevAddr(2, 2, 3, 7)
+	res, err = ns.HandleGetDevices(&pb.DevicesRequest{
+		DevAddr: &devAddr6,
+		FCnt:    1,
+	})
+	a.So(err, ShouldBeNil)
+	a.So(res.Results, ShouldHaveLength, 1)
+	a.So(*res.Results[0].NwkSKey, ShouldEqual, types.NwkSKey{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1})
+	a.So(res.Results[0].FCntUp, ShouldEqual, 0)
+
+	// Device with an unknown profile
+	ns.devices.Set(&device.Device{
+		DevAddr: getDevAddr(1, 2, 3, 4),
+		AppEUI:  types.AppEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 9)),
+		DevEUI:  types.DevEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 9)),
+		NwkSKey: nwkSKey,
+		Options: device.Options{
+			DeviceProfileID: "unknown",
+		},
+	})
+	defer func() {
+		ns.devices.Delete(types.AppEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 9)), types.DevEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 9)))
+	}()
+	res, err = ns.HandleGetDevices(&pb.DevicesRequest{
+		DevAddr: &devAddr1,
+		FCnt:    5,
+	})
+	a.So(err, ShouldBeNil)
+	a.So(res.Results, ShouldHaveLength, 1)
 }
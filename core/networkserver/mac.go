@@ -26,6 +26,19 @@ func bestSNR(metadata []*pb_gateway.RxMetadata) float32 {
 	return sorted[len(sorted)-1].Snr
 }
 
+func bestRSSI(metadata []*pb_gateway.RxMetadata) float32 {
+	if len(metadata) == 0 {
+		return 0
+	}
+	best := metadata[0].Rssi
+	for _, md := range metadata {
+		if md.Rssi > best {
+			best = md.Rssi
+		}
+	}
+	return best
+}
+
 var demodulationFloor = map[string]float32{
 	"SF7BW125":  -7.5,
 	"SF8BW125":  -10,
@@ -66,19 +66,46 @@ func (n *networkServerManager) GetDevice(ctx context.Context, in *pb_lorawan.Dev
 		lastSeen = dev.LastSeen
 	}
 
-	return &pb_lorawan.Device{
-		AppId:            dev.AppID,
-		AppEui:           &dev.AppEUI,
-		DevId:            dev.DevID,
-		DevEui:           &dev.DevEUI,
-		DevAddr:          &dev.DevAddr,
-		NwkSKey:          &dev.NwkSKey,
-		FCntUp:           dev.FCntUp,
-		FCntDown:         dev.FCntDown,
-		DisableFCntCheck: dev.Options.DisableFCntCheck,
-		Uses32BitFCnt:    dev.Options.Uses32BitFCnt,
-		LastSeen:         lastSeen.UnixNano(),
-	}, nil
+	pbDev := &pb_lorawan.Device{
+		AppId:               dev.AppID,
+		AppEui:              &dev.AppEUI,
+		DevId:               dev.DevID,
+		DevEui:              &dev.DevEUI,
+		DevAddr:             &dev.DevAddr,
+		NwkSKey:             &dev.NwkSKey,
+		FCntUp:              dev.FCntUp,
+		FCntDown:            dev.FCntDown,
+		DisableFCntCheck:    dev.Options.DisableFCntCheck,
+		Uses32BitFCnt:       dev.Options.Uses32BitFCnt,
+		DevStatusInterval:   uint32(dev.Options.DevStatusInterval / time.Second),
+		DisableDevStatus:    dev.Options.DisableDevStatus,
+		Rx1DrOffset:         uint32(dev.RX.RX1DROffset),
+		Rx2DataRate:         dev.RX.RX2DataRate,
+		Rx2Frequency:        dev.RX.RX2Frequency,
+		RxDelay:             uint32(dev.RX.RXDelay),
+		AdrStrategy:         dev.ADR.Strategy,
+		AdrMargin:           uint32(dev.ADR.Margin),
+		DeviceClass:         dev.Options.Class,
+		MacVersion:          dev.Options.MACVersion,
+		DeviceProfileId:     dev.Options.DeviceProfileID,
+		ServiceProfileId:    dev.Options.ServiceProfileID,
+		BeaconFrequency:     dev.ClassB.BeaconFrequency,
+		PingSlotPeriodicity: uint32(dev.ClassB.PingSlotPeriodicity),
+		LastSeen:            lastSeen.UnixNano(),
+	}
+
+	if dev.Options.MACVersion == pb_lorawan.MACVersion_LORAWAN_1_1 {
+		pbDev.SNwkSIntKey = &dev.Session.SNwkSIntKey
+		pbDev.NwkSEncKey = &dev.Session.NwkSEncKey
+	}
+
+	if status := deviceStatus(dev); status != nil {
+		pbDev.Battery = status.Battery
+		pbDev.Margin = status.Margin
+		pbDev.LastStatus = status.Time
+	}
+
+	return pbDev, nil
 }
 
 func (n *networkServerManager) SetDevice(ctx context.Context, in *pb_lorawan.Device) (*empty.Empty, error) {
@@ -86,6 +113,10 @@ func (n *networkServerManager) SetDevice(ctx context.Context, in *pb_lorawan.Dev
 		return nil, errors.Wrap(err, "Invalid Device")
 	}
 
+	if _, err := getADRStrategy(in.AdrStrategy); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device")
+	}
+
 	claims, err := n.networkServer.Component.ValidateTTNAuthContext(ctx)
 	if err != nil {
 		return nil, err
@@ -95,6 +126,21 @@ func (n *networkServerManager) SetDevice(ctx context.Context, in *pb_lorawan.Dev
 		return nil, err
 	}
 
+	if in.DeviceProfileId != "" {
+		deviceProfile, err := n.networkServer.profiles.GetDeviceProfile(in.DeviceProfileId)
+		if err != nil {
+			return nil, errors.Wrap(err, "Invalid Device")
+		}
+		if deviceProfile.MACVersion != in.MacVersion {
+			return nil, errors.NewErrInvalidArgument("MacVersion", "does not match the device profile")
+		}
+	}
+	if in.ServiceProfileId != "" {
+		if _, err := n.networkServer.profiles.GetServiceProfile(in.ServiceProfileId); err != nil {
+			return nil, errors.Wrap(err, "Invalid Device")
+		}
+	}
+
 	dev, err := n.getDevice(ctx, &pb_lorawan.DeviceIdentifier{AppEui: in.AppEui, DevEui: in.DevEui})
 	if err != nil && errors.GetErrType(err) != errors.NotFound {
 		return nil, err
@@ -112,17 +158,42 @@ func (n *networkServerManager) SetDevice(ctx context.Context, in *pb_lorawan.Dev
 	dev.DevEUI = *in.DevEui
 	dev.FCntUp = in.FCntUp
 	dev.FCntDown = in.FCntDown
-	dev.ADR = device.ADRSettings{Band: dev.ADR.Band, Margin: dev.ADR.Margin}
+	dev.ADR = device.ADRSettings{Band: dev.ADR.Band, Margin: int(in.AdrMargin), Strategy: in.AdrStrategy}
+	dev.RX.SendReq = true // Pending MAC commands are cleared below
+	dev.RX.RX1DROffset = int(in.Rx1DrOffset)
+	dev.RX.RX2DataRate = in.Rx2DataRate
+	dev.RX.RX2Frequency = in.Rx2Frequency
+	dev.RX.RXDelay = int(in.RxDelay)
+	dev.TxParams.SendReq = true
+	dev.TxParams.MaxEIRP = 0 // Set by the device profile
+	dev.Channels = nil
+	dev.ClassB.SendReq = true
+	dev.ClassB.BeaconFrequency = in.BeaconFrequency
 
 	dev.Options = device.Options{
 		DisableFCntCheck:      in.DisableFCntCheck,
 		Uses32BitFCnt:         in.Uses32BitFCnt,
 		ActivationConstraints: in.ActivationConstraints,
+		DevStatusInterval:     time.Duration(in.DevStatusInterval) * time.Second,
+		DisableDevStatus:      in.DisableDevStatus,
+		Class:                 in.DeviceClass,
+		MACVersion:            in.MacVersion,
+		DeviceProfileID:       in.DeviceProfileId,
+		ServiceProfileID:      in.ServiceProfileId,
 	}
 
 	if in.NwkSKey != nil && in.DevAddr != nil {
+		// A session that is changed by hand replaces the pending session
+		if *in.DevAddr != dev.DevAddr || *in.NwkSKey != dev.NwkSKey {
+			dev.PendingSession = device.PendingSession{}
+		}
 		dev.DevAddr = *in.DevAddr
 		dev.NwkSKey = *in.NwkSKey
+		dev.Session = device.SessionKeys{}
+		if in.SNwkSIntKey != nil && in.NwkSEncKey != nil {
+			dev.Session.SNwkSIntKey = *in.SNwkSIntKey
+			dev.Session.NwkSEncKey = *in.NwkSEncKey
+		}
 	}
 
 	err = n.networkServer.devices.Set(dev)
@@ -139,6 +210,15 @@ func (n *networkServerManager) SetDevice(ctx context.Context, in *pb_lorawan.Dev
 		return nil, err
 	}
 
+	macCommands, err := n.networkServer.devices.MACCommands(dev.AppEUI, dev.DevEUI)
+	if err != nil {
+		return nil, err
+	}
+	err = macCommands.Clear()
+	if err != nil {
+		return nil, err
+	}
+
 	return &empty.Empty{}, nil
 }
 
@@ -154,6 +234,25 @@ func (n *networkServerManager) DeleteDevice(ctx context.Context, in *pb_lorawan.
 	return &empty.Empty{}, nil
 }
 
+func (n *networkServerManager) GetDeviceStats(ctx context.Context, in *pb_lorawan.DeviceIdentifier) (*pb_lorawan.DeviceStats, error) {
+	dev, err := n.getDevice(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	history, err := n.networkServer.devices.Frames(dev.AppEUI, dev.DevEUI)
+	if err != nil {
+		return nil, err
+	}
+	frames, err := history.Get()
+	if err != nil {
+		return nil, err
+	}
+	stats := deviceStats(frames)
+	stats.AppEui = &dev.AppEUI
+	stats.DevEui = &dev.DevEUI
+	return stats, nil
+}
+
 func (n *networkServerManager) GetPrefixes(ctx context.Context, in *pb_lorawan.PrefixesRequest) (*pb_lorawan.PrefixesResponse, error) {
 	var mapping []*pb_lorawan.PrefixesResponse_PrefixMapping
 	for prefix, usage := range n.networkServer.prefixes {
@@ -200,4 +299,6 @@ func (n *networkServer) RegisterManager(s *grpc.Server) {
 	pb.RegisterNetworkServerManagerServer(s, server)
 	pb_lorawan.RegisterDeviceManagerServer(s, server)
 	pb_lorawan.RegisterDevAddrManagerServer(s, server)
+	pb_lorawan.RegisterMulticastGroupManagerServer(s, server)
+	pb.RegisterProfileManagerServer(s, &profileManager{networkServer: n})
 }
@@ -0,0 +1,139 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"fmt"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/multicast"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/brocaar/lorawan"
+)
+
+// multicastFCnt returns the full FCnt for the 16 lsb of the FCnt in a multicast downlink from the Handler
+func multicastFCnt(group *multicast.Group, fCnt uint32) (uint32, error) {
+	full := group.FCntDown&0xffff0000 | fCnt&0xffff
+	if full < group.FCntDown {
+		return 0, errors.NewErrInvalidArgument("Downlink", "FCnt was already used")
+	}
+	return full, nil
+}
+
+// multicastDownlinkPaths returns the DownlinkPaths of the gateways that transmit downlink for the multicast group.
+// These are the gateways that received the last uplink of the members, optionally limited to the GatewayIDs of the group.
+func (n *networkServer) multicastDownlinkPaths(group *multicast.Group) ([]device.DownlinkPath, error) {
+	var selected map[string]bool
+	if len(group.GatewayIDs) > 0 {
+		selected = make(map[string]bool, len(group.GatewayIDs))
+		for _, gatewayID := range group.GatewayIDs {
+			selected[gatewayID] = true
+		}
+	}
+	ids := make([]device.Identifier, len(group.Members))
+	for i, member := range group.Members {
+		ids[i] = device.Identifier{AppEUI: member.AppEUI, DevEUI: member.DevEUI}
+	}
+	members, err := n.devices.GetAll(ids)
+	if err != nil {
+		return nil, err
+	}
+	var paths []device.DownlinkPath
+	seen := make(map[string]bool)
+	for _, dev := range members {
+		path := dev.Downlink
+		if path.RouterID == "" || path.GatewayID == "" || seen[path.GatewayID] {
+			continue
+		}
+		if selected != nil && !selected[path.GatewayID] {
+			continue
+		}
+		seen[path.GatewayID] = true
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// HandleMulticastDownlink sets the MIC of a downlink to a multicast group, and returns a Class C downlink for every
+// gateway that transmits downlink for the group
+func (n *networkServer) HandleMulticastDownlink(message *pb_broker.DownlinkMessage) (downlinks []*pb_broker.DownlinkMessage, err error) {
+	if message.MulticastGroupId == "" {
+		return nil, errors.NewErrInvalidArgument("Downlink", "does not contain a MulticastGroupID")
+	}
+
+	n.status.downlink.Mark(1)
+
+	group, err := n.multicast.Get(message.AppId, message.MulticastGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = message.UnmarshalPayload()
+	if err != nil {
+		return nil, err
+	}
+	lorawanDownlinkMac := message.Message.GetLorawan().GetMacPayload()
+	if lorawanDownlinkMac == nil {
+		return nil, errors.NewErrInvalidArgument("Downlink", "does not contain a MAC payload")
+	}
+	if lorawanDownlinkMac.DevAddr != group.McAddr {
+		return nil, errors.NewErrInvalidArgument("Downlink", "DevAddr does not match McAddr of multicast group")
+	}
+	if len(lorawanDownlinkMac.FOpts) != 0 || lorawanDownlinkMac.FPort == 0 {
+		return nil, errors.NewErrInvalidArgument("Downlink", "can not contain MAC commands for multicast group")
+	}
+
+	paths, err := n.multicastDownlinkPaths(group)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("Gateways for downlink to multicast group %s", group.GroupID))
+	}
+
+	fCnt, err := multicastFCnt(group, lorawanDownlinkMac.FCnt)
+	if err != nil {
+		return nil, err
+	}
+	if err := n.multicast.ReserveFCntDown(group, fCnt); err != nil {
+		return nil, err
+	}
+	lorawanDownlinkMac.FCnt = fCnt // Use full 32-bit FCnt for setting MIC
+
+	phyPayload := message.Message.GetLorawan().PHYPayload()
+	phyPayload.SetMIC(lorawan.AES128Key(group.McNwkSKey))
+	payload, err := phyPayload.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range paths {
+		fp, err := band.Get(path.FrequencyPlan)
+		if err != nil {
+			return nil, err
+		}
+		params, err := rxParams(fp, 0, group.DataRate, group.Frequency)
+		if err != nil {
+			return nil, err
+		}
+		dataRate, err := fp.GetDataRateStringForIndex(int(params.DLSettings.RX2DataRate))
+		if err != nil {
+			return nil, err
+		}
+		option := downlinkOptionWithoutUplink(path, fp, dataRate, uint64(params.Frequency))
+		option.ProtocolConfig.GetLorawan().FCnt = fCnt
+		downlinks = append(downlinks, &pb_broker.DownlinkMessage{
+			Payload:          payload,
+			AppId:            message.AppId,
+			MulticastGroupId: message.MulticastGroupId,
+			DownlinkOption:   option,
+			Trace:            message.Trace.WithEvent(trace.ForwardEvent, "gateway", path.GatewayID),
+		})
+	}
+
+	return downlinks, nil
+}
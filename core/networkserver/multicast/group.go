@@ -0,0 +1,36 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package multicast
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+// Member of a multicast group
+type Member struct {
+	DevID  string       `json:"dev_id"`
+	AppEUI types.AppEUI `json:"app_eui"`
+	DevEUI types.DevEUI `json:"dev_eui"`
+}
+
+// Group contains the state of a multicast group
+type Group struct {
+	AppID   string `redis:"app_id"`
+	GroupID string `redis:"group_id"`
+
+	McAddr    types.DevAddr `redis:"mc_addr"`
+	McNwkSKey types.NwkSKey `redis:"mc_nwk_s_key"`
+	FCntDown  uint32        `redis:"f_cnt_down"`
+
+	DataRate  string `redis:"data_rate"` // empty: RX2 data rate of the frequency plan
+	Frequency uint64 `redis:"frequency"` // 0: RX2 frequency of the frequency plan
+
+	GatewayIDs []string `redis:"gateway_ids"` // empty: gateways that received the last uplink of the members
+	Members    []Member `redis:"members"`
+
+	CreatedAt time.Time `redis:"created_at"`
+	UpdatedAt time.Time `redis:"updated_at"`
+}
@@ -0,0 +1,140 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package multicast
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/storage"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"gopkg.in/redis.v5"
+)
+
+// Store interface for multicast groups
+type Store interface {
+	ListForApp(appID string, opts *storage.ListOptions) ([]*Group, error)
+	Get(appID, groupID string) (*Group, error)
+	Set(new *Group, properties ...string) error
+	Delete(appID, groupID string) error
+	ReserveFCntDown(group *Group, fCnt uint32) error
+}
+
+const defaultRedisPrefix = "ns"
+
+const redisGroupPrefix = "multicast_group"
+
+// NewRedisGroupStore creates a new Redis-based multicast group store
+func NewRedisGroupStore(client *redis.Client, prefix string) Store {
+	if prefix == "" {
+		prefix = defaultRedisPrefix
+	}
+	store := storage.NewRedisMapStore(client, prefix+":"+redisGroupPrefix)
+	store.SetBase(Group{}, "")
+	return &RedisGroupStore{
+		client: client,
+		prefix: prefix,
+		store:  store,
+	}
+}
+
+// RedisGroupStore stores multicast groups in Redis.
+// - Groups are stored as a Hash
+type RedisGroupStore struct {
+	client *redis.Client
+	prefix string
+	store  *storage.RedisMapStore
+}
+
+// ListForApp lists all multicast groups for a specific application
+func (s *RedisGroupStore) ListForApp(appID string, opts *storage.ListOptions) ([]*Group, error) {
+	groupsI, err := s.store.List(fmt.Sprintf("%s:*", appID), opts)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]*Group, len(groupsI))
+	for i, groupI := range groupsI {
+		if group, ok := groupI.(Group); ok {
+			groups[i] = &group
+		}
+	}
+	return groups, nil
+}
+
+// Get a specific multicast group
+func (s *RedisGroupStore) Get(appID, groupID string) (*Group, error) {
+	groupI, err := s.store.Get(fmt.Sprintf("%s:%s", appID, groupID))
+	if err != nil {
+		return nil, err
+	}
+	if group, ok := groupI.(Group); ok {
+		return &group, nil
+	}
+	return nil, errors.New("Database did not return a Group")
+}
+
+// Set a new multicast group or update an existing one. If properties are given, only those are updated.
+func (s *RedisGroupStore) Set(new *Group, properties ...string) error {
+	now := time.Now()
+	new.UpdatedAt = now
+	key := fmt.Sprintf("%s:%s", new.AppID, new.GroupID)
+	if len(properties) == 0 {
+		if existing, err := s.Get(new.AppID, new.GroupID); err == nil {
+			new.CreatedAt = existing.CreatedAt
+		} else {
+			new.CreatedAt = now
+		}
+		return s.store.Set(key, *new)
+	}
+	return s.store.Set(key, *new, append(properties, "updated_at")...)
+}
+
+// Delete a multicast group
+func (s *RedisGroupStore) Delete(appID, groupID string) error {
+	return s.store.Delete(fmt.Sprintf("%s:%s", appID, groupID))
+}
+
+// ReserveFCntDown reserves the FCnt for a downlink message to the multicast group, and stores the next FCntDown of the
+// group. An error is returned if the FCnt was already used.
+func (s *RedisGroupStore) ReserveFCntDown(group *Group, fCnt uint32) error {
+	if fCnt == math.MaxUint32 {
+		return errors.NewErrInvalidArgument("FCntDown", "can not be incremented")
+	}
+	key := s.prefix + ":" + redisGroupPrefix + ":" + fmt.Sprintf("%s:%s", group.AppID, group.GroupID)
+	next := fCnt + 1
+	err := s.client.Watch(func(tx *redis.Tx) error {
+		exists, err := tx.Exists(key).Result()
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.NewErrNotFound(key)
+		}
+		stored, err := tx.HGet(key, "f_cnt_down").Result()
+		if err != nil && err != redis.Nil {
+			return err
+		}
+		if stored != "" {
+			storedFCntDown, err := strconv.ParseUint(stored, 10, 32)
+			if err != nil {
+				return err
+			}
+			if uint64(fCnt) < storedFCntDown {
+				return errors.NewErrInvalidArgument("FCntDown", fmt.Sprintf("%d was already used", fCnt))
+			}
+		}
+		_, err = tx.Pipelined(func(pipe *redis.Pipeline) error {
+			pipe.HSet(key, "f_cnt_down", strconv.FormatUint(uint64(next), 10))
+			return nil
+		})
+		return err
+	}, key)
+	if err != nil {
+		return err
+	}
+	group.FCntDown = next
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/multicast"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// validateApp checks that the context has device rights to the application
+func (n *networkServerManager) validateApp(ctx context.Context, appID string) error {
+	claims, err := n.networkServer.Component.ValidateTTNAuthContext(ctx)
+	if err != nil {
+		return err
+	}
+	if n.clientRate.Limit(claims.Subject) {
+		return grpc.Errorf(codes.ResourceExhausted, "Rate limit for client reached")
+	}
+	return checkAppRights(claims, appID, rights.Devices)
+}
+
+func multicastGroupToPb(in *multicast.Group) *pb_lorawan.MulticastGroup {
+	out := &pb_lorawan.MulticastGroup{
+		AppId:      in.AppID,
+		GroupId:    in.GroupID,
+		McAddr:     &in.McAddr,
+		McNwkSKey:  &in.McNwkSKey,
+		FCntDown:   in.FCntDown,
+		DataRate:   in.DataRate,
+		Frequency:  in.Frequency,
+		GatewayIds: in.GatewayIDs,
+	}
+	for _, member := range in.Members {
+		member := member
+		out.Members = append(out.Members, &pb_lorawan.MulticastGroup_Member{
+			DevId:  member.DevID,
+			AppEui: &member.AppEUI,
+			DevEui: &member.DevEUI,
+		})
+	}
+	return out
+}
+
+func multicastGroupFromPb(in *pb_lorawan.MulticastGroup) *multicast.Group {
+	out := &multicast.Group{
+		AppID:      in.AppId,
+		GroupID:    in.GroupId,
+		McAddr:     *in.McAddr,
+		McNwkSKey:  *in.McNwkSKey,
+		FCntDown:   in.FCntDown,
+		DataRate:   in.DataRate,
+		Frequency:  in.Frequency,
+		GatewayIDs: in.GatewayIds,
+	}
+	for _, member := range in.Members {
+		out.Members = append(out.Members, multicast.Member{DevID: member.DevId})
+		if member.AppEui != nil {
+			out.Members[len(out.Members)-1].AppEUI = *member.AppEui
+		}
+		if member.DevEui != nil {
+			out.Members[len(out.Members)-1].DevEUI = *member.DevEui
+		}
+	}
+	return out
+}
+
+func (n *networkServerManager) GetMulticastGroup(ctx context.Context, in *pb_lorawan.MulticastGroupIdentifier) (*pb_lorawan.MulticastGroup, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Multicast Group Identifier")
+	}
+	if err := n.validateApp(ctx, in.AppId); err != nil {
+		return nil, err
+	}
+	group, err := n.networkServer.multicast.Get(in.AppId, in.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	return multicastGroupToPb(group), nil
+}
+
+func (n *networkServerManager) SetMulticastGroup(ctx context.Context, in *pb_lorawan.MulticastGroup) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Multicast Group")
+	}
+	if err := n.validateApp(ctx, in.AppId); err != nil {
+		return nil, err
+	}
+	for _, member := range in.Members {
+		if member.AppEui == nil || member.DevEui == nil {
+			return nil, errors.NewErrInvalidArgument("Members", "must contain AppEUI and DevEUI")
+		}
+		dev, err := n.networkServer.devices.Get(*member.AppEui, *member.DevEui)
+		if err != nil {
+			return nil, err
+		}
+		if dev.AppID != in.AppId || dev.DevID != member.DevId {
+			return nil, errors.NewErrInvalidArgument("Members", "AppID and DevID do not match AppEUI and DevEUI")
+		}
+	}
+	if err := n.networkServer.multicast.Set(multicastGroupFromPb(in)); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (n *networkServerManager) DeleteMulticastGroup(ctx context.Context, in *pb_lorawan.MulticastGroupIdentifier) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Multicast Group Identifier")
+	}
+	if err := n.validateApp(ctx, in.AppId); err != nil {
+		return nil, err
+	}
+	if _, err := n.networkServer.multicast.Get(in.AppId, in.GroupId); err != nil {
+		return nil, err
+	}
+	if err := n.networkServer.multicast.Delete(in.AppId, in.GroupId); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
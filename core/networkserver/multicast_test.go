@@ -0,0 +1,134 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/multicast"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestMulticastFCnt(t *testing.T) {
+	a := New(t)
+
+	group := &multicast.Group{FCntDown: 10}
+	fCnt, err := multicastFCnt(group, 10)
+	a.So(err, ShouldBeNil)
+	a.So(fCnt, ShouldEqual, 10)
+
+	_, err = multicastFCnt(group, 9)
+	a.So(err, ShouldNotBeNil)
+
+	group.FCntDown = 0x10005
+	fCnt, err = multicastFCnt(group, 0x0006)
+	a.So(err, ShouldBeNil)
+	a.So(fCnt, ShouldEqual, 0x10006)
+}
+
+func TestHandleMulticastDownlink(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		devices:   device.NewRedisDeviceStore(GetRedisClient(), "test-handle-multicast-downlink"),
+		multicast: multicast.NewRedisGroupStore(GetRedisClient(), "test-handle-multicast-downlink"),
+	}
+	ns.InitStatus()
+
+	appEUI := types.AppEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 8))
+	mcAddr := getDevAddr(1, 2, 3, 4)
+
+	fPort := uint8(3)
+	phy := lorawan.PHYPayload{
+		MHDR: lorawan.MHDR{
+			MType: lorawan.UnconfirmedDataDown,
+			Major: lorawan.LoRaWANR1,
+		},
+		MACPayload: &lorawan.MACPayload{
+			FPort: &fPort,
+			FHDR: lorawan.FHDR{
+				DevAddr: lorawan.DevAddr(mcAddr),
+				FCnt:    5,
+			},
+		},
+	}
+	bytes, _ := phy.MarshalBinary()
+
+	// Group Not Found
+	_, err := ns.HandleMulticastDownlink(&pb_broker.DownlinkMessage{AppId: "test", MulticastGroupId: "lights", Payload: bytes})
+	a.So(err, ShouldNotBeNil)
+
+	for i, gatewayID := range []string{"gtw-1", "gtw-2", "gtw-1"} {
+		ns.devices.Set(&device.Device{
+			AppEUI:   appEUI,
+			DevEUI:   types.DevEUI(getEUI(1, 2, 3, 4, 5, 6, 7, byte(i))),
+			AppID:    "test",
+			DevID:    "lamp",
+			Downlink: device.DownlinkPath{RouterID: "router", GatewayID: gatewayID, FrequencyPlan: "EU_863_870"},
+		})
+		defer ns.devices.Delete(appEUI, types.DevEUI(getEUI(1, 2, 3, 4, 5, 6, 7, byte(i))))
+	}
+
+	group := &multicast.Group{
+		AppID:   "test",
+		GroupID: "lights",
+		McAddr:  mcAddr,
+		Members: []multicast.Member{
+			{DevID: "lamp", AppEUI: appEUI, DevEUI: types.DevEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 0))},
+			{DevID: "lamp", AppEUI: appEUI, DevEUI: types.DevEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 1))},
+			{DevID: "lamp", AppEUI: appEUI, DevEUI: types.DevEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 2))},
+		},
+	}
+	ns.multicast.Set(group)
+	defer ns.multicast.Delete("test", "lights")
+
+	// All gateways that received the members
+	res, err := ns.HandleMulticastDownlink(&pb_broker.DownlinkMessage{AppId: "test", MulticastGroupId: "lights", Payload: bytes})
+	a.So(err, ShouldBeNil)
+	a.So(res, ShouldHaveLength, 2)
+	a.So(res[0].DownlinkOption.Identifier, ShouldEqual, "router:")
+	a.So(res[0].DownlinkOption.GatewayId, ShouldEqual, "gtw-1")
+	a.So(res[1].DownlinkOption.GatewayId, ShouldEqual, "gtw-2")
+	a.So(res[0].DownlinkOption.GetProtocolConfig().GetLorawan().DataRate, ShouldEqual, "SF12BW125")
+	a.So(res[0].DownlinkOption.GetProtocolConfig().GetLorawan().FCnt, ShouldEqual, 5)
+	a.So(res[0].DownlinkOption.GetGatewayConfig().Frequency, ShouldEqual, 869525000)
+	a.So(res[0].MulticastGroupId, ShouldEqual, "lights")
+
+	var phyPayload lorawan.PHYPayload
+	phyPayload.UnmarshalBinary(res[0].Payload)
+	a.So(phyPayload.MIC, ShouldNotEqual, [4]byte{0, 0, 0, 0})
+
+	group, _ = ns.multicast.Get("test", "lights")
+	a.So(group.FCntDown, ShouldEqual, 6)
+
+	// FCnt was already used
+	_, err = ns.HandleMulticastDownlink(&pb_broker.DownlinkMessage{AppId: "test", MulticastGroupId: "lights", Payload: bytes})
+	a.So(err, ShouldNotBeNil)
+
+	// Selected gateways and data rate
+	group.GatewayIDs = []string{"gtw-2"}
+	group.DataRate = "SF9BW125"
+	group.Frequency = 868100000
+	ns.multicast.Set(group)
+	phy.MACPayload.(*lorawan.MACPayload).FHDR.FCnt = 6
+	bytes, _ = phy.MarshalBinary()
+	res, err = ns.HandleMulticastDownlink(&pb_broker.DownlinkMessage{AppId: "test", MulticastGroupId: "lights", Payload: bytes})
+	a.So(err, ShouldBeNil)
+	a.So(res, ShouldHaveLength, 1)
+	a.So(res[0].DownlinkOption.GatewayId, ShouldEqual, "gtw-2")
+	a.So(res[0].DownlinkOption.GetProtocolConfig().GetLorawan().DataRate, ShouldEqual, "SF9BW125")
+	a.So(res[0].DownlinkOption.GetGatewayConfig().Frequency, ShouldEqual, 868100000)
+
+	// No gateways
+	group.GatewayIDs = []string{"gtw-3"}
+	ns.multicast.Set(group)
+	phy.MACPayload.(*lorawan.MACPayload).FHDR.FCnt = 7
+	bytes, _ = phy.MarshalBinary()
+	_, err = ns.HandleMulticastDownlink(&pb_broker.DownlinkMessage{AppId: "test", MulticastGroupId: "lights", Payload: bytes})
+	a.So(err, ShouldNotBeNil)
+}
@@ -4,11 +4,15 @@
 package networkserver
 
 import (
+	"time"
+
 	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
 	pb_handler "github.com/TheThingsNetwork/ttn/api/handler"
 	pb "github.com/TheThingsNetwork/ttn/api/networkserver"
 	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/multicast"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/profile"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"gopkg.in/redis.v5"
@@ -19,6 +23,9 @@ type NetworkServer interface {
 	component.Interface
 	component.ManagementInterface
 
+	WithCache(options device.CacheOptions)
+	WithDevStatusIntervals(intervals map[string]time.Duration)
+
 	UsePrefix(prefix types.DevAddrPrefix, usage []string) error
 	GetPrefixesFor(requiredUsages ...string) []types.DevAddrPrefix
 
@@ -27,13 +34,17 @@ type NetworkServer interface {
 	HandleActivate(*pb_handler.DeviceActivationResponse) (*pb_handler.DeviceActivationResponse, error)
 	HandleUplink(*pb_broker.DeduplicatedUplinkMessage) (*pb_broker.DeduplicatedUplinkMessage, error)
 	HandleDownlink(*pb_broker.DownlinkMessage) (*pb_broker.DownlinkMessage, error)
+	HandleMulticastDownlink(*pb_broker.DownlinkMessage) ([]*pb_broker.DownlinkMessage, error)
 }
 
 // NewRedisNetworkServer creates a new Redis-backed NetworkServer
 func NewRedisNetworkServer(client *redis.Client, netID int) NetworkServer {
 	ns := &networkServer{
-		devices:  device.NewRedisDeviceStore(client, "ns"),
-		prefixes: map[types.DevAddrPrefix][]string{},
+		client:    client,
+		devices:   device.NewRedisDeviceStore(client, "ns"),
+		profiles:  profile.NewRedisProfileStore(client, "ns"),
+		multicast: multicast.NewRedisGroupStore(client, "ns"),
+		prefixes:  map[types.DevAddrPrefix][]string{},
 	}
 	ns.netID = [3]byte{byte(netID >> 16), byte(netID >> 8), byte(netID)}
 	return ns
@@ -41,17 +52,27 @@ func NewRedisNetworkServer(client *redis.Client, netID int) NetworkServer {
 
 type networkServer struct {
 	*component.Component
-	devices  device.Store
-	netID    [3]byte
-	prefixes map[types.DevAddrPrefix][]string
-	status   *status
+	client    *redis.Client
+	devices   device.Store
+	profiles  profile.Store
+	multicast multicast.Store
+	netID     [3]byte
+	prefixes  map[types.DevAddrPrefix][]string
+	status    *status
+
+	devStatusIntervals map[string]time.Duration // Interval for requesting the device status per application
+}
+
+func (n *networkServer) WithCache(options device.CacheOptions) {
+	n.devices = device.NewCachedDeviceStore(n.devices, n.client, "ns", options)
 }
 
 func (n *networkServer) UsePrefix(prefix types.DevAddrPrefix, usage []string) error {
-	if prefix.Length < 7 {
+	netIDPrefix := types.NetID(n.netID).DevAddrPrefix()
+	if prefix.Length < netIDPrefix.Length {
 		return errors.NewErrInvalidArgument("Prefix", "invalid length")
 	}
-	if prefix.DevAddr[0]>>1 != n.netID[2] {
+	if !prefix.DevAddr.HasNetID(n.netID) {
 		return errors.NewErrInvalidArgument("Prefix", "invalid netID")
 	}
 	n.prefixes[prefix] = usage
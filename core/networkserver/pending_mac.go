@@ -0,0 +1,199 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"fmt"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// MaxMACCommandAttempts is the number of downlinks in which a pending MAC command is sent before the NetworkServer gives up on it
+var MaxMACCommandAttempts = 5
+
+// maxFOptsLen is the maximum number of bytes of MAC commands in the FOpts
+const maxFOptsLen = 15
+
+// maxMACPayloadLen is the maximum number of bytes of MAC commands on FPort 0 (the payload size of the lowest EU868 data rate)
+const maxMACPayloadLen = 51
+
+// phyPayloadOverhead is the size of the MHDR, the FHDR without FOpts and the MIC of a PHYPayload
+const phyPayloadOverhead = 1 + 7 + 4
+
+func macCommandsLen(cmds []pb_lorawan.MACCommand) (length int) {
+	for _, cmd := range cmds {
+		length += 1 + len(cmd.Payload)
+	}
+	return
+}
+
+// fitMACCommands returns the MAC commands that fit in max bytes, keeping their order
+func fitMACCommands(cmds []pb_lorawan.MACCommand, max int) (fit []pb_lorawan.MACCommand) {
+	var length int
+	for _, cmd := range cmds {
+		if cmdLen := 1 + len(cmd.Payload); length+cmdLen <= max {
+			length += cmdLen
+			fit = append(fit, cmd)
+		}
+	}
+	return
+}
+
+func marshalMACCommands(cmds []pb_lorawan.MACCommand) []byte {
+	out := make([]byte, 0, macCommandsLen(cmds))
+	for _, cmd := range cmds {
+		out = append(out, byte(cmd.Cid))
+		out = append(out, cmd.Payload...)
+	}
+	return out
+}
+
+func hasMACCommand(cmds []pb_lorawan.MACCommand, cid uint32) bool {
+	for _, cmd := range cmds {
+		if cmd.Cid == cid {
+			return true
+		}
+	}
+	return false
+}
+
+// handleUplinkPendingMAC removes the pending MAC commands that were answered by the device and adds the remaining ones
+// to the response template, so that they trigger a downlink.
+func (n *networkServer) handleUplinkPendingMAC(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device) error {
+	queue, err := n.devices.MACCommands(dev.AppEUI, dev.DevEUI)
+	if err != nil {
+		return err
+	}
+	pending, err := queue.Get()
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	lorawanUplinkMac := message.GetMessage().GetLorawan().GetMacPayload()
+	lorawanDownlinkMac := message.GetResponseTemplate().GetMessage().GetLorawan().GetMacPayload()
+
+	var answered []uint32
+	for _, cmd := range pending {
+		if hasMACCommand(lorawanUplinkMac.FOpts, cmd.CID) {
+			answered = append(answered, cmd.CID)
+			continue
+		}
+		if lorawanDownlinkMac == nil || cmd.Attempts >= MaxMACCommandAttempts || hasMACCommand(lorawanDownlinkMac.FOpts, cmd.CID) {
+			continue
+		}
+		lorawanDownlinkMac.FOpts = append(lorawanDownlinkMac.FOpts, pb_lorawan.MACCommand{
+			Cid:     cmd.CID,
+			Payload: cmd.Payload,
+		})
+	}
+
+	if len(answered) == 0 {
+		return nil
+	}
+	return queue.Remove(answered...)
+}
+
+// handleDownlinkPendingMAC adds the pending MAC commands to the downlink. If the MAC commands don't fit in the FOpts
+// and the downlink has no application payload, they are sent on FPort 0 instead. If the dwell time limits the size of
+// the downlink, fewer MAC commands are added.
+func (n *networkServer) handleDownlinkPendingMAC(message *pb_broker.DownlinkMessage, dev *device.Device) error {
+	lorawanDownlinkMac := message.GetMessage().GetLorawan().GetMacPayload()
+	if lorawanDownlinkMac == nil {
+		return nil
+	}
+
+	maxFOpts, maxMACPayload := maxFOptsLen, maxMACPayloadLen
+	if maxLen := maxDwellTimePHYPayloadLen(message, dev); maxLen != 0 {
+		length := phyPayloadOverhead
+		if len(lorawanDownlinkMac.FrmPayload) != 0 {
+			length += 1 + len(lorawanDownlinkMac.FrmPayload)
+		}
+		if length > maxLen {
+			return errors.NewErrInvalidArgument("Downlink", fmt.Sprintf("payload of %d bytes exceeds the dwell time at %s", len(lorawanDownlinkMac.FrmPayload), message.DownlinkOption.ProtocolConfig.GetLorawan().DataRate))
+		}
+		if maxLen-length < maxFOpts {
+			maxFOpts = maxLen - length
+		}
+		if maxLen-phyPayloadOverhead-1 < maxMACPayload {
+			maxMACPayload = maxLen - phyPayloadOverhead - 1
+		}
+	}
+
+	queue, err := n.devices.MACCommands(dev.AppEUI, dev.DevEUI)
+	if err != nil {
+		return err
+	}
+
+	// The attempts of the pending MAC commands are updated in a transaction, so the FOpts are built in the transaction
+	// and only added to the downlink when it succeeded
+	original := lorawanDownlinkMac.FOpts
+	var fOpts []pb_lorawan.MACCommand
+	var onFPort0 bool
+	expired, err := queue.Attempt(MaxMACCommandAttempts, func(pending []*device.MACCommand) (sent []uint32) {
+		isPending := func(cid uint32) bool {
+			for _, cmd := range pending {
+				if cmd.CID == cid {
+					return true
+				}
+			}
+			return false
+		}
+
+		// MAC commands that are not pending (such as answers to the uplink) go first
+		fOpts = make([]pb_lorawan.MACCommand, 0, len(original)+len(pending))
+		for _, cmd := range original {
+			if !isPending(cmd.Cid) {
+				fOpts = append(fOpts, cmd)
+			}
+		}
+		for _, cmd := range pending {
+			fOpts = append(fOpts, pb_lorawan.MACCommand{
+				Cid:     cmd.CID,
+				Payload: cmd.Payload,
+			})
+		}
+
+		onFPort0 = macCommandsLen(fOpts) > maxFOpts && len(lorawanDownlinkMac.FrmPayload) == 0
+		if onFPort0 {
+			fOpts = fitMACCommands(fOpts, maxMACPayload)
+		} else {
+			fOpts = fitMACCommands(fOpts, maxFOpts)
+		}
+
+		for _, cmd := range pending {
+			if hasMACCommand(fOpts, cmd.CID) {
+				sent = append(sent, cmd.CID)
+			}
+		}
+		return sent
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, cmd := range expired {
+		message.Trace = message.Trace.WithEvent(trace.DropEvent, macCMD, cmd.CID,
+			"reason", "no answer",
+			"attempts", cmd.Attempts,
+		)
+		rejectChannel(dev, cmd)
+	}
+
+	if onFPort0 {
+		// The FRMPayload is encrypted with the NwkSKey after setting the FCnt
+		lorawanDownlinkMac.FOpts = nil
+		lorawanDownlinkMac.FPort = 0
+		lorawanDownlinkMac.FrmPayload = marshalMACCommands(fOpts)
+	} else {
+		lorawanDownlinkMac.FOpts = fOpts
+	}
+	return nil
+}
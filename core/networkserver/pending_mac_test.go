@@ -0,0 +1,173 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestFitMACCommands(t *testing.T) {
+	a := New(t)
+	cmds := []pb_lorawan.MACCommand{
+		{Cid: 0x01, Payload: make([]byte, 9)},
+		{Cid: 0x02, Payload: make([]byte, 4)},
+		{Cid: 0x03, Payload: make([]byte, 2)},
+		{Cid: 0x04},
+	}
+	a.So(macCommandsLen(cmds), ShouldEqual, 19)
+	fit := fitMACCommands(cmds, 15)
+	a.So(fit, ShouldHaveLength, 3)
+	a.So(fit[0].Cid, ShouldEqual, 0x01)
+	a.So(fit[1].Cid, ShouldEqual, 0x02)
+	a.So(fit[2].Cid, ShouldEqual, 0x04)
+	a.So(marshalMACCommands(cmds[2:]), ShouldResemble, []byte{0x03, 0, 0, 0x04})
+}
+
+func TestHandleUplinkPendingMAC(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		devices: device.NewRedisDeviceStore(GetRedisClient(), "ns-test-handle-uplink-pending-mac"),
+	}
+
+	defer func() {
+		keys, _ := GetRedisClient().Keys("*ns-test-handle-uplink-pending-mac*").Result()
+		for _, key := range keys {
+			GetRedisClient().Del(key).Result()
+		}
+	}()
+
+	dev := &device.Device{AppEUI: types.AppEUI([8]byte{1}), DevEUI: types.DevEUI([8]byte{1})}
+	queue, _ := ns.devices.MACCommands(dev.AppEUI, dev.DevEUI)
+	queue.Push(&device.MACCommand{CID: uint32(lorawan.RXParamSetupReq), Payload: []byte{1, 2, 3, 4}})
+	queue.Push(&device.MACCommand{CID: uint32(lorawan.RXTimingSetupReq), Payload: []byte{1}})
+	queue.Push(&device.MACCommand{CID: uint32(lorawan.NewChannelReq), Payload: []byte{1, 2, 3, 4, 5}, Attempts: MaxMACCommandAttempts})
+
+	message := adrInitUplinkMessage()
+	message.Message.GetLorawan().GetMacPayload().FOpts = []pb_lorawan.MACCommand{
+		{Cid: uint32(lorawan.RXTimingSetupAns)},
+	}
+	err := ns.handleUplinkPendingMAC(message, dev)
+	a.So(err, ShouldBeNil)
+
+	// The answered command is no longer pending
+	pending, _ := queue.Get()
+	a.So(pending, ShouldHaveLength, 2)
+	a.So(pending[0].CID, ShouldEqual, lorawan.RXParamSetupReq)
+
+	// The remaining command should trigger a downlink
+	fOpts := message.ResponseTemplate.Message.GetLorawan().GetMacPayload().FOpts
+	a.So(fOpts, ShouldHaveLength, 1)
+	a.So(fOpts[0].Cid, ShouldEqual, lorawan.RXParamSetupReq)
+}
+
+func TestHandleDownlinkPendingMAC(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		devices: device.NewRedisDeviceStore(GetRedisClient(), "ns-test-handle-downlink-pending-mac"),
+	}
+
+	defer func() {
+		keys, _ := GetRedisClient().Keys("*ns-test-handle-downlink-pending-mac*").Result()
+		for _, key := range keys {
+			GetRedisClient().Del(key).Result()
+		}
+	}()
+
+	dev := &device.Device{AppEUI: types.AppEUI([8]byte{1}), DevEUI: types.DevEUI([8]byte{1})}
+	queue, _ := ns.devices.MACCommands(dev.AppEUI, dev.DevEUI)
+
+	// Nothing pending
+	message := adrInitDownlinkMessage()
+	err := ns.handleDownlinkPendingMAC(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(message.Message.GetLorawan().GetMacPayload().FOpts, ShouldHaveLength, 1)
+
+	// Pending commands are added to the FOpts
+	queue.Push(&device.MACCommand{CID: uint32(lorawan.RXParamSetupReq), Payload: []byte{1, 2, 3, 4}})
+	queue.Push(&device.MACCommand{CID: uint32(lorawan.RXTimingSetupReq), Payload: []byte{1}})
+	message = adrInitDownlinkMessage()
+	err = ns.handleDownlinkPendingMAC(message, dev)
+	a.So(err, ShouldBeNil)
+	fOpts := message.Message.GetLorawan().GetMacPayload().FOpts
+	a.So(fOpts, ShouldHaveLength, 3)
+	a.So(fOpts[0].Cid, ShouldEqual, lorawan.LinkCheckAns)
+	a.So(fOpts[1].Cid, ShouldEqual, lorawan.RXParamSetupReq)
+	a.So(fOpts[2].Cid, ShouldEqual, lorawan.RXTimingSetupReq)
+	pending, _ := queue.Get()
+	a.So(pending, ShouldHaveLength, 2)
+	a.So(pending[0].Attempts, ShouldEqual, 1)
+
+	// Commands that don't fit in the FOpts are kept for a later downlink if there is an application payload
+	queue.Push(&device.MACCommand{CID: uint32(lorawan.NewChannelReq), Payload: []byte{1, 2, 3, 4, 5}})
+	queue.Push(&device.MACCommand{CID: uint32(lorawan.DutyCycleReq), Payload: []byte{1}})
+	message = adrInitDownlinkMessage()
+	message.Message.GetLorawan().GetMacPayload().FPort = 1
+	message.Message.GetLorawan().GetMacPayload().FrmPayload = []byte{1, 2, 3}
+	err = ns.handleDownlinkPendingMAC(message, dev)
+	a.So(err, ShouldBeNil)
+	fOpts = message.Message.GetLorawan().GetMacPayload().FOpts
+	a.So(macCommandsLen(fOpts), ShouldBeLessThanOrEqualTo, maxFOptsLen)
+	a.So(hasMACCommand(fOpts, uint32(lorawan.NewChannelReq)), ShouldBeTrue)
+	a.So(hasMACCommand(fOpts, uint32(lorawan.DutyCycleReq)), ShouldBeFalse)
+	pending, _ = queue.Get()
+	a.So(pending, ShouldHaveLength, 4)
+	a.So(pending[2].Attempts, ShouldEqual, 1)
+	a.So(pending[3].Attempts, ShouldEqual, 0)
+
+	// Without application payload, the commands are sent on FPort 0
+	message = adrInitDownlinkMessage()
+	err = ns.handleDownlinkPendingMAC(message, dev)
+	a.So(err, ShouldBeNil)
+	macPayload := message.Message.GetLorawan().GetMacPayload()
+	a.So(macPayload.FOpts, ShouldBeEmpty)
+	a.So(macPayload.FPort, ShouldEqual, 0)
+	a.So(macPayload.FrmPayload, ShouldHaveLength, 16)
+	a.So(macPayload.FrmPayload[0], ShouldEqual, lorawan.LinkCheckAns)
+
+	// Commands are dropped after too many attempts
+	pending, _ = queue.Get()
+	for _, cmd := range pending {
+		cmd.Attempts = MaxMACCommandAttempts
+	}
+	queue.Set(pending...)
+	message = adrInitDownlinkMessage()
+	err = ns.handleDownlinkPendingMAC(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(message.Message.GetLorawan().GetMacPayload().FOpts, ShouldHaveLength, 1)
+	pending, _ = queue.Get()
+	a.So(pending, ShouldBeEmpty)
+
+	// The dwell time limits the MAC commands
+	dev.Downlink.FrequencyPlan = "AS_923"
+	queue.Push(&device.MACCommand{CID: uint32(lorawan.NewChannelReq), Payload: []byte{1, 2, 3, 4, 5}})
+	queue.Push(&device.MACCommand{CID: uint32(lorawan.RXParamSetupReq), Payload: []byte{1, 2, 3, 4}})
+	queue.Push(&device.MACCommand{CID: uint32(lorawan.DutyCycleReq), Payload: []byte{1}})
+	queue.Push(&device.MACCommand{CID: uint32(lorawan.RXTimingSetupReq), Payload: []byte{1}})
+	message = adrInitDownlinkMessage()
+	message.DownlinkOption = dwellTimeDownlinkOption("SF10BW125")
+	maxLen := maxDwellTimePHYPayloadLen(message, dev)
+	err = ns.handleDownlinkPendingMAC(message, dev)
+	a.So(err, ShouldBeNil)
+	macPayload = message.Message.GetLorawan().GetMacPayload()
+	length := phyPayloadOverhead + macCommandsLen(macPayload.FOpts)
+	if len(macPayload.FrmPayload) != 0 {
+		length += 1 + len(macPayload.FrmPayload)
+	}
+	a.So(length, ShouldBeLessThanOrEqualTo, maxLen)
+
+	// An application payload that exceeds the dwell time is rejected
+	message = adrInitDownlinkMessage()
+	message.DownlinkOption = dwellTimeDownlinkOption("SF10BW125")
+	message.Message.GetLorawan().GetMacPayload().FPort = 1
+	message.Message.GetLorawan().GetMacPayload().FrmPayload = make([]byte, maxLen)
+	err = ns.handleDownlinkPendingMAC(message, dev)
+	a.So(err, ShouldNotBeNil)
+}
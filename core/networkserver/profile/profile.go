@@ -0,0 +1,51 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"time"
+
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+)
+
+// DeviceProfile contains the LoRaWAN capabilities of a device model
+type DeviceProfile struct {
+	ProfileID   string `redis:"profile_id"`
+	Description string `redis:"description"`
+
+	MACVersion                 pb_lorawan.MACVersion `redis:"mac_version"`
+	RegionalParametersRevision string                `redis:"regional_parameters_revision"`
+
+	SupportsClassB bool `redis:"supports_class_b"`
+	SupportsClassC bool `redis:"supports_class_c"`
+	SupportsJoin   bool `redis:"supports_join"`
+
+	MaxEIRP       float32 `redis:"max_eirp"` // in dBm, 0: frequency plan default
+	Uses32BitFCnt bool    `redis:"uses_32_bit_fcnt"`
+
+	// RX settings (zero values indicate the defaults of the frequency plan)
+	RX1DROffset  int    `redis:"rx1_dr_offset"`
+	RX2DataRate  string `redis:"rx2_data_rate"`
+	RX2Frequency uint64 `redis:"rx2_frequency"`
+	RXDelay      int    `redis:"rx_delay"`
+
+	CreatedAt time.Time `redis:"created_at"`
+	UpdatedAt time.Time `redis:"updated_at"`
+}
+
+// ServiceProfile contains the settings of the network service for a group of devices
+type ServiceProfile struct {
+	ProfileID   string `redis:"profile_id"`
+	Description string `redis:"description"`
+
+	ActivationConstraints string        `redis:"activation_constraints"`
+	DisableFCntCheck      bool          `redis:"disable_fcnt_check"`
+	DevStatusInterval     time.Duration `redis:"dev_status_interval"` // 0: device, application or NetworkServer default
+
+	ADRStrategy string `redis:"adr_strategy"` // empty: NetworkServer default
+	ADRMargin   int    `redis:"adr_margin"`   // 0: NetworkServer default
+
+	CreatedAt time.Time `redis:"created_at"`
+	UpdatedAt time.Time `redis:"updated_at"`
+}
@@ -0,0 +1,139 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/storage"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"gopkg.in/redis.v5"
+)
+
+// Store interface for device profiles and service profiles
+type Store interface {
+	ListDeviceProfiles(opts *storage.ListOptions) ([]*DeviceProfile, error)
+	GetDeviceProfile(profileID string) (*DeviceProfile, error)
+	SetDeviceProfile(new *DeviceProfile) error
+	DeleteDeviceProfile(profileID string) error
+	ListServiceProfiles(opts *storage.ListOptions) ([]*ServiceProfile, error)
+	GetServiceProfile(profileID string) (*ServiceProfile, error)
+	SetServiceProfile(new *ServiceProfile) error
+	DeleteServiceProfile(profileID string) error
+}
+
+const defaultRedisPrefix = "ns"
+
+const redisDeviceProfilePrefix = "device_profile"
+const redisServiceProfilePrefix = "service_profile"
+
+// NewRedisProfileStore creates a new Redis-based profile store
+func NewRedisProfileStore(client *redis.Client, prefix string) Store {
+	if prefix == "" {
+		prefix = defaultRedisPrefix
+	}
+	deviceProfiles := storage.NewRedisMapStore(client, prefix+":"+redisDeviceProfilePrefix)
+	deviceProfiles.SetBase(DeviceProfile{}, "")
+	serviceProfiles := storage.NewRedisMapStore(client, prefix+":"+redisServiceProfilePrefix)
+	serviceProfiles.SetBase(ServiceProfile{}, "")
+	return &RedisProfileStore{
+		deviceProfiles:  deviceProfiles,
+		serviceProfiles: serviceProfiles,
+	}
+}
+
+// RedisProfileStore stores profiles in Redis.
+// - Device profiles and service profiles are stored as a Hash
+type RedisProfileStore struct {
+	deviceProfiles  *storage.RedisMapStore
+	serviceProfiles *storage.RedisMapStore
+}
+
+// ListDeviceProfiles lists all device profiles
+func (s *RedisProfileStore) ListDeviceProfiles(opts *storage.ListOptions) ([]*DeviceProfile, error) {
+	profilesI, err := s.deviceProfiles.List("", opts)
+	if err != nil {
+		return nil, err
+	}
+	profiles := make([]*DeviceProfile, len(profilesI))
+	for i, profileI := range profilesI {
+		if profile, ok := profileI.(DeviceProfile); ok {
+			profiles[i] = &profile
+		}
+	}
+	return profiles, nil
+}
+
+// GetDeviceProfile gets a specific device profile
+func (s *RedisProfileStore) GetDeviceProfile(profileID string) (*DeviceProfile, error) {
+	profileI, err := s.deviceProfiles.Get(profileID)
+	if err != nil {
+		return nil, err
+	}
+	if profile, ok := profileI.(DeviceProfile); ok {
+		return &profile, nil
+	}
+	return nil, errors.New("Database did not return a DeviceProfile")
+}
+
+// SetDeviceProfile creates a new device profile or replaces an existing one
+func (s *RedisProfileStore) SetDeviceProfile(new *DeviceProfile) error {
+	now := time.Now()
+	new.UpdatedAt = now
+	if existing, err := s.GetDeviceProfile(new.ProfileID); err == nil {
+		new.CreatedAt = existing.CreatedAt
+	} else {
+		new.CreatedAt = now
+	}
+	return s.deviceProfiles.Set(new.ProfileID, *new)
+}
+
+// DeleteDeviceProfile deletes a device profile
+func (s *RedisProfileStore) DeleteDeviceProfile(profileID string) error {
+	return s.deviceProfiles.Delete(profileID)
+}
+
+// ListServiceProfiles lists all service profiles
+func (s *RedisProfileStore) ListServiceProfiles(opts *storage.ListOptions) ([]*ServiceProfile, error) {
+	profilesI, err := s.serviceProfiles.List("", opts)
+	if err != nil {
+		return nil, err
+	}
+	profiles := make([]*ServiceProfile, len(profilesI))
+	for i, profileI := range profilesI {
+		if profile, ok := profileI.(ServiceProfile); ok {
+			profiles[i] = &profile
+		}
+	}
+	return profiles, nil
+}
+
+// GetServiceProfile gets a specific service profile
+func (s *RedisProfileStore) GetServiceProfile(profileID string) (*ServiceProfile, error) {
+	profileI, err := s.serviceProfiles.Get(profileID)
+	if err != nil {
+		return nil, err
+	}
+	if profile, ok := profileI.(ServiceProfile); ok {
+		return &profile, nil
+	}
+	return nil, errors.New("Database did not return a ServiceProfile")
+}
+
+// SetServiceProfile creates a new service profile or replaces an existing one
+func (s *RedisProfileStore) SetServiceProfile(new *ServiceProfile) error {
+	now := time.Now()
+	new.UpdatedAt = now
+	if existing, err := s.GetServiceProfile(new.ProfileID); err == nil {
+		new.CreatedAt = existing.CreatedAt
+	} else {
+		new.CreatedAt = now
+	}
+	return s.serviceProfiles.Set(new.ProfileID, *new)
+}
+
+// DeleteServiceProfile deletes a service profile
+func (s *RedisProfileStore) DeleteServiceProfile(profileID string) error {
+	return s.serviceProfiles.Delete(profileID)
+}
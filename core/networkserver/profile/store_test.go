@@ -0,0 +1,105 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"testing"
+
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDeviceProfileStore(t *testing.T) {
+	a := New(t)
+
+	NewRedisProfileStore(GetRedisClient(), "")
+
+	s := NewRedisProfileStore(GetRedisClient(), "networkserver-test-device-profile-store")
+
+	// Get non-existing
+	profile, err := s.GetDeviceProfile("test-model")
+	a.So(err, ShouldNotBeNil)
+	a.So(profile, ShouldBeNil)
+
+	// Create
+	err = s.SetDeviceProfile(&DeviceProfile{
+		ProfileID:      "test-model",
+		MACVersion:     pb_lorawan.MACVersion_LORAWAN_1_1,
+		SupportsClassC: true,
+		MaxEIRP:        14,
+		RX2DataRate:    "SF9BW125",
+	})
+	defer func() {
+		s.DeleteDeviceProfile("test-model")
+	}()
+	a.So(err, ShouldBeNil)
+
+	// Get existing
+	profile, err = s.GetDeviceProfile("test-model")
+	a.So(err, ShouldBeNil)
+	a.So(profile.MACVersion, ShouldEqual, pb_lorawan.MACVersion_LORAWAN_1_1)
+	a.So(profile.SupportsClassC, ShouldBeTrue)
+	a.So(profile.MaxEIRP, ShouldEqual, 14)
+	a.So(profile.RX2DataRate, ShouldEqual, "SF9BW125")
+	createdAt := profile.CreatedAt
+
+	// Replace
+	err = s.SetDeviceProfile(&DeviceProfile{
+		ProfileID: "test-model",
+		MaxEIRP:   16,
+	})
+	a.So(err, ShouldBeNil)
+
+	profile, err = s.GetDeviceProfile("test-model")
+	a.So(err, ShouldBeNil)
+	a.So(profile.SupportsClassC, ShouldBeFalse)
+	a.So(profile.MaxEIRP, ShouldEqual, 16)
+	a.So(profile.RX2DataRate, ShouldBeEmpty)
+	a.So(profile.CreatedAt, ShouldResemble, createdAt)
+
+	// List
+	profiles, err := s.ListDeviceProfiles(nil)
+	a.So(err, ShouldBeNil)
+	a.So(profiles, ShouldHaveLength, 1)
+
+	// Delete
+	err = s.DeleteDeviceProfile("test-model")
+	a.So(err, ShouldBeNil)
+	profile, err = s.GetDeviceProfile("test-model")
+	a.So(err, ShouldNotBeNil)
+	a.So(profile, ShouldBeNil)
+}
+
+func TestServiceProfileStore(t *testing.T) {
+	a := New(t)
+
+	s := NewRedisProfileStore(GetRedisClient(), "networkserver-test-service-profile-store")
+
+	err := s.SetServiceProfile(&ServiceProfile{
+		ProfileID:        "test-service",
+		DisableFCntCheck: true,
+		ADRStrategy:      "conservative",
+		ADRMargin:        10,
+	})
+	defer func() {
+		s.DeleteServiceProfile("test-service")
+	}()
+	a.So(err, ShouldBeNil)
+
+	profile, err := s.GetServiceProfile("test-service")
+	a.So(err, ShouldBeNil)
+	a.So(profile.DisableFCntCheck, ShouldBeTrue)
+	a.So(profile.ADRStrategy, ShouldEqual, "conservative")
+	a.So(profile.ADRMargin, ShouldEqual, 10)
+
+	profiles, err := s.ListServiceProfiles(nil)
+	a.So(err, ShouldBeNil)
+	a.So(profiles, ShouldHaveLength, 1)
+
+	err = s.DeleteServiceProfile("test-service")
+	a.So(err, ShouldBeNil)
+	_, err = s.GetServiceProfile("test-service")
+	a.So(err, ShouldNotBeNil)
+}
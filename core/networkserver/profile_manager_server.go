@@ -0,0 +1,244 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/TheThingsNetwork/ttn/api/networkserver"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/profile"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+type profileManager struct {
+	networkServer *networkServer
+}
+
+// validateRead checks that the context is authenticated
+func (p *profileManager) validateRead(ctx context.Context) error {
+	if p.networkServer.Identity.Id == "dev" {
+		return nil
+	}
+	_, err := p.networkServer.ValidateTTNAuthContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "No access")
+	}
+	return nil
+}
+
+// validateWrite checks that the context has access to this NetworkServer
+func (p *profileManager) validateWrite(ctx context.Context) error {
+	if p.networkServer.Identity.Id == "dev" {
+		return nil
+	}
+	claims, err := p.networkServer.ValidateTTNAuthContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "No access")
+	}
+	if !claims.ComponentAccess(p.networkServer.Identity.Id) {
+		return errors.NewErrPermissionDenied(fmt.Sprintf("Claims do not grant access to %s", p.networkServer.Identity.Id))
+	}
+	return nil
+}
+
+func deviceProfileToPb(in *profile.DeviceProfile) *pb.DeviceProfile {
+	return &pb.DeviceProfile{
+		ProfileId:                  in.ProfileID,
+		Description:                in.Description,
+		MacVersion:                 in.MACVersion,
+		RegionalParametersRevision: in.RegionalParametersRevision,
+		SupportsClassB:             in.SupportsClassB,
+		SupportsClassC:             in.SupportsClassC,
+		SupportsJoin:               in.SupportsJoin,
+		MaxEirp:                    in.MaxEIRP,
+		Uses32BitFCnt:              in.Uses32BitFCnt,
+		Rx1DrOffset:                uint32(in.RX1DROffset),
+		Rx2DataRate:                in.RX2DataRate,
+		Rx2Frequency:               in.RX2Frequency,
+		RxDelay:                    uint32(in.RXDelay),
+	}
+}
+
+func deviceProfileFromPb(in *pb.DeviceProfile) *profile.DeviceProfile {
+	return &profile.DeviceProfile{
+		ProfileID:                  in.ProfileId,
+		Description:                in.Description,
+		MACVersion:                 in.MacVersion,
+		RegionalParametersRevision: in.RegionalParametersRevision,
+		SupportsClassB:             in.SupportsClassB,
+		SupportsClassC:             in.SupportsClassC,
+		SupportsJoin:               in.SupportsJoin,
+		MaxEIRP:                    in.MaxEirp,
+		Uses32BitFCnt:              in.Uses32BitFCnt,
+		RX1DROffset:                int(in.Rx1DrOffset),
+		RX2DataRate:                in.Rx2DataRate,
+		RX2Frequency:               in.Rx2Frequency,
+		RXDelay:                    int(in.RxDelay),
+	}
+}
+
+func serviceProfileToPb(in *profile.ServiceProfile) *pb.ServiceProfile {
+	return &pb.ServiceProfile{
+		ProfileId:             in.ProfileID,
+		Description:           in.Description,
+		ActivationConstraints: in.ActivationConstraints,
+		DisableFCntCheck:      in.DisableFCntCheck,
+		DevStatusInterval:     uint32(in.DevStatusInterval / time.Second),
+		AdrStrategy:           in.ADRStrategy,
+		AdrMargin:             uint32(in.ADRMargin),
+	}
+}
+
+func serviceProfileFromPb(in *pb.ServiceProfile) *profile.ServiceProfile {
+	return &profile.ServiceProfile{
+		ProfileID:             in.ProfileId,
+		Description:           in.Description,
+		ActivationConstraints: in.ActivationConstraints,
+		DisableFCntCheck:      in.DisableFCntCheck,
+		DevStatusInterval:     time.Duration(in.DevStatusInterval) * time.Second,
+		ADRStrategy:           in.AdrStrategy,
+		ADRMargin:             int(in.AdrMargin),
+	}
+}
+
+// checkProfileUnused returns an error if there are devices that refer to the profile
+func (p *profileManager) checkProfileUnused(refersTo func(dev *device.Device) bool) error {
+	devices, err := p.networkServer.devices.List(nil)
+	if err != nil {
+		return err
+	}
+	var used int
+	for _, dev := range devices {
+		if dev != nil && refersTo(dev) {
+			used++
+		}
+	}
+	if used > 0 {
+		return errors.NewErrInvalidArgument("Profile", fmt.Sprintf("is still used by %d devices", used))
+	}
+	return nil
+}
+
+func (p *profileManager) GetDeviceProfile(ctx context.Context, in *pb.ProfileIdentifier) (*pb.DeviceProfile, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Profile Identifier")
+	}
+	if err := p.validateRead(ctx); err != nil {
+		return nil, err
+	}
+	deviceProfile, err := p.networkServer.profiles.GetDeviceProfile(in.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+	return deviceProfileToPb(deviceProfile), nil
+}
+
+func (p *profileManager) SetDeviceProfile(ctx context.Context, in *pb.DeviceProfile) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Profile")
+	}
+	if err := p.validateWrite(ctx); err != nil {
+		return nil, err
+	}
+	if err := p.networkServer.profiles.SetDeviceProfile(deviceProfileFromPb(in)); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (p *profileManager) DeleteDeviceProfile(ctx context.Context, in *pb.ProfileIdentifier) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Profile Identifier")
+	}
+	if err := p.validateWrite(ctx); err != nil {
+		return nil, err
+	}
+	if err := p.checkProfileUnused(func(dev *device.Device) bool { return dev.Options.DeviceProfileID == in.ProfileId }); err != nil {
+		return nil, err
+	}
+	if err := p.networkServer.profiles.DeleteDeviceProfile(in.ProfileId); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (p *profileManager) GetDeviceProfiles(ctx context.Context, in *pb.ProfilesRequest) (*pb.DeviceProfileList, error) {
+	if err := p.validateRead(ctx); err != nil {
+		return nil, err
+	}
+	deviceProfiles, err := p.networkServer.profiles.ListDeviceProfiles(nil)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.DeviceProfileList{Profiles: make([]*pb.DeviceProfile, 0, len(deviceProfiles))}
+	for _, deviceProfile := range deviceProfiles {
+		res.Profiles = append(res.Profiles, deviceProfileToPb(deviceProfile))
+	}
+	return res, nil
+}
+
+func (p *profileManager) GetServiceProfile(ctx context.Context, in *pb.ProfileIdentifier) (*pb.ServiceProfile, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Profile Identifier")
+	}
+	if err := p.validateRead(ctx); err != nil {
+		return nil, err
+	}
+	serviceProfile, err := p.networkServer.profiles.GetServiceProfile(in.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+	return serviceProfileToPb(serviceProfile), nil
+}
+
+func (p *profileManager) SetServiceProfile(ctx context.Context, in *pb.ServiceProfile) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Service Profile")
+	}
+	if _, err := getADRStrategy(in.AdrStrategy); err != nil {
+		return nil, errors.Wrap(err, "Invalid Service Profile")
+	}
+	if err := p.validateWrite(ctx); err != nil {
+		return nil, err
+	}
+	if err := p.networkServer.profiles.SetServiceProfile(serviceProfileFromPb(in)); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (p *profileManager) DeleteServiceProfile(ctx context.Context, in *pb.ProfileIdentifier) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Profile Identifier")
+	}
+	if err := p.validateWrite(ctx); err != nil {
+		return nil, err
+	}
+	if err := p.checkProfileUnused(func(dev *device.Device) bool { return dev.Options.ServiceProfileID == in.ProfileId }); err != nil {
+		return nil, err
+	}
+	if err := p.networkServer.profiles.DeleteServiceProfile(in.ProfileId); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (p *profileManager) GetServiceProfiles(ctx context.Context, in *pb.ProfilesRequest) (*pb.ServiceProfileList, error) {
+	if err := p.validateRead(ctx); err != nil {
+		return nil, err
+	}
+	serviceProfiles, err := p.networkServer.profiles.ListServiceProfiles(nil)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.ServiceProfileList{Profiles: make([]*pb.ServiceProfile, 0, len(serviceProfiles))}
+	for _, serviceProfile := range serviceProfiles {
+		res.Profiles = append(res.Profiles, serviceProfileToPb(serviceProfile))
+	}
+	return res, nil
+}
@@ -0,0 +1,71 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/profile"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// applyDeviceProfile overrides the capabilities of the device with those of the device profile. Changed RX settings and
+// transmit parameters are sent to the device with the next uplink.
+func applyDeviceProfile(dev *device.Device, p *profile.DeviceProfile) {
+	dev.Options.MACVersion = p.MACVersion
+	dev.Options.RegionalParametersRevision = p.RegionalParametersRevision
+	dev.Options.Uses32BitFCnt = p.Uses32BitFCnt
+
+	if (dev.Options.Class == pb_lorawan.DeviceClass_CLASS_B && !p.SupportsClassB) ||
+		(dev.Options.Class == pb_lorawan.DeviceClass_CLASS_C && !p.SupportsClassC) {
+		dev.Options.Class = pb_lorawan.DeviceClass_CLASS_A
+	}
+
+	if dev.RX.RX1DROffset != p.RX1DROffset || dev.RX.RX2DataRate != p.RX2DataRate ||
+		dev.RX.RX2Frequency != p.RX2Frequency || dev.RX.RXDelay != p.RXDelay {
+		dev.RX.RX1DROffset = p.RX1DROffset
+		dev.RX.RX2DataRate = p.RX2DataRate
+		dev.RX.RX2Frequency = p.RX2Frequency
+		dev.RX.RXDelay = p.RXDelay
+		dev.RX.SendReq = true
+	}
+
+	if dev.TxParams.MaxEIRP != p.MaxEIRP {
+		dev.TxParams.MaxEIRP = p.MaxEIRP
+		dev.TxParams.SendReq = true
+	}
+}
+
+// applyServiceProfile overrides the network service settings of the device with those of the service profile
+func applyServiceProfile(dev *device.Device, p *profile.ServiceProfile) {
+	dev.Options.ActivationConstraints = p.ActivationConstraints
+	dev.Options.DisableFCntCheck = p.DisableFCntCheck
+	if p.DevStatusInterval != 0 {
+		dev.Options.DevStatusInterval = p.DevStatusInterval
+	}
+	dev.ADR.Strategy = p.ADRStrategy
+	dev.ADR.Margin = p.ADRMargin
+}
+
+// applyProfiles overrides the settings of the device with those of the device profile and service profile that it
+// refers to. The device profile is returned if the device refers to one.
+func (n *networkServer) applyProfiles(dev *device.Device) (*profile.DeviceProfile, error) {
+	var deviceProfile *profile.DeviceProfile
+	if id := dev.Options.DeviceProfileID; id != "" {
+		p, err := n.profiles.GetDeviceProfile(id)
+		if err != nil {
+			return nil, errors.Wrap(err, "Could not get device profile")
+		}
+		applyDeviceProfile(dev, p)
+		deviceProfile = p
+	}
+	if id := dev.Options.ServiceProfileID; id != "" {
+		p, err := n.profiles.GetServiceProfile(id)
+		if err != nil {
+			return nil, errors.Wrap(err, "Could not get service profile")
+		}
+		applyServiceProfile(dev, p)
+	}
+	return deviceProfile, nil
+}
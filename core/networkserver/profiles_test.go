@@ -0,0 +1,104 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/profile"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestApplyProfiles(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		profiles: profile.NewRedisProfileStore(GetRedisClient(), "ns-test-apply-profiles"),
+	}
+
+	defer func() {
+		keys, _ := GetRedisClient().Keys("*ns-test-apply-profiles*").Result()
+		for _, key := range keys {
+			GetRedisClient().Del(key).Result()
+		}
+	}()
+
+	// Device without profiles
+	dev := &device.Device{Options: device.Options{Class: pb_lorawan.DeviceClass_CLASS_C}}
+	deviceProfile, err := ns.applyProfiles(dev)
+	a.So(err, ShouldBeNil)
+	a.So(deviceProfile, ShouldBeNil)
+	a.So(dev.Options.Class, ShouldEqual, pb_lorawan.DeviceClass_CLASS_C)
+
+	// Unknown profile
+	dev.Options.DeviceProfileID = "dp"
+	_, err = ns.applyProfiles(dev)
+	a.So(err, ShouldNotBeNil)
+
+	ns.profiles.SetDeviceProfile(&profile.DeviceProfile{
+		ProfileID:                  "dp",
+		MACVersion:                 pb_lorawan.MACVersion_LORAWAN_1_1,
+		RegionalParametersRevision: "RP002-1.0.0",
+		SupportsJoin:               true,
+		MaxEIRP:                    14,
+		Uses32BitFCnt:              true,
+		RX2DataRate:                "SF9BW125",
+	})
+	ns.profiles.SetServiceProfile(&profile.ServiceProfile{
+		ProfileID:         "sp",
+		DisableFCntCheck:  true,
+		DevStatusInterval: time.Hour,
+		ADRStrategy:       "conservative",
+		ADRMargin:         20,
+	})
+
+	dev.Options.ServiceProfileID = "sp"
+	deviceProfile, err = ns.applyProfiles(dev)
+	a.So(err, ShouldBeNil)
+	a.So(deviceProfile, ShouldNotBeNil)
+	a.So(deviceProfile.SupportsJoin, ShouldBeTrue)
+	a.So(dev.Options.Class, ShouldEqual, pb_lorawan.DeviceClass_CLASS_A)
+	a.So(dev.Options.MACVersion, ShouldEqual, pb_lorawan.MACVersion_LORAWAN_1_1)
+	a.So(dev.Options.RegionalParametersRevision, ShouldEqual, "RP002-1.0.0")
+	a.So(dev.Options.Uses32BitFCnt, ShouldBeTrue)
+	a.So(dev.RX.RX2DataRate, ShouldEqual, "SF9BW125")
+	a.So(dev.RX.SendReq, ShouldBeTrue)
+	a.So(dev.TxParams.MaxEIRP, ShouldEqual, 14)
+	a.So(dev.TxParams.SendReq, ShouldBeTrue)
+	a.So(dev.Options.DisableFCntCheck, ShouldBeTrue)
+	a.So(dev.Options.DevStatusInterval, ShouldEqual, time.Hour)
+	a.So(dev.ADR.Strategy, ShouldEqual, "conservative")
+	a.So(dev.ADR.Margin, ShouldEqual, 20)
+
+	// No MAC commands if the device already uses the settings of its profile
+	dev.RX.SendReq = false
+	dev.TxParams.SendReq = false
+	_, err = ns.applyProfiles(dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.RX.SendReq, ShouldBeFalse)
+	a.So(dev.TxParams.SendReq, ShouldBeFalse)
+}
+
+func TestCheckProfileUnused(t *testing.T) {
+	a := New(t)
+	p := &profileManager{networkServer: &networkServer{
+		devices: device.NewRedisDeviceStore(GetRedisClient(), "ns-test-check-profile-unused"),
+	}}
+
+	appEUI := types.AppEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 8))
+	devEUI := types.DevEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 8))
+	p.networkServer.devices.Set(&device.Device{AppEUI: appEUI, DevEUI: devEUI, Options: device.Options{DeviceProfileID: "dp"}})
+	defer func() {
+		p.networkServer.devices.Delete(appEUI, devEUI)
+	}()
+
+	err := p.checkProfileUnused(func(dev *device.Device) bool { return dev.Options.DeviceProfileID == "dp" })
+	a.So(err, ShouldNotBeNil)
+	err = p.checkProfileUnused(func(dev *device.Device) bool { return dev.Options.DeviceProfileID == "other" })
+	a.So(err, ShouldBeNil)
+}
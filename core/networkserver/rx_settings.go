@@ -0,0 +1,172 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"fmt"
+
+	"github.com/TheThingsNetwork/go-utils/log"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/brocaar/lorawan"
+)
+
+// rxParams returns the RXParamSetupReq payload for the given settings, using the defaults of the frequency plan for zero values
+func rxParams(fp band.FrequencyPlan, rx1DROffset int, rx2DataRate string, rx2Frequency uint64) (*lorawan.RXParamSetupReqPayload, error) {
+	params := &lorawan.RXParamSetupReqPayload{
+		Frequency: uint32(fp.RX2Frequency),
+		DLSettings: lorawan.DLSettings{
+			RX1DROffset: uint8(rx1DROffset),
+			RX2DataRate: uint8(fp.RX2DataRate),
+		},
+	}
+	if rx2DataRate != "" {
+		drIdx, err := fp.GetDataRateIndexFor(rx2DataRate)
+		if err != nil {
+			return nil, err
+		}
+		params.DLSettings.RX2DataRate = uint8(drIdx)
+	}
+	if rx2Frequency != 0 {
+		params.Frequency = uint32(rx2Frequency)
+	}
+	return params, nil
+}
+
+// rxDelay returns the RXTimingSetupReq delay for the given delay in seconds (0 and 1 both indicate 1 second)
+func rxDelay(delay int) uint8 {
+	if delay <= 1 {
+		return 0
+	}
+	return uint8(delay)
+}
+
+// handleUplinkRXSettings queues a RXParamSetupReq and RXTimingSetupReq if the desired receive window settings differ from
+// the settings that were acknowledged by the device
+func (n *networkServer) handleUplinkRXSettings(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device) error {
+	if !dev.RX.SendReq {
+		return nil
+	}
+
+	fp, err := band.Get(message.GetProtocolMetadata().GetLorawan().GetFrequencyPlan().String())
+	if err != nil {
+		return err
+	}
+
+	queue, err := n.devices.MACCommands(dev.AppEUI, dev.DevEUI)
+	if err != nil {
+		return err
+	}
+
+	desired, err := rxParams(fp, dev.RX.RX1DROffset, dev.RX.RX2DataRate, dev.RX.RX2Frequency)
+	if err != nil {
+		return err
+	}
+	acked, err := rxParams(fp, dev.RX.AckedRX1DROffset, dev.RX.AckedRX2DataRate, dev.RX.AckedRX2Frequency)
+	if err != nil {
+		return err
+	}
+	if *desired != *acked {
+		payload, err := desired.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		if err := queue.Push(&device.MACCommand{CID: uint32(lorawan.RXParamSetupReq), Payload: payload}); err != nil {
+			return err
+		}
+	}
+
+	if delay := rxDelay(dev.RX.RXDelay); delay != rxDelay(dev.RX.AckedRXDelay) {
+		payload, err := (&lorawan.RXTimingSetupReqPayload{Delay: delay}).MarshalBinary()
+		if err != nil {
+			return err
+		}
+		if err := queue.Push(&device.MACCommand{CID: uint32(lorawan.RXTimingSetupReq), Payload: payload}); err != nil {
+			return err
+		}
+	}
+
+	dev.RX.SendReq = false
+
+	return nil
+}
+
+// pendingMACCommand returns the pending MAC command with the given CID, or nil if there is none
+func (n *networkServer) pendingMACCommand(dev *device.Device, cid lorawan.CID) (*device.MACCommand, error) {
+	queue, err := n.devices.MACCommands(dev.AppEUI, dev.DevEUI)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := queue.Get()
+	if err != nil {
+		return nil, err
+	}
+	for _, cmd := range pending {
+		if cmd.CID == uint32(cid) {
+			return cmd, nil
+		}
+	}
+	return nil, nil
+}
+
+func (n *networkServer) handleUplinkRXParamSetup(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device, cmd pb_lorawan.MACCommand) error {
+	var answer lorawan.RXParamSetupAnsPayload
+	if err := answer.UnmarshalBinary(cmd.Payload); err != nil {
+		return nil
+	}
+	message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "rx-param-setup",
+		"channel-ack", answer.ChannelACK,
+		"rx2-data-rate-ack", answer.RX2DataRateACK,
+		"rx1-dr-offset-ack", answer.RX1DROffsetACK,
+	)
+	if !answer.ChannelACK || !answer.RX2DataRateACK || !answer.RX1DROffsetACK {
+		n.Ctx.WithFields(log.Fields{
+			"AppEUI": dev.AppEUI,
+			"DevEUI": dev.DevEUI,
+			"Answer": fmt.Sprintf("%v/%v/%v", answer.ChannelACK, answer.RX2DataRateACK, answer.RX1DROffsetACK),
+		}).Warn("Negative RXParamSetupAns")
+		return nil
+	}
+
+	req, err := n.pendingMACCommand(dev, lorawan.RXParamSetupReq)
+	if err != nil || req == nil {
+		return err
+	}
+	var params lorawan.RXParamSetupReqPayload
+	if err := params.UnmarshalBinary(req.Payload); err != nil {
+		return err
+	}
+	fp, err := band.Get(message.GetProtocolMetadata().GetLorawan().GetFrequencyPlan().String())
+	if err != nil {
+		return err
+	}
+	rx2DataRate, err := fp.GetDataRateStringForIndex(int(params.DLSettings.RX2DataRate))
+	if err != nil {
+		return err
+	}
+	dev.RX.AckedRX1DROffset = int(params.DLSettings.RX1DROffset)
+	dev.RX.AckedRX2DataRate = rx2DataRate
+	dev.RX.AckedRX2Frequency = uint64(params.Frequency)
+
+	return nil
+}
+
+func (n *networkServer) handleUplinkRXTimingSetup(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device) error {
+	req, err := n.pendingMACCommand(dev, lorawan.RXTimingSetupReq)
+	if err != nil || req == nil {
+		return err
+	}
+	var params lorawan.RXTimingSetupReqPayload
+	if err := params.UnmarshalBinary(req.Payload); err != nil {
+		return err
+	}
+	message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "rx-timing-setup",
+		"delay", params.Delay,
+	)
+	dev.RX.AckedRXDelay = int(params.Delay)
+	return nil
+}
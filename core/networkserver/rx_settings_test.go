@@ -0,0 +1,101 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestRXDelay(t *testing.T) {
+	a := New(t)
+	a.So(rxDelay(0), ShouldEqual, 0)
+	a.So(rxDelay(1), ShouldEqual, 0)
+	a.So(rxDelay(5), ShouldEqual, 5)
+}
+
+func TestHandleRXSettings(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		Component: &component.Component{
+			Ctx: GetLogger(t, "TestHandleRXSettings"),
+		},
+		devices: device.NewRedisDeviceStore(GetRedisClient(), "ns-test-handle-rx-settings"),
+	}
+
+	defer func() {
+		keys, _ := GetRedisClient().Keys("*ns-test-handle-rx-settings*").Result()
+		for _, key := range keys {
+			GetRedisClient().Del(key).Result()
+		}
+	}()
+
+	dev := &device.Device{AppEUI: types.AppEUI([8]byte{1}), DevEUI: types.DevEUI([8]byte{1})}
+	queue, _ := ns.devices.MACCommands(dev.AppEUI, dev.DevEUI)
+
+	// Nothing to do
+	err := ns.handleUplinkRXSettings(adrInitUplinkMessage(), dev)
+	a.So(err, ShouldBeNil)
+	pending, _ := queue.Get()
+	a.So(pending, ShouldBeEmpty)
+
+	// Defaults of the frequency plan don't need a MAC command
+	dev.RX = device.RXSettings{SendReq: true, RX2DataRate: "SF9BW125", RXDelay: 1}
+	err = ns.handleUplinkRXSettings(adrInitUplinkMessage(), dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.RX.SendReq, ShouldBeFalse)
+	pending, _ = queue.Get()
+	a.So(pending, ShouldBeEmpty)
+
+	// Changed settings
+	dev.RX = device.RXSettings{SendReq: true, RX1DROffset: 1, RX2DataRate: "SF12BW125", RXDelay: 3}
+	err = ns.handleUplinkRXSettings(adrInitUplinkMessage(), dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.RX.SendReq, ShouldBeFalse)
+	pending, _ = queue.Get()
+	a.So(pending, ShouldHaveLength, 2)
+	a.So(pending[0].CID, ShouldEqual, lorawan.RXParamSetupReq)
+	var params lorawan.RXParamSetupReqPayload
+	params.UnmarshalBinary(pending[0].Payload)
+	a.So(params.Frequency, ShouldEqual, 869525000)
+	a.So(params.DLSettings.RX1DROffset, ShouldEqual, 1)
+	a.So(params.DLSettings.RX2DataRate, ShouldEqual, 0)
+	a.So(pending[1].CID, ShouldEqual, lorawan.RXTimingSetupReq)
+	a.So(pending[1].Payload, ShouldResemble, []byte{3})
+
+	// Negative answer
+	message := adrInitUplinkMessage()
+	answer, _ := (&lorawan.RXParamSetupAnsPayload{ChannelACK: true, RX1DROffsetACK: true}).MarshalBinary()
+	err = ns.handleUplinkRXParamSetup(message, dev, pb_lorawan.MACCommand{Cid: uint32(lorawan.RXParamSetupAns), Payload: answer})
+	a.So(err, ShouldBeNil)
+	a.So(dev.RX.AckedRX2DataRate, ShouldBeEmpty)
+
+	// Positive answers
+	message = adrInitUplinkMessage()
+	answer, _ = (&lorawan.RXParamSetupAnsPayload{ChannelACK: true, RX2DataRateACK: true, RX1DROffsetACK: true}).MarshalBinary()
+	err = ns.handleUplinkRXParamSetup(message, dev, pb_lorawan.MACCommand{Cid: uint32(lorawan.RXParamSetupAns), Payload: answer})
+	a.So(err, ShouldBeNil)
+	a.So(dev.RX.AckedRX1DROffset, ShouldEqual, 1)
+	a.So(dev.RX.AckedRX2DataRate, ShouldEqual, "SF12BW125")
+	a.So(dev.RX.AckedRX2Frequency, ShouldEqual, 869525000)
+
+	err = ns.handleUplinkRXTimingSetup(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.RX.AckedRXDelay, ShouldEqual, 3)
+
+	// Nothing changed anymore
+	queue.Clear()
+	dev.RX.SendReq = true
+	err = ns.handleUplinkRXSettings(adrInitUplinkMessage(), dev)
+	a.So(err, ShouldBeNil)
+	pending, _ = queue.Get()
+	a.So(pending, ShouldBeEmpty)
+}
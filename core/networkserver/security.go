@@ -0,0 +1,219 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"bytes"
+	"crypto/aes"
+	"encoding/binary"
+	"fmt"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/mic"
+	"github.com/brocaar/lorawan"
+)
+
+// resetInd is the CID of the ResetInd and ResetConf MAC commands (LoRaWAN 1.1)
+const resetInd = lorawan.CID(0x01)
+
+// rekeyInd is the CID of the RekeyInd and RekeyConf MAC commands (LoRaWAN 1.1)
+const rekeyInd = lorawan.CID(0x0B)
+
+// serverMinorVersion is the highest minor version of LoRaWAN 1.x that is supported by the NetworkServer
+const serverMinorVersion = 1
+
+// fOptsOffset is the offset of the FOpts in the PHYPayload of a data message (MHDR, DevAddr, FCtrl, FCnt)
+const fOptsOffset = 8
+
+// cryptFOpts encrypts or decrypts the FOpts of a LoRaWAN 1.1 data message with the NwkSEncKey
+func cryptFOpts(nwkSEncKey types.NwkSKey, uplink bool, devAddr types.DevAddr, fCnt uint32, fOpts []byte) ([]byte, error) {
+	if len(fOpts) > 15 {
+		return nil, errors.NewErrInvalidArgument("FOpts", "can not be longer than 15 bytes")
+	}
+	block, err := aes.NewCipher(nwkSEncKey[:])
+	if err != nil {
+		return nil, err
+	}
+	a := make([]byte, aes.BlockSize)
+	a[0] = 0x01
+	if !uplink {
+		a[5] = 0x01
+	}
+	binary.LittleEndian.PutUint32(a[6:10], binary.BigEndian.Uint32(devAddr[:]))
+	binary.LittleEndian.PutUint32(a[10:14], fCnt)
+	a[15] = 0x01
+	s := make([]byte, aes.BlockSize)
+	block.Encrypt(s, a)
+	out := make([]byte, len(fOpts))
+	for i := range fOpts {
+		out[i] = fOpts[i] ^ s[i]
+	}
+	return out, nil
+}
+
+// uplinkChannel returns the data rate index and channel index of an uplink message
+func uplinkChannel(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device) (txDR, txCh uint8, err error) {
+	lorawanMeta := message.GetProtocolMetadata().GetLorawan()
+	fp, err := band.Get(lorawanMeta.GetFrequencyPlan().String())
+	if err != nil {
+		return 0, 0, err
+	}
+	dr, err := fp.GetDataRateIndexFor(lorawanMeta.GetDataRate())
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(message.GetGatewayMetadata()) == 0 {
+		return 0, 0, errors.NewErrInvalidArgument("Uplink", "no gateway metadata")
+	}
+	frequency := message.GatewayMetadata[0].Frequency
+	for i, ch := range deviceChannels(fp, dev) {
+		if ch.Frequency == frequency {
+			return uint8(dr), uint8(i), nil
+		}
+	}
+	return 0, 0, errors.NewErrInvalidArgument("Uplink", fmt.Sprintf("frequency %d is not in the channel table of the device", frequency))
+}
+
+// handleUplinkSecurity validates the full MIC of an uplink message of a LoRaWAN 1.1 device and decrypts its FOpts. The
+// Broker only validates the half of the MIC that is computed with the FNwkSIntKey.
+func (n *networkServer) handleUplinkSecurity(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device) error {
+	lorawanUplinkMsg := message.GetMessage().GetLorawan()
+	lorawanUplinkMac := lorawanUplinkMsg.GetMacPayload()
+	payload := message.GetPayload()
+	if len(payload) < fOptsOffset+4 {
+		return errors.NewErrInvalidArgument("Uplink", "payload too short")
+	}
+
+	txDR, txCh, err := uplinkChannel(message, dev)
+	if err != nil {
+		return err
+	}
+	var confFCnt uint32
+	if lorawanUplinkMac.Ack {
+		confFCnt = dev.Session.LastConfirmedFCntDown
+	}
+	uplinkMIC, err := mic.UplinkMIC(dev.NwkSKey, dev.Session.SNwkSIntKey, confFCnt, txDR, txCh, dev.DevAddr, lorawanUplinkMac.FCnt, payload[:len(payload)-4])
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(uplinkMIC[:], payload[len(payload)-4:]) {
+		return errors.NewErrPermissionDenied("Invalid MIC")
+	}
+
+	if fOptsLen := int(payload[5] & 0x0f); fOptsLen > 0 {
+		if len(payload) < fOptsOffset+fOptsLen+4 {
+			return errors.NewErrInvalidArgument("Uplink", "payload too short")
+		}
+		fOpts, err := cryptFOpts(dev.Session.NwkSEncKey, true, dev.DevAddr, lorawanUplinkMac.FCnt, payload[fOptsOffset:fOptsOffset+fOptsLen])
+		if err != nil {
+			return err
+		}
+		decrypted := make([]byte, len(payload))
+		copy(decrypted, payload)
+		copy(decrypted[fOptsOffset:], fOpts)
+		msg, err := pb_lorawan.MessageFromPHYPayloadBytes(decrypted)
+		if err != nil {
+			return err
+		}
+		lorawanUplinkMac.FOpts = msg.GetMacPayload().FOpts
+	}
+
+	if lorawanUplinkMsg.IsConfirmed() {
+		dev.Session.LastConfirmedFCntUp = lorawanUplinkMac.FCnt
+	}
+
+	return nil
+}
+
+// handleDownlinkSecurity encrypts the FOpts of a downlink message to a LoRaWAN 1.1 device and returns the marshaled
+// message with the MIC computed with the SNwkSIntKey. MAC commands on FPort 0 must already be encrypted.
+func handleDownlinkSecurity(message *pb_broker.DownlinkMessage, dev *device.Device) ([]byte, error) {
+	lorawanDownlinkMsg := message.GetMessage().GetLorawan()
+	lorawanDownlinkMac := lorawanDownlinkMsg.GetMacPayload()
+
+	if len(lorawanDownlinkMac.FOpts) != 0 {
+		fOpts, err := cryptFOpts(dev.Session.NwkSEncKey, false, dev.DevAddr, lorawanDownlinkMac.FCnt, marshalMACCommands(lorawanDownlinkMac.FOpts))
+		if err != nil {
+			return nil, err
+		}
+		// The encrypted FOpts are marshaled as they are
+		lorawanDownlinkMac.FOpts = []pb_lorawan.MACCommand{{Cid: uint32(fOpts[0]), Payload: fOpts[1:]}}
+	}
+
+	phyPayload := lorawanDownlinkMsg.PHYPayload()
+	phyBytes, err := phyPayload.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	var confFCnt uint32
+	if lorawanDownlinkMac.Ack {
+		confFCnt = dev.Session.LastConfirmedFCntUp
+	}
+	downlinkMIC, err := mic.DownlinkMIC(dev.Session.SNwkSIntKey, confFCnt, dev.DevAddr, lorawanDownlinkMac.FCnt, phyBytes[:len(phyBytes)-4])
+	if err != nil {
+		return nil, err
+	}
+	copy(phyBytes[len(phyBytes)-4:], downlinkMIC[:])
+	lorawanDownlinkMsg.Mic = downlinkMIC[:]
+
+	if lorawanDownlinkMsg.IsConfirmed() {
+		dev.Session.LastConfirmedFCntDown = lorawanDownlinkMac.FCnt
+	}
+
+	return phyBytes, nil
+}
+
+// minorVersion returns the minor version that the NetworkServer answers a ResetInd or RekeyInd with
+func minorVersion(cmd pb_lorawan.MACCommand) byte {
+	minor := cmd.Payload[0] & 0x0f
+	if minor > serverMinorVersion {
+		minor = serverMinorVersion
+	}
+	return minor
+}
+
+// handleUplinkReset answers the ResetInd of an ABP device, which resets its MAC settings to the defaults
+func (n *networkServer) handleUplinkReset(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device, cmd pb_lorawan.MACCommand) {
+	if dev.Options.MACVersion != pb_lorawan.MACVersion_LORAWAN_1_1 || len(cmd.Payload) != 1 {
+		return
+	}
+	minor := minorVersion(cmd)
+	message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "reset",
+		"minor", minor,
+	)
+	resetMACSettings(dev)
+	dev.Channels = nil
+
+	if lorawanDownlinkMac := message.GetResponseTemplate().GetMessage().GetLorawan().GetMacPayload(); lorawanDownlinkMac != nil {
+		lorawanDownlinkMac.FOpts = append(lorawanDownlinkMac.FOpts, pb_lorawan.MACCommand{
+			Cid:     uint32(resetInd),
+			Payload: []byte{minor},
+		})
+	}
+}
+
+// handleUplinkRekey answers the RekeyInd of an OTAA device, which indicates that the device uses its new session keys
+func (n *networkServer) handleUplinkRekey(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device, cmd pb_lorawan.MACCommand) {
+	if dev.Options.MACVersion != pb_lorawan.MACVersion_LORAWAN_1_1 || len(cmd.Payload) != 1 {
+		return
+	}
+	minor := minorVersion(cmd)
+	message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "rekey",
+		"minor", minor,
+	)
+
+	if lorawanDownlinkMac := message.GetResponseTemplate().GetMessage().GetLorawan().GetMacPayload(); lorawanDownlinkMac != nil {
+		lorawanDownlinkMac.FOpts = append(lorawanDownlinkMac.FOpts, pb_lorawan.MACCommand{
+			Cid:     uint32(rekeyInd),
+			Payload: []byte{minor},
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
+	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/mic"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func securityTestDevice() *device.Device {
+	return &device.Device{
+		DevAddr: types.DevAddr{1, 2, 3, 4},
+		NwkSKey: types.NwkSKey{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		Options: device.Options{MACVersion: pb_lorawan.MACVersion_LORAWAN_1_1},
+		Session: device.SessionKeys{
+			SNwkSIntKey: types.NwkSKey{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
+			NwkSEncKey:  types.NwkSKey{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3},
+		},
+	}
+}
+
+func TestCryptFOpts(t *testing.T) {
+	a := New(t)
+	key := types.NwkSKey{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3}
+	fOpts := []byte{0x02, 0x03, 0x05, 0x01}
+
+	encrypted, err := cryptFOpts(key, true, types.DevAddr{1, 2, 3, 4}, 1, fOpts)
+	a.So(err, ShouldBeNil)
+	a.So(encrypted, ShouldHaveLength, 4)
+	a.So(encrypted, ShouldNotResemble, fOpts)
+
+	decrypted, err := cryptFOpts(key, true, types.DevAddr{1, 2, 3, 4}, 1, encrypted)
+	a.So(err, ShouldBeNil)
+	a.So(decrypted, ShouldResemble, fOpts)
+
+	downlink, _ := cryptFOpts(key, false, types.DevAddr{1, 2, 3, 4}, 1, fOpts)
+	a.So(downlink, ShouldNotResemble, encrypted)
+
+	_, err = cryptFOpts(key, true, types.DevAddr{1, 2, 3, 4}, 1, make([]byte, 16))
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestHandleUplinkSecurity(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{}
+	dev := securityTestDevice()
+
+	buildMessage := func(fCnt uint32, uplinkMIC func(msg []byte) [4]byte) *pb_broker.DeduplicatedUplinkMessage {
+		phy := lorawan.PHYPayload{
+			MHDR: lorawan.MHDR{MType: lorawan.ConfirmedDataUp, Major: lorawan.LoRaWANR1},
+			MACPayload: &lorawan.MACPayload{
+				FHDR: lorawan.FHDR{
+					DevAddr: lorawan.DevAddr(dev.DevAddr),
+					FCnt:    fCnt,
+					FOpts:   []lorawan.MACCommand{{CID: lorawan.LinkCheckReq}},
+				},
+			},
+		}
+		msg := pb_lorawan.MessageFromPHYPayload(phy)
+		payload, _ := phy.MarshalBinary()
+		fOpts, _ := cryptFOpts(dev.Session.NwkSEncKey, true, dev.DevAddr, fCnt, payload[8:9])
+		copy(payload[8:9], fOpts)
+		msg.GetMacPayload().FOpts = []pb_lorawan.MACCommand{{Cid: uint32(fOpts[0])}}
+		computed := uplinkMIC(payload[:len(payload)-4])
+		copy(payload[len(payload)-4:], computed[:])
+
+		return &pb_broker.DeduplicatedUplinkMessage{
+			Payload: payload,
+			Message: &pb_protocol.Message{Protocol: &pb_protocol.Message_Lorawan{Lorawan: &msg}},
+			ProtocolMetadata: &pb_protocol.RxMetadata{Protocol: &pb_protocol.RxMetadata_Lorawan{Lorawan: &pb_lorawan.Metadata{
+				FrequencyPlan: pb_lorawan.FrequencyPlan_EU_863_870,
+				DataRate:      "SF7BW125",
+			}}},
+			GatewayMetadata: []*pb_gateway.RxMetadata{{Frequency: 868300000}},
+		}
+	}
+
+	// Valid MIC (SF7BW125 is DR5, 868.3 MHz is channel 1)
+	message := buildMessage(42, func(msg []byte) [4]byte {
+		res, _ := mic.UplinkMIC(dev.NwkSKey, dev.Session.SNwkSIntKey, 0, 5, 1, dev.DevAddr, 42, msg)
+		return res
+	})
+	err := ns.handleUplinkSecurity(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(message.GetMessage().GetLorawan().GetMacPayload().FOpts, ShouldHaveLength, 1)
+	a.So(message.GetMessage().GetLorawan().GetMacPayload().FOpts[0].Cid, ShouldEqual, lorawan.LinkCheckReq)
+	a.So(dev.Session.LastConfirmedFCntUp, ShouldEqual, 42)
+
+	// MIC computed for another channel
+	message = buildMessage(43, func(msg []byte) [4]byte {
+		res, _ := mic.UplinkMIC(dev.NwkSKey, dev.Session.SNwkSIntKey, 0, 5, 2, dev.DevAddr, 43, msg)
+		return res
+	})
+	err = ns.handleUplinkSecurity(message, dev)
+	a.So(err, ShouldNotBeNil)
+
+	// LoRaWAN 1.0 MIC
+	message = buildMessage(44, func(msg []byte) [4]byte {
+		res, _ := mic.LegacyUplinkMIC(dev.NwkSKey, dev.DevAddr, 44, msg)
+		return res
+	})
+	err = ns.handleUplinkSecurity(message, dev)
+	a.So(err, ShouldNotBeNil)
+	a.So(dev.Session.LastConfirmedFCntUp, ShouldEqual, 42)
+
+	// Frequency that is not in the channel table of the device
+	message = buildMessage(45, func(msg []byte) [4]byte {
+		res, _ := mic.UplinkMIC(dev.NwkSKey, dev.Session.SNwkSIntKey, 0, 5, 0, dev.DevAddr, 45, msg)
+		return res
+	})
+	message.GatewayMetadata[0].Frequency = 867100000
+	err = ns.handleUplinkSecurity(message, dev)
+	a.So(err, ShouldNotBeNil)
+	a.So(dev.Session.LastConfirmedFCntUp, ShouldEqual, 42)
+}
+
+func TestHandleDownlinkSecurity(t *testing.T) {
+	a := New(t)
+	dev := securityTestDevice()
+	dev.Session.LastConfirmedFCntUp = 42
+
+	message := &pb_broker.DownlinkMessage{Message: new(pb_protocol.Message)}
+	lorawanMsg := message.Message.InitLoRaWAN()
+	lorawanMsg.MType = pb_lorawan.MType_CONFIRMED_DOWN
+	mac := lorawanMsg.InitDownlink()
+	mac.DevAddr = dev.DevAddr
+	mac.FCnt = 10
+	mac.Ack = true
+	mac.FOpts = []pb_lorawan.MACCommand{{Cid: uint32(lorawan.LinkCheckAns), Payload: []byte{20, 1}}}
+
+	payload, err := handleDownlinkSecurity(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(payload[5]&0x0f, ShouldEqual, 3)
+
+	fOpts, _ := cryptFOpts(dev.Session.NwkSEncKey, false, dev.DevAddr, 10, payload[8:11])
+	a.So(fOpts, ShouldResemble, []byte{byte(lorawan.LinkCheckAns), 20, 1})
+
+	expected, _ := mic.DownlinkMIC(dev.Session.SNwkSIntKey, 42, dev.DevAddr, 10, payload[:len(payload)-4])
+	a.So(payload[len(payload)-4:], ShouldResemble, expected[:])
+	a.So(dev.Session.LastConfirmedFCntDown, ShouldEqual, 10)
+}
+
+func TestHandleUplinkResetRekey(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{}
+
+	dev := securityTestDevice()
+	dev.Channels = []device.Channel{{Frequency: 868100000}}
+	dev.RX.AckedRXDelay = 5
+	message := adrInitUplinkMessage()
+	ns.handleUplinkReset(message, dev, pb_lorawan.MACCommand{Cid: uint32(resetInd), Payload: []byte{0x02}})
+	a.So(dev.Channels, ShouldBeNil)
+	a.So(dev.RX.AckedRXDelay, ShouldEqual, 0)
+	a.So(dev.RX.SendReq, ShouldBeTrue)
+	fOpts := message.GetResponseTemplate().GetMessage().GetLorawan().GetMacPayload().FOpts
+	a.So(fOpts, ShouldHaveLength, 1)
+	a.So(fOpts[0].Cid, ShouldEqual, resetInd)
+	a.So(fOpts[0].Payload, ShouldResemble, []byte{0x01})
+
+	message = adrInitUplinkMessage()
+	ns.handleUplinkRekey(message, dev, pb_lorawan.MACCommand{Cid: uint32(rekeyInd), Payload: []byte{0x01}})
+	fOpts = message.GetResponseTemplate().GetMessage().GetLorawan().GetMacPayload().FOpts
+	a.So(fOpts, ShouldHaveLength, 1)
+	a.So(fOpts[0].Cid, ShouldEqual, rekeyInd)
+	a.So(fOpts[0].Payload, ShouldResemble, []byte{0x01})
+
+	// LoRaWAN 1.0 devices do not send ResetInd or RekeyInd
+	dev.Options.MACVersion = pb_lorawan.MACVersion_LORAWAN_1_0
+	message = adrInitUplinkMessage()
+	ns.handleUplinkRekey(message, dev, pb_lorawan.MACCommand{Cid: uint32(rekeyInd), Payload: []byte{0x01}})
+	a.So(message.GetResponseTemplate().GetMessage().GetLorawan().GetMacPayload().FOpts, ShouldBeEmpty)
+}
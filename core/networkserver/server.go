@@ -118,6 +118,20 @@ func (s *networkServerRPC) Downlink(ctx context.Context, message *broker.Downlin
 	return res, nil
 }
 
+func (s *networkServerRPC) MulticastDownlink(ctx context.Context, message *broker.DownlinkMessage) (*pb.MulticastDownlinkResponse, error) {
+	if err := s.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := message.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Downlink")
+	}
+	res, err := s.networkServer.HandleMulticastDownlink(message)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MulticastDownlinkResponse{Downlinks: res}, nil
+}
+
 // RegisterRPC registers this networkserver as a NetworkServerServer (github.com/TheThingsNetwork/ttn/api/networkserver)
 func (n *networkServer) RegisterRPC(s *grpc.Server) {
 	server := &networkServerRPC{n}
@@ -49,5 +49,18 @@ func (n *networkServer) GetStatus() *pb.Status {
 		Rate5:  float32(activations.Rate5()),
 		Rate15: float32(activations.Rate15()),
 	}
+	for prefix, usage := range n.prefixes {
+		prefixStatus := &pb.Status_PrefixStatus{
+			Prefix:         prefix.String(),
+			Usage:          usage,
+			TotalAddresses: prefixSize(prefix),
+		}
+		if n.devices != nil {
+			if used, err := n.devices.CountAddresses(prefix); err == nil {
+				prefixStatus.UsedAddresses = uint64(used)
+			}
+		}
+		status.Prefixes = append(status.Prefixes, prefixStatus)
+	}
 	return status
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	pb "github.com/TheThingsNetwork/ttn/api/networkserver"
+	"github.com/TheThingsNetwork/ttn/core/types"
 	. "github.com/smartystreets/assertions"
 )
 
@@ -18,4 +19,12 @@ func TestStatus(t *testing.T) {
 	a.So(ns.status, ShouldNotBeNil)
 	status := ns.GetStatus()
 	a.So(status.Uplink.Rate1, ShouldEqual, 0)
+
+	ns.prefixes = map[types.DevAddrPrefix][]string{
+		types.DevAddrPrefix{DevAddr: [4]byte{0x26, 0x00, 0x00, 0x00}, Length: 7}: []string{"otaa"},
+	}
+	status = ns.GetStatus()
+	a.So(status.Prefixes, ShouldHaveLength, 1)
+	a.So(status.Prefixes[0].Prefix, ShouldEqual, "26000000/7")
+	a.So(status.Prefixes[0].TotalAddresses, ShouldEqual, uint64(1<<25))
 }
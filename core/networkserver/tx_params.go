@@ -0,0 +1,152 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"fmt"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/utils/toa"
+	"github.com/brocaar/lorawan"
+)
+
+// txParamSetupReq is the CID of the TxParamSetupReq and TxParamSetupAns MAC commands (LoRaWAN 1.0.2)
+const txParamSetupReq = lorawan.CID(0x09)
+
+// maxEIRPTable contains the values (in dBm) of the MaxEIRP field of the TxParamSetupReq
+var maxEIRPTable = [16]float32{8, 10, 12, 13, 14, 16, 18, 20, 21, 24, 26, 27, 29, 30, 33, 36}
+
+// maxEIRPIndex returns the index of the highest value in maxEIRPTable that does not exceed the given max EIRP
+func maxEIRPIndex(maxEIRP float32) uint8 {
+	var idx uint8
+	for i, eirp := range maxEIRPTable {
+		if eirp <= maxEIRP {
+			idx = uint8(i)
+		}
+	}
+	return idx
+}
+
+// txParams returns the transmit parameters of the frequency plan as they can be sent in a TxParamSetupReq. The max EIRP
+// is limited to the given max EIRP of the device if it is not zero.
+func txParams(fp band.FrequencyPlan, maxEIRP float32) band.TxParams {
+	params := *fp.TxParams
+	if maxEIRP != 0 && maxEIRP < params.MaxEIRP {
+		params.MaxEIRP = maxEIRP
+	}
+	params.MaxEIRP = maxEIRPTable[maxEIRPIndex(params.MaxEIRP)]
+	return params
+}
+
+// maxPHYPayloadLen is the maximum size of a LoRa PHYPayload
+const maxPHYPayloadLen = 255
+
+// maxDwellTimePHYPayloadLen returns the maximum size of the PHYPayload of the downlink if its time on air is limited by
+// the dwell time of the frequency plan or of the device, or 0 if it is not limited
+func maxDwellTimePHYPayloadLen(message *pb_broker.DownlinkMessage, dev *device.Device) int {
+	config := message.GetDownlinkOption().GetProtocolConfig().GetLorawan()
+	if config == nil || config.Modulation != pb_lorawan.Modulation_LORA {
+		return 0
+	}
+	limited := dev.TxParams.AckedDownlinkDwellTime
+	if fp, err := band.Get(dev.Downlink.FrequencyPlan); err == nil && fp.TxParams != nil && fp.TxParams.DownlinkDwellTime {
+		limited = true
+	}
+	if !limited {
+		return 0
+	}
+	max := -1
+	for min, size := 0, maxPHYPayloadLen; min <= size; {
+		mid := (min + size) / 2
+		duration, err := toa.ComputeLoRa(uint(mid), config.DataRate, config.CodingRate)
+		if err != nil {
+			return 0
+		}
+		if duration <= band.MaxDwellTime {
+			max, min = mid, mid+1
+		} else {
+			size = mid - 1
+		}
+	}
+	return max
+}
+
+func marshalTxParamSetupReq(params band.TxParams) []byte {
+	b := maxEIRPIndex(params.MaxEIRP)
+	if params.UplinkDwellTime {
+		b |= 1 << 4
+	}
+	if params.DownlinkDwellTime {
+		b |= 1 << 5
+	}
+	return []byte{b}
+}
+
+func unmarshalTxParamSetupReq(payload []byte) (params band.TxParams, err error) {
+	if len(payload) != 1 {
+		return params, fmt.Errorf("TxParamSetupReq payload should be 1 byte, not %d", len(payload))
+	}
+	params.MaxEIRP = maxEIRPTable[payload[0]&0x0f]
+	params.UplinkDwellTime = payload[0]&(1<<4) != 0
+	params.DownlinkDwellTime = payload[0]&(1<<5) != 0
+	return params, nil
+}
+
+// handleUplinkTxParams queues a TxParamSetupReq if the transmit parameters of the frequency plan differ from the
+// transmit parameters that were acknowledged by the device
+func (n *networkServer) handleUplinkTxParams(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device) error {
+	if !dev.TxParams.SendReq {
+		return nil
+	}
+
+	fp, err := band.Get(message.GetProtocolMetadata().GetLorawan().GetFrequencyPlan().String())
+	if err != nil {
+		return err
+	}
+
+	if fp.TxParams != nil {
+		acked := band.TxParams{
+			UplinkDwellTime:   dev.TxParams.AckedUplinkDwellTime,
+			DownlinkDwellTime: dev.TxParams.AckedDownlinkDwellTime,
+			MaxEIRP:           dev.TxParams.AckedMaxEIRP,
+		}
+		if desired := txParams(fp, dev.TxParams.MaxEIRP); desired != acked {
+			queue, err := n.devices.MACCommands(dev.AppEUI, dev.DevEUI)
+			if err != nil {
+				return err
+			}
+			if err := queue.Push(&device.MACCommand{CID: uint32(txParamSetupReq), Payload: marshalTxParamSetupReq(desired)}); err != nil {
+				return err
+			}
+		}
+	}
+
+	dev.TxParams.SendReq = false
+
+	return nil
+}
+
+func (n *networkServer) handleUplinkTxParamSetup(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device) error {
+	req, err := n.pendingMACCommand(dev, txParamSetupReq)
+	if err != nil || req == nil {
+		return err
+	}
+	params, err := unmarshalTxParamSetupReq(req.Payload)
+	if err != nil {
+		return err
+	}
+	message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "tx-param-setup",
+		"uplink-dwell-time", params.UplinkDwellTime,
+		"downlink-dwell-time", params.DownlinkDwellTime,
+		"max-eirp", params.MaxEIRP,
+	)
+	dev.TxParams.AckedUplinkDwellTime = params.UplinkDwellTime
+	dev.TxParams.AckedDownlinkDwellTime = params.DownlinkDwellTime
+	dev.TxParams.AckedMaxEIRP = params.MaxEIRP
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	"github.com/TheThingsNetwork/ttn/utils/toa"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestTxParamSetupReq(t *testing.T) {
+	a := New(t)
+
+	a.So(maxEIRPIndex(16), ShouldEqual, 5)
+	a.So(maxEIRPIndex(30), ShouldEqual, 13)
+	a.So(maxEIRPIndex(15), ShouldEqual, 4)
+	a.So(maxEIRPIndex(0), ShouldEqual, 0)
+
+	payload := marshalTxParamSetupReq(band.TxParams{UplinkDwellTime: true, DownlinkDwellTime: true, MaxEIRP: 16})
+	a.So(payload, ShouldResemble, []byte{0x35})
+	params, err := unmarshalTxParamSetupReq(payload)
+	a.So(err, ShouldBeNil)
+	a.So(params, ShouldResemble, band.TxParams{UplinkDwellTime: true, DownlinkDwellTime: true, MaxEIRP: 16})
+	_, err = unmarshalTxParamSetupReq(nil)
+	a.So(err, ShouldNotBeNil)
+}
+
+func dwellTimeDownlinkOption(dataRate string) *pb_broker.DownlinkOption {
+	return &pb_broker.DownlinkOption{
+		ProtocolConfig: &pb_protocol.TxConfiguration{Protocol: &pb_protocol.TxConfiguration_Lorawan{Lorawan: &pb_lorawan.TxConfiguration{
+			Modulation: pb_lorawan.Modulation_LORA,
+			DataRate:   dataRate,
+			CodingRate: "4/5",
+		}}},
+	}
+}
+
+func TestMaxDwellTimePHYPayloadLen(t *testing.T) {
+	a := New(t)
+
+	message := &pb_broker.DownlinkMessage{DownlinkOption: dwellTimeDownlinkOption("SF10BW125")}
+
+	// No dwell time in EU
+	dev := &device.Device{}
+	dev.Downlink.FrequencyPlan = "EU_863_870"
+	a.So(maxDwellTimePHYPayloadLen(message, dev), ShouldEqual, 0)
+
+	// Dwell time in AS
+	dev.Downlink.FrequencyPlan = "AS_923"
+	maxLen := maxDwellTimePHYPayloadLen(message, dev)
+	a.So(maxLen, ShouldBeGreaterThan, phyPayloadOverhead)
+	duration, _ := toa.ComputeLoRa(uint(maxLen), "SF10BW125", "4/5")
+	a.So(duration, ShouldBeLessThanOrEqualTo, band.MaxDwellTime)
+	duration, _ = toa.ComputeLoRa(uint(maxLen+1), "SF10BW125", "4/5")
+	a.So(duration, ShouldBeGreaterThan, band.MaxDwellTime)
+
+	// Higher data rates allow larger downlinks
+	message.DownlinkOption = dwellTimeDownlinkOption("SF7BW125")
+	a.So(maxDwellTimePHYPayloadLen(message, dev), ShouldBeGreaterThan, maxLen)
+
+	// Dwell time that was acknowledged by the device
+	dev = &device.Device{}
+	dev.TxParams.AckedDownlinkDwellTime = true
+	a.So(maxDwellTimePHYPayloadLen(message, dev), ShouldBeGreaterThan, 0)
+}
+
+func TestHandleTxParams(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		Component: &component.Component{
+			Ctx: GetLogger(t, "TestHandleTxParams"),
+		},
+		devices: device.NewRedisDeviceStore(GetRedisClient(), "ns-test-handle-tx-params"),
+	}
+
+	defer func() {
+		keys, _ := GetRedisClient().Keys("*ns-test-handle-tx-params*").Result()
+		for _, key := range keys {
+			GetRedisClient().Del(key).Result()
+		}
+	}()
+
+	dev := &device.Device{AppEUI: types.AppEUI([8]byte{1}), DevEUI: types.DevEUI([8]byte{1})}
+	queue, _ := ns.devices.MACCommands(dev.AppEUI, dev.DevEUI)
+
+	// Frequency plan without TX parameters
+	dev.TxParams.SendReq = true
+	err := ns.handleUplinkTxParams(adrInitUplinkMessage(), dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.TxParams.SendReq, ShouldBeFalse)
+	pending, _ := queue.Get()
+	a.So(pending, ShouldBeEmpty)
+
+	// Frequency plan with dwell time
+	dev.TxParams.SendReq = true
+	message := adrInitUplinkMessage()
+	message.GetProtocolMetadata().GetLorawan().FrequencyPlan = pb_lorawan.FrequencyPlan_AS_923
+	err = ns.handleUplinkTxParams(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.TxParams.SendReq, ShouldBeFalse)
+	pending, _ = queue.Get()
+	a.So(pending, ShouldHaveLength, 1)
+	a.So(pending[0].CID, ShouldEqual, txParamSetupReq)
+	a.So(pending[0].Payload, ShouldResemble, []byte{0x35})
+
+	// Answer
+	err = ns.handleUplinkTxParamSetup(adrInitUplinkMessage(), dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.TxParams.AckedUplinkDwellTime, ShouldBeTrue)
+	a.So(dev.TxParams.AckedDownlinkDwellTime, ShouldBeTrue)
+	a.So(dev.TxParams.AckedMaxEIRP, ShouldEqual, 16)
+
+	// Nothing to do once the device acknowledged the TX parameters
+	queue.Clear()
+	dev.TxParams.SendReq = true
+	err = ns.handleUplinkTxParams(message, dev)
+	a.So(err, ShouldBeNil)
+	pending, _ = queue.Get()
+	a.So(pending, ShouldBeEmpty)
+
+	// Device with a lower max EIRP than the frequency plan
+	dev.TxParams.SendReq = true
+	dev.TxParams.MaxEIRP = 14
+	err = ns.handleUplinkTxParams(message, dev)
+	a.So(err, ShouldBeNil)
+	pending, _ = queue.Get()
+	a.So(pending, ShouldHaveLength, 1)
+	a.So(pending[0].Payload, ShouldResemble, []byte{0x34})
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/api/trace"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 )
@@ -29,6 +30,12 @@ func (n *networkServer) HandleUplink(message *pb_broker.DeduplicatedUplinkMessag
 		return nil, err
 	}
 
+	// The profiles are applied before the update starts, so that their settings are not stored in the device
+	_, err = n.applyProfiles(dev)
+	if err != nil {
+		return nil, err
+	}
+
 	message.Trace = message.Trace.WithEvent(trace.UpdateStateEvent)
 
 	dev.StartUpdate()
@@ -42,9 +49,33 @@ func (n *networkServer) HandleUplink(message *pb_broker.DeduplicatedUplinkMessag
 		}
 	}()
 
+	// The first uplink in the pending session of a device that rejoined discards its previous session
+	if usesPendingSession(message, dev) {
+		message.Trace = message.Trace.WithEvent("activate pending session")
+		err = n.activatePendingSession(dev)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// LoRaWAN 1.1 devices have a separate SNwkSIntKey and encrypted FOpts
+	if dev.Options.MACVersion == pb_lorawan.MACVersion_LORAWAN_1_1 {
+		err = n.handleUplinkSecurity(message, dev)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	n.handleUplinkFCnt(message, dev)
+
 	dev.FCntUp = lorawanUplinkMac.FCnt
 	dev.LastSeen = time.Now()
 
+	// Remember the best gateway for downlink that is not a response to this uplink
+	if option := message.GetResponseTemplate().GetDownlinkOption(); option != nil {
+		dev.Downlink = downlinkPath(option, message.GetProtocolMetadata().GetLorawan().GetFrequencyPlan().String())
+	}
+
 	// Prepare Downlink
 	message.InitResponseTemplate()
 	lorawanDownlinkMsg := message.ResponseTemplate.Message.InitLoRaWAN()
@@ -39,6 +39,7 @@ func (n *networkServer) handleUplinkMAC(message *pb_broker.DeduplicatedUplinkMes
 	}
 
 	// MAC Commands
+	var linkADRAns []lorawan.LinkADRAnsPayload
 	for _, cmd := range lorawanUplinkMac.FOpts {
 		switch cmd.Cid {
 		case uint32(lorawan.LinkCheckReq):
@@ -62,19 +63,85 @@ func (n *networkServer) handleUplinkMAC(message *pb_broker.DeduplicatedUplinkMes
 				"power-ack", answer.PowerACK,
 				"channel-mask-ack", answer.ChannelMaskACK,
 			)
-			if answer.DataRateACK && answer.PowerACK && answer.ChannelMaskACK {
-				dev.ADR.Failed = 0
-				dev.ADR.SendReq = false
-			} else {
-				dev.ADR.Failed++
+			linkADRAns = append(linkADRAns, answer)
+			if !answer.DataRateACK || !answer.PowerACK || !answer.ChannelMaskACK {
 				ctx.
 					WithField("Answer", fmt.Sprintf("%v/%v/%v", answer.DataRateACK, answer.PowerACK, answer.ChannelMaskACK)).
 					Warn("Negative LinkADRAns")
 			}
+		case uint32(lorawan.DevStatusAns):
+			n.handleUplinkDevStatus(message, dev, cmd)
+		case uint32(lorawan.RXParamSetupAns):
+			if err := n.handleUplinkRXParamSetup(message, dev, cmd); err != nil {
+				return err
+			}
+		case uint32(lorawan.RXTimingSetupAns):
+			if err := n.handleUplinkRXTimingSetup(message, dev); err != nil {
+				return err
+			}
+		case uint32(lorawan.NewChannelAns):
+			if err := n.handleUplinkNewChannel(message, dev, cmd); err != nil {
+				return err
+			}
+		case uint32(txParamSetupReq):
+			if err := n.handleUplinkTxParamSetup(message, dev); err != nil {
+				return err
+			}
+		case uint32(dlChannelReq):
+			if err := n.handleUplinkDlChannel(message, dev, cmd); err != nil {
+				return err
+			}
+		case uint32(pingSlotInfoReq):
+			n.handleUplinkPingSlotInfo(message, dev, cmd)
+		case uint32(beaconFreqReq):
+			if err := n.handleUplinkBeaconFreq(message, dev, cmd); err != nil {
+				return err
+			}
+		case uint32(deviceTimeReq):
+			n.handleUplinkDeviceTime(message)
+		case uint32(resetInd):
+			n.handleUplinkReset(message, dev, cmd)
+		case uint32(rekeyInd):
+			n.handleUplinkRekey(message, dev, cmd)
 		default:
 		}
 	}
 
+	// A block of LinkADRReqs is answered with a LinkADRAns for each LinkADRReq
+	if len(linkADRAns) > 0 {
+		handleUplinkLinkADR(dev, linkADRAns)
+	}
+
+	// RX Settings
+	if err := n.handleUplinkRXSettings(message, dev); err != nil {
+		return err
+	}
+
+	// Channels
+	if err := n.handleUplinkChannels(message, dev); err != nil {
+		return err
+	}
+
+	// TX Parameters
+	if err := n.handleUplinkTxParams(message, dev); err != nil {
+		return err
+	}
+
+	// Class B
+	if err := n.handleUplinkClassB(message, dev); err != nil {
+		return err
+	}
+
+	// Pending MAC Commands
+	if err := n.handleUplinkPendingMAC(message, dev); err != nil {
+		return err
+	}
+
+	// Device Status
+	if lorawanMeta := message.GetProtocolMetadata().GetLorawan(); lorawanMeta != nil {
+		lorawanMeta.DeviceStatus = deviceStatus(dev)
+	}
+
 	// We can't send MAC on port 0; send them on port 1
 	if len(lorawanDownlinkMac.FOpts) != 0 && lorawanDownlinkMac.FPort == 0 {
 		lorawanDownlinkMac.FPort = 1
@@ -13,6 +13,7 @@ import (
 	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/profile"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	"github.com/brocaar/lorawan"
@@ -120,3 +121,88 @@ func TestHandleUplink(t *testing.T) {
 	a.So(dev.FCntUp, ShouldEqual, 1)
 	a.So(time.Now().Sub(dev.LastSeen), ShouldBeLessThan, 1*time.Second)
 }
+
+func TestHandleUplinkWithProfiles(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		Component: &component.Component{
+			Ctx: GetLogger(t, "TestHandleUplinkWithProfiles"),
+		},
+		devices:  device.NewRedisDeviceStore(GetRedisClient(), "ns-test-handle-uplink-with-profiles"),
+		profiles: profile.NewRedisProfileStore(GetRedisClient(), "ns-test-handle-uplink-with-profiles"),
+	}
+	ns.InitStatus()
+
+	defer func() {
+		keys, _ := GetRedisClient().Keys("*ns-test-handle-uplink-with-profiles*").Result()
+		for _, key := range keys {
+			GetRedisClient().Del(key).Result()
+		}
+	}()
+
+	ns.profiles.SetDeviceProfile(&profile.DeviceProfile{
+		ProfileID:     "dp",
+		Uses32BitFCnt: true,
+	})
+	ns.profiles.SetServiceProfile(&profile.ServiceProfile{
+		ProfileID:         "sp",
+		DevStatusInterval: time.Hour,
+		ADRStrategy:       "conservative",
+		ADRMargin:         20,
+	})
+
+	appEUI := types.AppEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 9))
+	devEUI := types.DevEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 9))
+	devAddr := getDevAddr(1, 2, 3, 5)
+	ns.devices.Set(&device.Device{
+		DevAddr: devAddr,
+		AppEUI:  appEUI,
+		DevEUI:  devEUI,
+		Options: device.Options{
+			Class:            pb_lorawan.DeviceClass_CLASS_C,
+			DeviceProfileID:  "dp",
+			ServiceProfileID: "sp",
+		},
+	})
+
+	phy := lorawan.PHYPayload{
+		MHDR: lorawan.MHDR{
+			MType: lorawan.UnconfirmedDataUp,
+			Major: lorawan.LoRaWANR1,
+		},
+		MACPayload: &lorawan.MACPayload{
+			FHDR: lorawan.FHDR{
+				DevAddr: lorawan.DevAddr([4]byte(devAddr)),
+				FCnt:    1,
+			},
+		},
+	}
+	bytes, _ := phy.MarshalBinary()
+
+	message := &pb_broker.DeduplicatedUplinkMessage{
+		AppEui:           &appEUI,
+		DevEui:           &devEUI,
+		Payload:          bytes,
+		ResponseTemplate: &pb_broker.DownlinkMessage{DownlinkOption: &pb_broker.DownlinkOption{}},
+		GatewayMetadata: []*pb_gateway.RxMetadata{
+			&pb_gateway.RxMetadata{},
+		},
+		ProtocolMetadata: &pb_protocol.RxMetadata{Protocol: &pb_protocol.RxMetadata_Lorawan{
+			Lorawan: &pb_lorawan.Metadata{
+				DataRate: "SF7BW125",
+			},
+		}},
+	}
+	_, err := ns.HandleUplink(message)
+	a.So(err, ShouldBeNil)
+
+	// The state of the device is updated, but the settings of the profiles are not stored in the device
+	dev, err := ns.devices.Get(appEUI, devEUI)
+	a.So(err, ShouldBeNil)
+	a.So(dev.FCntUp, ShouldEqual, 1)
+	a.So(dev.Options.Class, ShouldEqual, pb_lorawan.DeviceClass_CLASS_C)
+	a.So(dev.Options.Uses32BitFCnt, ShouldBeFalse)
+	a.So(dev.Options.DevStatusInterval, ShouldEqual, 0)
+	a.So(dev.ADR.Strategy, ShouldEqual, "")
+	a.So(dev.ADR.Margin, ShouldEqual, 0)
+}
@@ -0,0 +1,328 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package router
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
+	"github.com/TheThingsNetwork/ttn/api/ratelimit"
+	pb "github.com/TheThingsNetwork/ttn/api/router"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/router/basicstation"
+	"github.com/TheThingsNetwork/ttn/core/router/gateway"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/gpstime"
+	"github.com/TheThingsNetwork/ttn/utils/random"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc/metadata"
+)
+
+// Paths of the LoRa Basics Station endpoints
+const (
+	stationDiscoveryPath = "/router-info"
+	stationTrafficPath   = "/traffic/"
+)
+
+// stationPendingDownlinks is the number of recent downlink messages of which a session waits for the dntxed
+const stationPendingDownlinks = 256
+
+type stationServer struct {
+	router        *router
+	rpc           *routerRPC
+	ctx           ttnlog.Interface
+	listener      net.Listener
+	frequencyPlan string
+	uplinkRate    *ratelimit.Registry
+}
+
+// stationSession is the connection of a station to the traffic endpoint
+type stationSession struct {
+	ctx     ttnlog.Interface
+	gateway *gateway.Gateway
+	ws      *websocket.Conn
+	plan    band.FrequencyPlan
+	writeMu sync.Mutex
+
+	mu        sync.Mutex
+	xtime     int64
+	rctx      int64
+	diid      int64
+	downlinks map[int64]string // diid to downlink ID, for the dntxed
+}
+
+func (r *router) WithBasicStation(address string, frequencyPlan string) Router {
+	r.stationAddress = address
+	r.stationFrequencyPlan = frequencyPlan
+	return r
+}
+
+// listenBasicStation starts listening for gateways that use the LNS protocol of the LoRa Basics Station
+func (r *router) listenBasicStation() error {
+	lis, err := net.Listen("tcp", r.stationAddress)
+	if err != nil {
+		return errors.Wrap(err, "Could not start Basic Station listener")
+	}
+	s := &stationServer{
+		router:        r,
+		rpc:           &routerRPC{router: r},
+		ctx:           r.Ctx.WithField("Protocol", "BasicStation"),
+		listener:      lis,
+		frequencyPlan: r.stationFrequencyPlan,
+		uplinkRate:    ratelimit.NewRegistry(1500, time.Minute), // Same as the gRPC server
+	}
+	mux := http.NewServeMux()
+	mux.Handle(stationDiscoveryPath, websocket.Server{Handler: s.handleDiscovery})
+	mux.Handle(stationTrafficPath, websocket.Server{Handler: s.handleTraffic})
+	r.station = s
+	s.ctx.WithField("Address", lis.Addr()).Info("Listening for LoRa Basics Stations")
+	go http.Serve(lis, mux)
+	return nil
+}
+
+func (s *stationServer) Close() {
+	s.listener.Close()
+}
+
+// handleDiscovery tells the station the traffic endpoint that it should connect to
+func (s *stationServer) handleDiscovery(ws *websocket.Conn) {
+	defer ws.Close()
+	var req basicstation.DiscoveryRequest
+	if err := websocket.JSON.Receive(ws, &req); err != nil {
+		s.ctx.WithError(err).Debug("Could not receive discovery request")
+		return
+	}
+	scheme := "ws"
+	if ws.Request().TLS != nil || ws.Request().Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "wss"
+	}
+	res := basicstation.DiscoveryResponse{
+		Router: req.Router,
+		URI:    fmt.Sprintf("%s://%s%s%s", scheme, ws.Request().Host, stationTrafficPath, GatewayIDForEUI(types.EUI64(req.Router))),
+	}
+	if err := websocket.JSON.Send(ws, res); err != nil {
+		s.ctx.WithError(err).Debug("Could not send discovery response")
+	}
+}
+
+// handleTraffic handles the messages of a station that is connected to the traffic endpoint
+func (s *stationServer) handleTraffic(ws *websocket.Conn) {
+	defer ws.Close()
+	gatewayID := strings.TrimPrefix(ws.Request().URL.Path, stationTrafficPath)
+	ctx := s.ctx.WithField("GatewayID", gatewayID)
+
+	// The station sends the gateway token in the Authorization header
+	token := strings.TrimPrefix(ws.Request().Header.Get("Authorization"), "Bearer ")
+	gtw, err := s.rpc.gatewayFromMetadata(metadata.Pairs("id", gatewayID, "token", token))
+	if err != nil {
+		ctx.WithError(err).Warn("Rejected LoRa Basics Station")
+		return
+	}
+
+	session := &stationSession{ctx: ctx, gateway: gtw, ws: ws}
+	var subscriptionID string
+	defer func() {
+		if subscriptionID != "" {
+			s.router.UnsubscribeDownlink(gtw.ID, subscriptionID)
+		}
+		ctx.Info("LoRa Basics Station disconnected")
+	}()
+
+	for {
+		var data []byte
+		if err := websocket.Message.Receive(ws, &data); err != nil {
+			ctx.WithError(err).Debug("Could not receive message")
+			return
+		}
+		received := time.Now()
+		var msg basicstation.Message
+		if err := json.Unmarshal(data, &msg); err != nil {
+			ctx.WithError(err).Warn("Could not decode message")
+			continue
+		}
+		switch msg.MsgType {
+		case basicstation.TypeVersion:
+			if err := s.handleVersion(session, data); err != nil {
+				ctx.WithError(err).Warn("Could not configure LoRa Basics Station")
+				return
+			}
+			if subscriptionID != "" {
+				continue
+			}
+			subscriptionID = random.String(10)
+			downlink, err := s.router.SubscribeDownlink(gtw.ID, subscriptionID)
+			if err != nil {
+				ctx.WithError(err).Warn("Could not subscribe to downlink")
+				subscriptionID = ""
+				continue
+			}
+			go func() {
+				for message := range downlink {
+					s.sendDownlink(session, message)
+				}
+			}()
+		case basicstation.TypeUplinkDataFrame, basicstation.TypeJoinRequest:
+			if err := s.handleUplink(session, msg.MsgType, data, received); err != nil {
+				ctx.WithError(err).Warn("Could not handle uplink")
+			}
+		case basicstation.TypeDownlinkTransmitted:
+			var dntxed basicstation.DownlinkTransmitted
+			if err := json.Unmarshal(data, &dntxed); err != nil {
+				ctx.WithError(err).Warn("Could not decode dntxed")
+				continue
+			}
+			ctx.WithField("DIID", dntxed.DIID).Debug("LoRa Basics Station transmitted downlink")
+			s.handleTransmitted(session, dntxed)
+		case basicstation.TypeTimeSync:
+			var timeSync basicstation.TimeSync
+			if err := json.Unmarshal(data, &timeSync); err != nil {
+				ctx.WithError(err).Warn("Could not decode timesync")
+				continue
+			}
+			timeSync.GPSTime = int64(gpstime.ToGPS(time.Now()) / time.Microsecond)
+			timeSync.MuxTime = basicstation.MuxTime(time.Now())
+			session.send(timeSync)
+		default:
+			ctx.WithField("MsgType", msg.MsgType).Debug("Ignoring unknown message")
+		}
+	}
+}
+
+// handleVersion configures the station with the frequency plan of the gateway
+func (s *stationServer) handleVersion(session *stationSession, data []byte) error {
+	var version basicstation.Version
+	if err := json.Unmarshal(data, &version); err != nil {
+		return err
+	}
+	frequencyPlan := s.frequencyPlan
+	if status, _ := session.gateway.Status.Get(); status != nil && status.FrequencyPlan != "" {
+		frequencyPlan = status.FrequencyPlan
+	}
+	routerConfig, err := basicstation.NewRouterConfig(frequencyPlan)
+	if err != nil {
+		return err
+	}
+	plan, err := band.Get(frequencyPlan)
+	if err != nil {
+		return err
+	}
+	session.mu.Lock()
+	session.plan = plan
+	session.mu.Unlock()
+	session.ctx.WithFields(ttnlog.Fields{
+		"Station":       version.Station,
+		"Model":         version.Model,
+		"FrequencyPlan": frequencyPlan,
+	}).Info("LoRa Basics Station connected")
+	if err := session.send(routerConfig); err != nil {
+		return err
+	}
+	status := &pb_gateway.Status{Platform: fmt.Sprintf("%s (Station %s)", version.Model, version.Station), FrequencyPlan: frequencyPlan}
+	return s.router.HandleGatewayStatus(session.gateway.ID, status)
+}
+
+// handleUplink handles an updf or jreq. Its RefTime is used to measure the round-trip time to the station, which the
+// station echoes from the MuxTime of the messages that it receives.
+func (s *stationServer) handleUplink(session *stationSession, msgType string, data []byte, received time.Time) error {
+	var payload []byte
+	var radio basicstation.RadioMetadata
+	var refTime float64
+	switch msgType {
+	case basicstation.TypeUplinkDataFrame:
+		var updf basicstation.UplinkDataFrame
+		if err := json.Unmarshal(data, &updf); err != nil {
+			return err
+		}
+		var err error
+		if payload, err = updf.PHYPayload(); err != nil {
+			return err
+		}
+		radio = updf.RadioMetadata
+		refTime = updf.RefTime
+	case basicstation.TypeJoinRequest:
+		var jreq basicstation.JoinRequest
+		if err := json.Unmarshal(data, &jreq); err != nil {
+			return err
+		}
+		payload = jreq.PHYPayload()
+		radio = jreq.RadioMetadata
+		refTime = jreq.RefTime
+	}
+
+	if rtt, ok := basicstation.RoundTripTime(refTime, received); ok {
+		session.gateway.Schedule.AddRTT(rtt)
+	}
+
+	session.mu.Lock()
+	plan := session.plan
+	session.xtime, session.rctx = radio.UpInfo.XTime, radio.UpInfo.RCtx
+	session.mu.Unlock()
+	if plan.DataRates == nil {
+		return errors.NewErrInvalidArgument("Uplink", "received before router configuration")
+	}
+
+	uplink, err := radio.ToUplink(payload, plan)
+	if err != nil {
+		return err
+	}
+	if s.uplinkRate.Limit(session.gateway.ID) {
+		return errors.New("Gateway reached uplink rate limit")
+	}
+	return s.router.HandleUplink(session.gateway.ID, uplink)
+}
+
+func (s *stationServer) sendDownlink(session *stationSession, downlink *pb.DownlinkMessage) {
+	session.mu.Lock()
+	dnmsg, err := basicstation.FromDownlink(downlink, session.plan, session.xtime, session.rctx)
+	if err == nil {
+		session.diid++
+		dnmsg.DIID = session.diid
+		if downlink.Id != "" {
+			if session.downlinks == nil {
+				session.downlinks = make(map[int64]string)
+			}
+			session.downlinks[dnmsg.DIID] = downlink.Id
+			delete(session.downlinks, dnmsg.DIID-stationPendingDownlinks)
+		}
+	}
+	session.mu.Unlock()
+	if err != nil {
+		session.ctx.WithError(err).Warn("Could not convert downlink to dnmsg")
+		return
+	}
+	dnmsg.MuxTime = basicstation.MuxTime(time.Now())
+	if err := session.send(dnmsg); err != nil {
+		session.ctx.WithError(err).Warn("Could not send downlink")
+	}
+}
+
+// handleTransmitted acknowledges the downlink message. The station does not report downlink messages that it did not
+// transmit.
+func (s *stationServer) handleTransmitted(session *stationSession, dntxed basicstation.DownlinkTransmitted) {
+	session.mu.Lock()
+	downlinkID, ok := session.downlinks[dntxed.DIID]
+	delete(session.downlinks, dntxed.DIID)
+	session.mu.Unlock()
+	if !ok {
+		return
+	}
+	ack := &pb_gateway.TxAcknowledgement{DownlinkId: downlinkID, Result: pb_gateway.TxAcknowledgement_SUCCESS}
+	if err := s.router.HandleTxAck(session.gateway.ID, ack); err != nil {
+		session.ctx.WithError(err).Debug("Could not handle dntxed")
+	}
+}
+
+func (s *stationSession) send(msg interface{}) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	return websocket.JSON.Send(s.ws, msg)
+}
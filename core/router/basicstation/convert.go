@@ -0,0 +1,174 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package basicstation
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"time"
+
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
+	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	pb_router "github.com/TheThingsNetwork/ttn/api/router"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/gpstime"
+	lora "github.com/brocaar/lorawan/band"
+)
+
+// PHYPayload returns the LoRaWAN PHYPayload of the data frame
+func (f *UplinkDataFrame) PHYPayload() ([]byte, error) {
+	fOpts, err := hex.DecodeString(f.FOpts)
+	if err != nil {
+		return nil, errors.NewErrInvalidArgument("FOpts", err.Error())
+	}
+	frmPayload, err := hex.DecodeString(f.FRMPayload)
+	if err != nil {
+		return nil, errors.NewErrInvalidArgument("FRMPayload", err.Error())
+	}
+	payload := make([]byte, 8, 13+len(fOpts)+len(frmPayload))
+	payload[0] = f.MHdr
+	binary.LittleEndian.PutUint32(payload[1:], uint32(f.DevAddr))
+	payload[5] = f.FCtrl
+	binary.LittleEndian.PutUint16(payload[6:], f.FCnt)
+	payload = append(payload, fOpts...)
+	if f.FPort >= 0 {
+		payload = append(payload, uint8(f.FPort))
+		payload = append(payload, frmPayload...)
+	}
+	return appendMIC(payload, f.MIC), nil
+}
+
+// PHYPayload returns the LoRaWAN PHYPayload of the join request
+func (j *JoinRequest) PHYPayload() []byte {
+	payload := make([]byte, 1, 23)
+	payload[0] = j.MHdr
+	payload = append(payload, reverse(j.JoinEUI[:])...)
+	payload = append(payload, reverse(j.DevEUI[:])...)
+	payload = append(payload, byte(j.DevNonce), byte(j.DevNonce>>8))
+	return appendMIC(payload, j.MIC)
+}
+
+func appendMIC(payload []byte, mic int32) []byte {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], uint32(mic))
+	return append(payload, b[:]...)
+}
+
+func reverse(in []byte) []byte {
+	out := make([]byte, len(in))
+	for i, b := range in {
+		out[len(in)-1-i] = b
+	}
+	return out
+}
+
+// ToUplink converts the PHYPayload and the radio metadata to an uplink message
+func (m *RadioMetadata) ToUplink(payload []byte, plan band.FrequencyPlan) (*pb_router.UplinkMessage, error) {
+	if m.DataRate < 0 || m.DataRate >= len(plan.DataRates) {
+		return nil, errors.NewErrInvalidArgument("DR", "unknown in frequency plan")
+	}
+	lorawan := new(pb_lorawan.Metadata)
+	switch dr := plan.DataRates[m.DataRate]; dr.Modulation {
+	case lora.LoRaModulation:
+		datr, err := types.ConvertDataRate(dr)
+		if err != nil {
+			return nil, err
+		}
+		lorawan.Modulation = pb_lorawan.Modulation_LORA
+		lorawan.DataRate = datr.String()
+		lorawan.CodingRate = "4/5" // The station does not report the coding rate, but LoRaWAN always uses 4/5
+	case lora.FSKModulation:
+		lorawan.Modulation = pb_lorawan.Modulation_FSK
+		lorawan.BitRate = uint32(dr.BitRate)
+	default:
+		return nil, errors.NewErrInvalidArgument("DR", "unknown in frequency plan")
+	}
+
+	gateway := &pb_gateway.RxMetadata{
+		Timestamp: uint32(m.UpInfo.XTime),
+		Frequency: m.Frequency,
+		Rssi:      m.UpInfo.RSSI,
+		Snr:       m.UpInfo.SNR,
+	}
+	if m.UpInfo.GPSTime != 0 {
+		gateway.Time = gpstime.FromGPS(time.Duration(m.UpInfo.GPSTime) * time.Microsecond).UnixNano()
+	}
+
+	return &pb_router.UplinkMessage{
+		Payload:          payload,
+		ProtocolMetadata: &pb_protocol.RxMetadata{Protocol: &pb_protocol.RxMetadata_Lorawan{Lorawan: lorawan}},
+		GatewayMetadata:  gateway,
+	}, nil
+}
+
+// XTime returns the xtime of the 32-bit timestamp, based on a recent xtime of the same session. The timestamp must
+// not be before the reference.
+func XTime(reference int64, timestamp uint32) int64 {
+	xtime := reference&^0xffffffff | int64(timestamp)
+	if timestamp < uint32(reference) {
+		xtime += 1 << 32
+	}
+	return xtime
+}
+
+// MuxTime returns the time in the format of the MuxTime of a message to the station
+func MuxTime(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Second)
+}
+
+// RoundTripTime returns the round-trip time of a message from the station that was received at the given time. The
+// RefTime of the message is the MuxTime of the last message to the station, plus the time since the station received
+// it. It returns false if the message has no RefTime.
+func RoundTripTime(refTime float64, received time.Time) (time.Duration, bool) {
+	if refTime == 0 {
+		return 0, false
+	}
+	rtt := received.Sub(time.Unix(0, int64(refTime*float64(time.Second))))
+	if rtt < 0 {
+		return 0, false
+	}
+	return rtt, true
+}
+
+// FromDownlink converts the downlink message to a dnmsg. The xtime and rctx are of a recent uplink message of the
+// station, and are used to convert the timestamp of the downlink message to the time of the station.
+func FromDownlink(downlink *pb_router.DownlinkMessage, plan band.FrequencyPlan, xtime int64, rctx int64) (*DownlinkMessage, error) {
+	gateway := downlink.GetGatewayConfiguration()
+	lorawan := downlink.GetProtocolConfiguration().GetLorawan()
+	if gateway == nil || lorawan == nil {
+		return nil, errors.NewErrInvalidArgument("Downlink", "does not contain a LoRaWAN gateway configuration")
+	}
+	if xtime == 0 {
+		return nil, errors.NewErrInvalidArgument("Downlink", "no uplink received from station yet")
+	}
+
+	dataRate, err := (&pb_lorawan.Metadata{
+		Modulation: lorawan.Modulation,
+		DataRate:   lorawan.DataRate,
+		BitRate:    lorawan.BitRate,
+	}).GetLoRaWANDataRate()
+	if err != nil {
+		return nil, err
+	}
+	dr, err := plan.GetDataRate(dataRate)
+	if err != nil {
+		return nil, errors.NewErrInvalidArgument("Downlink", "data rate not in frequency plan")
+	}
+
+	// The station transmits RxDelay seconds after the given xtime, so we go back one second from the timestamp
+	const rxDelay = 1
+	return &DownlinkMessage{
+		MsgType: TypeDownlinkMessage,
+		DC:      0,
+		PDU:     hex.EncodeToString(downlink.Payload),
+		RxDelay: rxDelay,
+		RX1DR:   dr,
+		RX1Freq: gateway.Frequency,
+		XTime:   XTime(xtime, gateway.Timestamp) - int64(rxDelay*time.Second/time.Microsecond),
+		RCtx:    rctx,
+	}, nil
+}
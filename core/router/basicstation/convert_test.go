@@ -0,0 +1,129 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package basicstation
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
+	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	pb_router "github.com/TheThingsNetwork/ttn/api/router"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestUplinkDataFrame(t *testing.T) {
+	a := New(t)
+
+	var updf UplinkDataFrame
+	err := json.Unmarshal([]byte(`{"msgtype":"updf","MHdr":64,"DevAddr":637604404,"FCtrl":128,"FCnt":5,"FOpts":"","FPort":1,"FRMPayload":"0102","MIC":-123,
+		"DR":5,"Freq":868100000,"upinfo":{"rctx":0,"xtime":1152921504912266872,"gpstime":0,"rssi":-42,"snr":7.5}}`), &updf)
+	a.So(err, ShouldBeNil)
+
+	payload, err := updf.PHYPayload()
+	a.So(err, ShouldBeNil)
+	a.So(payload, ShouldResemble, []byte{0x40, 0x34, 0x12, 0x01, 0x26, 0x80, 0x05, 0x00, 0x01, 0x01, 0x02, 0x85, 0xff, 0xff, 0xff})
+
+	updf.FPort = -1
+	updf.FRMPayload = ""
+	payload, _ = updf.PHYPayload()
+	a.So(payload, ShouldHaveLength, 12)
+
+	plan, _ := band.Get("EU_863_870")
+	uplink, err := updf.ToUplink([]byte{1, 2, 3}, plan)
+	a.So(err, ShouldBeNil)
+	a.So(uplink.ProtocolMetadata.GetLorawan(), ShouldResemble, &pb_lorawan.Metadata{
+		Modulation: pb_lorawan.Modulation_LORA,
+		DataRate:   "SF7BW125",
+		CodingRate: "4/5",
+	})
+	a.So(uplink.GatewayMetadata, ShouldResemble, &pb_gateway.RxMetadata{
+		Timestamp: 0x12345678,
+		Frequency: 868100000,
+		Rssi:      -42,
+		Snr:       7.5,
+	})
+
+	updf.DataRate = 20
+	_, err = updf.ToUplink([]byte{1, 2, 3}, plan)
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestJoinRequest(t *testing.T) {
+	a := New(t)
+
+	var jreq JoinRequest
+	err := json.Unmarshal([]byte(`{"msgtype":"jreq","MHdr":0,"JoinEui":"01-02-03-04-05-06-07-08","DevEui":"11-12-13-14-15-16-17-18","DevNonce":258,"MIC":16909060,
+		"DR":0,"Freq":868300000,"upinfo":{"xtime":1}}`), &jreq)
+	a.So(err, ShouldBeNil)
+	a.So(jreq.PHYPayload(), ShouldResemble, []byte{
+		0x00,
+		0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01,
+		0x18, 0x17, 0x16, 0x15, 0x14, 0x13, 0x12, 0x11,
+		0x02, 0x01,
+		0x04, 0x03, 0x02, 0x01,
+	})
+}
+
+func TestXTime(t *testing.T) {
+	a := New(t)
+	a.So(XTime(0x0100000012345678, 0x12345679), ShouldEqual, 0x0100000012345679)
+	a.So(XTime(0x0100000012345678, 0x00000010), ShouldEqual, 0x0100000100000010) // Rollover
+}
+
+func TestRoundTripTime(t *testing.T) {
+	a := New(t)
+	sent := time.Now()
+	muxTime := MuxTime(sent)
+
+	_, ok := RoundTripTime(0, sent)
+	a.So(ok, ShouldBeFalse)
+
+	// The station held the message for 100ms
+	rtt, ok := RoundTripTime(muxTime+0.1, sent.Add(150*time.Millisecond))
+	a.So(ok, ShouldBeTrue)
+	a.So(rtt, ShouldAlmostEqual, 50*time.Millisecond, time.Millisecond)
+
+	_, ok = RoundTripTime(muxTime+0.2, sent.Add(150*time.Millisecond))
+	a.So(ok, ShouldBeFalse)
+}
+
+func TestFromDownlink(t *testing.T) {
+	a := New(t)
+
+	plan, _ := band.Get("EU_863_870")
+	downlink := &pb_router.DownlinkMessage{
+		Payload: []byte{1, 2, 3},
+		ProtocolConfiguration: &pb_protocol.TxConfiguration{Protocol: &pb_protocol.TxConfiguration_Lorawan{Lorawan: &pb_lorawan.TxConfiguration{
+			Modulation: pb_lorawan.Modulation_LORA,
+			DataRate:   "SF9BW125",
+			CodingRate: "4/5",
+		}}},
+		GatewayConfiguration: &pb_gateway.TxConfiguration{
+			Timestamp: 0x12345678 + 2000000,
+			Frequency: 869525000,
+			Power:     27,
+		},
+	}
+
+	_, err := FromDownlink(downlink, plan, 0, 0)
+	a.So(err, ShouldNotBeNil) // No uplink yet
+
+	dnmsg, err := FromDownlink(downlink, plan, 0x0100000012345678, 42)
+	a.So(err, ShouldBeNil)
+	a.So(dnmsg.MsgType, ShouldEqual, TypeDownlinkMessage)
+	a.So(dnmsg.PDU, ShouldEqual, "010203")
+	a.So(dnmsg.RX1DR, ShouldEqual, 3)
+	a.So(dnmsg.RX1Freq, ShouldEqual, 869525000)
+	a.So(dnmsg.RxDelay, ShouldEqual, 1)
+	a.So(dnmsg.XTime, ShouldEqual, 0x0100000012345678+1000000) // The station adds RxDelay
+	a.So(dnmsg.RCtx, ShouldEqual, 42)
+
+	downlink.ProtocolConfiguration.GetLorawan().DataRate = "SF6BW125"
+	_, err = FromDownlink(downlink, plan, 0x0100000012345678, 42)
+	a.So(err, ShouldNotBeNil)
+}
@@ -0,0 +1,203 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Package basicstation implements the LNS protocol of the LoRa Basics Station
+package basicstation
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// Types of the messages
+const (
+	TypeVersion             = "version"
+	TypeRouterConfig        = "router_config"
+	TypeUplinkDataFrame     = "updf"
+	TypeJoinRequest         = "jreq"
+	TypeDownlinkMessage     = "dnmsg"
+	TypeDownlinkTransmitted = "dntxed"
+	TypeTimeSync            = "timesync"
+)
+
+// DiscoveryRequest is sent by the station to the router-info endpoint
+type DiscoveryRequest struct {
+	Router EUI `json:"router"`
+}
+
+// DiscoveryResponse is the response to a DiscoveryRequest
+type DiscoveryResponse struct {
+	Router EUI    `json:"router"`
+	Muxs   EUI    `json:"muxs"`
+	URI    string `json:"uri,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Message contains the type of a message on the traffic endpoint
+type Message struct {
+	MsgType string `json:"msgtype"`
+}
+
+// Version is sent by the station after connecting to the traffic endpoint
+type Version struct {
+	Station  string `json:"station"`
+	Firmware string `json:"firmware"`
+	Package  string `json:"package"`
+	Model    string `json:"model"`
+	Protocol int    `json:"protocol"`
+	Features string `json:"features"`
+}
+
+// UpInfo contains the reception metadata of an uplink message
+type UpInfo struct {
+	RCtx    int64   `json:"rctx"`    // Radio context
+	XTime   int64   `json:"xtime"`   // Internal time of reception in microseconds, with the session in the upper bits
+	GPSTime int64   `json:"gpstime"` // GPS time of reception in microseconds, 0 if unknown
+	RSSI    float32 `json:"rssi"`
+	SNR     float32 `json:"snr"`
+}
+
+// RadioMetadata contains the radio metadata of an uplink message
+type RadioMetadata struct {
+	DataRate  int    `json:"DR"`
+	Frequency uint64 `json:"Freq"`
+	UpInfo    UpInfo `json:"upinfo"`
+}
+
+// UplinkDataFrame is a LoRaWAN data frame that was received by the station
+type UplinkDataFrame struct {
+	MHdr       uint8   `json:"MHdr"`
+	DevAddr    int32   `json:"DevAddr"`
+	FCtrl      uint8   `json:"FCtrl"`
+	FCnt       uint16  `json:"FCnt"`
+	FOpts      string  `json:"FOpts"`
+	FPort      int     `json:"FPort"` // -1 if there is no FPort
+	FRMPayload string  `json:"FRMPayload"`
+	MIC        int32   `json:"MIC"`
+	RefTime    float64 `json:"RefTime,omitempty"` // MuxTime of the last message to the station, plus the time since it was received
+	RadioMetadata
+}
+
+// JoinRequest is a LoRaWAN join request that was received by the station
+type JoinRequest struct {
+	MHdr     uint8   `json:"MHdr"`
+	JoinEUI  EUI     `json:"JoinEui"`
+	DevEUI   EUI     `json:"DevEui"`
+	DevNonce uint16  `json:"DevNonce"`
+	MIC      int32   `json:"MIC"`
+	RefTime  float64 `json:"RefTime,omitempty"` // MuxTime of the last message to the station, plus the time since it was received
+	RadioMetadata
+}
+
+// DownlinkMessage is a message that the station should transmit
+type DownlinkMessage struct {
+	MsgType  string  `json:"msgtype"`
+	DevEUI   EUI     `json:"DevEui"`
+	DC       int     `json:"dC"`   // Device class
+	DIID     int64   `json:"diid"` // Downlink identifier
+	PDU      string  `json:"pdu"`
+	RxDelay  int     `json:"RxDelay"`
+	RX1DR    int     `json:"RX1DR"`
+	RX1Freq  uint64  `json:"RX1Freq"`
+	Priority int     `json:"priority"`
+	XTime    int64   `json:"xtime"`
+	RCtx     int64   `json:"rctx"`
+	MuxTime  float64 `json:"MuxTime,omitempty"` // Time at which the router sent the message, in seconds since the Unix epoch
+}
+
+// DownlinkTransmitted is sent by the station when it transmitted a downlink message
+type DownlinkTransmitted struct {
+	DIID    int64   `json:"diid"`
+	DevEUI  EUI     `json:"DevEui"`
+	RCtx    int64   `json:"rctx"`
+	XTime   int64   `json:"xtime"`
+	TxTime  float64 `json:"txtime"`
+	GPSTime int64   `json:"gpstime"`
+}
+
+// TimeSync is sent by the station to synchronize with GPS time, and returned by the router with the GPS time
+type TimeSync struct {
+	MsgType string  `json:"msgtype"`
+	TxTime  float64 `json:"txtime"`
+	GPSTime int64   `json:"gpstime,omitempty"`
+	MuxTime float64 `json:"MuxTime,omitempty"` // Time at which the router sent the message, in seconds since the Unix epoch
+}
+
+// EUI is an EUI in the format of the LoRa Basics Station
+type EUI types.EUI64
+
+// MarshalJSON implements the json.Marshaler interface
+func (eui EUI) MarshalJSON() ([]byte, error) {
+	parts := make([]string, len(eui))
+	for i, b := range eui {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+	return json.Marshal(strings.Join(parts, "-"))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. The EUI can be a number, a string of hex bytes that are
+// optionally separated by dashes, or an ID6 (for example "::1" or "1:2:3:4").
+func (eui *EUI) UnmarshalJSON(data []byte) error {
+	if !strings.HasPrefix(string(data), `"`) {
+		var number uint64
+		if err := json.Unmarshal(data, &number); err != nil {
+			return err
+		}
+		binary.BigEndian.PutUint64(eui[:], number)
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	if strings.Contains(str, ":") {
+		return eui.parseID6(str)
+	}
+	parsed, err := types.ParseEUI64(strings.Replace(str, "-", "", -1))
+	if err != nil {
+		return err
+	}
+	*eui = EUI(parsed)
+	return nil
+}
+
+// parseID6 parses an ID6, which is an EUI in 4 groups of 16 bits, where "::" replaces consecutive groups of zeros
+func (eui *EUI) parseID6(str string) error {
+	split := func(str string) []string {
+		if str == "" {
+			return nil
+		}
+		return strings.Split(str, ":")
+	}
+	var groups []string
+	if i := strings.Index(str, "::"); i >= 0 {
+		head, tail := split(str[:i]), split(str[i+2:])
+		if len(head)+len(tail) > 3 {
+			return errors.NewErrInvalidArgument("ID6", "too many groups")
+		}
+		groups = append(groups, head...)
+		for len(groups)+len(tail) < 4 {
+			groups = append(groups, "0")
+		}
+		groups = append(groups, tail...)
+	} else {
+		groups = split(str)
+	}
+	if len(groups) != 4 {
+		return errors.NewErrInvalidArgument("ID6", "must have 4 groups")
+	}
+	for i, group := range groups {
+		value, err := strconv.ParseUint(group, 16, 16)
+		if err != nil {
+			return errors.NewErrInvalidArgument("ID6", err.Error())
+		}
+		binary.BigEndian.PutUint16(eui[2*i:], uint16(value))
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package basicstation
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestEUI(t *testing.T) {
+	a := New(t)
+
+	expected := EUI{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+
+	data, err := json.Marshal(expected)
+	a.So(err, ShouldBeNil)
+	a.So(string(data), ShouldEqual, `"01-02-03-04-05-06-07-08"`)
+
+	for _, input := range []string{
+		`"01-02-03-04-05-06-07-08"`,
+		`"0102030405060708"`,
+		`"102:304:506:708"`,
+		`72623859790382856`,
+	} {
+		var eui EUI
+		a.So(json.Unmarshal([]byte(input), &eui), ShouldBeNil)
+		a.So(eui, ShouldEqual, expected)
+	}
+
+	var eui EUI
+	a.So(json.Unmarshal([]byte(`"::1"`), &eui), ShouldBeNil)
+	a.So(eui, ShouldEqual, EUI{0, 0, 0, 0, 0, 0, 0, 1})
+	a.So(json.Unmarshal([]byte(`"1::"`), &eui), ShouldBeNil)
+	a.So(eui, ShouldEqual, EUI{0, 1, 0, 0, 0, 0, 0, 0})
+	a.So(json.Unmarshal([]byte(`"1:2::3"`), &eui), ShouldBeNil)
+	a.So(eui, ShouldEqual, EUI{0, 1, 0, 2, 0, 0, 0, 3})
+
+	a.So(json.Unmarshal([]byte(`"1:2:3"`), &eui), ShouldNotBeNil)
+	a.So(json.Unmarshal([]byte(`"1:2:3:4::5"`), &eui), ShouldNotBeNil)
+	a.So(json.Unmarshal([]byte(`"01-02"`), &eui), ShouldNotBeNil)
+}
@@ -0,0 +1,213 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package basicstation
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	lora "github.com/brocaar/lorawan/band"
+)
+
+// RouterConfig configures the station for a frequency plan
+type RouterConfig struct {
+	MsgType    string       `json:"msgtype"`
+	NetID      []uint32     `json:"NetID"`   // nil to accept all NetIDs
+	JoinEUI    [][2]uint64  `json:"JoinEui"` // nil to accept all JoinEUIs
+	Region     string       `json:"region"`
+	HWSpec     string       `json:"hwspec"`
+	FreqRange  [2]uint64    `json:"freq_range"`
+	DRs        [16][3]int   `json:"DRs"` // SF (0 for FSK, -1 if undefined), bandwidth in kHz, downlink only
+	SX1301Conf []SX1301Conf `json:"sx1301_conf"`
+	MuxTime    float64      `json:"MuxTime"`
+}
+
+// SX1301Conf is the configuration of an SX1301 concentrator
+type SX1301Conf struct {
+	Radios  [2]SX1301Radio
+	MultiSF []*SX1301Channel
+	LoRaStd *SX1301Channel
+	FSK     *SX1301Channel
+}
+
+// SX1301Radio is the configuration of a radio of an SX1301 concentrator
+type SX1301Radio struct {
+	Enable bool   `json:"enable"`
+	Freq   uint64 `json:"freq"`
+}
+
+// SX1301Channel is the configuration of a channel of an SX1301 concentrator
+type SX1301Channel struct {
+	Enable       bool  `json:"enable"`
+	Radio        int   `json:"radio"`
+	IF           int64 `json:"if"`
+	Bandwidth    int   `json:"bandwidth,omitempty"`
+	SpreadFactor int   `json:"spread_factor,omitempty"`
+	DataRate     int   `json:"datarate,omitempty"`
+}
+
+// sx1301MultiSFChannels is the number of multi-SF channels of an SX1301 concentrator
+const sx1301MultiSFChannels = 8
+
+// sx1301RadioSpan is the maximum distance between the frequencies of channels on the same radio
+const sx1301RadioSpan = 800000
+
+// MarshalJSON implements the json.Marshaler interface
+func (c SX1301Conf) MarshalJSON() ([]byte, error) {
+	conf := map[string]interface{}{
+		"radio_0": c.Radios[0],
+		"radio_1": c.Radios[1],
+	}
+	for i := 0; i < sx1301MultiSFChannels; i++ {
+		channel := &SX1301Channel{}
+		if i < len(c.MultiSF) {
+			channel = c.MultiSF[i]
+		}
+		conf[fmt.Sprintf("chan_multiSF_%d", i)] = channel
+	}
+	if c.LoRaStd != nil {
+		conf["chan_Lora_std"] = c.LoRaStd
+	}
+	if c.FSK != nil {
+		conf["chan_FSK"] = c.FSK
+	}
+	return json.Marshal(conf)
+}
+
+type region struct {
+	name      string
+	freqRange [2]uint64
+}
+
+// regions contains the LoRa Basics Station regions of the frequency plans
+var regions = map[string]region{
+	pb_lorawan.FrequencyPlan_EU_863_870.String(): {"EU863", [2]uint64{863000000, 870000000}},
+	pb_lorawan.FrequencyPlan_US_902_928.String(): {"US902", [2]uint64{902000000, 928000000}},
+	pb_lorawan.FrequencyPlan_CN_779_787.String(): {"CN779", [2]uint64{779000000, 787000000}},
+	pb_lorawan.FrequencyPlan_EU_433.String():     {"EU433", [2]uint64{433050000, 434790000}},
+	pb_lorawan.FrequencyPlan_AU_915_928.String(): {"AU915", [2]uint64{915000000, 928000000}},
+	pb_lorawan.FrequencyPlan_CN_470_510.String(): {"CN470", [2]uint64{470000000, 510000000}},
+	pb_lorawan.FrequencyPlan_AS_923.String():     {"AS923", [2]uint64{915000000, 928000000}},
+	pb_lorawan.FrequencyPlan_AS_920_923.String(): {"AS923", [2]uint64{920000000, 923500000}},
+	pb_lorawan.FrequencyPlan_AS_923_925.String(): {"AS923", [2]uint64{923000000, 925000000}},
+	pb_lorawan.FrequencyPlan_KR_920_923.String(): {"KR920", [2]uint64{920900000, 923300000}},
+}
+
+// NewRouterConfig returns the router configuration for the frequency plan
+func NewRouterConfig(frequencyPlan string) (*RouterConfig, error) {
+	region, ok := regions[frequencyPlan]
+	if !ok {
+		return nil, errors.NewErrInvalidArgument("Frequency Plan", "not supported by LoRa Basics Station")
+	}
+	plan, err := band.Get(frequencyPlan)
+	if err != nil {
+		return nil, err
+	}
+	conf := &RouterConfig{
+		MsgType:    TypeRouterConfig,
+		Region:     region.name,
+		HWSpec:     "sx1301/1",
+		FreqRange:  region.freqRange,
+		SX1301Conf: []SX1301Conf{sx1301Conf(plan)},
+		MuxTime:    float64(time.Now().UnixNano()) / float64(time.Second),
+	}
+
+	uplink := make(map[int]bool)
+	for _, channel := range plan.UplinkChannels {
+		for _, dr := range channel.DataRates {
+			uplink[dr] = true
+		}
+	}
+	for i := range conf.DRs {
+		conf.DRs[i] = [3]int{-1, 0, 0}
+		if i >= len(plan.DataRates) {
+			continue
+		}
+		switch dr := plan.DataRates[i]; dr.Modulation {
+		case lora.LoRaModulation:
+			conf.DRs[i] = [3]int{dr.SpreadFactor, dr.Bandwidth, 0}
+		case lora.FSKModulation:
+			conf.DRs[i] = [3]int{0, 0, 0}
+		default:
+			continue
+		}
+		if !uplink[i] {
+			conf.DRs[i][2] = 1
+		}
+	}
+
+	return conf, nil
+}
+
+// sx1301Conf returns the concentrator configuration for the uplink channels of the frequency plan
+func sx1301Conf(plan band.FrequencyPlan) (conf SX1301Conf) {
+	type channel struct {
+		freq uint64
+		conf *SX1301Channel
+	}
+	var channels []channel
+	for _, ch := range plan.UplinkChannels {
+		var multiSF bool
+		for _, idx := range ch.DataRates {
+			if idx < 0 || idx >= len(plan.DataRates) {
+				continue
+			}
+			dr := plan.DataRates[idx]
+			var sx1301Channel *SX1301Channel
+			switch {
+			case dr.Modulation == lora.LoRaModulation && dr.Bandwidth == 125:
+				if multiSF || len(conf.MultiSF) == sx1301MultiSFChannels {
+					continue
+				}
+				multiSF = true
+				sx1301Channel = &SX1301Channel{Enable: true}
+				conf.MultiSF = append(conf.MultiSF, sx1301Channel)
+			case dr.Modulation == lora.LoRaModulation && conf.LoRaStd == nil:
+				sx1301Channel = &SX1301Channel{Enable: true, Bandwidth: dr.Bandwidth * 1000, SpreadFactor: dr.SpreadFactor}
+				conf.LoRaStd = sx1301Channel
+			case dr.Modulation == lora.FSKModulation && conf.FSK == nil:
+				sx1301Channel = &SX1301Channel{Enable: true, Bandwidth: 125000, DataRate: dr.BitRate}
+				conf.FSK = sx1301Channel
+			default:
+				continue
+			}
+			channels = append(channels, channel{uint64(ch.Frequency), sx1301Channel})
+		}
+	}
+
+	// Assign the channels to the radios, and center the radios on their channels
+	sort.Slice(channels, func(i, j int) bool { return channels[i].freq < channels[j].freq })
+	var min, max [2]uint64
+	radio := -1
+	for _, ch := range channels {
+		if radio < 0 || (radio < len(min) && ch.freq-min[radio] > sx1301RadioSpan) {
+			radio++
+			if radio < len(min) {
+				min[radio] = ch.freq
+			}
+		}
+		if radio >= len(min) {
+			ch.conf.Enable = false // Out of range of the radios
+			continue
+		}
+		max[radio] = ch.freq
+		ch.conf.Radio = radio
+	}
+	for i := range conf.Radios {
+		if i <= radio {
+			conf.Radios[i] = SX1301Radio{Enable: true, Freq: (min[i] + max[i]) / 2}
+		}
+	}
+	for _, ch := range channels {
+		if ch.conf.Enable {
+			ch.conf.IF = int64(ch.freq) - int64(conf.Radios[ch.conf.Radio].Freq)
+		}
+	}
+	return
+}
@@ -0,0 +1,47 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package basicstation
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestNewRouterConfig(t *testing.T) {
+	a := New(t)
+
+	conf, err := NewRouterConfig("EU_863_870")
+	a.So(err, ShouldBeNil)
+	a.So(conf.MsgType, ShouldEqual, TypeRouterConfig)
+	a.So(conf.Region, ShouldEqual, "EU863")
+	a.So(conf.FreqRange, ShouldResemble, [2]uint64{863000000, 870000000})
+	a.So(conf.DRs[0], ShouldResemble, [3]int{12, 125, 0})
+	a.So(conf.DRs[6], ShouldResemble, [3]int{7, 250, 0})
+	a.So(conf.DRs[7], ShouldResemble, [3]int{0, 0, 0}) // FSK
+	a.So(conf.DRs[15], ShouldResemble, [3]int{-1, 0, 0})
+
+	a.So(conf.SX1301Conf, ShouldHaveLength, 1)
+	sx1301 := conf.SX1301Conf[0]
+	a.So(sx1301.Radios[0], ShouldResemble, SX1301Radio{Enable: true, Freq: 867500000})
+	a.So(sx1301.Radios[1], ShouldResemble, SX1301Radio{Enable: true, Freq: 868450000})
+	a.So(sx1301.MultiSF, ShouldHaveLength, 8)
+	a.So(*sx1301.MultiSF[0], ShouldResemble, SX1301Channel{Enable: true, Radio: 1, IF: -350000}) // 868.1 MHz
+	a.So(*sx1301.MultiSF[3], ShouldResemble, SX1301Channel{Enable: true, Radio: 0, IF: -400000}) // 867.1 MHz
+	a.So(*sx1301.LoRaStd, ShouldResemble, SX1301Channel{Enable: true, Radio: 1, IF: -150000, Bandwidth: 250000, SpreadFactor: 7})
+	a.So(*sx1301.FSK, ShouldResemble, SX1301Channel{Enable: true, Radio: 1, IF: 350000, Bandwidth: 125000, DataRate: 50000})
+
+	data, err := json.Marshal(sx1301)
+	a.So(err, ShouldBeNil)
+	var fields map[string]interface{}
+	json.Unmarshal(data, &fields)
+	a.So(fields, ShouldContainKey, "radio_0")
+	a.So(fields, ShouldContainKey, "chan_multiSF_7")
+	a.So(fields, ShouldContainKey, "chan_Lora_std")
+	a.So(fields, ShouldContainKey, "chan_FSK")
+
+	_, err = NewRouterConfig("UNKNOWN")
+	a.So(err, ShouldNotBeNil)
+}
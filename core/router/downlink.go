@@ -21,9 +21,14 @@ import (
 	"github.com/TheThingsNetwork/ttn/core/router/gateway"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/random"
 	"github.com/TheThingsNetwork/ttn/utils/toa"
 )
 
+// minDownlinkHandlingTime is the time that the broker and handler need at least to handle a downlink option before
+// it is sent to the gateway
+const minDownlinkHandlingTime = 100 * time.Millisecond
+
 func (r *router) SubscribeDownlink(gatewayID string, subscriptionID string) (<-chan *pb.DownlinkMessage, error) {
 	ctx := r.Ctx.WithFields(ttnlog.Fields{
 		"GatewayID": gatewayID,
@@ -56,7 +61,13 @@ func (r *router) UnsubscribeDownlink(gatewayID string, subscriptionID string) er
 	return nil
 }
 
-func (r *router) HandleDownlink(downlink *pb_broker.DownlinkMessage) (err error) {
+func (r *router) HandleDownlink(downlink *pb_broker.DownlinkMessage) error {
+	return r.handleDownlink(downlink, nil)
+}
+
+// handleDownlink handles a downlink message from the broker. If the broker is not nil, the acknowledgement of the
+// gateway is forwarded to it.
+func (r *router) handleDownlink(downlink *pb_broker.DownlinkMessage, brk *broker) (err error) {
 	var gateway *gateway.Gateway
 	defer func() {
 		if err != nil {
@@ -72,26 +83,50 @@ func (r *router) HandleDownlink(downlink *pb_broker.DownlinkMessage) (err error)
 
 	option := downlink.DownlinkOption
 
+	identifier := option.Identifier
+	if r.Component != nil && r.Component.Identity != nil {
+		identifier = strings.TrimPrefix(option.Identifier, fmt.Sprintf("%s:", r.Component.Identity.Id))
+	}
+	if identifier == "" {
+		// Downlink that is not a response to an uplink (Class B or Class C) gets its option from the gateway schedule,
+		// so it gets a unique identifier here, to match the acknowledgement of the gateway
+		option.Identifier += random.String(16)
+	}
+
 	downlinkMessage := &pb.DownlinkMessage{
 		Payload:               downlink.Payload,
+		Id:                    option.Identifier,
 		ProtocolConfiguration: option.ProtocolConfig,
 		GatewayConfiguration:  option.GatewayConfig,
 		Trace:                 downlink.Trace,
 	}
 
-	identifier := option.Identifier
-	if r.Component != nil && r.Component.Identity != nil {
-		identifier = strings.TrimPrefix(option.Identifier, fmt.Sprintf("%s:", r.Component.Identity.Id))
-	}
-
 	gateway = r.getGateway(downlink.DownlinkOption.GatewayId)
+	r.addPendingTxAck(brk, downlink)
+	if err := checkDwellTime(gateway, downlinkMessage); err != nil {
+		r.dropPendingTxAck(gateway.ID, option.Identifier, pb_gateway.TxAcknowledgement_DWELL_TIME)
+		return err
+	}
+	// The gateway reports the downlink messages that it drops
 	return gateway.HandleDownlink(identifier, downlinkMessage)
 }
 
+// checkDwellTime returns an error if the time on air of the downlink exceeds the dwell time of the frequency plan
+func checkDwellTime(gw *gateway.Gateway, downlink *pb.DownlinkMessage) error {
+	fp, err := gw.FrequencyPlan(downlink.GetGatewayConfiguration().GetFrequency())
+	if err != nil || fp.TxParams == nil || !fp.TxParams.DownlinkDwellTime {
+		return nil
+	}
+	if duration := time.Duration(gateway.DownlinkLength(downlink)) * time.Microsecond; duration > band.MaxDwellTime {
+		return errors.NewErrInvalidArgument("Downlink", fmt.Sprintf("time on air of %s exceeds the dwell time of %s", duration, band.MaxDwellTime))
+	}
+	return nil
+}
+
 // buildDownlinkOption builds a DownlinkOption with default values
 func (r *router) buildDownlinkOption(gatewayID string, band band.FrequencyPlan) *pb_broker.DownlinkOption {
 	dataRate, _ := types.ConvertDataRate(band.DataRates[band.RX2DataRate])
-	return &pb_broker.DownlinkOption{
+	option := &pb_broker.DownlinkOption{
 		GatewayId: gatewayID,
 		ProtocolConfig: &pb_protocol.TxConfiguration{Protocol: &pb_protocol.TxConfiguration_Lorawan{Lorawan: &pb_lorawan.TxConfiguration{
 			Modulation: pb_lorawan.Modulation_LORA,
@@ -105,6 +140,10 @@ func (r *router) buildDownlinkOption(gatewayID string, band band.FrequencyPlan)
 			Power:                 int32(band.DefaultTXPower),
 		},
 	}
+	if band.TxParams != nil && option.GatewayConfig.Power > int32(band.TxParams.MaxEIRP) {
+		option.GatewayConfig.Power = int32(band.TxParams.MaxEIRP)
+	}
+	return option
 }
 
 func (r *router) buildDownlinkOptions(uplink *pb.UplinkMessage, isActivation bool, gateway *gateway.Gateway) (downlinkOptions []*pb_broker.DownlinkOption) {
@@ -219,7 +258,10 @@ func computeDownlinkScores(gateway *gateway.Gateway, uplink *pb.UplinkMessage, o
 		frequencyPlan = band.Guess(uplink.GatewayMetadata.Frequency)
 	}
 
+	fp, _ := band.Get(frequencyPlan)
+
 	gatewayRx, _ := gateway.Utilization.Get()
+	now := time.Now()
 	for _, option := range options {
 
 		// Invalid if no LoRaWAN
@@ -254,8 +296,21 @@ func computeDownlinkScores(gateway *gateway.Gateway, uplink *pb.UplinkMessage, o
 			continue
 		}
 
+		// Invalid if even a downlink without payload exceeds the dwell time
+		if fp.TxParams != nil && fp.TxParams.DownlinkDwellTime && lorawan.Modulation == pb_lorawan.Modulation_LORA {
+			if minTime, _ := toa.ComputeLoRa(13, lorawan.DataRate, lorawan.CodingRate); minTime > band.MaxDwellTime {
+				option.Score = 1000
+				continue
+			}
+		}
+
 		timeScore := math.Min(time.Seconds()*5, 10) // 2 seconds will be 10 (max)
 
+		// Prefer data rates at which a downlink of the maximum size does not exceed the dwell time
+		if fp.TxParams != nil && fp.TxParams.DownlinkDwellTime && time > band.MaxDwellTime {
+			timeScore += 20
+		}
+
 		signalScore := 0.0 // Between 0 and 20 (lower is better)
 		{
 			// Prefer high SNR
@@ -266,7 +321,7 @@ func computeDownlinkScores(gateway *gateway.Gateway, uplink *pb.UplinkMessage, o
 			signalScore += math.Min(float64(uplink.GatewayMetadata.Rssi*-0.1), 10)
 		}
 
-		utilizationScore := 0.0 // Between 0 and 40 (lower is better) will be over 100 if forbidden
+		utilizationScore := 0.0 // Between 0 and 50 (lower is better) will be over 100 if forbidden
 		{
 			// Avoid gateways that do more Rx
 			utilizationScore += math.Min(gatewayRx*50, 20) / 2 // 40% utilization = 10 (max)
@@ -276,29 +331,23 @@ func computeDownlinkScores(gateway *gateway.Gateway, uplink *pb.UplinkMessage, o
 			channelRx, channelTx := gateway.Utilization.GetChannel(freq)
 			utilizationScore += math.Min((channelTx+channelRx)*200, 20) / 2 // 10% utilization = 10 (max)
 
-			// European Duty Cycle
-			if frequencyPlan == "EU_863_870" {
-				var duty float64
-				switch {
-				case freq >= 863000000 && freq < 868000000:
-					duty = 0.01 // g 863.0 – 868.0 MHz 1%
-				case freq >= 868000000 && freq < 868600000:
-					duty = 0.01 // g1 868.0 – 868.6 MHz 1%
-				case freq >= 868700000 && freq < 869200000:
-					duty = 0.001 // g2 868.7 – 869.2 MHz 0.1%
-				case freq >= 869400000 && freq < 869650000:
-					duty = 0.1 // g3 869.4 – 869.65 MHz 10%
-				case freq >= 869700000 && freq < 870000000:
-					duty = 0.01 // g4 869.7 – 870.0 MHz 1%
-				default:
-					utilizationScore += 100 // Transmissions on this frequency are forbidden
-				}
-				if channelTx > duty {
+			// Duty Cycle of the sub-band
+			if fp.SubBands != nil {
+				if subBand, ok := fp.GetSubBand(freq); ok {
+					remaining := gateway.DutyCycle.Remaining(subBand)
+					if time > remaining {
+						utilizationScore += 100 // Transmission would exceed the duty cycle of the last hour
+					}
+					if channelTx > subBand.DutyCycle {
+						utilizationScore += 100 // The channel is already used more than the duty cycle allows
+					}
+					// Impact on duty-cycle (in order to prefer RX2 for SF9BW125)
+					utilizationScore += math.Min(time.Seconds()/subBand.DutyCycle/100, 20)
+					// Avoid sub-bands with little remaining duty-cycle: 0 if unused, 10 if exhausted
+					utilizationScore += 10 - math.Min(remaining.Seconds()/subBand.MaxTimeOnAir().Seconds()*10, 10)
+				} else {
 					utilizationScore += 100 // Transmissions on this frequency are forbidden
 				}
-				if duty > 0 {
-					utilizationScore += math.Min(time.Seconds()/duty/100, 20) // Impact on duty-cycle (in order to prefer RX2 for SF9BW125)
-				}
 			}
 		}
 
@@ -311,6 +360,13 @@ func computeDownlinkScores(gateway *gateway.Gateway, uplink *pb.UplinkMessage, o
 			} else {
 				scheduleScore += math.Min(float64(conflicts*10), 30) // max 30
 			}
+			// Avoid options that can not be sent to the gateway in time, which makes high-latency gateways use RX2
+			if deadlineAt := gateway.Schedule.DeadlineAt(option.GatewayConfig.Timestamp); !deadlineAt.IsZero() {
+				option.Deadline = deadlineAt.UnixNano()
+				if deadlineAt.Sub(now) < minDownlinkHandlingTime {
+					scheduleScore += 100
+				}
+			}
 		}
 
 		option.Score = uint32((timeScore + signalScore + utilizationScore + scheduleScore) * 10)
@@ -4,6 +4,7 @@
 package router
 
 import (
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -455,6 +456,16 @@ func TestComputeDownlinkScores(t *testing.T) {
 	a.So(options, ShouldHaveLength, 1) // RX1 Removed
 	a.So(options[0].GatewayConfig.Frequency, ShouldNotEqual, 868100000)
 
+	// European Duty-cycle Enforcement over the last hour
+	testSubject = newReferenceUplink()
+	testSubjectgtw = newReferenceGateway(t, "EU_863_870")
+	for i := 0; i < 700; i++ {
+		testSubjectgtw.DutyCycle.Reserve(fmt.Sprint(i), newReferenceDownlink(), nil) // 700 times 56ms is more than 1% of an hour
+	}
+	options = r.buildDownlinkOptions(testSubject, false, testSubjectgtw)
+	a.So(options, ShouldHaveLength, 1) // RX1 Removed
+	a.So(options[0].GatewayConfig.Frequency, ShouldEqual, 869525000)
+
 	// European Duty-cycle Preferences - Prefer RX1 for low SF
 	testSubject = newReferenceUplink()
 	testSubject.ProtocolMetadata.GetLorawan().DataRate = "SF7BW125"
@@ -488,4 +499,39 @@ func TestComputeDownlinkScores(t *testing.T) {
 	testSubject2Score = r.buildDownlinkOptions(testSubject2, false, testSubjectgtw)[1].Score
 	a.So(testSubject1Score, ShouldBeGreaterThan, refScore) // Scheduling conflict with RX1
 	a.So(testSubject2Score, ShouldEqual, refScore)         // No scheduling conflicts
+
+	// Low-latency gateway
+	testSubject = newReferenceUplink()
+	testSubjectgtw = newReferenceGateway(t, "EU_863_870")
+	testSubjectgtw.Schedule.Sync(testSubject.GatewayMetadata.Timestamp)
+	testSubjectgtw.Schedule.AddRTT(50 * time.Millisecond)
+	options = r.buildDownlinkOptions(testSubject, false, testSubjectgtw)
+	a.So(options, ShouldHaveLength, 2)
+	a.So(options[1].Deadline, ShouldBeGreaterThan, time.Now().UnixNano())
+
+	// High-latency gateway
+	testSubject = newReferenceUplink()
+	testSubjectgtw = newReferenceGateway(t, "EU_863_870")
+	testSubjectgtw.Schedule.Sync(testSubject.GatewayMetadata.Timestamp)
+	testSubjectgtw.Schedule.AddRTT(900 * time.Millisecond)
+	options = r.buildDownlinkOptions(testSubject, false, testSubjectgtw)
+	a.So(options, ShouldHaveLength, 1) // RX1 Removed
+	a.So(options[0].GatewayConfig.Frequency, ShouldEqual, 869525000)
+}
+
+func TestCheckDwellTime(t *testing.T) {
+	a := New(t)
+
+	downlink := newReferenceDownlink()
+	downlink.ProtocolConfiguration.GetLorawan().DataRate = "SF10BW125"
+	downlink.Payload = make([]byte, 60)
+
+	// No dwell time in EU
+	a.So(checkDwellTime(newReferenceGateway(t, "EU_863_870"), downlink), ShouldBeNil)
+
+	// Dwell time in AS
+	downlink.GatewayConfiguration.Frequency = 923200000
+	a.So(checkDwellTime(newReferenceGateway(t, "AS_923"), downlink), ShouldNotBeNil)
+	downlink.Payload = make([]byte, 10)
+	a.So(checkDwellTime(newReferenceGateway(t, "AS_923"), downlink), ShouldBeNil)
 }
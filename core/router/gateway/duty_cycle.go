@@ -0,0 +1,111 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package gateway
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	pb_router "github.com/TheThingsNetwork/ttn/api/router"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// DutyCycle manages the time on air of the transmissions of a gateway
+// It is based on a sliding window of band.DutyCycleWindow
+type DutyCycle interface {
+	// Reserve registers the transmission of a scheduled downlink message with the given id. It returns an error if the
+	// transmission would exceed the duty cycle of the sub-band. Without sub-band, the duty cycle is not checked.
+	Reserve(id string, downlink *pb_router.DownlinkMessage, subBand *band.SubBand) error
+	// Release removes the reservation of a scheduled downlink message that is not transmitted
+	Release(id string)
+	// Get returns the time on air of the transmissions in the sub-band during the window
+	Get(subBand band.SubBand) time.Duration
+	// Remaining returns the time on air that is left in the sub-band during the window
+	Remaining(subBand band.SubBand) time.Duration
+}
+
+// NewDutyCycle creates a new DutyCycle
+func NewDutyCycle() DutyCycle {
+	return &dutyCycle{}
+}
+
+type transmission struct {
+	id        string
+	time      time.Time
+	frequency uint64
+	timeOnAir time.Duration
+}
+
+type dutyCycle struct {
+	mu            sync.Mutex
+	transmissions []transmission // ordered by time
+}
+
+// expire removes the transmissions that are outside the window. It should be called with the lock held
+func (d *dutyCycle) expire() {
+	from := time.Now().Add(-1 * band.DutyCycleWindow)
+	var i int
+	for i < len(d.transmissions) && d.transmissions[i].time.Before(from) {
+		i++
+	}
+	d.transmissions = d.transmissions[i:]
+}
+
+func (d *dutyCycle) Reserve(id string, downlink *pb_router.DownlinkMessage, subBand *band.SubBand) error {
+	timeOnAir := time.Duration(DownlinkLength(downlink)) * time.Microsecond
+	if timeOnAir == 0 {
+		return nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.expire()
+	if subBand != nil && timeOnAir > subBand.MaxTimeOnAir()-d.get(*subBand) {
+		return errors.NewErrInvalidArgument("Downlink", fmt.Sprintf("time on air of %s exceeds the remaining duty cycle of the sub-band", timeOnAir))
+	}
+	d.transmissions = append(d.transmissions, transmission{
+		id:        id,
+		time:      time.Now(),
+		frequency: downlink.GetGatewayConfiguration().GetFrequency(),
+		timeOnAir: timeOnAir,
+	})
+	return nil
+}
+
+func (d *dutyCycle) Release(id string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i, tx := range d.transmissions {
+		if tx.id == id {
+			d.transmissions = append(d.transmissions[:i], d.transmissions[i+1:]...)
+			return
+		}
+	}
+}
+
+func (d *dutyCycle) Get(subBand band.SubBand) time.Duration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.expire()
+	return d.get(subBand)
+}
+
+// get returns the time on air of the transmissions in the sub-band. It should be called with the lock held
+func (d *dutyCycle) get(subBand band.SubBand) (timeOnAir time.Duration) {
+	for _, tx := range d.transmissions {
+		if subBand.Contains(tx.frequency) {
+			timeOnAir += tx.timeOnAir
+		}
+	}
+	return
+}
+
+func (d *dutyCycle) Remaining(subBand band.SubBand) time.Duration {
+	remaining := subBand.MaxTimeOnAir() - d.Get(subBand)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
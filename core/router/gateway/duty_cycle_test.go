@@ -0,0 +1,72 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package gateway
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/band"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDutyCycle(t *testing.T) {
+	a := New(t)
+	d := NewDutyCycle()
+
+	g1 := band.SubBand{MinFrequency: 868000000, MaxFrequency: 868600000, DutyCycle: 0.01}
+	g3 := band.SubBand{MinFrequency: 869400000, MaxFrequency: 869650000, DutyCycle: 0.1}
+
+	a.So(d.Get(g1), ShouldEqual, 0)
+	a.So(d.Remaining(g1), ShouldEqual, 36*time.Second)
+
+	downlink := buildDownlink(868100000)
+	timeOnAir := time.Duration(DownlinkLength(downlink)) * time.Microsecond
+	d.Reserve("first", downlink, nil)
+	d.Reserve("second", buildDownlink(868300000), nil)
+	a.So(d.Get(g1), ShouldEqual, 2*timeOnAir)
+	a.So(d.Remaining(g1), ShouldEqual, 36*time.Second-2*timeOnAir)
+	a.So(d.Get(g3), ShouldEqual, 0)
+
+	// Transmissions outside the window do not count
+	d.(*dutyCycle).transmissions[0].time = time.Now().Add(-1 * band.DutyCycleWindow).Add(-1 * time.Second)
+	a.So(d.Get(g1), ShouldEqual, timeOnAir)
+
+	// The remaining time on air is never negative
+	for i := 0; i < 1000; i++ {
+		d.Reserve(fmt.Sprint(i), buildDownlink(868500000), nil)
+	}
+	a.So(d.Remaining(g1), ShouldEqual, 0)
+}
+
+func TestDutyCycleReserve(t *testing.T) {
+	a := New(t)
+	d := NewDutyCycle()
+
+	g2 := band.SubBand{MinFrequency: 868700000, MaxFrequency: 869200000, DutyCycle: 0.001}
+
+	downlink := buildDownlink(868800000)
+	timeOnAir := time.Duration(DownlinkLength(downlink)) * time.Microsecond
+
+	// Reservations count immediately
+	a.So(d.Reserve("first", downlink, &g2), ShouldBeNil)
+	a.So(d.Get(g2), ShouldEqual, timeOnAir)
+
+	// Reservations that exceed the duty cycle are rejected
+	var reserved int
+	for i := 0; i < 100; i++ {
+		if d.Reserve(fmt.Sprintf("downlink-%d", i), buildDownlink(868800000), &g2) == nil {
+			reserved++
+		}
+	}
+	a.So(reserved, ShouldBeLessThan, 100)
+	a.So(d.Remaining(g2), ShouldBeLessThan, timeOnAir)
+	a.So(d.Reserve("other", buildDownlink(868800000), &g2), ShouldNotBeNil)
+
+	// Released reservations do not count
+	d.Release("first")
+	a.So(d.Get(g2), ShouldEqual, time.Duration(reserved)*timeOnAir)
+	a.So(d.Reserve("other", buildDownlink(868800000), &g2), ShouldBeNil)
+}
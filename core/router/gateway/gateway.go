@@ -13,6 +13,9 @@ import (
 	pb_monitor "github.com/TheThingsNetwork/ttn/api/monitor"
 	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	pb_router "github.com/TheThingsNetwork/ttn/api/router"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/gpstime"
 )
 
 // NewGateway creates a new in-memory Gateway structure
@@ -22,6 +25,7 @@ func NewGateway(ctx ttnlog.Interface, id string) *Gateway {
 		ID:          id,
 		Status:      NewStatusStore(),
 		Utilization: NewUtilization(),
+		DutyCycle:   NewDutyCycle(),
 		Schedule:    NewSchedule(ctx),
 		Ctx:         ctx,
 	}
@@ -34,6 +38,7 @@ type Gateway struct {
 	ID          string
 	Status      StatusStore
 	Utilization Utilization
+	DutyCycle   DutyCycle
 	Schedule    Schedule
 	LastSeen    time.Time
 
@@ -44,12 +49,48 @@ type Gateway struct {
 	Monitor       *pb_monitor.Client
 	MonitorStream pb_monitor.GenericStream
 
+	// Dropped is called when a downlink message is dropped before it is sent to the gateway
+	Dropped func(downlink *pb_router.DownlinkMessage, result pb.TxAcknowledgement_Result)
+
 	Ctx ttnlog.Interface
 }
 
+// drop reports a downlink message that is dropped before it is sent to the gateway
+func (g *Gateway) drop(downlink *pb_router.DownlinkMessage, result pb.TxAcknowledgement_Result) {
+	if g.Dropped != nil {
+		g.Dropped(downlink, result)
+	}
+}
+
+// scheduleResult returns the result of the acknowledgement of a downlink message that could not be scheduled
+func scheduleResult(err error) pb.TxAcknowledgement_Result {
+	switch errors.GetErrType(err) {
+	case errors.AlreadyExists:
+		return pb.TxAcknowledgement_COLLISION_PACKET
+	case errors.InvalidArgument, errors.NotFound:
+		return pb.TxAcknowledgement_TOO_LATE
+	}
+	return pb.TxAcknowledgement_UNKNOWN_ERROR
+}
+
 func (g *Gateway) SetAuth(token string, authenticated bool) {
 	g.mu.Lock()
 	defer g.mu.Unlock()
+	g.setAuth(token, authenticated)
+}
+
+// SetAnonymous marks a gateway that connects without a token (such as over UDP) as not authenticated, unless the
+// gateway already connected with a token.
+func (g *Gateway) SetAnonymous() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.token != "" {
+		return
+	}
+	g.setAuth("", false)
+}
+
+func (g *Gateway) setAuth(token string, authenticated bool) {
 	g.authenticated = authenticated
 	if g.MonitorStream != nil {
 		if token == g.token {
@@ -76,6 +117,9 @@ func (g *Gateway) HandleStatus(status *pb.Status) (err error) {
 	if err = g.Status.Update(status); err != nil {
 		return err
 	}
+	if status.Rtt != 0 {
+		g.Schedule.AddRTT(time.Duration(status.Rtt) * time.Millisecond)
+	}
 	g.updateLastSeen()
 	return nil
 }
@@ -109,10 +153,81 @@ func (g *Gateway) HandleUplink(uplink *pb_router.UplinkMessage) (err error) {
 	return nil
 }
 
+// FrequencyPlan returns the frequency plan of the gateway, or guesses it from the given frequency
+func (g *Gateway) FrequencyPlan(frequency uint64) (band.FrequencyPlan, error) {
+	status, _ := g.Status.Get() // This just returns empty if non-existing
+	frequencyPlan := status.GetFrequencyPlan()
+	if frequencyPlan == "" {
+		frequencyPlan = band.Guess(frequency)
+	}
+	return band.Get(frequencyPlan)
+}
+
+// reserveDutyCycle reserves the time on air of the downlink with the given identifier in the duty cycle of its
+// sub-band. It returns an error if the downlink would exceed the duty cycle of its sub-band.
+func (g *Gateway) reserveDutyCycle(identifier string, downlink *pb_router.DownlinkMessage) error {
+	if g.DutyCycle == nil {
+		return nil
+	}
+	frequency := downlink.GetGatewayConfiguration().GetFrequency()
+	fp, err := g.FrequencyPlan(frequency)
+	if err != nil || fp.SubBands == nil {
+		return g.DutyCycle.Reserve(identifier, downlink, nil)
+	}
+	subBand, ok := fp.GetSubBand(frequency)
+	if !ok {
+		return errors.NewErrInvalidArgument("Frequency", "not in a sub-band of the frequency plan")
+	}
+	return g.DutyCycle.Reserve(identifier, downlink, &subBand)
+}
+
+// releaseDutyCycle releases the time on air that was reserved for the downlink with the given identifier
+func (g *Gateway) releaseDutyCycle(identifier string) {
+	if g.DutyCycle != nil {
+		g.DutyCycle.Release(identifier)
+	}
+}
+
 func (g *Gateway) HandleDownlink(identifier string, downlink *pb_router.DownlinkMessage) (err error) {
 	ctx := g.Ctx.WithField("Identifier", identifier).WithFields(fields.Get(downlink))
+	if identifier == "" {
+		// Downlink that is not a response to an uplink (Class B or Class C)
+		var timestamp uint32
+		if gpsTime := downlink.GetGatewayConfiguration().GetGpsTime(); gpsTime != 0 {
+			// Transmit in a Class B ping slot, which requires a gateway that is synchronized with GPS time
+			if status, _ := g.Status.Get(); status.GetGps().GetTime() == 0 {
+				err = errors.NewErrInvalidArgument("Gateway", "does not report GPS time")
+				ctx.WithError(err).Warn("Could not get option for downlink")
+				g.drop(downlink, pb.TxAcknowledgement_GPS_UNLOCKED)
+				return err
+			}
+			identifier, timestamp, err = g.Schedule.GetTimeOption(gpstime.FromGPS(time.Duration(gpsTime)*time.Microsecond), DownlinkLength(downlink))
+		} else {
+			// Transmit as soon as possible
+			identifier, timestamp, err = g.Schedule.GetImmediateOption(DownlinkLength(downlink))
+		}
+		if err != nil {
+			ctx.WithError(err).Warn("Could not get option for downlink")
+			g.drop(downlink, scheduleResult(err))
+			return err
+		}
+		if downlink.GatewayConfiguration == nil {
+			downlink.GatewayConfiguration = new(pb.TxConfiguration)
+		}
+		downlink.GatewayConfiguration.Timestamp = timestamp
+		ctx = ctx.WithField("Identifier", identifier)
+	}
+	// The time on air is reserved before the downlink is scheduled, so that concurrent downlinks can not exceed the
+	// duty cycle together. The schedule releases it if the downlink is not sent to the gateway.
+	if err = g.reserveDutyCycle(identifier, downlink); err != nil {
+		ctx.WithError(err).Warn("Could not schedule downlink")
+		g.drop(downlink, pb.TxAcknowledgement_DUTY_CYCLE)
+		return err
+	}
 	if err = g.Schedule.Schedule(identifier, downlink); err != nil {
 		ctx.WithError(err).Warn("Could not schedule downlink")
+		g.releaseDutyCycle(identifier)
+		g.drop(downlink, scheduleResult(err))
 		return err
 	}
 	ctx.Debug("Scheduled downlink")
@@ -4,8 +4,11 @@
 package gateway
 
 import (
+	"fmt"
 	"testing"
 
+	pb "github.com/TheThingsNetwork/ttn/api/gateway"
+	pb_router "github.com/TheThingsNetwork/ttn/api/router"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	. "github.com/smartystreets/assertions"
 )
@@ -15,3 +18,72 @@ func TestNewGateway(t *testing.T) {
 	gtw := NewGateway(GetLogger(t, "TestNewGateway"), "eui-0102030405060708")
 	a.So(gtw, ShouldNotBeNil)
 }
+
+func TestSetAnonymous(t *testing.T) {
+	a := New(t)
+	gtw := NewGateway(GetLogger(t, "TestSetAnonymous"), "eui-0102030405060708")
+
+	status := &pb.Status{}
+	gtw.SetAnonymous()
+	gtw.HandleStatus(status)
+	a.So(status.GatewayTrusted, ShouldBeFalse)
+
+	// A gateway that connected with a token remains authenticated
+	gtw.SetAuth("token", true)
+	gtw.SetAnonymous()
+	gtw.HandleStatus(status)
+	a.So(status.GatewayTrusted, ShouldBeTrue)
+}
+
+func TestReserveDutyCycle(t *testing.T) {
+	a := New(t)
+	gtw := NewGateway(GetLogger(t, "TestReserveDutyCycle"), "eui-0102030405060708")
+	gtw.Status.Update(&pb.Status{FrequencyPlan: "EU_863_870"})
+
+	a.So(gtw.reserveDutyCycle("first", buildDownlink(868100000)), ShouldBeNil)
+
+	// Not in a sub-band
+	a.So(gtw.reserveDutyCycle("other", buildDownlink(869300000)), ShouldNotBeNil)
+
+	// Exceeds the duty cycle of the 0.1% sub-band
+	for i := 0; i < 100; i++ {
+		gtw.DutyCycle.Reserve(fmt.Sprint(i), buildDownlink(868800000), nil)
+	}
+	a.So(gtw.reserveDutyCycle("other", buildDownlink(868800000)), ShouldNotBeNil)
+	a.So(gtw.reserveDutyCycle("second", buildDownlink(868100000)), ShouldBeNil)
+}
+
+func TestHandleDownlinkDropped(t *testing.T) {
+	a := New(t)
+	gtw := NewGateway(GetLogger(t, "TestHandleDownlinkDropped"), "eui-0102030405060708")
+	gtw.Status.Update(&pb.Status{FrequencyPlan: "EU_863_870"})
+	gtw.Schedule.Sync(0)
+
+	var dropped []pb.TxAcknowledgement_Result
+	gtw.Dropped = func(downlink *pb_router.DownlinkMessage, result pb.TxAcknowledgement_Result) {
+		dropped = append(dropped, result)
+	}
+
+	// Unknown option
+	err := gtw.HandleDownlink("unknown", buildDownlink(868100000))
+	a.So(err, ShouldNotBeNil)
+
+	// Class B without GPS time
+	downlink := buildDownlink(868100000)
+	downlink.GatewayConfiguration.GpsTime = 1000000
+	err = gtw.HandleDownlink("", downlink)
+	a.So(err, ShouldNotBeNil)
+
+	// Exceeds the duty cycle
+	for i := 0; i < 100; i++ {
+		gtw.DutyCycle.Reserve(fmt.Sprint(i), buildDownlink(868800000), nil)
+	}
+	err = gtw.HandleDownlink("", buildDownlink(868800000))
+	a.So(err, ShouldNotBeNil)
+
+	a.So(dropped, ShouldResemble, []pb.TxAcknowledgement_Result{
+		pb.TxAcknowledgement_TOO_LATE,
+		pb.TxAcknowledgement_GPS_UNLOCKED,
+		pb.TxAcknowledgement_DUTY_CYCLE,
+	})
+}
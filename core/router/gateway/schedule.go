@@ -10,6 +10,7 @@ import (
 	"time"
 
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
 	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	router_pb "github.com/TheThingsNetwork/ttn/api/router"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
@@ -24,12 +25,23 @@ type Schedule interface {
 	Sync(timestamp uint32)
 	// Get an "option" on a transmission slot at timestamp for the maximum duration of length (both in microseconds)
 	GetOption(timestamp uint32, length uint32) (id string, score uint)
+	// Get an "option" on the first free transmission slot for the maximum duration of length (in microseconds)
+	GetImmediateOption(length uint32) (id string, timestamp uint32, err error)
+	// Get an "option" on the transmission slot at time t for the maximum duration of length (in microseconds)
+	GetTimeOption(t time.Time, length uint32) (id string, timestamp uint32, err error)
 	// Schedule a transmission on a slot
 	Schedule(id string, downlink *router_pb.DownlinkMessage) error
 	// Subscribe to downlink messages
 	Subscribe(subscriptionID string) <-chan *router_pb.DownlinkMessage
 	// Whether the gateway has active downlink
 	IsActive() bool
+	// Update the deadline with a measured round-trip time to the gateway
+	AddRTT(rtt time.Duration)
+	// Get the deadline for sending a downlink message to the gateway before its transmission
+	Deadline() time.Duration
+	// Get the time at which a downlink message for a transmission at timestamp (in microseconds) must be sent to the
+	// gateway. The time is zero if the schedule is not synchronized with the gateway.
+	DeadlineAt(timestamp uint32) time.Time
 	// Stop the subscription
 	Stop(subscriptionID string)
 }
@@ -81,6 +93,10 @@ type schedule struct {
 	downlinkSubscriptionsLock sync.RWMutex
 	downlinkSubscriptions     map[string]chan *router_pb.DownlinkMessage
 	gateway                   *Gateway
+
+	rttLock sync.RWMutex
+	srtt    time.Duration // smoothed round-trip time to the gateway, 0 if unknown
+	rttvar  time.Duration // variation of the round-trip time
 }
 
 func (s *schedule) GoString() (str string) {
@@ -92,10 +108,18 @@ func (s *schedule) GoString() (str string) {
 	return
 }
 
-// Deadline for sending a downlink back to the gateway
-// TODO: Make configurable
+// Deadline for sending a downlink back to the gateway, if the round-trip time to the gateway is not known
 var Deadline = 800 * time.Millisecond
 
+// MinDeadline and MaxDeadline bound the deadline that is derived from the round-trip time to the gateway
+var (
+	MinDeadline = 200 * time.Millisecond
+	MaxDeadline = 1500 * time.Millisecond
+)
+
+// DeadlineMargin is added to the round-trip time to the gateway, for processing by the gateway
+var DeadlineMargin = 100 * time.Millisecond
+
 const uintmax = 1 << 32
 
 // getConflicts walks over the schedule and returns the number of conflicts.
@@ -103,7 +127,16 @@ const uintmax = 1 << 32
 func (s *schedule) getConflicts(timestamp uint32, length uint32) (conflicts uint) {
 	s.RLock()
 	defer s.RUnlock()
+	return s.conflicts("", timestamp, length)
+}
+
+// conflicts returns the number of conflicts with the items other than the item with the given id. The schedule must be
+// locked by the caller.
+func (s *schedule) conflicts(id string, timestamp uint32, length uint32) (conflicts uint) {
 	for _, item := range s.items {
+		if item.id == id {
+			continue
+		}
 		scheduledFrom := uint64(item.timestamp) % uintmax
 		scheduledTo := scheduledFrom + uint64(item.length)
 		from := uint64(timestamp)
@@ -143,13 +176,55 @@ func (s *schedule) Sync(timestamp uint32) {
 	atomic.StoreInt64(&s.offset, time.Now().UnixNano()-int64(timestamp)*1000)
 }
 
+// see interface
+func (s *schedule) AddRTT(rtt time.Duration) {
+	s.rttLock.Lock()
+	defer s.rttLock.Unlock()
+	// Smoothed like the round-trip time of TCP (RFC 6298)
+	if s.srtt == 0 {
+		s.srtt, s.rttvar = rtt, rtt/2
+		return
+	}
+	diff := s.srtt - rtt
+	if diff < 0 {
+		diff = -diff
+	}
+	s.rttvar = (3*s.rttvar + diff) / 4
+	s.srtt = (7*s.srtt + rtt) / 8
+}
+
+// see interface
+func (s *schedule) Deadline() time.Duration {
+	s.rttLock.RLock()
+	defer s.rttLock.RUnlock()
+	if s.srtt == 0 {
+		return Deadline
+	}
+	deadline := s.srtt + 4*s.rttvar + DeadlineMargin
+	if deadline < MinDeadline {
+		return MinDeadline
+	}
+	if deadline > MaxDeadline {
+		return MaxDeadline
+	}
+	return deadline
+}
+
+// see interface
+func (s *schedule) DeadlineAt(timestamp uint32) time.Time {
+	if atomic.LoadInt64(&s.offset) == 0 {
+		return time.Time{}
+	}
+	return s.realtime(timestamp).Add(-1 * s.Deadline())
+}
+
 // see interface
 func (s *schedule) GetOption(timestamp uint32, length uint32) (id string, score uint) {
 	id = random.String(32)
 	score = s.getConflicts(timestamp, length)
 	item := &scheduledItem{
 		id:         id,
-		deadlineAt: s.realtime(timestamp).Add(-1 * Deadline),
+		deadlineAt: s.realtime(timestamp).Add(-1 * s.Deadline()),
 		timestamp:  timestamp,
 		length:     length,
 		score:      score,
@@ -160,6 +235,53 @@ func (s *schedule) GetOption(timestamp uint32, length uint32) (id string, score
 	return id, score
 }
 
+// ImmediateDelay is the time that is added to the Deadline when scheduling a downlink as soon as possible
+var ImmediateDelay = 100 * time.Millisecond
+
+// maxImmediateAttempts is the number of scheduled downlinks that GetImmediateOption will try to avoid
+const maxImmediateAttempts = 10
+
+// timestamp gets the timestamp (in microseconds) for a synchronized time. This is the inverse of func realtime()
+func (s *schedule) timestamp(t time.Time) (uint32, error) {
+	offset := atomic.LoadInt64(&s.offset)
+	if offset == 0 {
+		return 0, errors.NewErrInternal("Schedule not synchronized with gateway")
+	}
+	return uint32((t.UnixNano() - offset) / 1000), nil
+}
+
+// see interface
+func (s *schedule) GetImmediateOption(length uint32) (id string, timestamp uint32, err error) {
+	timestamp, err = s.timestamp(time.Now().Add(s.Deadline() + ImmediateDelay))
+	if err != nil {
+		return "", 0, err
+	}
+	for i := 0; i < maxImmediateAttempts; i++ {
+		if s.getConflicts(timestamp, length) < 100 {
+			break
+		}
+		timestamp += length + uint32(ImmediateDelay/time.Microsecond)
+	}
+	id, _ = s.GetOption(timestamp, length)
+	return id, timestamp, nil
+}
+
+// see interface
+func (s *schedule) GetTimeOption(t time.Time, length uint32) (id string, timestamp uint32, err error) {
+	timestamp, err = s.timestamp(t)
+	if err != nil {
+		return "", 0, err
+	}
+	if time.Now().After(t.Add(-1 * s.Deadline())) {
+		return "", 0, errors.NewErrInvalidArgument("Time", "is too close to the Deadline")
+	}
+	if s.getConflicts(timestamp, length) >= 100 {
+		return "", 0, errors.NewErrAlreadyExists(fmt.Sprintf("Downlink at %s", t))
+	}
+	id, _ = s.GetOption(timestamp, length)
+	return id, timestamp, nil
+}
+
 // see interface
 func (s *schedule) Schedule(id string, downlink *router_pb.DownlinkMessage) error {
 	ctx := s.ctx.WithField("Identifier", id)
@@ -167,27 +289,29 @@ func (s *schedule) Schedule(id string, downlink *router_pb.DownlinkMessage) erro
 	s.Lock()
 	defer s.Unlock()
 	if item, ok := s.items[id]; ok {
-		item.payload = downlink
+		timestamp, length := item.timestamp, item.length
 
-		if lorawan := downlink.GetProtocolConfiguration().GetLorawan(); lorawan != nil {
-			var time time.Duration
-			if lorawan.Modulation == pb_lorawan.Modulation_LORA {
-				// Calculate max ToA
-				time, _ = toa.ComputeLoRa(
-					uint(len(downlink.Payload)),
-					lorawan.DataRate,
-					lorawan.CodingRate,
-				)
+		// The Broker may have moved the downlink to the RX delay of the device
+		if gateway := downlink.GetGatewayConfiguration(); gateway != nil {
+			timestamp = gateway.Timestamp
+		}
+		if downlink.GetProtocolConfiguration().GetLorawan() != nil {
+			length = DownlinkLength(downlink)
+		}
+
+		// A moved downlink was not validated when the option was built
+		if timestamp != item.timestamp || length > item.length {
+			deadlineAt := s.realtime(timestamp).Add(-1 * s.Deadline())
+			if time.Now().After(deadlineAt) {
+				return errors.NewErrInvalidArgument("Downlink", "is too close to the Deadline")
 			}
-			if lorawan.Modulation == pb_lorawan.Modulation_FSK {
-				// Calculate max ToA
-				time, _ = toa.ComputeFSK(
-					uint(len(downlink.Payload)),
-					int(lorawan.BitRate),
-				)
+			if s.conflicts(id, timestamp, length) >= 100 {
+				return errors.NewErrAlreadyExists(fmt.Sprintf("Downlink at %d", timestamp))
 			}
-			item.length = uint32(time / 1000)
+			item.deadlineAt = deadlineAt
 		}
+		item.payload = downlink
+		item.timestamp, item.length = timestamp, length
 
 		if time.Now().Before(item.deadlineAt) {
 			// Schedule transmission before the Deadline
@@ -197,26 +321,37 @@ func (s *schedule) Schedule(id string, downlink *router_pb.DownlinkMessage) erro
 				downlink.Trace = downlink.Trace.WithEvent("schedule", "duration", waitTime)
 				<-time.After(waitTime)
 				s.RLock()
-				defer s.RUnlock()
-				if s.downlink != nil {
+				sent := s.downlink != nil
+				if sent {
 					ctx.Debug("Send Downlink")
 					s.downlink <- item.payload
 				}
+				s.RUnlock()
+				if !sent {
+					ctx.Warn("Unable to send Downlink")
+					s.drop(item.id, item.payload, pb_gateway.TxAcknowledgement_UNKNOWN_ERROR)
+				}
 			}()
 		} else {
 			go func() {
 				s.RLock()
-				defer s.RUnlock()
+				result := pb_gateway.TxAcknowledgement_SUCCESS
 				if s.downlink != nil {
 					overdue := time.Now().Sub(item.deadlineAt)
-					if overdue < Deadline {
+					if overdue < s.Deadline() {
 						ctx.WithField("Overdue", overdue).Debug("Send Downlink")
 						s.downlink <- item.payload
 					} else {
 						ctx.WithField("Overdue", overdue).Warn("Discard Late Downlink")
+						result = pb_gateway.TxAcknowledgement_TOO_LATE
 					}
 				} else {
 					ctx.Warn("Unable to send Downlink")
+					result = pb_gateway.TxAcknowledgement_UNKNOWN_ERROR
+				}
+				s.RUnlock()
+				if result != pb_gateway.TxAcknowledgement_SUCCESS {
+					s.drop(item.id, item.payload, result)
 				}
 			}()
 		}
@@ -226,6 +361,40 @@ func (s *schedule) Schedule(id string, downlink *router_pb.DownlinkMessage) erro
 	return errors.NewErrNotFound(id)
 }
 
+// drop releases the time on air of a scheduled downlink message that is not sent to the gateway, and reports it. The
+// schedule must not be locked by the caller, as the gateway may forward the report to the broker.
+func (s *schedule) drop(id string, downlink *router_pb.DownlinkMessage, result pb_gateway.TxAcknowledgement_Result) {
+	if s.gateway != nil {
+		s.gateway.releaseDutyCycle(id)
+		s.gateway.drop(downlink, result)
+	}
+}
+
+// DownlinkLength returns the maximum time on air of a downlink message in microseconds, or 0 if it can not be computed
+func DownlinkLength(downlink *router_pb.DownlinkMessage) uint32 {
+	lorawan := downlink.GetProtocolConfiguration().GetLorawan()
+	if lorawan == nil {
+		return 0
+	}
+	var time time.Duration
+	if lorawan.Modulation == pb_lorawan.Modulation_LORA {
+		// Calculate max ToA
+		time, _ = toa.ComputeLoRa(
+			uint(len(downlink.Payload)),
+			lorawan.DataRate,
+			lorawan.CodingRate,
+		)
+	}
+	if lorawan.Modulation == pb_lorawan.Modulation_FSK {
+		// Calculate max ToA
+		time, _ = toa.ComputeFSK(
+			uint(len(downlink.Payload)),
+			int(lorawan.BitRate),
+		)
+	}
+	return uint32(time / 1000)
+}
+
 func (s *schedule) Stop(subscriptionID string) {
 	s.downlinkSubscriptionsLock.Lock()
 	defer s.downlinkSubscriptionsLock.Unlock()
@@ -250,6 +419,7 @@ func (s *schedule) Subscribe(subscriptionID string) <-chan *router_pb.DownlinkMe
 				if s.gateway != nil && s.gateway.Utilization != nil {
 					s.gateway.Utilization.AddTx(downlink) // FIXME: Issue #420
 				}
+				// The time on air was already reserved in the duty cycle when the downlink was scheduled
 				s.downlinkSubscriptionsLock.RLock()
 				for _, ch := range s.downlinkSubscriptions {
 					select {
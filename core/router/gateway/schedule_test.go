@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	pb "github.com/TheThingsNetwork/ttn/api/gateway"
 	router_pb "github.com/TheThingsNetwork/ttn/api/router"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	. "github.com/smartystreets/assertions"
@@ -101,6 +102,90 @@ func TestScheduleGetOption(t *testing.T) {
 	a.So(conflicts, ShouldEqual, 1)
 }
 
+func TestScheduleGetImmediateOption(t *testing.T) {
+	a := New(t)
+	s := NewSchedule(GetLogger(t, "TestScheduleGetImmediateOption")).(*schedule)
+
+	// Not synchronized
+	_, _, err := s.GetImmediateOption(100)
+	a.So(err, ShouldNotBeNil)
+
+	s.Sync(1000)
+	expected := 1000 + uint32((Deadline+ImmediateDelay)/time.Microsecond)
+	id, timestamp, err := s.GetImmediateOption(100)
+	a.So(err, ShouldBeNil)
+	a.So(timestamp, ShouldAlmostEqual, expected, 1000)
+
+	// Options without downlink are no reason to move the slot
+	_, other, err := s.GetImmediateOption(100)
+	a.So(err, ShouldBeNil)
+	a.So(other, ShouldAlmostEqual, expected, 1000)
+
+	// A scheduled downlink is avoided
+	err = s.Schedule(id, &router_pb.DownlinkMessage{})
+	a.So(err, ShouldBeNil)
+	_, other, err = s.GetImmediateOption(100)
+	a.So(err, ShouldBeNil)
+	a.So(other, ShouldBeGreaterThanOrEqualTo, timestamp+100)
+}
+
+func TestScheduleGetTimeOption(t *testing.T) {
+	a := New(t)
+	s := NewSchedule(GetLogger(t, "TestScheduleGetTimeOption")).(*schedule)
+
+	at := time.Now().Add(Deadline + time.Second)
+
+	// Not synchronized
+	_, _, err := s.GetTimeOption(at, 100)
+	a.So(err, ShouldNotBeNil)
+
+	s.Sync(1000)
+	id, timestamp, err := s.GetTimeOption(at, 100)
+	a.So(err, ShouldBeNil)
+	a.So(s.realtime(timestamp).UnixNano(), ShouldAlmostEqual, at.UnixNano(), time.Microsecond)
+
+	// The slot is taken
+	err = s.Schedule(id, &router_pb.DownlinkMessage{})
+	a.So(err, ShouldBeNil)
+	_, _, err = s.GetTimeOption(at, 100)
+	a.So(err, ShouldNotBeNil)
+
+	// Too late
+	_, _, err = s.GetTimeOption(time.Now(), 100)
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestScheduleDeadline(t *testing.T) {
+	a := New(t)
+	s := NewSchedule(GetLogger(t, "TestScheduleDeadline")).(*schedule)
+
+	// Not synchronized
+	a.So(s.DeadlineAt(1000000).IsZero(), ShouldBeTrue)
+
+	// Unknown latency
+	a.So(s.Deadline(), ShouldEqual, Deadline)
+	s.Sync(0)
+	a.So(s.DeadlineAt(1000000), ShouldHappenWithin, 10*time.Millisecond, time.Now().Add(time.Second-Deadline))
+
+	// Low latency
+	for i := 0; i < 10; i++ {
+		s.AddRTT(10 * time.Millisecond)
+	}
+	a.So(s.Deadline(), ShouldEqual, MinDeadline)
+
+	// Increasing latency
+	s.AddRTT(500 * time.Millisecond)
+	a.So(s.Deadline(), ShouldBeGreaterThan, MinDeadline)
+	a.So(s.Deadline(), ShouldBeLessThanOrEqualTo, MaxDeadline)
+	a.So(s.DeadlineAt(1000000), ShouldHappenWithin, 10*time.Millisecond, time.Now().Add(time.Second-s.Deadline()))
+
+	// High latency
+	for i := 0; i < 10; i++ {
+		s.AddRTT(2 * time.Second)
+	}
+	a.So(s.Deadline(), ShouldEqual, MaxDeadline)
+}
+
 func TestScheduleSchedule(t *testing.T) {
 	a := New(t)
 	s := NewSchedule(GetLogger(t, "TestScheduleSchedule")).(*schedule)
@@ -116,6 +201,14 @@ func TestScheduleSchedule(t *testing.T) {
 
 	_, conflicts = s.GetOption(50, 100)
 	a.So(conflicts, ShouldEqual, 100)
+
+	// A downlink that is moved to a scheduled downlink is rejected
+	id, conflicts = s.GetOption(1000, 100)
+	a.So(conflicts, ShouldEqual, 0)
+	err = s.Schedule(id, &router_pb.DownlinkMessage{GatewayConfiguration: &pb.TxConfiguration{Timestamp: 150}})
+	a.So(err, ShouldNotBeNil)
+	err = s.Schedule(id, &router_pb.DownlinkMessage{GatewayConfiguration: &pb.TxConfiguration{Timestamp: 2000}})
+	a.So(err, ShouldBeNil)
 }
 
 func TestScheduleSubscribe(t *testing.T) {
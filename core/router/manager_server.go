@@ -5,8 +5,10 @@ package router
 
 import (
 	"fmt"
+	"time"
 
 	pb "github.com/TheThingsNetwork/ttn/api/router"
+	"github.com/TheThingsNetwork/ttn/core/band"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
 	"google.golang.org/grpc"
@@ -34,10 +36,22 @@ func (r *routerManager) GatewayStatus(ctx context.Context, in *pb.GatewayStatusR
 	if err != nil {
 		return nil, err
 	}
-	return &pb.GatewayStatusResponse{
+	res := &pb.GatewayStatusResponse{
 		LastSeen: gtw.LastSeen.UnixNano(),
 		Status:   status,
-	}, nil
+	}
+	if fp, err := band.Get(status.FrequencyPlan); err == nil {
+		for _, subBand := range fp.SubBands {
+			res.SubBands = append(res.SubBands, &pb.GatewayStatusResponse_SubBand{
+				MinFrequency: subBand.MinFrequency,
+				MaxFrequency: subBand.MaxFrequency,
+				DutyCycle:    float32(subBand.DutyCycle),
+				TimeOnAir:    uint32(gtw.DutyCycle.Get(subBand) / time.Millisecond),
+				Remaining:    uint32(gtw.DutyCycle.Remaining(subBand) / time.Millisecond),
+			})
+		}
+	}
+	return res, nil
 }
 
 func (r *routerManager) GetStatus(ctx context.Context, in *pb.StatusRequest) (*pb.Status, error) {
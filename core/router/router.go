@@ -4,6 +4,7 @@
 package router
 
 import (
+	"strings"
 	"sync"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	pb "github.com/TheThingsNetwork/ttn/api/router"
 	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/router/gateway"
+	"github.com/TheThingsNetwork/ttn/core/types"
 )
 
 // Router component
@@ -34,6 +36,15 @@ type Router interface {
 	UnsubscribeDownlink(gatewayID string, subscriptionID string) error
 	// Handle a device activation
 	HandleActivation(gatewayID string, activation *pb.DeviceActivationRequest) (*pb.DeviceActivationResponse, error)
+	// Handle the acknowledgement of a downlink message from a gateway
+	HandleTxAck(gatewayID string, ack *pb_gateway.TxAcknowledgement) error
+
+	// Listen for gateways that use the Semtech UDP protocol. If gateways (EUI to gateway ID) is not empty, only those
+	// gateways are accepted, and they are trusted. An empty gateway ID defaults to "eui-" followed by the EUI.
+	WithUDP(address string, gateways map[types.EUI64]string) Router
+	// Listen for gateways that use the LNS protocol of the LoRa Basics Station. The frequency plan is used for
+	// gateways that did not report their frequency plan.
+	WithBasicStation(address string, frequencyPlan string) Router
 
 	getGateway(gatewayID string) *gateway.Gateway
 }
@@ -51,6 +62,7 @@ func NewRouter() Router {
 	return &router{
 		gateways: make(map[string]*gateway.Gateway),
 		brokers:  make(map[string]*broker),
+		txAcks:   make(map[string]*pendingTxAck),
 	}
 }
 
@@ -61,6 +73,16 @@ type router struct {
 	brokers      map[string]*broker
 	brokersLock  sync.RWMutex
 	status       *status
+	txAcks       map[string]*pendingTxAck
+	txAcksLock   sync.Mutex
+
+	udpAddress  string
+	udpGateways map[types.EUI64]string
+	udp         *udpServer
+
+	stationAddress       string
+	stationFrequencyPlan string
+	station              *stationServer
 }
 
 func (r *router) tickGateways() {
@@ -87,13 +109,32 @@ func (r *router) Init(c *component.Component) error {
 	go func() {
 		for range time.Tick(5 * time.Second) {
 			r.tickGateways()
+			r.expireTxAcks()
 		}
 	}()
+	if r.udpAddress != "" {
+		err = r.listenUDP()
+		if err != nil {
+			return err
+		}
+	}
+	if r.stationAddress != "" {
+		err = r.listenBasicStation()
+		if err != nil {
+			return err
+		}
+	}
 	r.Component.SetStatus(component.StatusHealthy)
 	return nil
 }
 
 func (r *router) Shutdown() {
+	if r.udp != nil {
+		r.udp.Close()
+	}
+	if r.station != nil {
+		r.station.Close()
+	}
 	r.brokersLock.Lock()
 	defer r.brokersLock.Unlock()
 	for _, broker := range r.brokers {
@@ -102,6 +143,11 @@ func (r *router) Shutdown() {
 	}
 }
 
+// GatewayIDForEUI returns the gateway ID of a gateway that only identifies itself with its EUI
+func GatewayIDForEUI(eui types.EUI64) string {
+	return "eui-" + strings.ToLower(eui.String())
+}
+
 // getGateway gets or creates a Gateway
 func (r *router) getGateway(id string) *gateway.Gateway {
 	// We're going to be optimistic and guess that the gateway is already active
@@ -119,6 +165,9 @@ func (r *router) getGateway(id string) *gateway.Gateway {
 	if !ok {
 		gtw = gateway.NewGateway(r.Ctx, id)
 		gtw.Monitor = r.Component.Monitor
+		gtw.Dropped = func(downlink *pb.DownlinkMessage, result pb_gateway.TxAcknowledgement_Result) {
+			r.dropPendingTxAck(id, downlink.Id, result)
+		}
 
 		r.gateways[id] = gtw
 	}
@@ -170,7 +219,7 @@ func (r *router) getBroker(brokerAnnouncement *pb_discovery.Announcement) (*brok
 					brk.association.Uplink(message)
 				case message, ok := <-brk.association.Downlink():
 					if ok {
-						go r.HandleDownlink(message)
+						go r.handleDownlink(message, brk)
 					}
 				}
 			}
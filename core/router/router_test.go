@@ -3,8 +3,18 @@
 
 package router
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/smartystreets/assertions"
+)
 
 func TestRouterIntegration(t *testing.T) {
 
 }
+
+func TestGatewayIDForEUI(t *testing.T) {
+	a := New(t)
+	a.So(GatewayIDForEUI(types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xAB}), ShouldEqual, "eui-01020304050607ab")
+}
@@ -0,0 +1,145 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package semtech
+
+import (
+	"encoding/base64"
+	"math"
+	"time"
+
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
+	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	pb_router "github.com/TheThingsNetwork/ttn/api/router"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/gpstime"
+)
+
+// Modulations in rxpk and txpk
+const (
+	ModulationLoRa = "LORA"
+	ModulationFSK  = "FSK"
+)
+
+// ToUplink converts the received packet to an uplink message
+func (rxpk *RXPK) ToUplink() (*pb_router.UplinkMessage, error) {
+	payload, err := base64.StdEncoding.DecodeString(rxpk.Data)
+	if err != nil {
+		return nil, errors.NewErrInvalidArgument("rxpk data", err.Error())
+	}
+
+	lorawan := new(pb_lorawan.Metadata)
+	switch rxpk.Modu {
+	case ModulationLoRa:
+		lorawan.Modulation = pb_lorawan.Modulation_LORA
+		lorawan.DataRate = rxpk.DatR.LoRa
+		lorawan.CodingRate = rxpk.CodR
+	case ModulationFSK:
+		lorawan.Modulation = pb_lorawan.Modulation_FSK
+		lorawan.BitRate = rxpk.DatR.FSK
+	default:
+		return nil, errors.NewErrInvalidArgument("rxpk modulation", rxpk.Modu)
+	}
+
+	gateway := &pb_gateway.RxMetadata{
+		Timestamp: rxpk.Tmst,
+		RfChain:   rxpk.RFCh,
+		Channel:   rxpk.Chan,
+		Frequency: uint64(math.Floor(rxpk.Freq*1000000 + 0.5)),
+		Rssi:      float32(rxpk.RSSI),
+		Snr:       rxpk.LSNR,
+	}
+	if rxpk.Tmms != nil {
+		gateway.Time = gpstime.FromGPS(time.Duration(*rxpk.Tmms) * time.Millisecond).UnixNano()
+	} else if t := time.Time(rxpk.Time); !t.IsZero() {
+		gateway.Time = t.UnixNano()
+	}
+
+	return &pb_router.UplinkMessage{
+		Payload:          payload,
+		ProtocolMetadata: &pb_protocol.RxMetadata{Protocol: &pb_protocol.RxMetadata_Lorawan{Lorawan: lorawan}},
+		GatewayMetadata:  gateway,
+	}, nil
+}
+
+// ToStatus converts the stat to a gateway status message
+func (stat *Stat) ToStatus() *pb_gateway.Status {
+	status := &pb_gateway.Status{
+		RxIn:         stat.RXNb,
+		RxOk:         stat.RXOK,
+		TxIn:         stat.DWNb,
+		TxOk:         stat.TXNb,
+		Platform:     stat.Pfrm,
+		ContactEmail: stat.Mail,
+		Description:  stat.Desc,
+	}
+	if t := time.Time(stat.Time); !t.IsZero() {
+		status.Time = t.UnixNano()
+	}
+	if stat.Lati != nil && stat.Long != nil {
+		status.Gps = &pb_gateway.GPSMetadata{
+			Latitude:  float32(*stat.Lati),
+			Longitude: float32(*stat.Long),
+		}
+		if stat.Alti != nil {
+			status.Gps.Altitude = *stat.Alti
+		}
+	}
+	return status
+}
+
+// FromDownlink converts the downlink message to a packet that the gateway should transmit
+func FromDownlink(downlink *pb_router.DownlinkMessage) (*TXPK, error) {
+	gateway := downlink.GetGatewayConfiguration()
+	lorawan := downlink.GetProtocolConfiguration().GetLorawan()
+	if gateway == nil || lorawan == nil {
+		return nil, errors.NewErrInvalidArgument("Downlink", "does not contain a LoRaWAN gateway configuration")
+	}
+
+	txpk := &TXPK{
+		Freq: float64(gateway.Frequency) / 1000000,
+		RFCh: gateway.RfChain,
+		Powe: gateway.Power,
+		IPol: gateway.PolarizationInversion,
+		Size: uint32(len(downlink.Payload)),
+		Data: base64.StdEncoding.EncodeToString(downlink.Payload),
+	}
+	if gateway.GpsTime != 0 {
+		tmms := gateway.GpsTime / 1000
+		txpk.Tmms = &tmms
+	} else {
+		tmst := gateway.Timestamp
+		txpk.Tmst = &tmst
+	}
+
+	switch lorawan.Modulation {
+	case pb_lorawan.Modulation_LORA:
+		txpk.Modu = ModulationLoRa
+		txpk.DatR.LoRa = lorawan.DataRate
+		txpk.CodR = lorawan.CodingRate
+	case pb_lorawan.Modulation_FSK:
+		txpk.Modu = ModulationFSK
+		txpk.DatR.FSK = lorawan.BitRate
+		txpk.FDev = gateway.FrequencyDeviation
+	}
+
+	return txpk, nil
+}
+
+// ToTxAcknowledgement converts the txpk_ack of the downlink message with the given ID to a TX acknowledgement
+func (ack *TXPKAck) ToTxAcknowledgement(downlinkID string) *pb_gateway.TxAcknowledgement {
+	txAck := &pb_gateway.TxAcknowledgement{DownlinkId: downlinkID}
+	switch ack.Error {
+	case "", "NONE":
+		txAck.Result = pb_gateway.TxAcknowledgement_SUCCESS
+	default:
+		// The errors of the packet forwarder have the same names as the results
+		if result, ok := pb_gateway.TxAcknowledgement_Result_value[ack.Error]; ok {
+			txAck.Result = pb_gateway.TxAcknowledgement_Result(result)
+		} else {
+			txAck.Result = pb_gateway.TxAcknowledgement_UNKNOWN_ERROR
+		}
+	}
+	return txAck
+}
@@ -0,0 +1,140 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package semtech
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
+	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	pb_router "github.com/TheThingsNetwork/ttn/api/router"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestToUplink(t *testing.T) {
+	a := New(t)
+
+	var payload PushDataPayload
+	err := json.Unmarshal([]byte(`{"rxpk":[
+		{"time":"2017-06-07T08:09:10.123456Z","tmst":3512348611,"chan":2,"rfch":0,"freq":868.500000,"stat":1,"modu":"LORA","datr":"SF7BW125","codr":"4/6","rssi":-35,"lsnr":5.1,"size":3,"data":"AQID"},
+		{"tmst":3512348612,"chan":9,"rfch":1,"freq":868.800000,"stat":1,"modu":"FSK","datr":50000,"rssi":-75,"size":3,"data":"AQID"}
+	]}`), &payload)
+	a.So(err, ShouldBeNil)
+	a.So(payload.RXPK, ShouldHaveLength, 2)
+	a.So(payload.Stat, ShouldBeNil)
+
+	uplink, err := payload.RXPK[0].ToUplink()
+	a.So(err, ShouldBeNil)
+	a.So(uplink.Payload, ShouldResemble, []byte{1, 2, 3})
+	a.So(uplink.ProtocolMetadata.GetLorawan(), ShouldResemble, &pb_lorawan.Metadata{
+		Modulation: pb_lorawan.Modulation_LORA,
+		DataRate:   "SF7BW125",
+		CodingRate: "4/6",
+	})
+	a.So(uplink.GatewayMetadata, ShouldResemble, &pb_gateway.RxMetadata{
+		Timestamp: 3512348611,
+		Time:      time.Date(2017, 6, 7, 8, 9, 10, 123456000, time.UTC).UnixNano(),
+		Channel:   2,
+		Frequency: 868500000,
+		Rssi:      -35,
+		Snr:       5.1,
+	})
+
+	uplink, err = payload.RXPK[1].ToUplink()
+	a.So(err, ShouldBeNil)
+	a.So(uplink.ProtocolMetadata.GetLorawan().Modulation, ShouldEqual, pb_lorawan.Modulation_FSK)
+	a.So(uplink.ProtocolMetadata.GetLorawan().BitRate, ShouldEqual, 50000)
+	a.So(uplink.GatewayMetadata.Frequency, ShouldEqual, 868800000)
+	a.So(uplink.GatewayMetadata.RfChain, ShouldEqual, 1)
+
+	_, err = (&RXPK{Modu: "LORA", Data: "not base64"}).ToUplink()
+	a.So(err, ShouldNotBeNil)
+	_, err = (&RXPK{Modu: "OOK", Data: "AQID"}).ToUplink()
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestToStatus(t *testing.T) {
+	a := New(t)
+
+	var payload PushDataPayload
+	err := json.Unmarshal([]byte(`{"stat":{"time":"2017-06-07 08:09:10 GMT","lati":52.37403,"long":4.88968,"alti":3,"rxnb":10,"rxok":8,"rxfw":8,"ackr":100.0,"dwnb":2,"txnb":1,"pfrm":"Kerlink","desc":"Test Gateway"}}`), &payload)
+	a.So(err, ShouldBeNil)
+	a.So(payload.Stat, ShouldNotBeNil)
+
+	status := payload.Stat.ToStatus()
+	a.So(status.Time, ShouldEqual, time.Date(2017, 6, 7, 8, 9, 10, 0, time.UTC).UnixNano())
+	a.So(status.RxIn, ShouldEqual, 10)
+	a.So(status.RxOk, ShouldEqual, 8)
+	a.So(status.TxIn, ShouldEqual, 2)
+	a.So(status.TxOk, ShouldEqual, 1)
+	a.So(status.Platform, ShouldEqual, "Kerlink")
+	a.So(status.Description, ShouldEqual, "Test Gateway")
+	a.So(status.Gps, ShouldResemble, &pb_gateway.GPSMetadata{Latitude: 52.37403, Longitude: 4.88968, Altitude: 3})
+
+	// Without location
+	a.So((&Stat{}).ToStatus().Gps, ShouldBeNil)
+}
+
+func TestFromDownlink(t *testing.T) {
+	a := New(t)
+
+	downlink := &pb_router.DownlinkMessage{
+		Payload: []byte{1, 2, 3},
+		ProtocolConfiguration: &pb_protocol.TxConfiguration{Protocol: &pb_protocol.TxConfiguration_Lorawan{Lorawan: &pb_lorawan.TxConfiguration{
+			Modulation: pb_lorawan.Modulation_LORA,
+			DataRate:   "SF9BW125",
+			CodingRate: "4/5",
+		}}},
+		GatewayConfiguration: &pb_gateway.TxConfiguration{
+			Timestamp:             1000000,
+			Frequency:             869525000,
+			Power:                 27,
+			PolarizationInversion: true,
+		},
+	}
+	txpk, err := FromDownlink(downlink)
+	a.So(err, ShouldBeNil)
+	data, err := json.Marshal(PullRespPayload{TXPK: *txpk})
+	a.So(err, ShouldBeNil)
+	a.So(string(data), ShouldEqual, `{"txpk":{"tmst":1000000,"freq":869.525,"rfch":0,"powe":27,"modu":"LORA","datr":"SF9BW125","codr":"4/5","ipol":true,"size":3,"data":"AQID"}}`)
+
+	// Class B
+	downlink.GatewayConfiguration.GpsTime = 1000000000123000
+	txpk, _ = FromDownlink(downlink)
+	a.So(txpk.Tmst, ShouldBeNil)
+	a.So(*txpk.Tmms, ShouldEqual, 1000000000123)
+
+	// FSK
+	downlink.ProtocolConfiguration.GetLorawan().Modulation = pb_lorawan.Modulation_FSK
+	downlink.ProtocolConfiguration.GetLorawan().BitRate = 50000
+	downlink.GatewayConfiguration.FrequencyDeviation = 25000
+	txpk, _ = FromDownlink(downlink)
+	data, _ = json.Marshal(txpk.DatR)
+	a.So(string(data), ShouldEqual, "50000")
+	a.So(txpk.FDev, ShouldEqual, 25000)
+	a.So(txpk.CodR, ShouldBeEmpty)
+
+	_, err = FromDownlink(&pb_router.DownlinkMessage{})
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestToTxAcknowledgement(t *testing.T) {
+	a := New(t)
+
+	for txErr, result := range map[string]pb_gateway.TxAcknowledgement_Result{
+		"":                 pb_gateway.TxAcknowledgement_SUCCESS,
+		"NONE":             pb_gateway.TxAcknowledgement_SUCCESS,
+		"TOO_LATE":         pb_gateway.TxAcknowledgement_TOO_LATE,
+		"COLLISION_PACKET": pb_gateway.TxAcknowledgement_COLLISION_PACKET,
+		"GPS_UNLOCKED":     pb_gateway.TxAcknowledgement_GPS_UNLOCKED,
+		"SOMETHING_ELSE":   pb_gateway.TxAcknowledgement_UNKNOWN_ERROR,
+	} {
+		ack := (&TXPKAck{Error: txErr}).ToTxAcknowledgement("downlink-id")
+		a.So(ack.DownlinkId, ShouldEqual, "downlink-id")
+		a.So(ack.Result, ShouldEqual, result)
+	}
+}
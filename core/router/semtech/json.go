@@ -0,0 +1,155 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package semtech
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PushDataPayload is the payload of a PUSH_DATA packet
+type PushDataPayload struct {
+	RXPK []RXPK `json:"rxpk,omitempty"`
+	Stat *Stat  `json:"stat,omitempty"`
+}
+
+// PullRespPayload is the payload of a PULL_RESP packet
+type PullRespPayload struct {
+	TXPK TXPK `json:"txpk"`
+}
+
+// TxAckPayload is the payload of a TX_ACK packet
+type TxAckPayload struct {
+	TXPKAck TXPKAck `json:"txpk_ack"`
+}
+
+// RXPK contains a packet that was received by the gateway
+type RXPK struct {
+	Time ExpandedTime `json:"time"` // UTC time of reception
+	Tmms *uint64      `json:"tmms"` // GPS time of reception in milliseconds since the GPS epoch
+	Tmst uint32       `json:"tmst"` // Internal timestamp of reception in microseconds
+	Freq float64      `json:"freq"` // Frequency in MHz
+	Chan uint32       `json:"chan"` // Concentrator IF channel
+	RFCh uint32       `json:"rfch"` // Concentrator RF chain
+	Stat int32        `json:"stat"` // CRC status: 1 = OK, -1 = fail, 0 = no CRC
+	Modu string       `json:"modu"` // Modulation: LORA or FSK
+	DatR DataRate     `json:"datr"` // LoRa data rate or FSK bit rate
+	CodR string       `json:"codr"` // LoRa coding rate
+	RSSI int32        `json:"rssi"` // RSSI in dBm
+	LSNR float32      `json:"lsnr"` // LoRa SNR in dB
+	Size uint32       `json:"size"` // Payload size in bytes
+	Data string       `json:"data"` // Base64 encoded payload
+}
+
+// Stat contains the status of the gateway
+type Stat struct {
+	Time CompactTime `json:"time"` // UTC system time of the gateway
+	Lati *float64    `json:"lati"` // GPS latitude in degrees
+	Long *float64    `json:"long"` // GPS longitude in degrees
+	Alti *int32      `json:"alti"` // GPS altitude in meters
+	RXNb uint32      `json:"rxnb"` // Number of received packets
+	RXOK uint32      `json:"rxok"` // Number of received packets with a valid CRC
+	RXFW uint32      `json:"rxfw"` // Number of forwarded packets
+	ACKR float64     `json:"ackr"` // Percentage of acknowledged upstream datagrams
+	DWNb uint32      `json:"dwnb"` // Number of received downlink packets
+	TXNb uint32      `json:"txnb"` // Number of emitted packets
+	Pfrm string      `json:"pfrm"` // Platform (TTN extension)
+	Mail string      `json:"mail"` // Contact email (TTN extension)
+	Desc string      `json:"desc"` // Description (TTN extension)
+}
+
+// TXPK contains a packet that the gateway should transmit
+type TXPK struct {
+	Imme bool     `json:"imme,omitempty"` // Send immediately
+	Tmst *uint32  `json:"tmst,omitempty"` // Send at this internal timestamp in microseconds
+	Tmms *uint64  `json:"tmms,omitempty"` // Send at this GPS time in milliseconds since the GPS epoch
+	Freq float64  `json:"freq"`           // Frequency in MHz
+	RFCh uint32   `json:"rfch"`           // Concentrator RF chain
+	Powe int32    `json:"powe"`           // Output power in dBm
+	Modu string   `json:"modu"`           // Modulation: LORA or FSK
+	DatR DataRate `json:"datr"`           // LoRa data rate or FSK bit rate
+	CodR string   `json:"codr,omitempty"` // LoRa coding rate
+	FDev uint32   `json:"fdev,omitempty"` // FSK frequency deviation in Hz
+	IPol bool     `json:"ipol"`           // LoRa polarization inversion
+	Prea uint32   `json:"prea,omitempty"` // Preamble size
+	Size uint32   `json:"size"`           // Payload size in bytes
+	Data string   `json:"data"`           // Base64 encoded payload
+	NCRC bool     `json:"ncrc,omitempty"` // Disable the CRC
+}
+
+// TXPKAck contains the result of a transmission request
+type TXPKAck struct {
+	Error string `json:"error,omitempty"` // NONE if the packet was accepted for transmission
+}
+
+// DataRate is a LoRa data rate (SF{spreadingfactor}BW{bandwidth}) or an FSK bit rate
+type DataRate struct {
+	LoRa string
+	FSK  uint32
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (d DataRate) MarshalJSON() ([]byte, error) {
+	if d.LoRa != "" {
+		return json.Marshal(d.LoRa)
+	}
+	return json.Marshal(d.FSK)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (d *DataRate) UnmarshalJSON(data []byte) error {
+	if strings.HasPrefix(string(data), `"`) {
+		return json.Unmarshal(data, &d.LoRa)
+	}
+	return json.Unmarshal(data, &d.FSK)
+}
+
+// ExpandedTime is a time in the ISO 8601 "expanded" format, used in rxpk
+type ExpandedTime time.Time
+
+// MarshalJSON implements the json.Marshaler interface
+func (t ExpandedTime) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(time.Time(t).UTC().Format(time.RFC3339Nano))), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (t *ExpandedTime) UnmarshalJSON(data []byte) error {
+	str, err := strconv.Unquote(string(data))
+	if err != nil {
+		return err
+	}
+	parsed, err := time.Parse(time.RFC3339Nano, str)
+	if err != nil {
+		return err
+	}
+	*t = ExpandedTime(parsed)
+	return nil
+}
+
+// compactTimeFormat is the format of the time in stat
+const compactTimeFormat = "2006-01-02 15:04:05 MST"
+
+// CompactTime is a time in the "compact" format, used in stat
+type CompactTime time.Time
+
+// MarshalJSON implements the json.Marshaler interface
+func (t CompactTime) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(time.Time(t).UTC().Format(compactTimeFormat))), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (t *CompactTime) UnmarshalJSON(data []byte) error {
+	str, err := strconv.Unquote(string(data))
+	if err != nil {
+		return err
+	}
+	parsed, err := time.Parse(compactTimeFormat, str)
+	if err != nil {
+		return err
+	}
+	*t = CompactTime(parsed)
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Package semtech implements the UDP protocol of the Semtech packet forwarder
+package semtech
+
+import (
+	"fmt"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// Versions of the protocol
+const (
+	Version1 uint8 = 1
+	Version2 uint8 = 2
+)
+
+// Identifiers of the packets
+const (
+	PushData uint8 = 0x00
+	PushAck  uint8 = 0x01
+	PullData uint8 = 0x02
+	PullResp uint8 = 0x03
+	PullAck  uint8 = 0x04
+	TxAck    uint8 = 0x05
+)
+
+// Packet is a packet of the Semtech UDP protocol
+type Packet struct {
+	Version    uint8
+	Token      [2]byte
+	Identifier uint8
+	// GatewayEUI is only present in PUSH_DATA, PULL_DATA and TX_ACK packets
+	GatewayEUI types.EUI64
+	// Payload is the JSON payload of PUSH_DATA, PULL_RESP and TX_ACK packets
+	Payload []byte
+}
+
+// hasGatewayEUI returns true if packets with the given identifier contain the EUI of the gateway
+func hasGatewayEUI(identifier uint8) bool {
+	return identifier == PushData || identifier == PullData || identifier == TxAck
+}
+
+// Ack returns the acknowledgement for a PUSH_DATA or PULL_DATA packet
+func (p *Packet) Ack() *Packet {
+	ack := &Packet{Version: p.Version, Token: p.Token}
+	switch p.Identifier {
+	case PushData:
+		ack.Identifier = PushAck
+	case PullData:
+		ack.Identifier = PullAck
+	default:
+		return nil
+	}
+	return ack
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface
+func (p Packet) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 4, 12+len(p.Payload))
+	data[0] = p.Version
+	copy(data[1:3], p.Token[:])
+	data[3] = p.Identifier
+	if hasGatewayEUI(p.Identifier) {
+		data = append(data, p.GatewayEUI.Bytes()...)
+	}
+	return append(data, p.Payload...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface
+func (p *Packet) UnmarshalBinary(data []byte) error {
+	if len(data) < 4 {
+		return errors.NewErrInvalidArgument("Packet", "too short")
+	}
+	if data[0] != Version1 && data[0] != Version2 {
+		return errors.NewErrInvalidArgument("Packet", fmt.Sprintf("unknown protocol version %d", data[0]))
+	}
+	if data[3] > TxAck {
+		return errors.NewErrInvalidArgument("Packet", fmt.Sprintf("unknown identifier %d", data[3]))
+	}
+	p.Version = data[0]
+	copy(p.Token[:], data[1:3])
+	p.Identifier = data[3]
+	data = data[4:]
+	if hasGatewayEUI(p.Identifier) {
+		if len(data) < 8 {
+			return errors.NewErrInvalidArgument("Packet", "does not contain a gateway EUI")
+		}
+		copy(p.GatewayEUI[:], data[:8])
+		data = data[8:]
+	}
+	p.Payload = nil
+	if len(data) > 0 {
+		p.Payload = append([]byte{}, data...)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package semtech
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestPacket(t *testing.T) {
+	a := New(t)
+
+	pushData := []byte{2, 0xab, 0xcd, PushData, 1, 2, 3, 4, 5, 6, 7, 8, '{', '}'}
+	var packet Packet
+	a.So(packet.UnmarshalBinary(pushData), ShouldBeNil)
+	a.So(packet.Version, ShouldEqual, Version2)
+	a.So(packet.Token, ShouldResemble, [2]byte{0xab, 0xcd})
+	a.So(packet.Identifier, ShouldEqual, PushData)
+	a.So(packet.GatewayEUI, ShouldEqual, types.EUI64{1, 2, 3, 4, 5, 6, 7, 8})
+	a.So(packet.Payload, ShouldResemble, []byte("{}"))
+
+	data, err := packet.MarshalBinary()
+	a.So(err, ShouldBeNil)
+	a.So(data, ShouldResemble, pushData)
+
+	ack, _ := packet.Ack().MarshalBinary()
+	a.So(ack, ShouldResemble, []byte{2, 0xab, 0xcd, PushAck})
+
+	pullData := []byte{1, 0x12, 0x34, PullData, 1, 2, 3, 4, 5, 6, 7, 8}
+	a.So(packet.UnmarshalBinary(pullData), ShouldBeNil)
+	a.So(packet.Payload, ShouldBeNil)
+	ack, _ = packet.Ack().MarshalBinary()
+	a.So(ack, ShouldResemble, []byte{1, 0x12, 0x34, PullAck})
+
+	pullResp, _ := Packet{Version: Version2, Token: [2]byte{1, 2}, Identifier: PullResp, Payload: []byte("{}")}.MarshalBinary()
+	a.So(pullResp, ShouldResemble, []byte{2, 1, 2, PullResp, '{', '}'})
+
+	a.So(packet.UnmarshalBinary([]byte{2, 0, 0}), ShouldNotBeNil)                    // Too short
+	a.So(packet.UnmarshalBinary([]byte{3, 0, 0, PushAck}), ShouldNotBeNil)           // Unknown version
+	a.So(packet.UnmarshalBinary([]byte{2, 0, 0, 6}), ShouldNotBeNil)                 // Unknown identifier
+	a.So(packet.UnmarshalBinary([]byte{2, 0, 0, PullData, 1, 2, 3}), ShouldNotBeNil) // No EUI
+}
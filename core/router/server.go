@@ -15,6 +15,7 @@ import (
 	"github.com/TheThingsNetwork/ttn/core/router/gateway"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"github.com/TheThingsNetwork/ttn/utils/random"
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/spf13/viper"
 	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
 	"google.golang.org/grpc"
@@ -141,6 +142,21 @@ func (r *routerRPC) Activate(ctx context.Context, req *pb.DeviceActivationReques
 	return r.router.HandleActivation(gateway.ID, req)
 }
 
+// TxAck implements RouterServer interface (github.com/TheThingsNetwork/ttn/api/router)
+func (r *routerRPC) TxAck(ctx context.Context, ack *pb_gateway.TxAcknowledgement) (*empty.Empty, error) {
+	gateway, err := r.gatewayFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := ack.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid TX Acknowledgement")
+	}
+	if err := r.router.HandleTxAck(gateway.ID, ack); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
 // RegisterRPC registers this router as a RouterServer (github.com/TheThingsNetwork/ttn/api/router)
 func (r *router) RegisterRPC(s *grpc.Server) {
 	server := &routerRPC{router: r}
@@ -0,0 +1,105 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package router
+
+import (
+	"fmt"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
+	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// txAckTimeout is the time after which the router stops waiting for the acknowledgement of a downlink message. Class B
+// downlink can be scheduled more than two minutes in advance.
+const txAckTimeout = 5 * time.Minute
+
+// pendingTxAck is a downlink message that is waiting for the acknowledgement of the gateway
+type pendingTxAck struct {
+	broker  *broker
+	ack     *pb_broker.TxAcknowledgement
+	expires time.Time
+}
+
+// addPendingTxAck remembers the broker and device of a downlink message, so that the acknowledgement of the gateway
+// can be forwarded to the broker
+func (r *router) addPendingTxAck(brk *broker, downlink *pb_broker.DownlinkMessage) {
+	if brk == nil || downlink.DevId == "" {
+		return // Multicast downlink and downlink that did not come from a broker are not acknowledged
+	}
+	ack := &pb_broker.TxAcknowledgement{
+		DownlinkId: downlink.DownlinkOption.Identifier,
+		GatewayId:  downlink.DownlinkOption.GatewayId,
+		DevEui:     downlink.DevEui,
+		AppEui:     downlink.AppEui,
+		AppId:      downlink.AppId,
+		DevId:      downlink.DevId,
+	}
+	r.txAcksLock.Lock()
+	defer r.txAcksLock.Unlock()
+	if r.txAcks == nil {
+		r.txAcks = make(map[string]*pendingTxAck)
+	}
+	r.txAcks[ack.DownlinkId] = &pendingTxAck{broker: brk, ack: ack, expires: time.Now().Add(txAckTimeout)}
+}
+
+// dropPendingTxAck forwards a failed acknowledgement to the broker for a downlink message that the router dropped
+// before it was sent to the gateway
+func (r *router) dropPendingTxAck(gatewayID string, downlinkID string, result pb_gateway.TxAcknowledgement_Result) {
+	err := r.HandleTxAck(gatewayID, &pb_gateway.TxAcknowledgement{DownlinkId: downlinkID, Result: result})
+	if err != nil && errors.GetErrType(err) != errors.NotFound {
+		r.Ctx.WithError(err).WithField("DownlinkID", downlinkID).Warn("Could not report dropped downlink")
+	}
+}
+
+// expireTxAcks removes the downlink messages that were not acknowledged in time
+func (r *router) expireTxAcks() {
+	r.txAcksLock.Lock()
+	defer r.txAcksLock.Unlock()
+	now := time.Now()
+	for id, pending := range r.txAcks {
+		if now.After(pending.expires) {
+			delete(r.txAcks, id)
+		}
+	}
+}
+
+func (r *router) HandleTxAck(gatewayID string, ack *pb_gateway.TxAcknowledgement) error {
+	ctx := r.Ctx.WithFields(ttnlog.Fields{
+		"GatewayID":  gatewayID,
+		"DownlinkID": ack.DownlinkId,
+		"Result":     ack.Result,
+	})
+
+	r.txAcksLock.Lock()
+	pending, ok := r.txAcks[ack.DownlinkId]
+	if ok && pending.ack.GatewayId == gatewayID {
+		delete(r.txAcks, ack.DownlinkId)
+	}
+	r.txAcksLock.Unlock()
+	if !ok || pending.ack.GatewayId != gatewayID {
+		return errors.NewErrNotFound(fmt.Sprintf("Downlink %s", ack.DownlinkId))
+	}
+
+	if ack.Result == pb_gateway.TxAcknowledgement_SUCCESS {
+		ctx.Debug("Gateway transmitted downlink")
+	} else {
+		ctx.Warn("Gateway did not transmit downlink")
+	}
+
+	forward := *pending.ack
+	forward.Result = ack.Result
+	forward.Trace = forward.Trace.WithEvent(trace.ForwardEvent)
+
+	reqCtx, cancel := context.WithTimeout(r.Component.GetContext(""), 5*time.Second)
+	defer cancel()
+	if _, err := pending.broker.client.TxAck(reqCtx, &forward); err != nil {
+		return errors.Wrap(errors.FromGRPCError(err), "Could not forward TX acknowledgement to broker")
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package router
+
+import (
+	"testing"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
+	"github.com/TheThingsNetwork/ttn/api/monitor"
+	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/router/gateway"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/smartystreets/assertions"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+type txAckBrokerClient struct {
+	pb_broker.BrokerClient
+	acks []*pb_broker.TxAcknowledgement
+}
+
+func (c *txAckBrokerClient) TxAck(ctx context.Context, in *pb_broker.TxAcknowledgement, opts ...grpc.CallOption) (*empty.Empty, error) {
+	c.acks = append(c.acks, in)
+	return &empty.Empty{}, nil
+}
+
+func TestHandleTxAck(t *testing.T) {
+	a := New(t)
+
+	r := &router{
+		Component: &component.Component{
+			Ctx:     GetLogger(t, "TestHandleTxAck"),
+			Monitor: monitor.NewClient(monitor.DefaultClientConfig),
+		},
+		gateways: map[string]*gateway.Gateway{},
+	}
+	r.InitStatus()
+
+	client := &txAckBrokerClient{}
+	brk := &broker{client: client}
+
+	gtwID := "eui-0102030405060708"
+	appEUI, devEUI := types.AppEUI{1, 2, 3, 4, 5, 6, 7, 8}, types.DevEUI{1, 2, 3, 4, 5, 6, 7, 8}
+	id, _ := r.getGateway(gtwID).Schedule.GetOption(0, 10*1000)
+	err := r.handleDownlink(&pb_broker.DownlinkMessage{
+		Payload: []byte{},
+		AppEui:  &appEUI,
+		DevEui:  &devEUI,
+		AppId:   "app",
+		DevId:   "dev",
+		DownlinkOption: &pb_broker.DownlinkOption{
+			GatewayId:      gtwID,
+			Identifier:     id,
+			ProtocolConfig: &pb_protocol.TxConfiguration{},
+			GatewayConfig:  &pb_gateway.TxConfiguration{},
+		},
+	}, brk)
+	a.So(err, ShouldBeNil)
+
+	// Unknown downlink
+	err = r.HandleTxAck(gtwID, &pb_gateway.TxAcknowledgement{DownlinkId: "unknown"})
+	a.So(err, ShouldNotBeNil)
+
+	// Other gateway
+	err = r.HandleTxAck("other-gateway", &pb_gateway.TxAcknowledgement{DownlinkId: id})
+	a.So(err, ShouldNotBeNil)
+
+	err = r.HandleTxAck(gtwID, &pb_gateway.TxAcknowledgement{DownlinkId: id, Result: pb_gateway.TxAcknowledgement_TOO_LATE})
+	a.So(err, ShouldBeNil)
+	a.So(client.acks, ShouldHaveLength, 1)
+	a.So(client.acks[0].DownlinkId, ShouldEqual, id)
+	a.So(client.acks[0].GatewayId, ShouldEqual, gtwID)
+	a.So(client.acks[0].AppId, ShouldEqual, "app")
+	a.So(client.acks[0].DevId, ShouldEqual, "dev")
+	a.So(*client.acks[0].DevEui, ShouldEqual, devEUI)
+	a.So(client.acks[0].Result, ShouldEqual, pb_gateway.TxAcknowledgement_TOO_LATE)
+
+	// Only acknowledged once
+	err = r.HandleTxAck(gtwID, &pb_gateway.TxAcknowledgement{DownlinkId: id})
+	a.So(err, ShouldNotBeNil)
+
+	// Downlink that is dropped by the router is acknowledged as failed
+	client.acks = nil
+	err = r.handleDownlink(&pb_broker.DownlinkMessage{
+		Payload: []byte{},
+		AppEui:  &appEUI,
+		DevEui:  &devEUI,
+		AppId:   "app",
+		DevId:   "dev",
+		DownlinkOption: &pb_broker.DownlinkOption{
+			GatewayId:      gtwID,
+			Identifier:     "unknown",
+			ProtocolConfig: &pb_protocol.TxConfiguration{},
+			GatewayConfig:  &pb_gateway.TxConfiguration{},
+		},
+	}, brk)
+	a.So(err, ShouldNotBeNil)
+	a.So(client.acks, ShouldHaveLength, 1)
+	a.So(client.acks[0].DownlinkId, ShouldEqual, "unknown")
+	a.So(client.acks[0].Result, ShouldEqual, pb_gateway.TxAcknowledgement_TOO_LATE)
+	a.So(r.txAcks, ShouldBeEmpty)
+
+	// Downlink that is not a response to an uplink gets a unique identifier
+	r.getGateway(gtwID).Schedule.Sync(0)
+	var identifiers []string
+	for i := 0; i < 2; i++ {
+		option := &pb_broker.DownlinkOption{
+			GatewayId:      gtwID,
+			ProtocolConfig: &pb_protocol.TxConfiguration{},
+			GatewayConfig:  &pb_gateway.TxConfiguration{},
+		}
+		err = r.handleDownlink(&pb_broker.DownlinkMessage{
+			Payload:        []byte{},
+			AppEui:         &appEUI,
+			DevEui:         &devEUI,
+			AppId:          "app",
+			DevId:          "dev",
+			DownlinkOption: option,
+		}, brk)
+		a.So(err, ShouldBeNil)
+		a.So(option.Identifier, ShouldNotBeEmpty)
+		identifiers = append(identifiers, option.Identifier)
+	}
+	a.So(identifiers[0], ShouldNotEqual, identifiers[1])
+	a.So(r.txAcks, ShouldHaveLength, 2)
+}
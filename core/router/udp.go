@@ -0,0 +1,346 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package router
+
+import (
+	"encoding/json"
+	"net"
+	"sync"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api/ratelimit"
+	pb "github.com/TheThingsNetwork/ttn/api/router"
+	"github.com/TheThingsNetwork/ttn/core/router/semtech"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/random"
+)
+
+// udpSubscriptionID is the downlink subscription ID of gateways that are connected over UDP
+const udpSubscriptionID = "udp"
+
+// udpGatewayTimeout is the time after the last PULL_DATA of a gateway after which its downlink subscription is stopped
+const udpGatewayTimeout = time.Minute
+
+// maxUDPPacketSize is the maximum size of the payload of a UDP packet
+const maxUDPPacketSize = 65507
+
+type udpGateway struct {
+	id string
+
+	mu         sync.Mutex
+	version    uint8
+	addr       *net.UDPAddr
+	lastPull   time.Time
+	subscribed bool
+	downlinks  map[[2]byte]udpDownlink // PULL_RESP token to downlink, for the TX_ACK
+}
+
+// udpDownlink is a PULL_RESP that is waiting for the TX_ACK of the gateway
+type udpDownlink struct {
+	id   string
+	sent time.Time
+}
+
+type udpServer struct {
+	router     *router
+	ctx        ttnlog.Interface
+	conn       *net.UDPConn
+	allowlist  map[types.EUI64]string
+	uplinkRate *ratelimit.Registry
+	statusRate *ratelimit.Registry
+
+	gateways     map[types.EUI64]*udpGateway
+	gatewaysLock sync.Mutex
+	stop         chan struct{}
+}
+
+func (r *router) WithUDP(address string, gateways map[types.EUI64]string) Router {
+	r.udpAddress = address
+	r.udpGateways = gateways
+	return r
+}
+
+// listenUDP starts listening for gateways that use the Semtech UDP protocol
+func (r *router) listenUDP() error {
+	addr, err := net.ResolveUDPAddr("udp", r.udpAddress)
+	if err != nil {
+		return errors.Wrap(err, "Invalid UDP address")
+	}
+	conn, err := net.ListenUDP("udp", addr)
+	if err != nil {
+		return errors.Wrap(err, "Could not start UDP listener")
+	}
+	r.udp = &udpServer{
+		router:     r,
+		ctx:        r.Ctx.WithField("Protocol", "UDP"),
+		conn:       conn,
+		allowlist:  r.udpGateways,
+		uplinkRate: ratelimit.NewRegistry(1500, time.Minute), // Same as the gRPC server
+		statusRate: ratelimit.NewRegistry(10, time.Minute),
+		gateways:   make(map[types.EUI64]*udpGateway),
+		stop:       make(chan struct{}),
+	}
+	r.udp.ctx.WithFields(ttnlog.Fields{
+		"Address":  conn.LocalAddr(),
+		"Gateways": len(r.udpGateways),
+	}).Info("Listening for UDP packet forwarders")
+	go r.udp.serve()
+	go r.udp.expireGateways()
+	return nil
+}
+
+func (s *udpServer) Close() {
+	close(s.stop)
+	s.conn.Close()
+	s.gatewaysLock.Lock()
+	defer s.gatewaysLock.Unlock()
+	for eui, gtw := range s.gateways {
+		s.unsubscribe(gtw)
+		delete(s.gateways, eui)
+	}
+}
+
+func (s *udpServer) serve() {
+	buf := make([]byte, maxUDPPacketSize)
+	for {
+		n, addr, err := s.conn.ReadFromUDP(buf)
+		if err != nil {
+			if netErr, ok := err.(net.Error); ok && netErr.Temporary() {
+				continue
+			}
+			s.ctx.WithError(err).Debug("Stopped UDP listener")
+			return
+		}
+		var packet semtech.Packet
+		if err := packet.UnmarshalBinary(buf[:n]); err != nil {
+			s.ctx.WithField("Address", addr).WithError(err).Debug("Received invalid UDP packet")
+			continue
+		}
+		s.handle(&packet, addr)
+	}
+}
+
+// getGateway returns the gateway with the given EUI, or an error if the gateway is not allowed to connect. It also
+// returns whether the gateway is new.
+func (s *udpServer) getGateway(eui types.EUI64) (gtw *udpGateway, created bool, err error) {
+	s.gatewaysLock.Lock()
+	defer s.gatewaysLock.Unlock()
+	if gtw, ok := s.gateways[eui]; ok {
+		return gtw, false, nil
+	}
+	gtw = &udpGateway{id: GatewayIDForEUI(eui)}
+	if len(s.allowlist) > 0 {
+		id, ok := s.allowlist[eui]
+		if !ok {
+			return nil, false, errors.NewErrPermissionDenied("Gateway not in UDP allowlist")
+		}
+		if id != "" {
+			gtw.id = id
+		}
+	}
+	s.gateways[eui] = gtw
+	return gtw, true, nil
+}
+
+func (s *udpServer) handle(packet *semtech.Packet, addr *net.UDPAddr) {
+	ctx := s.ctx.WithFields(ttnlog.Fields{
+		"GatewayEUI": packet.GatewayEUI,
+		"Address":    addr,
+	})
+	switch packet.Identifier {
+	case semtech.PushData, semtech.PullData, semtech.TxAck:
+	default:
+		ctx.WithField("Identifier", packet.Identifier).Debug("Ignoring unexpected UDP packet")
+		return
+	}
+	gtw, created, err := s.getGateway(packet.GatewayEUI)
+	if err != nil {
+		ctx.WithError(err).Debug("Rejected UDP packet")
+		return
+	}
+	ctx = ctx.WithField("GatewayID", gtw.id)
+	if created {
+		// The EUI of a UDP packet can be spoofed, so UDP gateways are never authenticated
+		s.router.getGateway(gtw.id).SetAnonymous()
+	}
+
+	switch packet.Identifier {
+	case semtech.PushData:
+		s.write(ctx, packet.Ack(), addr)
+		s.handlePushData(ctx, gtw, packet)
+	case semtech.PullData:
+		s.write(ctx, packet.Ack(), addr)
+		s.handlePullData(ctx, gtw, packet, addr)
+	case semtech.TxAck:
+		s.handleTxAck(ctx, gtw, packet)
+	}
+}
+
+func (s *udpServer) handlePushData(ctx ttnlog.Interface, gtw *udpGateway, packet *semtech.Packet) {
+	var payload semtech.PushDataPayload
+	if err := json.Unmarshal(packet.Payload, &payload); err != nil {
+		ctx.WithError(err).Warn("Could not decode PUSH_DATA payload")
+		return
+	}
+	for _, rxpk := range payload.RXPK {
+		if rxpk.Stat == -1 {
+			ctx.Debug("Dropping rxpk with invalid CRC")
+			continue
+		}
+		uplink, err := rxpk.ToUplink()
+		if err != nil {
+			ctx.WithError(err).Warn("Could not convert rxpk to uplink")
+			continue
+		}
+		if s.uplinkRate.Limit(gtw.id) {
+			ctx.Warn("Gateway reached uplink rate limit")
+			continue
+		}
+		go s.router.HandleUplink(gtw.id, uplink)
+	}
+	if payload.Stat != nil {
+		if s.statusRate.Limit(gtw.id) {
+			ctx.Warn("Gateway reached status rate limit")
+			return
+		}
+		go s.router.HandleGatewayStatus(gtw.id, payload.Stat.ToStatus())
+	}
+}
+
+func (s *udpServer) handlePullData(ctx ttnlog.Interface, gtw *udpGateway, packet *semtech.Packet, addr *net.UDPAddr) {
+	gtw.mu.Lock()
+	gtw.version = packet.Version
+	gtw.addr = addr
+	gtw.lastPull = time.Now()
+	subscribed := gtw.subscribed
+	gtw.subscribed = true
+	gtw.mu.Unlock()
+	if subscribed {
+		return
+	}
+	downlink, err := s.router.SubscribeDownlink(gtw.id, udpSubscriptionID)
+	if err != nil {
+		ctx.WithError(err).Warn("Could not subscribe to downlink")
+		gtw.mu.Lock()
+		gtw.subscribed = false
+		gtw.mu.Unlock()
+		return
+	}
+	go func() {
+		for message := range downlink {
+			s.sendDownlink(ctx, gtw, message)
+		}
+	}()
+}
+
+func (s *udpServer) sendDownlink(ctx ttnlog.Interface, gtw *udpGateway, downlink *pb.DownlinkMessage) {
+	txpk, err := semtech.FromDownlink(downlink)
+	if err != nil {
+		ctx.WithError(err).Warn("Could not convert downlink to txpk")
+		return
+	}
+	payload, err := json.Marshal(semtech.PullRespPayload{TXPK: *txpk})
+	if err != nil {
+		ctx.WithError(err).Warn("Could not encode PULL_RESP payload")
+		return
+	}
+	gtw.mu.Lock()
+	packet := &semtech.Packet{Version: gtw.version, Identifier: semtech.PullResp, Payload: payload}
+	addr := gtw.addr
+	if packet.Version != semtech.Version1 {
+		// Packet forwarders of version 2 respond with a TX_ACK that has the token of the PULL_RESP, which is also used
+		// to measure the round-trip time to the gateway
+		copy(packet.Token[:], random.Bytes(2))
+		if gtw.downlinks == nil {
+			gtw.downlinks = make(map[[2]byte]udpDownlink)
+		}
+		for token, pending := range gtw.downlinks {
+			if time.Since(pending.sent) > udpGatewayTimeout {
+				delete(gtw.downlinks, token) // The TX_ACK got lost
+			}
+		}
+		gtw.downlinks[packet.Token] = udpDownlink{id: downlink.Id, sent: time.Now()}
+	}
+	gtw.mu.Unlock()
+	s.write(ctx, packet, addr)
+}
+
+func (s *udpServer) handleTxAck(ctx ttnlog.Interface, gtw *udpGateway, packet *semtech.Packet) {
+	var payload semtech.TxAckPayload
+	if len(packet.Payload) > 0 {
+		if err := json.Unmarshal(packet.Payload, &payload); err != nil {
+			ctx.WithError(err).Warn("Could not decode TX_ACK payload")
+			return
+		}
+	}
+	if txErr := payload.TXPKAck.Error; txErr != "" && txErr != "NONE" {
+		ctx.WithField("Error", txErr).Warn("Gateway did not transmit downlink")
+	} else {
+		ctx.Debug("Gateway accepted downlink")
+	}
+
+	gtw.mu.Lock()
+	downlink, ok := gtw.downlinks[packet.Token]
+	delete(gtw.downlinks, packet.Token)
+	gtw.mu.Unlock()
+	if !ok {
+		return
+	}
+	// The packet forwarder sends the TX_ACK as soon as it received the PULL_RESP
+	s.router.getGateway(gtw.id).Schedule.AddRTT(time.Since(downlink.sent))
+	if downlink.id == "" {
+		return
+	}
+	if err := s.router.HandleTxAck(gtw.id, payload.TXPKAck.ToTxAcknowledgement(downlink.id)); err != nil {
+		ctx.WithError(err).Debug("Could not handle TX_ACK")
+	}
+}
+
+func (s *udpServer) write(ctx ttnlog.Interface, packet *semtech.Packet, addr *net.UDPAddr) {
+	data, err := packet.MarshalBinary()
+	if err == nil {
+		_, err = s.conn.WriteToUDP(data, addr)
+	}
+	if err != nil {
+		ctx.WithError(err).Warn("Could not send UDP packet")
+	}
+}
+
+// unsubscribe stops the downlink subscription of the gateway
+func (s *udpServer) unsubscribe(gtw *udpGateway) {
+	gtw.mu.Lock()
+	defer gtw.mu.Unlock()
+	if gtw.subscribed {
+		s.router.UnsubscribeDownlink(gtw.id, udpSubscriptionID)
+		gtw.subscribed = false
+		gtw.downlinks = nil
+	}
+}
+
+// expireGateways stops the downlink subscriptions of gateways that stopped sending PULL_DATA
+func (s *udpServer) expireGateways() {
+	ticker := time.NewTicker(udpGatewayTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+		s.gatewaysLock.Lock()
+		for eui, gtw := range s.gateways {
+			gtw.mu.Lock()
+			expired := time.Since(gtw.lastPull) > udpGatewayTimeout
+			gtw.mu.Unlock()
+			if expired {
+				s.ctx.WithField("GatewayID", gtw.id).Debug("UDP gateway timed out")
+				s.unsubscribe(gtw)
+				delete(s.gateways, eui)
+			}
+		}
+		s.gatewaysLock.Unlock()
+	}
+}
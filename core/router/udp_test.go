@@ -0,0 +1,91 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package router
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	pb_discovery "github.com/TheThingsNetwork/ttn/api/discovery"
+	"github.com/TheThingsNetwork/ttn/core/router/semtech"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestUDP(t *testing.T) {
+	a := New(t)
+
+	r := getTestRouter(t)
+	r.Identity = &pb_discovery.Announcement{Id: "test-router"}
+	allowed := types.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	r.WithUDP("127.0.0.1:0", map[types.EUI64]string{allowed: "test-gateway"})
+	a.So(r.listenUDP(), ShouldBeNil)
+	defer r.udp.Close()
+
+	conn, err := net.DialUDP("udp", nil, r.udp.conn.LocalAddr().(*net.UDPAddr))
+	a.So(err, ShouldBeNil)
+	defer conn.Close()
+
+	exchange := func(packet semtech.Packet) *semtech.Packet {
+		data, _ := packet.MarshalBinary()
+		conn.Write(data)
+		conn.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+		buf := make([]byte, 1024)
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil
+		}
+		var res semtech.Packet
+		res.UnmarshalBinary(buf[:n])
+		return &res
+	}
+
+	// Gateway that is not in the allowlist
+	ack := exchange(semtech.Packet{Version: semtech.Version2, Identifier: semtech.PullData, GatewayEUI: types.EUI64{8, 7, 6, 5, 4, 3, 2, 1}})
+	a.So(ack, ShouldBeNil)
+
+	// Status
+	ack = exchange(semtech.Packet{
+		Version:    semtech.Version2,
+		Token:      [2]byte{1, 2},
+		Identifier: semtech.PushData,
+		GatewayEUI: allowed,
+		Payload:    []byte(`{"stat":{"rxnb":1,"desc":"Fake Gateway"}}`),
+	})
+	a.So(ack, ShouldNotBeNil)
+	a.So(ack.Identifier, ShouldEqual, semtech.PushAck)
+	a.So(ack.Token, ShouldResemble, [2]byte{1, 2})
+
+	time.Sleep(50 * time.Millisecond)
+	status, err := r.getGateway("test-gateway").Status.Get()
+	a.So(err, ShouldBeNil)
+	a.So(status.Description, ShouldEqual, "Fake Gateway")
+	a.So(status.GatewayTrusted, ShouldBeFalse)
+
+	// Later UDP packets do not change the authentication of a gateway that connected with a token
+	r.getGateway("test-gateway").SetAuth("token", true)
+	ack = exchange(semtech.Packet{
+		Version:    semtech.Version2,
+		Token:      [2]byte{1, 3},
+		Identifier: semtech.PushData,
+		GatewayEUI: allowed,
+		Payload:    []byte(`{"stat":{"rxnb":2,"desc":"Fake Gateway"}}`),
+	})
+	a.So(ack, ShouldNotBeNil)
+	time.Sleep(50 * time.Millisecond)
+	status, err = r.getGateway("test-gateway").Status.Get()
+	a.So(err, ShouldBeNil)
+	a.So(status.GatewayTrusted, ShouldBeTrue)
+
+	// Downlink subscription
+	ack = exchange(semtech.Packet{Version: semtech.Version2, Token: [2]byte{3, 4}, Identifier: semtech.PullData, GatewayEUI: allowed})
+	a.So(ack, ShouldNotBeNil)
+	a.So(ack.Identifier, ShouldEqual, semtech.PullAck)
+	time.Sleep(50 * time.Millisecond)
+	gtw, _, _ := r.udp.getGateway(allowed)
+	gtw.mu.Lock()
+	a.So(gtw.subscribed, ShouldBeTrue)
+	gtw.mu.Unlock()
+}
@@ -176,6 +176,16 @@ type NetID [3]byte
 
 var emptyNetID NetID
 
+// ParseNetID parses a 24-bit hex-encoded string to a NetID
+func ParseNetID(input string) (netID NetID, err error) {
+	bytes, err := ParseHEX(input, 3)
+	if err != nil {
+		return
+	}
+	copy(netID[:], bytes)
+	return
+}
+
 // Bytes returns the NetID as a byte slice
 func (n NetID) Bytes() []byte {
 	return n[:]
@@ -252,3 +262,33 @@ func (n *NetID) Unmarshal(data []byte) error {
 func (n NetID) Equal(other NetID) bool {
 	return n == other
 }
+
+// nwkIDBits is the number of NwkID bits in a DevAddr for each NetID type
+var nwkIDBits = [8]int{6, 6, 9, 11, 12, 13, 15, 17}
+
+// Type returns the type of the NetID (the 3 MSB)
+func (n NetID) Type() int {
+	return int(n[0] >> 5)
+}
+
+// ID returns the 21-bit ID of the NetID (the 21 LSB)
+func (n NetID) ID() uint32 {
+	return uint32(n[0]&0x1f)<<16 | uint32(n[1])<<8 | uint32(n[2])
+}
+
+// NwkID returns the NwkID that is used in the DevAddrs of the NetID (the LSB of the ID)
+func (n NetID) NwkID() uint32 {
+	return n.ID() & (1<<uint(nwkIDBits[n.Type()]) - 1)
+}
+
+// DevAddrPrefix returns the prefix of the DevAddrs that belong to the NetID
+func (n NetID) DevAddrPrefix() DevAddrPrefix {
+	netIDType := uint(n.Type())
+	bits := uint(nwkIDBits[netIDType])
+	length := netIDType + 1 + bits
+	prefix := uint32(1<<netIDType-1)<<(32-netIDType) | n.NwkID()<<(32-length)
+	return DevAddrPrefix{
+		DevAddr: DevAddr{byte(prefix >> 24), byte(prefix >> 16), byte(prefix >> 8), byte(prefix)},
+		Length:  int(length),
+	}
+}
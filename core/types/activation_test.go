@@ -182,3 +182,29 @@ func TestNetID(t *testing.T) {
 	a.So(err, ShouldBeNil)
 	a.So(uOut, ShouldResemble, &nid)
 }
+
+func TestNetIDDevAddrPrefix(t *testing.T) {
+	a := New(t)
+
+	netID, err := ParseNetID("000013")
+	a.So(err, ShouldBeNil)
+	a.So(netID.Type(), ShouldEqual, 0)
+	a.So(netID.NwkID(), ShouldEqual, 0x13)
+	a.So(netID.DevAddrPrefix(), ShouldResemble, DevAddrPrefix{DevAddr{0x26, 0, 0, 0}, 7})
+
+	netID, _ = ParseNetID("010213")
+	a.So(netID.ID(), ShouldEqual, 0x010213)
+	a.So(netID.DevAddrPrefix(), ShouldResemble, DevAddrPrefix{DevAddr{0x26, 0, 0, 0}, 7})
+
+	netID, _ = ParseNetID("600001")
+	a.So(netID.Type(), ShouldEqual, 3)
+	a.So(netID.NwkID(), ShouldEqual, 1)
+	a.So(netID.DevAddrPrefix(), ShouldResemble, DevAddrPrefix{DevAddr{0xE0, 0x02, 0, 0}, 15})
+
+	netID, _ = ParseNetID("E00003")
+	a.So(netID.Type(), ShouldEqual, 7)
+	a.So(netID.DevAddrPrefix(), ShouldResemble, DevAddrPrefix{DevAddr{0xFE, 0, 0x01, 0x80}, 25})
+
+	_, err = ParseNetID("0013")
+	a.So(err, ShouldNotBeNil)
+}
@@ -222,3 +222,8 @@ func (addr DevAddr) WithPrefix(prefix DevAddrPrefix) (prefixed DevAddr) {
 func (addr DevAddr) HasPrefix(prefix DevAddrPrefix) bool {
 	return addr.Mask(prefix.Length) == prefix.DevAddr.Mask(prefix.Length)
 }
+
+// HasNetID returns true if the DevAddr belongs to the given NetID
+func (addr DevAddr) HasNetID(netID NetID) bool {
+	return addr.HasPrefix(netID.DevAddrPrefix())
+}
@@ -114,6 +114,15 @@ func TestDevAddrHasPrefix(t *testing.T) {
 	a.So(addr.HasPrefix(DevAddrPrefix{DevAddr{1, 1, 1, 1}, 15}), ShouldBeFalse)
 }
 
+func TestDevAddrHasNetID(t *testing.T) {
+	a := New(t)
+	a.So(DevAddr{0x26, 0x01, 0x23, 0x45}.HasNetID(NetID{0x00, 0x00, 0x13}), ShouldBeTrue)
+	a.So(DevAddr{0x27, 0x01, 0x23, 0x45}.HasNetID(NetID{0x00, 0x00, 0x13}), ShouldBeTrue)
+	a.So(DevAddr{0x28, 0x01, 0x23, 0x45}.HasNetID(NetID{0x00, 0x00, 0x13}), ShouldBeFalse)
+	a.So(DevAddr{0xE0, 0x03, 0x23, 0x45}.HasNetID(NetID{0x60, 0x00, 0x01}), ShouldBeTrue)
+	a.So(DevAddr{0xE0, 0x03, 0x23, 0x45}.HasNetID(NetID{0x00, 0x00, 0x13}), ShouldBeFalse)
+}
+
 func TestParseDevAddrPrefix(t *testing.T) {
 	a := New(t)
 	prefix, err := ParseDevAddrPrefix("XYZ")
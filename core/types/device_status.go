@@ -0,0 +1,11 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package types
+
+// DeviceStatus contains the status of a device, as last reported by the device
+type DeviceStatus struct {
+	Battery uint8    `json:"battery"` // 0: external power source, 1..254: battery level, 255: unable to measure
+	Margin  int8     `json:"margin"`  // demodulation margin in dB
+	Time    JSONTime `json:"time,omitempty"`
+}
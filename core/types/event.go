@@ -10,14 +10,18 @@ type EventType string
 const (
 	UplinkErrorEvent EventType = "up/errors"
 
-	DownlinkScheduledEvent EventType = "down/scheduled"
-	DownlinkSentEvent      EventType = "down/sent"
-	DownlinkErrorEvent     EventType = "down/errors"
-	DownlinkAckEvent       EventType = "down/acks"
+	DownlinkScheduledEvent   EventType = "down/scheduled"
+	DownlinkSentEvent        EventType = "down/sent"
+	DownlinkTransmittedEvent EventType = "down/transmitted"
+	DownlinkFailedEvent      EventType = "down/failed"
+	DownlinkErrorEvent       EventType = "down/errors"
+	DownlinkAckEvent         EventType = "down/acks"
 
 	ActivationEvent      EventType = "activations"
 	ActivationErrorEvent EventType = "activations/errors"
 
+	FCntAnomalyEvent EventType = "f_cnt/anomalies"
+
 	CreateEvent EventType = "create"
 	UpdateEvent EventType = "update"
 	DeleteEvent EventType = "delete"
@@ -45,6 +49,14 @@ type ActivationEventData struct {
 	Metadata Metadata `json:"metadata"`
 }
 
+// FCntAnomalyEventData is added to frame counter anomaly events
+type FCntAnomalyEventData struct {
+	Type         string `json:"type"`               // reset, gap, rollover_16 or rollover_32
+	Downlink     bool   `json:"downlink,omitempty"` // the anomaly is in the downlink frame counter
+	FCnt         uint32 `json:"counter"`
+	PreviousFCnt uint32 `json:"previous_counter"`
+}
+
 // DownlinkEventConfigInfo contains configuration information for a downlink message, all fields are optional
 type DownlinkEventConfigInfo struct {
 	Modulation string `json:"modulation,omitempty"`
@@ -63,3 +75,9 @@ type DownlinkEventData struct {
 	GatewayID string                  `json:"gateway_id,omitempty"`
 	Config    DownlinkEventConfigInfo `json:"config,omitempty"`
 }
+
+// DownlinkTxAckEventData is added to the events of downlink messages that were acknowledged by the gateway
+type DownlinkTxAckEventData struct {
+	ErrorEventData
+	GatewayID string `json:"gateway_id"`
+}
@@ -5,12 +5,13 @@ package types
 
 // Metadata contains metadata of a message
 type Metadata struct {
-	Time       JSONTime          `json:"time,omitempty,omitempty"`
-	Frequency  float32           `json:"frequency,omitempty"`
-	Modulation string            `json:"modulation,omitempty"`
-	DataRate   string            `json:"data_rate,omitempty"`
-	Bitrate    uint32            `json:"bit_rate,omitempty"`
-	CodingRate string            `json:"coding_rate,omitempty"`
-	Gateways   []GatewayMetadata `json:"gateways,omitempty"`
+	Time         JSONTime          `json:"time,omitempty,omitempty"`
+	Frequency    float32           `json:"frequency,omitempty"`
+	Modulation   string            `json:"modulation,omitempty"`
+	DataRate     string            `json:"data_rate,omitempty"`
+	Bitrate      uint32            `json:"bit_rate,omitempty"`
+	CodingRate   string            `json:"coding_rate,omitempty"`
+	Gateways     []GatewayMetadata `json:"gateways,omitempty"`
+	DeviceStatus *DeviceStatus     `json:"device_status,omitempty"`
 	LocationMetadata
 }
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/TheThingsNetwork/ttn/api"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/ttnctl/util"
 	"github.com/spf13/cobra"
 )
@@ -35,9 +36,20 @@ var devicesInfoCmd = &cobra.Command{
      AppKey: <nil>
     AppSKey: D8DD37B4B709BA76C6FEC62CAD0CCE51
     NwkSKey: 3382A3066850293421ED8D392B9BF4DF
-     FCntUp: 0
+     FCntUp: 100
    FCntDown: 0
     Options:
+
+    Link Quality (last 20 frames, FCnt 81-100):
+
+    Packet Loss: 5.0%
+            SNR: min -4.2, p10 -2.0, median 5.5, p90 8.8, max 9.5
+           RSSI: min -118, p10 -115, median -97, p90 -85, max -81
+       Gateways: 1.6 per frame
+                 my-gateway (19 frames)
+                 other-gateway (13 frames)
+     Data Rates: SF7BW125 (12), SF9BW125 (8)
+    Frequencies: 868.1 MHz (7), 868.3 MHz (7), 868.5 MHz (6)
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		assertArgsLength(cmd, args, 1, 1)
@@ -92,23 +104,75 @@ var devicesInfoCmd = &cobra.Command{
 			fmt.Printf("     DevEUI: %s\n", devEUI)
 			fmt.Printf("    DevAddr: %s\n", formatBytes(lorawan.DevAddr, byteFormat))
 			fmt.Printf("     AppKey: %s\n", formatBytes(lorawan.AppKey, byteFormat))
+			if lorawan.MacVersion == pb_lorawan.MACVersion_LORAWAN_1_1 {
+				fmt.Printf("     NwkKey: %s\n", formatBytes(lorawan.NwkKey, byteFormat))
+			}
 			fmt.Printf("    AppSKey: %s\n", formatBytes(lorawan.AppSKey, byteFormat))
 			fmt.Printf("    NwkSKey: %s\n", formatBytes(lorawan.NwkSKey, byteFormat))
 
 			fmt.Printf("     FCntUp: %d\n", lorawan.FCntUp)
 			fmt.Printf("   FCntDown: %d\n", lorawan.FCntDown)
 			options := []string{}
+			if lorawan.MacVersion == pb_lorawan.MACVersion_LORAWAN_1_1 {
+				options = append(options, "LoRaWAN1.1")
+			}
 			if lorawan.DisableFCntCheck {
 				options = append(options, "FCntCheckDisabled")
 			} else {
 				options = append(options, "FCntCheckEnabled")
 			}
+			if lorawan.DisableDevStatus {
+				options = append(options, "DevStatusDisabled")
+			}
 			if lorawan.Uses32BitFCnt {
 				options = append(options, "32BitFCnt")
 			} else {
 				options = append(options, "16BitFCnt")
 			}
+			switch lorawan.DeviceClass {
+			case pb_lorawan.DeviceClass_CLASS_B:
+				options = append(options, fmt.Sprintf("ClassB (ping slot every %ds)", 1<<lorawan.PingSlotPeriodicity))
+			case pb_lorawan.DeviceClass_CLASS_C:
+				options = append(options, "ClassC")
+			}
 			fmt.Printf("    Options: %s\n", strings.Join(options, ", "))
+			if lorawan.DeviceProfileId != "" || lorawan.ServiceProfileId != "" {
+				fmt.Printf("   Profiles: device %q, service %q\n", lorawan.DeviceProfileId, lorawan.ServiceProfileId)
+			}
+
+			stats, err := manager.GetDeviceStats(appID, devID)
+			if err != nil {
+				fmt.Println()
+				ctx.WithError(err).Warn("Could not get link quality statistics")
+				return
+			}
+			if stats.Frames == 0 {
+				return
+			}
+			fmt.Println()
+			fmt.Printf("    Link Quality (last %d frames, FCnt %d-%d):\n", stats.Frames, stats.FirstFCnt, stats.LastFCnt)
+			fmt.Println()
+			fmt.Printf("    Packet Loss: %.1f%%\n", stats.PacketLoss*100)
+			if snr := stats.Snr; snr != nil {
+				fmt.Printf("            SNR: min %.1f, p10 %.1f, median %.1f, p90 %.1f, max %.1f\n", snr.Min, snr.P10, snr.Median, snr.P90, snr.Max)
+			}
+			if rssi := stats.Rssi; rssi != nil {
+				fmt.Printf("           RSSI: min %.0f, p10 %.0f, median %.0f, p90 %.0f, max %.0f\n", rssi.Min, rssi.P10, rssi.Median, rssi.P90, rssi.Max)
+			}
+			fmt.Printf("       Gateways: %.1f per frame\n", stats.AverageGateways)
+			for _, gateway := range stats.Gateways {
+				fmt.Printf("                 %s (%d frames)\n", gateway.GatewayId, gateway.Frames)
+			}
+			dataRates := make([]string, 0, len(stats.DataRates))
+			for _, dataRate := range stats.DataRates {
+				dataRates = append(dataRates, fmt.Sprintf("%s (%d)", dataRate.DataRate, dataRate.Frames))
+			}
+			fmt.Printf("     Data Rates: %s\n", strings.Join(dataRates, ", "))
+			frequencies := make([]string, 0, len(stats.Frequencies))
+			for _, frequency := range stats.Frequencies {
+				frequencies = append(frequencies, fmt.Sprintf("%.1f MHz (%d)", float64(frequency.Frequency)/1000000, frequency.Frames))
+			}
+			fmt.Printf("    Frequencies: %s\n", strings.Join(frequencies, ", "))
 		}
 
 	},
@@ -9,6 +9,7 @@ import (
 
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	"github.com/TheThingsNetwork/ttn/api"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/ttnctl/util"
 	"github.com/spf13/cobra"
@@ -113,6 +114,22 @@ var devicesSetCmd = &cobra.Command{
 			dev.GetLorawanDevice().AppKey = &key
 		}
 
+		if in, err := cmd.Flags().GetString("nwk-key"); err == nil && in != "" {
+			key, err := types.ParseAppKey(in)
+			if err != nil {
+				ctx.Fatalf("Invalid NwkKey: %s", err)
+			}
+			dev.GetLorawanDevice().NwkKey = &key
+		}
+
+		if in, err := cmd.Flags().GetString("mac-version"); err == nil && in != "" {
+			version, ok := pb_lorawan.MACVersion_value["LORAWAN_"+strings.Replace(in, ".", "_", -1)]
+			if !ok {
+				ctx.Fatalf("Invalid LoRaWAN version: %s", in)
+			}
+			dev.GetLorawanDevice().MacVersion = pb_lorawan.MACVersion(version)
+		}
+
 		if in, err := cmd.Flags().GetInt("fcnt-up"); err == nil && in != -1 {
 			dev.GetLorawanDevice().FCntUp = uint32(in)
 		}
@@ -137,6 +154,44 @@ var devicesSetCmd = &cobra.Command{
 			dev.GetLorawanDevice().Uses32BitFCnt = false
 		}
 
+		if in, err := cmd.Flags().GetString("adr-strategy"); err == nil && in != "" {
+			dev.GetLorawanDevice().AdrStrategy = in
+		}
+
+		if in, err := cmd.Flags().GetInt("adr-margin"); err == nil && in != -1 {
+			dev.GetLorawanDevice().AdrMargin = uint32(in)
+		}
+
+		if in, err := cmd.Flags().GetBool("disable-dev-status"); err == nil && in {
+			dev.GetLorawanDevice().DisableDevStatus = true
+		}
+
+		if in, err := cmd.Flags().GetBool("enable-dev-status"); err == nil && in {
+			dev.GetLorawanDevice().DisableDevStatus = false
+		}
+
+		if in, err := cmd.Flags().GetString("class"); err == nil && in != "" {
+			class, ok := pb_lorawan.DeviceClass_value["CLASS_"+strings.ToUpper(in)]
+			if !ok {
+				ctx.Fatalf("Invalid device class: %s", in)
+			}
+			dev.GetLorawanDevice().DeviceClass = pb_lorawan.DeviceClass(class)
+		}
+
+		if in, err := cmd.Flags().GetString("device-profile"); err == nil && in != "" {
+			if in == "none" {
+				in = ""
+			}
+			dev.GetLorawanDevice().DeviceProfileId = in
+		}
+
+		if in, err := cmd.Flags().GetString("service-profile"); err == nil && in != "" {
+			if in == "none" {
+				in = ""
+			}
+			dev.GetLorawanDevice().ServiceProfileId = in
+		}
+
 		if in, err := cmd.Flags().GetFloat32("latitude"); err == nil && in != 0 {
 			dev.Latitude = in
 		}
@@ -176,6 +231,8 @@ func init() {
 	devicesSetCmd.Flags().String("nwk-s-key", "", "Set NwkSKey")
 	devicesSetCmd.Flags().String("app-s-key", "", "Set AppSKey")
 	devicesSetCmd.Flags().String("app-key", "", "Set AppKey")
+	devicesSetCmd.Flags().String("nwk-key", "", "Set NwkKey (LoRaWAN 1.1)")
+	devicesSetCmd.Flags().String("mac-version", "", "Set LoRaWAN version (1.0, 1.1)")
 
 	devicesSetCmd.Flags().Int("fcnt-up", -1, "Set FCnt Up")
 	devicesSetCmd.Flags().Int("fcnt-down", -1, "Set FCnt Down")
@@ -185,6 +242,17 @@ func init() {
 	devicesSetCmd.Flags().Bool("32-bit-fcnt", false, "Use 32 bit FCnt (default)")
 	devicesSetCmd.Flags().Bool("16-bit-fcnt", false, "Use 16 bit FCnt")
 
+	devicesSetCmd.Flags().String("adr-strategy", "", "Set ADR strategy (default, conservative, mobile, disabled)")
+	devicesSetCmd.Flags().Int("adr-margin", -1, "Set ADR margin in dB (0: NetworkServer default)")
+
+	devicesSetCmd.Flags().Bool("disable-dev-status", false, "Disable device status requests")
+	devicesSetCmd.Flags().Bool("enable-dev-status", false, "Enable device status requests (default)")
+
+	devicesSetCmd.Flags().String("class", "", "Set device class (A, B, C)")
+
+	devicesSetCmd.Flags().String("device-profile", "", "Set device profile on the NetworkServer (none: no profile)")
+	devicesSetCmd.Flags().String("service-profile", "", "Set service profile on the NetworkServer (none: no profile)")
+
 	devicesSetCmd.Flags().Float32("latitude", 0, "Set latitude")
 	devicesSetCmd.Flags().Float32("longitude", 0, "Set longitude")
 	devicesSetCmd.Flags().Int32("altitude", 0, "Set altitude")
@@ -67,6 +67,12 @@ var gatewaysStatusCmd = &cobra.Command{
 		}())
 		printKV("Rx", fmt.Sprintf("(in: %d; ok: %d)", resp.Status.RxIn, resp.Status.RxOk))
 		printKV("Tx", fmt.Sprintf("(in: %d; ok: %d)", resp.Status.TxIn, resp.Status.TxOk))
+		for _, subBand := range resp.SubBands {
+			printKV(
+				fmt.Sprintf("Sub-band %.1f-%.1f", float64(subBand.MinFrequency)/1e6, float64(subBand.MaxFrequency)/1e6),
+				fmt.Sprintf("(duty cycle: %g%%; used: %s; remaining: %s)", subBand.DutyCycle*100, time.Duration(subBand.TimeOnAir)*time.Millisecond, time.Duration(subBand.Remaining)*time.Millisecond),
+			)
+		}
 		fmt.Println()
 	},
 }
@@ -0,0 +1,60 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var multicastDeleteCmd = &cobra.Command{
+	Use:   "delete [Group ID]",
+	Short: "Delete a multicast group",
+	Long:  `ttnctl multicast delete can be used to delete a multicast group. The members are not deleted.`,
+	Example: `$ ttnctl multicast delete lights
+  INFO Using Application                        AppID=test
+Are you sure you want to delete multicast group lights from application test?
+> yes
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Deleted multicast group                  AppID=test GroupID=lights
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		groupID := strings.ToLower(args[0])
+		if err := api.NotEmptyAndValidID(groupID, "Group ID"); err != nil {
+			ctx.Fatal(err.Error())
+		}
+
+		appID := util.GetAppID(ctx)
+
+		if !confirm(fmt.Sprintf("Are you sure you want to delete multicast group %s from application %s?", groupID, appID)) {
+			ctx.Info("Not doing anything")
+			return
+		}
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		err := manager.DeleteMulticastGroup(appID, groupID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not delete multicast group.")
+		}
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID":   appID,
+			"GroupID": groupID,
+		}).Info("Deleted multicast group")
+	},
+}
+
+func init() {
+	multicastCmd.AddCommand(multicastDeleteCmd)
+}
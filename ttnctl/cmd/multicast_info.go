@@ -0,0 +1,96 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var multicastInfoCmd = &cobra.Command{
+	Use:   "info [Group ID]",
+	Short: "Get information about a multicast group",
+	Long:  `ttnctl multicast info can be used to get information about a multicast group.`,
+	Example: `$ ttnctl multicast info lights
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Found multicast group
+
+  Application ID: test
+        Group ID: lights
+
+       McAddr: 26001B3C
+    McNwkSKey: 3382A3066850293421ED8D392B9BF4DF
+    McAppSKey: D8DD37B4B709BA76C6FEC62CAD0CCE51
+     FCntDown: 12
+    Data Rate: RX2 default
+    Frequency: RX2 default
+     Gateways: gateways of the members
+      Members: lamp-1, lamp-2
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		groupID := strings.ToLower(args[0])
+		if err := api.NotEmptyAndValidID(groupID, "Group ID"); err != nil {
+			ctx.Fatal(err.Error())
+		}
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		group, err := manager.GetMulticastGroup(appID, groupID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get multicast group.")
+		}
+
+		byteFormat, _ := cmd.Flags().GetString("format")
+
+		ctx.Info("Found multicast group")
+
+		fmt.Println()
+
+		fmt.Printf("  Application ID: %s\n", group.AppId)
+		fmt.Printf("        Group ID: %s\n", group.GroupId)
+		fmt.Println()
+		fmt.Printf("       McAddr: %s\n", formatBytes(group.McAddr, byteFormat))
+		fmt.Printf("    McNwkSKey: %s\n", formatBytes(group.McNwkSKey, byteFormat))
+		fmt.Printf("    McAppSKey: %s\n", formatBytes(group.McAppSKey, byteFormat))
+		fmt.Printf("     FCntDown: %d\n", group.FCntDown)
+
+		dataRate := "RX2 default"
+		if group.DataRate != "" {
+			dataRate = group.DataRate
+		}
+		fmt.Printf("    Data Rate: %s\n", dataRate)
+		frequency := "RX2 default"
+		if group.Frequency != 0 {
+			frequency = fmt.Sprintf("%d Hz", group.Frequency)
+		}
+		fmt.Printf("    Frequency: %s\n", frequency)
+		gateways := "gateways of the members"
+		if len(group.GatewayIds) != 0 {
+			gateways = strings.Join(group.GatewayIds, ", ")
+		}
+		fmt.Printf("     Gateways: %s\n", gateways)
+
+		members := make([]string, 0, len(group.Members))
+		for _, member := range group.Members {
+			members = append(members, member.DevId)
+		}
+		fmt.Printf("      Members: %s\n", strings.Join(members, ", "))
+	},
+}
+
+func init() {
+	multicastCmd.AddCommand(multicastInfoCmd)
+	multicastInfoCmd.Flags().String("format", "hex", "Formatting: hex/msb/lsb")
+}
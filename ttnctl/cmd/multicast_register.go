@@ -0,0 +1,131 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"strings"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/go-utils/random"
+	"github.com/TheThingsNetwork/ttn/api"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var multicastRegisterCmd = &cobra.Command{
+	Use:   "register [Group ID] [McNwkSKey] [McAppSKey]",
+	Short: "Register a new multicast group",
+	Long: `ttnctl multicast register can be used to register a new multicast group.
+The members of the group must be Class C devices that are provisioned with the McAddr and the multicast session keys.`,
+	Example: `$ ttnctl multicast register lights --members lamp-1,lamp-2
+  INFO Using Application                        AppID=test
+  INFO Generating random McNwkSKey...
+  INFO Generating random McAppSKey...
+  INFO Discovering Handler...                   Handler=ttn-handler-eu
+  INFO Connecting with Handler...               Handler=eu.thethings.network:1904
+  INFO Requesting McAddr for multicast group...
+  INFO Registered multicast group               AppID=test GroupID=lights McAddr=26001B3C McAppSKey=D8DD37B4B709BA76C6FEC62CAD0CCE51 McNwkSKey=3382A3066850293421ED8D392B9BF4DF
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 3)
+
+		var err error
+
+		groupID := strings.ToLower(args[0])
+		if err := api.NotEmptyAndValidID(groupID, "Group ID"); err != nil {
+			ctx.Fatal(err.Error())
+		}
+
+		appID := util.GetAppID(ctx)
+
+		var mcNwkSKey types.NwkSKey
+		if len(args) > 1 {
+			mcNwkSKey, err = types.ParseNwkSKey(args[1])
+			if err != nil {
+				ctx.Fatalf("Invalid McNwkSKey: %s", err)
+			}
+		} else {
+			ctx.Info("Generating random McNwkSKey...")
+			random.FillBytes(mcNwkSKey[:])
+		}
+
+		var mcAppSKey types.AppSKey
+		if len(args) > 2 {
+			mcAppSKey, err = types.ParseAppSKey(args[2])
+			if err != nil {
+				ctx.Fatalf("Invalid McAppSKey: %s", err)
+			}
+		} else {
+			ctx.Info("Generating random McAppSKey...")
+			random.FillBytes(mcAppSKey[:])
+		}
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		var mcAddr types.DevAddr
+		if in, err := cmd.Flags().GetString("mc-addr"); err == nil && in != "" {
+			mcAddr, err = types.ParseDevAddr(in)
+			if err != nil {
+				ctx.Fatalf("Invalid McAddr: %s", err)
+			}
+		} else {
+			ctx.Info("Requesting McAddr for multicast group...")
+			mcAddr, err = manager.GetDevAddr("abp")
+			if err != nil {
+				ctx.WithError(err).Fatal("Could not request multicast address")
+			}
+		}
+
+		group := &pb_lorawan.MulticastGroup{
+			AppId:     appID,
+			GroupId:   groupID,
+			McAddr:    &mcAddr,
+			McNwkSKey: &mcNwkSKey,
+			McAppSKey: &mcAppSKey,
+		}
+
+		if in, err := cmd.Flags().GetStringSlice("members"); err == nil {
+			for _, devID := range in {
+				group.Members = append(group.Members, &pb_lorawan.MulticastGroup_Member{DevId: strings.ToLower(devID)})
+			}
+		}
+
+		if in, err := cmd.Flags().GetStringSlice("gateways"); err == nil {
+			group.GatewayIds = in
+		}
+
+		if in, err := cmd.Flags().GetString("data-rate"); err == nil {
+			group.DataRate = in
+		}
+
+		if in, err := cmd.Flags().GetUint64("frequency"); err == nil {
+			group.Frequency = in
+		}
+
+		err = manager.SetMulticastGroup(group)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not register multicast group")
+		}
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID":     appID,
+			"GroupID":   groupID,
+			"McAddr":    mcAddr,
+			"McNwkSKey": mcNwkSKey,
+			"McAppSKey": mcAppSKey,
+		}).Info("Registered multicast group")
+	},
+}
+
+func init() {
+	multicastCmd.AddCommand(multicastRegisterCmd)
+	multicastRegisterCmd.Flags().String("mc-addr", "", "Use this McAddr instead of requesting one")
+	multicastRegisterCmd.Flags().StringSlice("members", []string{}, "Device IDs of the members")
+	multicastRegisterCmd.Flags().StringSlice("gateways", []string{}, "Gateway IDs of the gateways that transmit downlink (default: gateways of the members)")
+	multicastRegisterCmd.Flags().String("data-rate", "", "Data rate of downlink (default: RX2 data rate)")
+	multicastRegisterCmd.Flags().Uint64("frequency", 0, "Frequency of downlink in Hz (default: RX2 frequency)")
+}
@@ -0,0 +1,88 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var multicastSendCmd = &cobra.Command{
+	Use:   "send [Group ID] [Payload]",
+	Short: "Send a downlink message to a multicast group",
+	Long:  `ttnctl multicast send can be used to send a downlink message to all members of a multicast group.`,
+	Example: `$ ttnctl multicast send lights aabc
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Sent multicast downlink                  AppID=test GroupID=lights
+
+$ ttnctl multicast send lights --json '{"on":true}'
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Sent multicast downlink                  AppID=test GroupID=lights
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 2, 2)
+
+		appID := util.GetAppID(ctx)
+		ctx = ctx.WithField("AppID", appID)
+
+		groupID := strings.ToLower(args[0])
+		if err := api.NotEmptyAndValidID(groupID, "Group ID"); err != nil {
+			ctx.Fatal(err.Error())
+		}
+		ctx = ctx.WithField("GroupID", groupID)
+
+		jsonflag, err := cmd.Flags().GetBool("json")
+		if err != nil {
+			ctx.WithError(err).Fatal("Failed to read json flag")
+		}
+
+		fPort, err := cmd.Flags().GetInt("fport")
+		if err != nil {
+			ctx.WithError(err).Fatal("Failed to read fport flag")
+		}
+
+		if args[1] == "" {
+			ctx.Info("Invalid command")
+			cmd.UsageFunc()(cmd)
+			return
+		}
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		if jsonflag {
+			var fields map[string]interface{}
+			if err := json.Unmarshal([]byte(args[1]), &fields); err != nil {
+				ctx.WithError(err).Fatal("Invalid json string")
+			}
+			err = manager.SendMulticastDownlinkWithFields(appID, groupID, uint32(fPort), fields)
+		} else {
+			var payload []byte
+			payload, err = types.ParseHEX(args[1], len(args[1])/2)
+			if err != nil {
+				ctx.WithError(err).Fatal("Invalid Payload")
+			}
+			err = manager.SendMulticastDownlinkWithPayload(appID, groupID, uint32(fPort), payload)
+		}
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not send multicast downlink")
+		}
+		ctx.Info("Sent multicast downlink")
+	},
+}
+
+func init() {
+	multicastCmd.AddCommand(multicastSendCmd)
+	multicastSendCmd.Flags().Int("fport", 1, "FPort for downlink")
+	multicastSendCmd.Flags().Bool("json", false, "Provide the payload as JSON")
+}
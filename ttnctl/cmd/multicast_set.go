@@ -0,0 +1,139 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"strings"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var multicastSetCmd = &cobra.Command{
+	Use:   "set [Group ID]",
+	Short: "Set properties of a multicast group",
+	Long:  `ttnctl multicast set can be used to set properties and members of a multicast group.`,
+	Example: `$ ttnctl multicast set lights --add-members lamp-3 --remove-members lamp-1
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Updated multicast group                  AppID=test GroupID=lights
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		groupID := strings.ToLower(args[0])
+		if err := api.NotEmptyAndValidID(groupID, "Group ID"); err != nil {
+			ctx.Fatal(err.Error())
+		}
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		group, err := manager.GetMulticastGroup(appID, groupID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get existing multicast group.")
+		}
+
+		// Do all updates
+
+		if in, err := cmd.Flags().GetString("mc-addr"); err == nil && in != "" {
+			mcAddr, err := types.ParseDevAddr(in)
+			if err != nil {
+				ctx.Fatalf("Invalid McAddr: %s", err)
+			}
+			group.McAddr = &mcAddr
+		}
+
+		if in, err := cmd.Flags().GetString("mc-nwk-s-key"); err == nil && in != "" {
+			key, err := types.ParseNwkSKey(in)
+			if err != nil {
+				ctx.Fatalf("Invalid McNwkSKey: %s", err)
+			}
+			group.McNwkSKey = &key
+		}
+
+		if in, err := cmd.Flags().GetString("mc-app-s-key"); err == nil && in != "" {
+			key, err := types.ParseAppSKey(in)
+			if err != nil {
+				ctx.Fatalf("Invalid McAppSKey: %s", err)
+			}
+			group.McAppSKey = &key
+		}
+
+		if in, err := cmd.Flags().GetInt("fcnt-down"); err == nil && in != -1 {
+			group.FCntDown = uint32(in)
+		}
+
+		if in, err := cmd.Flags().GetStringSlice("remove-members"); err == nil && len(in) > 0 {
+			remove := make(map[string]bool)
+			for _, devID := range in {
+				remove[strings.ToLower(devID)] = true
+			}
+			members := make([]*pb_lorawan.MulticastGroup_Member, 0, len(group.Members))
+			for _, member := range group.Members {
+				if !remove[member.DevId] {
+					members = append(members, member)
+				}
+			}
+			group.Members = members
+		}
+
+		if in, err := cmd.Flags().GetStringSlice("add-members"); err == nil {
+			for _, devID := range in {
+				group.Members = append(group.Members, &pb_lorawan.MulticastGroup_Member{DevId: strings.ToLower(devID)})
+			}
+		}
+
+		if in, err := cmd.Flags().GetStringSlice("gateways"); err == nil && len(in) > 0 {
+			if len(in) == 1 && in[0] == "none" {
+				in = nil
+			}
+			group.GatewayIds = in
+		}
+
+		if in, err := cmd.Flags().GetString("data-rate"); err == nil && in != "" {
+			if in == "default" {
+				in = ""
+			}
+			group.DataRate = in
+		}
+
+		if in, err := cmd.Flags().GetUint64("frequency"); err == nil && in != 0 {
+			group.Frequency = in
+		}
+
+		err = manager.SetMulticastGroup(group)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not update multicast group")
+		}
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID":   appID,
+			"GroupID": groupID,
+		}).Info("Updated multicast group")
+	},
+}
+
+func init() {
+	multicastCmd.AddCommand(multicastSetCmd)
+
+	multicastSetCmd.Flags().String("mc-addr", "", "Set McAddr")
+	multicastSetCmd.Flags().String("mc-nwk-s-key", "", "Set McNwkSKey")
+	multicastSetCmd.Flags().String("mc-app-s-key", "", "Set McAppSKey")
+	multicastSetCmd.Flags().Int("fcnt-down", -1, "Set FCnt Down")
+
+	multicastSetCmd.Flags().StringSlice("add-members", []string{}, "Add devices to the group")
+	multicastSetCmd.Flags().StringSlice("remove-members", []string{}, "Remove devices from the group")
+
+	multicastSetCmd.Flags().StringSlice("gateways", []string{}, "Set gateways that transmit downlink (none: gateways of the members)")
+	multicastSetCmd.Flags().String("data-rate", "", "Set data rate of downlink (default: RX2 data rate)")
+	multicastSetCmd.Flags().Uint64("frequency", 0, "Set frequency of downlink in Hz")
+}
@@ -0,0 +1,23 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package gpstime
+
+import "time"
+
+// Epoch is the start of GPS time
+var Epoch = time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+// LeapSeconds is the number of leap seconds that UTC has been adjusted with since the GPS epoch. GPS time is not
+// adjusted for leap seconds, so it is ahead of UTC by this number of seconds. The last leap second was on 31 December 2016.
+var LeapSeconds = 18 * time.Second
+
+// ToGPS returns the GPS time (time since the GPS epoch) for t
+func ToGPS(t time.Time) time.Duration {
+	return t.Sub(Epoch) + LeapSeconds
+}
+
+// FromGPS returns the time for the given GPS time (time since the GPS epoch)
+func FromGPS(gps time.Duration) time.Time {
+	return Epoch.Add(gps - LeapSeconds)
+}
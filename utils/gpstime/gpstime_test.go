@@ -0,0 +1,23 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package gpstime
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestGPSTime(t *testing.T) {
+	a := New(t)
+
+	a.So(ToGPS(Epoch), ShouldEqual, LeapSeconds)
+	a.So(FromGPS(LeapSeconds), ShouldResemble, Epoch)
+
+	// 2017-01-01 00:00:00 UTC is 1167264018 seconds after the GPS epoch
+	tm := time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC)
+	a.So(ToGPS(tm), ShouldEqual, 1167264018*time.Second)
+	a.So(FromGPS(1167264018*time.Second).Equal(tm), ShouldBeTrue)
+}
@@ -0,0 +1,75 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Package keywrap implements the AES Key Wrap algorithm (RFC 3394) that the LoRaWAN Backend Interfaces use to
+// exchange session keys
+package keywrap
+
+import (
+	"crypto/aes"
+	"crypto/subtle"
+	"encoding/binary"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+var defaultIV = []byte{0xA6, 0xA6, 0xA6, 0xA6, 0xA6, 0xA6, 0xA6, 0xA6}
+
+// Wrap wraps the plaintext key with the key encryption key (KEK)
+func Wrap(kek, plaintext []byte) ([]byte, error) {
+	if len(plaintext)%8 != 0 || len(plaintext) < 16 {
+		return nil, errors.NewErrInvalidArgument("Key", "length must be a multiple of 8 bytes and at least 16 bytes")
+	}
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+	n := len(plaintext) / 8
+	a := make([]byte, 8)
+	copy(a, defaultIV)
+	r := make([]byte, len(plaintext))
+	copy(r, plaintext)
+	b := make([]byte, aes.BlockSize)
+	for j := 0; j < 6; j++ {
+		for i := 0; i < n; i++ {
+			copy(b, a)
+			copy(b[8:], r[i*8:i*8+8])
+			block.Encrypt(b, b)
+			t := uint64(n*j + i + 1)
+			binary.BigEndian.PutUint64(a, binary.BigEndian.Uint64(b[:8])^t)
+			copy(r[i*8:i*8+8], b[8:])
+		}
+	}
+	return append(a, r...), nil
+}
+
+// Unwrap unwraps the ciphertext key with the key encryption key (KEK)
+func Unwrap(kek, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext)%8 != 0 || len(ciphertext) < 24 {
+		return nil, errors.NewErrInvalidArgument("Wrapped Key", "length must be a multiple of 8 bytes and at least 24 bytes")
+	}
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+	n := len(ciphertext)/8 - 1
+	a := make([]byte, 8)
+	copy(a, ciphertext[:8])
+	r := make([]byte, len(ciphertext)-8)
+	copy(r, ciphertext[8:])
+	b := make([]byte, aes.BlockSize)
+	for j := 5; j >= 0; j-- {
+		for i := n - 1; i >= 0; i-- {
+			t := uint64(n*j + i + 1)
+			binary.BigEndian.PutUint64(b, binary.BigEndian.Uint64(a)^t)
+			copy(b[8:], r[i*8:i*8+8])
+			block.Decrypt(b, b)
+			copy(a, b[:8])
+			copy(r[i*8:i*8+8], b[8:])
+		}
+	}
+	if subtle.ConstantTimeCompare(a, defaultIV) != 1 {
+		return nil, errors.NewErrInvalidArgument("Wrapped Key", "integrity check failed")
+	}
+	return r, nil
+}
@@ -0,0 +1,39 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package keywrap
+
+import (
+	"encoding/hex"
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestKeyWrap(t *testing.T) {
+	a := New(t)
+
+	// Test vector of RFC 3394 (4.1 Wrap 128 bits of Key Data with a 128-bit KEK)
+	kek, _ := hex.DecodeString("000102030405060708090A0B0C0D0E0F")
+	key, _ := hex.DecodeString("00112233445566778899AABBCCDDEEFF")
+	expected, _ := hex.DecodeString("1FA68B0A8112B447AEF34BD8FB5A7B829D3E862371D2CFE5")
+
+	wrapped, err := Wrap(kek, key)
+	a.So(err, ShouldBeNil)
+	a.So(wrapped, ShouldResemble, expected)
+
+	unwrapped, err := Unwrap(kek, wrapped)
+	a.So(err, ShouldBeNil)
+	a.So(unwrapped, ShouldResemble, key)
+
+	// Wrong KEK
+	otherKEK, _ := hex.DecodeString("0F0E0D0C0B0A09080706050403020100")
+	_, err = Unwrap(otherKEK, wrapped)
+	a.So(err, ShouldNotBeNil)
+
+	// Invalid lengths
+	_, err = Wrap(kek, key[:8])
+	a.So(err, ShouldNotBeNil)
+	_, err = Unwrap(kek, wrapped[:16])
+	a.So(err, ShouldNotBeNil)
+}
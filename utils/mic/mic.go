@@ -0,0 +1,103 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Package mic computes the Message Integrity Codes of LoRaWAN 1.1 messages. The msg arguments are the bytes of the
+// PHYPayload without the MIC (as transmitted), all other arguments are MSB-first.
+package mic
+
+import (
+	"encoding/binary"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/jacobsa/crypto/cmac"
+)
+
+const (
+	uplink   = 0
+	downlink = 1
+)
+
+func computeCMAC(key []byte, b0 []byte, msg []byte) (out [16]byte, err error) {
+	hash, err := cmac.New(key)
+	if err != nil {
+		return out, err
+	}
+	if _, err = hash.Write(b0); err != nil {
+		return out, err
+	}
+	if _, err = hash.Write(msg); err != nil {
+		return out, err
+	}
+	copy(out[:], hash.Sum(nil))
+	return out, nil
+}
+
+// block returns a B0 or B1 block. The caller sets the bytes 1 to 4.
+func block(dir byte, devAddr types.DevAddr, fCnt uint32, msgLen int) []byte {
+	b := make([]byte, 16)
+	b[0] = 0x49
+	b[5] = dir
+	binary.LittleEndian.PutUint32(b[6:10], binary.BigEndian.Uint32(devAddr[:]))
+	binary.LittleEndian.PutUint32(b[10:14], fCnt)
+	b[15] = byte(msgLen)
+	return b
+}
+
+// LegacyUplinkMIC returns the LoRaWAN 1.0 MIC of an uplink data message. For LoRaWAN 1.1 devices, the first two bytes
+// of the MIC computed with the FNwkSIntKey are the last two bytes of the uplink MIC.
+func LegacyUplinkMIC(nwkSKey types.NwkSKey, devAddr types.DevAddr, fCnt uint32, msg []byte) (mic [4]byte, err error) {
+	cmacF, err := computeCMAC(nwkSKey[:], block(uplink, devAddr, fCnt, len(msg)), msg)
+	if err != nil {
+		return mic, err
+	}
+	copy(mic[:], cmacF[:4])
+	return mic, nil
+}
+
+// UplinkMIC returns the LoRaWAN 1.1 MIC of an uplink data message. The confFCnt is the FCnt of the confirmed downlink
+// that is acknowledged by the uplink, txDR and txCh are the data rate index and channel index of the uplink.
+func UplinkMIC(fNwkSIntKey, sNwkSIntKey types.NwkSKey, confFCnt uint32, txDR, txCh uint8, devAddr types.DevAddr, fCnt uint32, msg []byte) (mic [4]byte, err error) {
+	cmacF, err := computeCMAC(fNwkSIntKey[:], block(uplink, devAddr, fCnt, len(msg)), msg)
+	if err != nil {
+		return mic, err
+	}
+	b1 := block(uplink, devAddr, fCnt, len(msg))
+	binary.LittleEndian.PutUint16(b1[1:3], uint16(confFCnt))
+	b1[3] = txDR
+	b1[4] = txCh
+	cmacS, err := computeCMAC(sNwkSIntKey[:], b1, msg)
+	if err != nil {
+		return mic, err
+	}
+	copy(mic[0:2], cmacS[0:2])
+	copy(mic[2:4], cmacF[0:2])
+	return mic, nil
+}
+
+// DownlinkMIC returns the LoRaWAN 1.1 MIC of a downlink data message. The confFCnt is the FCnt of the confirmed uplink
+// that is acknowledged by the downlink.
+func DownlinkMIC(sNwkSIntKey types.NwkSKey, confFCnt uint32, devAddr types.DevAddr, fCnt uint32, msg []byte) (mic [4]byte, err error) {
+	b0 := block(downlink, devAddr, fCnt, len(msg))
+	binary.LittleEndian.PutUint16(b0[1:3], uint16(confFCnt))
+	cmacS, err := computeCMAC(sNwkSIntKey[:], b0, msg)
+	if err != nil {
+		return mic, err
+	}
+	copy(mic[:], cmacS[:4])
+	return mic, nil
+}
+
+// JoinAcceptMIC returns the LoRaWAN 1.1 MIC of a JoinAccept (with the OptNeg bit set) in response to a JoinRequest
+// (joinReqType 0xFF) or RejoinRequest (joinReqType 0 to 2)
+func JoinAcceptMIC(jsIntKey types.AppKey, joinReqType byte, joinEUI types.AppEUI, devNonce [2]byte, msg []byte) (mic [4]byte, err error) {
+	prefix := make([]byte, 11)
+	prefix[0] = joinReqType
+	binary.LittleEndian.PutUint64(prefix[1:9], binary.BigEndian.Uint64(joinEUI[:]))
+	prefix[9], prefix[10] = devNonce[1], devNonce[0]
+	cmacJS, err := computeCMAC(jsIntKey[:], prefix, msg)
+	if err != nil {
+		return mic, err
+	}
+	copy(mic[:], cmacJS[:4])
+	return mic, nil
+}
@@ -0,0 +1,95 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package mic
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func uplinkPHYPayload(fCnt uint32) lorawan.PHYPayload {
+	fPort := uint8(1)
+	return lorawan.PHYPayload{
+		MHDR: lorawan.MHDR{MType: lorawan.UnconfirmedDataUp, Major: lorawan.LoRaWANR1},
+		MACPayload: &lorawan.MACPayload{
+			FHDR:       lorawan.FHDR{DevAddr: lorawan.DevAddr{0x26, 0x01, 0x23, 0x45}, FCnt: fCnt},
+			FPort:      &fPort,
+			FRMPayload: []lorawan.Payload{&lorawan.DataPayload{Bytes: []byte{0x01, 0x02, 0x03}}},
+		},
+	}
+}
+
+func TestLegacyUplinkMIC(t *testing.T) {
+	a := New(t)
+
+	key := types.NwkSKey{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10}
+	phy := uplinkPHYPayload(0x10042)
+	a.So(phy.SetMIC(lorawan.AES128Key(key)), ShouldBeNil)
+	bytes, _ := phy.MarshalBinary()
+
+	mic, err := LegacyUplinkMIC(key, types.DevAddr{0x26, 0x01, 0x23, 0x45}, 0x10042, bytes[:len(bytes)-4])
+	a.So(err, ShouldBeNil)
+	a.So(mic, ShouldResemble, phy.MIC)
+}
+
+func TestUplinkMIC(t *testing.T) {
+	a := New(t)
+
+	fNwkSIntKey := types.NwkSKey{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10}
+	sNwkSIntKey := types.NwkSKey{0x10, 0x0F, 0x0E, 0x0D, 0x0C, 0x0B, 0x0A, 0x09, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01}
+	devAddr := types.DevAddr{0x26, 0x01, 0x23, 0x45}
+	phy := uplinkPHYPayload(42)
+	bytes, _ := phy.MarshalBinary()
+	msg := bytes[:len(bytes)-4]
+
+	mic, err := UplinkMIC(fNwkSIntKey, sNwkSIntKey, 0, 5, 2, devAddr, 42, msg)
+	a.So(err, ShouldBeNil)
+
+	// The last two bytes can be verified without the SNwkSIntKey
+	legacy, _ := LegacyUplinkMIC(fNwkSIntKey, devAddr, 42, msg)
+	a.So(mic[2:4], ShouldResemble, legacy[0:2])
+
+	// The first two bytes depend on the data rate, channel and confirmed FCnt
+	other, _ := UplinkMIC(fNwkSIntKey, sNwkSIntKey, 0, 4, 2, devAddr, 42, msg)
+	a.So(other[0:2], ShouldNotResemble, mic[0:2])
+	a.So(other[2:4], ShouldResemble, mic[2:4])
+	other, _ = UplinkMIC(fNwkSIntKey, sNwkSIntKey, 0, 5, 3, devAddr, 42, msg)
+	a.So(other[0:2], ShouldNotResemble, mic[0:2])
+	other, _ = UplinkMIC(fNwkSIntKey, sNwkSIntKey, 1, 5, 2, devAddr, 42, msg)
+	a.So(other[0:2], ShouldNotResemble, mic[0:2])
+}
+
+func TestDownlinkMIC(t *testing.T) {
+	a := New(t)
+
+	key := types.NwkSKey{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10}
+	devAddr := types.DevAddr{0x26, 0x01, 0x23, 0x45}
+	msg := []byte{0x60, 0x45, 0x23, 0x01, 0x26, 0x00, 0x2a, 0x00}
+
+	mic, err := DownlinkMIC(key, 0, devAddr, 42, msg)
+	a.So(err, ShouldBeNil)
+	other, _ := DownlinkMIC(key, 0, devAddr, 43, msg)
+	a.So(other, ShouldNotResemble, mic)
+	other, _ = DownlinkMIC(key, 7, devAddr, 42, msg)
+	a.So(other, ShouldNotResemble, mic)
+	again, _ := DownlinkMIC(key, 0, devAddr, 42, msg)
+	a.So(again, ShouldResemble, mic)
+}
+
+func TestJoinAcceptMIC(t *testing.T) {
+	a := New(t)
+
+	key := types.AppKey{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10}
+	msg := []byte{0x20, 0x01, 0x02, 0x03, 0x13, 0x00, 0x00, 0x45, 0x23, 0x01, 0x26, 0x80, 0x01}
+
+	mic, err := JoinAcceptMIC(key, 0xFF, types.AppEUI{1, 2, 3, 4, 5, 6, 7, 8}, [2]byte{0x73, 0x69}, msg)
+	a.So(err, ShouldBeNil)
+	other, _ := JoinAcceptMIC(key, 0xFF, types.AppEUI{1, 2, 3, 4, 5, 6, 7, 8}, [2]byte{0x73, 0x70}, msg)
+	a.So(other, ShouldNotResemble, mic)
+	other, _ = JoinAcceptMIC(key, 0x00, types.AppEUI{1, 2, 3, 4, 5, 6, 7, 8}, [2]byte{0x73, 0x69}, msg)
+	a.So(other, ShouldNotResemble, mic)
+}
@@ -0,0 +1,52 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package otaa
+
+import (
+	"crypto/aes"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/mic"
+	"github.com/brocaar/lorawan"
+)
+
+// MarshalJoinAccept11 marshals a LoRaWAN 1.1 JoinAccept in response to a JoinRequest. The OptNeg bit in the DLSettings
+// indicates that the network implements LoRaWAN 1.1. The MIC is computed with the JSIntKey and the JoinAccept is
+// encrypted with the NwkKey.
+func MarshalJoinAccept11(phy lorawan.PHYPayload, nwkKey types.AppKey, devEUI types.DevEUI, joinEUI types.AppEUI, devNonce [2]byte) ([]byte, error) {
+	mhdr, err := phy.MHDR.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	payload, err := phy.MACPayload.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	payload[10] |= 0x80 // OptNeg
+
+	jsIntKey, _ := CalculateJoinServerKeys(nwkKey, devEUI)
+	msg := make([]byte, 0, len(mhdr)+len(payload))
+	msg = append(msg, mhdr...)
+	msg = append(msg, payload...)
+	joinAcceptMIC, err := mic.JoinAcceptMIC(jsIntKey, 0xFF, joinEUI, devNonce, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// The JoinAccept is encrypted with an AES decrypt operation, so that the device only needs to implement encryption
+	encrypted := append(payload, joinAcceptMIC[:]...)
+	if len(encrypted)%aes.BlockSize != 0 {
+		return nil, errors.NewErrInvalidArgument("JoinAccept", "invalid length")
+	}
+	block, err := aes.NewCipher(nwkKey[:])
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(encrypted); i += aes.BlockSize {
+		block.Decrypt(encrypted[i:i+aes.BlockSize], encrypted[i:i+aes.BlockSize])
+	}
+
+	return append(mhdr, encrypted...), nil
+}
@@ -0,0 +1,43 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package otaa
+
+import (
+	"crypto/aes"
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/mic"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestMarshalJoinAccept11(t *testing.T) {
+	a := New(t)
+
+	nwkKey := types.AppKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+	devEUI := types.DevEUI{1, 2, 3, 4, 5, 6, 7, 8}
+	joinEUI := types.AppEUI{8, 7, 6, 5, 4, 3, 2, 1}
+	devNonce := [2]byte{1, 2}
+
+	phy := lorawan.PHYPayload{
+		MHDR: lorawan.MHDR{MType: lorawan.JoinAccept, Major: lorawan.LoRaWANR1},
+		MACPayload: &lorawan.JoinAcceptPayload{
+			AppNonce: [3]byte{0, 0, 1},
+			DevAddr:  lorawan.DevAddr{1, 2, 3, 4},
+		},
+	}
+	payload, err := MarshalJoinAccept11(phy, nwkKey, devEUI, joinEUI, devNonce)
+	a.So(err, ShouldBeNil)
+	a.So(payload, ShouldHaveLength, 17)
+
+	block, _ := aes.NewCipher(nwkKey[:])
+	decrypted := make([]byte, 16)
+	block.Encrypt(decrypted, payload[1:])
+	a.So(decrypted[10]&0x80, ShouldNotEqual, 0) // OptNeg
+
+	jsIntKey, _ := CalculateJoinServerKeys(nwkKey, devEUI)
+	expected, _ := mic.JoinAcceptMIC(jsIntKey, 0xFF, joinEUI, devNonce, append([]byte{payload[0]}, decrypted[:12]...))
+	a.So(decrypted[12:], ShouldResemble, expected[:])
+}
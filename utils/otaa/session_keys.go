@@ -28,6 +28,49 @@ func CalculateSessionKeys(appKey types.AppKey, appNonce [3]byte, netID [3]byte,
 	return
 }
 
+// CalculateSessionKeys11 calculates the LoRaWAN 1.1 session keys. The AppSKey is derived from the AppKey, the
+// FNwkSIntKey, SNwkSIntKey and NwkSEncKey are derived from the NwkKey.
+// All arguments are MSB-first
+func CalculateSessionKeys11(nwkKey types.AppKey, appKey types.AppKey, joinNonce [3]byte, joinEUI types.AppEUI, devNonce [2]byte) (appSKey types.AppSKey, fNwkSIntKey, sNwkSIntKey, nwkSEncKey types.NwkSKey, err error) {
+
+	buf := make([]byte, 16)
+	copy(buf[1:4], reverse(joinNonce[:]))
+	copy(buf[4:12], reverse(joinEUI[:]))
+	copy(buf[12:14], reverse(devNonce[:]))
+
+	nwkBlock, _ := aes.NewCipher(nwkKey[:])
+	appBlock, _ := aes.NewCipher(appKey[:])
+
+	buf[0] = 0x1
+	nwkBlock.Encrypt(fNwkSIntKey[:], buf)
+	buf[0] = 0x2
+	appBlock.Encrypt(appSKey[:], buf)
+	buf[0] = 0x3
+	nwkBlock.Encrypt(sNwkSIntKey[:], buf)
+	buf[0] = 0x4
+	nwkBlock.Encrypt(nwkSEncKey[:], buf)
+
+	return
+}
+
+// CalculateJoinServerKeys calculates the LoRaWAN 1.1 JSIntKey (used for the MIC of the JoinAccept) and JSEncKey
+// (used for encrypting JoinAccepts in response to a RejoinRequest)
+// All arguments are MSB-first
+func CalculateJoinServerKeys(nwkKey types.AppKey, devEUI types.DevEUI) (jsIntKey, jsEncKey types.AppKey) {
+
+	buf := make([]byte, 16)
+	copy(buf[1:9], reverse(devEUI[:]))
+
+	block, _ := aes.NewCipher(nwkKey[:])
+
+	buf[0] = 0x5
+	block.Encrypt(jsEncKey[:], buf)
+	buf[0] = 0x6
+	block.Encrypt(jsIntKey[:], buf)
+
+	return
+}
+
 // reverse is used to convert between MSB-first and LSB-first
 func reverse(in []byte) (out []byte) {
 	for i := len(in) - 1; i >= 0; i-- {
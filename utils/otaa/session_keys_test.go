@@ -29,3 +29,40 @@ func TestCalculateSessionKeys(t *testing.T) {
 	a.So(appSKey, ShouldResemble, expectedAppSKey)
 	a.So(nwkSKey, ShouldResemble, expectedNwkSKey)
 }
+
+func TestCalculateSessionKeys11(t *testing.T) {
+	a := New(t)
+
+	nwkKey := types.AppKey{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10}
+	appKey := types.AppKey{0xBE, 0xC4, 0x99, 0xC6, 0x9E, 0x9C, 0x93, 0x9E, 0x41, 0x3B, 0x66, 0x39, 0x61, 0x63, 0x6C, 0x61}
+	joinEUI := types.AppEUI{0x70, 0xB3, 0xD5, 0x7E, 0xF0, 0x00, 0x00, 0x01}
+	devNonce := [2]byte{0x73, 0x69}
+	joinNonce := [3]byte{0xAE, 0x3B, 0x1C}
+
+	appSKey, fNwkSIntKey, sNwkSIntKey, nwkSEncKey, err := CalculateSessionKeys11(nwkKey, appKey, joinNonce, joinEUI, devNonce)
+	a.So(err, ShouldBeNil)
+	a.So(fNwkSIntKey, ShouldNotResemble, sNwkSIntKey)
+	a.So(fNwkSIntKey, ShouldNotResemble, nwkSEncKey)
+	a.So(sNwkSIntKey, ShouldNotResemble, nwkSEncKey)
+
+	// The AppSKey only depends on the AppKey, the network session keys only on the NwkKey
+	otherAppSKey, otherFNwkSIntKey, _, _, _ := CalculateSessionKeys11(appKey, appKey, joinNonce, joinEUI, devNonce)
+	a.So(otherAppSKey, ShouldResemble, appSKey)
+	a.So(otherFNwkSIntKey, ShouldNotResemble, fNwkSIntKey)
+	_, otherFNwkSIntKey, _, _, _ = CalculateSessionKeys11(nwkKey, nwkKey, joinNonce, joinEUI, devNonce)
+	a.So(otherFNwkSIntKey, ShouldResemble, fNwkSIntKey)
+
+	// The JoinEUI is part of the derivation
+	otherAppSKey, _, _, _, _ = CalculateSessionKeys11(nwkKey, appKey, joinNonce, types.AppEUI{}, devNonce)
+	a.So(otherAppSKey, ShouldNotResemble, appSKey)
+}
+
+func TestCalculateJoinServerKeys(t *testing.T) {
+	a := New(t)
+
+	nwkKey := types.AppKey{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10}
+	jsIntKey, jsEncKey := CalculateJoinServerKeys(nwkKey, types.DevEUI{1, 2, 3, 4, 5, 6, 7, 8})
+	a.So(jsIntKey, ShouldNotResemble, jsEncKey)
+	otherJSIntKey, _ := CalculateJoinServerKeys(nwkKey, types.DevEUI{8, 7, 6, 5, 4, 3, 2, 1})
+	a.So(otherJSIntKey, ShouldNotResemble, jsIntKey)
+}
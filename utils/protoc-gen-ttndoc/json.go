@@ -44,6 +44,13 @@ var exampleValues = map[string]interface{}{
 	".lorawan.Device.dev_id":                  "some-dev-id",
 	".lorawan.Device.nwk_s_key":               "01020304050607080102030405060708",
 	".lorawan.Device.uses32_bit_f_cnt":        true,
+
+	".networkserver.*.description":                              "Some description of the profile",
+	".networkserver.*.profile_id":                               "some-profile-id",
+	".networkserver.DeviceProfile.regional_parameters_revision": "1.0.2-rB",
+	".networkserver.DeviceProfile.supports_join":                true,
+	".networkserver.DeviceProfile.uses32_bit_f_cnt":             true,
+	".networkserver.ServiceProfile.activation_constraints":      "local",
 }
 
 func (m *message) MapExample(tree *tree) map[string]interface{} {